@@ -0,0 +1,168 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// listenFDsStart is the first file descriptor passed by systemd socket activation
+const listenFDsStart = 3
+
+// listen starts the server using the listen mode selected in configuration.
+// Precedence: systemd socket activation, Unix socket, then TCP (optionally TLS).
+func listen(app *fiber.App, cfg *config.Config) error {
+	if cfg.Server.SystemdActivation {
+		ln, err := systemdListener()
+		if err != nil {
+			return err
+		}
+		if ln != nil {
+			logger.Info("Using systemd socket activation", zap.String("address", ln.Addr().String()))
+			return app.Listener(ln)
+		}
+	}
+
+	if cfg.Server.UnixSocket != "" {
+		ln, err := unixListener(cfg.Server.UnixSocket, cfg.Server.UnixSocketMode)
+		if err != nil {
+			return err
+		}
+		logger.Info("Listening on Unix socket", zap.String("path", cfg.Server.UnixSocket))
+		return app.Listener(ln)
+	}
+
+	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
+	tlsCfg := cfg.Server.TLS
+
+	if !tlsCfg.Enabled {
+		return app.Listen(addr)
+	}
+
+	var manager *autocert.Manager
+	if tlsCfg.Autocert {
+		if len(tlsCfg.Hosts) == 0 {
+			return fmt.Errorf("tls autocert requires at least one host")
+		}
+		manager = &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(tlsCfg.Hosts...),
+			Cache:      autocert.DirCache(tlsCfg.CacheDir),
+			Email:      tlsCfg.Email,
+		}
+	}
+
+	if tlsCfg.RedirectHTTP {
+		go serveHTTPRedirect(cfg, manager)
+	}
+
+	if manager != nil {
+		logger.Info("Using Let's Encrypt certificates", zap.Strings("hosts", tlsCfg.Hosts))
+		// fasthttp only speaks HTTP/1.1, so don't offer h2 in ALPN
+		tlsConfig := manager.TLSConfig()
+		tlsConfig.NextProtos = []string{"http/1.1", acme.ALPNProto}
+		ln, err := tls.Listen("tcp", addr, tlsConfig)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", addr, err)
+		}
+		return app.Listener(ln)
+	}
+
+	if tlsCfg.CertFile == "" || tlsCfg.KeyFile == "" {
+		return fmt.Errorf("tls requires cert_file and key_file, or autocert")
+	}
+	return app.ListenTLS(addr, tlsCfg.CertFile, tlsCfg.KeyFile)
+}
+
+// serveHTTPRedirect redirects plain HTTP to HTTPS and answers ACME HTTP-01 challenges
+func serveHTTPRedirect(cfg *config.Config, manager *autocert.Manager) {
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		host, _, err := net.SplitHostPort(r.Host)
+		if err != nil {
+			host = r.Host
+		}
+		if cfg.Server.Port != 443 {
+			host = net.JoinHostPort(host, strconv.Itoa(cfg.Server.Port))
+		}
+		http.Redirect(w, r, "https://"+host+r.URL.RequestURI(), http.StatusMovedPermanently)
+	})
+	if manager != nil {
+		handler = manager.HTTPHandler(handler)
+	}
+
+	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.TLS.HTTPPort)
+	logger.Info("HTTP redirect listener starting", zap.String("address", addr))
+
+	srv := &http.Server{
+		Addr:        addr,
+		Handler:     handler,
+		ReadTimeout: cfg.Server.ReadTimeout,
+	}
+	if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Error("HTTP redirect listener failed", zap.Error(err))
+	}
+}
+
+// systemdListener returns the first socket passed by systemd, or nil when not socket-activated
+func systemdListener() (net.Listener, error) {
+	if os.Getenv("LISTEN_PID") != strconv.Itoa(os.Getpid()) {
+		return nil, nil
+	}
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || n < 1 {
+		return nil, nil
+	}
+
+	// Don't pass the sockets on to child processes
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+
+	f := os.NewFile(uintptr(listenFDsStart), "systemd-socket")
+	defer f.Close()
+
+	ln, err := net.FileListener(f)
+	if err != nil {
+		return nil, fmt.Errorf("failed to use systemd socket: %w", err)
+	}
+	return ln, nil
+}
+
+// unixListener listens on a Unix domain socket, replacing a stale socket file
+func unixListener(path, mode string) (net.Listener, error) {
+	if info, err := os.Stat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale socket: %w", err)
+		}
+	}
+
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s: %w", path, err)
+	}
+
+	if mode != "" {
+		perm, err := strconv.ParseUint(mode, 8, 32)
+		if err != nil {
+			ln.Close()
+			return nil, fmt.Errorf("invalid unix_socket_mode %q: %w", mode, err)
+		}
+		if err := os.Chmod(path, os.FileMode(perm)); err != nil {
+			ln.Close()
+			return nil, fmt.Errorf("failed to set socket permissions: %w", err)
+		}
+	}
+
+	return ln, nil
+}
@@ -1,25 +1,79 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 
+	"github.com/resume-rag/backend/internal/analytics"
 	"github.com/resume-rag/backend/internal/api"
 	"github.com/resume-rag/backend/internal/api/handlers"
 	"github.com/resume-rag/backend/internal/api/middleware"
+	"github.com/resume-rag/backend/internal/audit"
+	"github.com/resume-rag/backend/internal/backup"
+	"github.com/resume-rag/backend/internal/cache"
+	"github.com/resume-rag/backend/internal/changes"
+	"github.com/resume-rag/backend/internal/checklist"
+	"github.com/resume-rag/backend/internal/cleanup"
+	"github.com/resume-rag/backend/internal/commute"
 	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/contacts"
+	"github.com/resume-rag/backend/internal/deadline"
+	"github.com/resume-rag/backend/internal/digest"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/enrichment"
+	"github.com/resume-rag/backend/internal/favorites"
+	"github.com/resume-rag/backend/internal/feed"
+	"github.com/resume-rag/backend/internal/geo"
+	"github.com/resume-rag/backend/internal/goals"
+	"github.com/resume-rag/backend/internal/llm"
+	"github.com/resume-rag/backend/internal/mailcheck"
+	"github.com/resume-rag/backend/internal/mailmerge"
+	"github.com/resume-rag/backend/internal/mlclient"
+	"github.com/resume-rag/backend/internal/notify"
+	"github.com/resume-rag/backend/internal/operations"
+	"github.com/resume-rag/backend/internal/outreach"
+	"github.com/resume-rag/backend/internal/pii"
+	"github.com/resume-rag/backend/internal/practice"
+	"github.com/resume-rag/backend/internal/push"
+	"github.com/resume-rag/backend/internal/quality"
+	"github.com/resume-rag/backend/internal/questions"
+	"github.com/resume-rag/backend/internal/rag"
+	"github.com/resume-rag/backend/internal/ranking"
+	"github.com/resume-rag/backend/internal/readiness"
+	"github.com/resume-rag/backend/internal/redact"
+	"github.com/resume-rag/backend/internal/repository"
+	"github.com/resume-rag/backend/internal/resumes"
+	"github.com/resume-rag/backend/internal/retention"
+	"github.com/resume-rag/backend/internal/scoring"
+	"github.com/resume-rag/backend/internal/scrapeprofiles"
+	"github.com/resume-rag/backend/internal/scraper"
+	"github.com/resume-rag/backend/internal/secrets"
+	"github.com/resume-rag/backend/internal/share"
+	"github.com/resume-rag/backend/internal/stats"
+	"github.com/resume-rag/backend/internal/storage"
+	"github.com/resume-rag/backend/internal/stories"
+	"github.com/resume-rag/backend/internal/tailor"
+	"github.com/resume-rag/backend/internal/taskevents"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/internal/transcribe"
+	"github.com/resume-rag/backend/internal/vectorstore"
+	"github.com/resume-rag/backend/migrations"
 	"github.com/resume-rag/backend/pkg/logger"
 )
 
 func main() {
 	// Parse flags
 	configPath := flag.String("config", "", "Path to config file")
+	migrate := flag.Bool("migrate", false, "Apply pending database migrations before starting")
 	flag.Parse()
 
 	// Load configuration
@@ -32,6 +86,9 @@ func main() {
 	// Initialize logger
 	logger.Init(cfg.Server.Debug)
 	defer logger.Sync()
+	if cfg.Privacy.ScrubPII {
+		logger.SetScrubber(pii.Scrub)
+	}
 
 	logger.Info("Starting ResumeAI API",
 		zap.String("version", "2.0.0"),
@@ -39,28 +96,473 @@ func main() {
 	)
 
 	// Create Fiber app
-	app := fiber.New(fiber.Config{
+	fiberCfg := fiber.Config{
 		AppName:               "ResumeAI API v2.0.0",
 		ReadTimeout:           cfg.Server.ReadTimeout,
 		WriteTimeout:          cfg.Server.WriteTimeout,
 		DisableStartupMessage: !cfg.Server.Debug,
 		ErrorHandler:          errorHandler,
-	})
+	}
+
+	// Spoken practice answers can be larger than the default body limit
+	if cfg.Transcription.Enabled && cfg.Transcription.MaxAudioBytes > fiber.DefaultBodyLimit {
+		fiberCfg.BodyLimit = cfg.Transcription.MaxAudioBytes + 1<<20
+	}
+
+	// Only honor forwarded headers from configured proxies. Client IPs are
+	// resolved by middleware.ResolveClientIP, since Fiber's c.IP() takes the
+	// leftmost forwarded address, which the client controls.
+	if len(cfg.Server.TrustedProxies) > 0 {
+		fiberCfg.EnableTrustedProxyCheck = true
+		fiberCfg.TrustedProxies = cfg.Server.TrustedProxies
+	}
+
+	app := fiber.New(fiberCfg)
 
 	// Setup middleware
-	middleware.Setup(app, cfg)
+	payloadLogger := middleware.Setup(app, cfg)
+
+	// Background workers stop when ctx is cancelled on shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// File storage
+	store, err := storage.New(ctx, cfg.Storage)
+	if err != nil {
+		logger.Fatal("Failed to initialize storage", zap.Error(err))
+	}
+
+	// H1B filing data for visa sponsorship flags
+	h1b := enrichment.NewH1BIndex(cfg.Enrichment.H1B)
+	if path := cfg.Enrichment.H1B.DatasetPath; path != "" {
+		if employers, err := h1b.ImportFile(path); err != nil {
+			logger.Warn("Failed to import H1B dataset", zap.String("path", path), zap.Error(err))
+		} else {
+			logger.Info("Imported H1B dataset", zap.Int("employers", employers))
+		}
+	}
+
+	// Company ratings, from bulk import and periodic refresh
+	ratings, err := newRatingStore(cfg.Enrichment.Ratings)
+	if err != nil {
+		logger.Fatal("Failed to initialize company ratings", zap.Error(err))
+	}
+	if path := cfg.Enrichment.Ratings.ImportPath; path != "" {
+		if imported, err := ratings.ImportFile(path); err != nil {
+			logger.Warn("Failed to import company ratings", zap.String("path", path), zap.Error(err))
+		} else {
+			logger.Info("Imported company ratings", zap.Int("companies", imported))
+		}
+	}
+	ratings.Start(ctx)
+
+	// Market salary benchmarks from a public salary survey
+	salaries := enrichment.NewSalaryBenchmarks(cfg.Enrichment.Salaries)
+	if path := cfg.Enrichment.Salaries.DatasetPath; path != "" {
+		if roles, err := salaries.ImportFile(path); err != nil {
+			logger.Warn("Failed to import salary survey", zap.String("path", path), zap.Error(err))
+		} else {
+			logger.Info("Imported salary survey", zap.Int("roles", roles))
+		}
+	}
+
+	// Geocoder for job locations and the commute home address (nil when disabled)
+	geocoder, err := geo.New(cfg.Geo)
+	if err != nil {
+		logger.Fatal("Failed to initialize geocoder", zap.Error(err))
+	}
+
+	// PostgreSQL, with each connection scoped to the request's tenant
+	pool, err := newDatabase(ctx, cfg.Database.Postgres)
+	if err != nil {
+		if *migrate {
+			logger.Fatal("Failed to connect to PostgreSQL to apply migrations", zap.Error(err))
+		}
+		// The in-memory stores used without a database are shared by all
+		// tenants; only row-level security keeps tenant data apart
+		if cfg.Tenancy.Enabled {
+			logger.Fatal("Failed to connect to PostgreSQL, which tenancy requires", zap.Error(err))
+		}
+		logger.Warn("Failed to connect to PostgreSQL; job list data will not be persisted", zap.Error(err))
+	} else {
+		defer pool.Close()
+	}
+	if *migrate {
+		applied, err := migrations.Up(ctx, pool)
+		if err != nil {
+			logger.Fatal("Failed to apply database migrations", zap.Error(err))
+		}
+		logger.Info("Database schema is up to date", zap.Int("applied", len(applied)))
+	}
+
+	// Data retention worker
+	var retentionWorker *retention.Worker
+	if cfg.Retention.Enabled {
+		retentionWorker = retention.NewWorker(cfg.Retention.Interval,
+			retention.DefaultTargets(cfg.Retention, pool, store, tenant.IDs(cfg.Tenancy))...)
+		retentionWorker.Start(ctx)
+	}
+
+	// ML service for embeddings and reranking; the connection is made lazily
+	// and closed once the server has shut down
+	mlClient, err := mlclient.New(cfg.MLService)
+	if err != nil {
+		logger.Fatal("Failed to create ML service client", zap.Error(err))
+	}
+	defer mlClient.Close()
 
 	// Create placeholder services (will be replaced with real implementations)
 	deps := &api.Dependencies{
-		DB:               nil, // TODO: Connect to PostgreSQL
-		MLClient:         nil, // TODO: Connect to ML service via gRPC
-		ChatService:      &handlers.PlaceholderChatService{},
+		MLClient:         mlClient,
 		AnalyzerService:  nil,
 		JobMatchService:  nil,
 		InterviewService: nil,
 		EmailService:     nil,
+		EmailSender:      nil, // TODO: SMTP/Gmail sender
 		JobListService:   &handlers.PlaceholderJobListService{},
+		Cache:            cache.New(cfg.Cache),
+		PayloadLogger:    payloadLogger,
+		Storage:          store,
+		Audit:            audit.NewMemoryStore(10000),
+		H1B:              h1b,
+		Ratings:          ratings,
+		SalaryBenchmarks: salaries,
+		LLMQueue:         llm.NewLimiter(cfg.LLM.Queue),
+		Operations:       operations.NewRegistry(),
+	}
+
+	// Shared timeline of scrapes, matching, digests and cleanups
+	var taskEventStore taskevents.Store = taskevents.NewMemoryStore()
+	if pool != nil {
+		taskEventStore = taskevents.NewPostgresStore(pool)
+	}
+	taskEvents := taskevents.NewLog(taskEventStore)
+	deps.TaskEvents = taskEvents
+
+	// Notification preferences; held notifications go out once quiet hours
+	// end (TODO: register an email sender once SMTP delivery is configured)
+	var notificationStore notify.Store = notify.NewMemoryStore()
+	if pool != nil {
+		notificationStore = notify.NewPostgresStore(pool)
+	}
+	notifications := notify.NewService(notificationStore)
+	notifications.Start(ctx, time.Minute)
+	deps.Notifications = notifications
+
+	// Web Push to subscribed browsers, the notifications' push channel
+	if cfg.Push.Enabled {
+		var pushStore push.Store = push.NewMemoryStore()
+		if pool != nil {
+			pushStore = push.NewPostgresStore(pool)
+		}
+		pushService, err := push.NewService(pushStore, cfg.Push)
+		if err != nil {
+			logger.Fatal("Failed to initialize push notifications", zap.Error(err))
+		}
+		notifications.AddSender(domain.NotificationPush, pushService)
+		deps.Push = pushService
+	}
+
+	var jobRepo *repository.JobListService
+	var systemStats *stats.PostgresSystemStats
+	if pool != nil {
+		deps.DB = pool
+		jobRepo = repository.NewJobListService(pool, cfg.Quality.MinScore)
+		jobRepo.SetTaskEvents(taskEvents)
+		jobRepo.SetGeocoder(geocoder, cfg.Geo.DefaultRadiusKm)
+		deps.JobListService = jobRepo
+		deps.Audit = audit.NewPostgresStore(pool)
+		deps.Stats = stats.NewPostgresStats(pool)
+		systemStats = stats.NewPostgresSystemStats(pool)
+		deps.SystemStats = systemStats
+		deps.Insights = analytics.NewPostgresInsights(pool)
+
+		// Per-source credibility weights scale match scores when ranking
+		weights := ranking.NewSourceWeights(cfg.Scraping)
+		jobRepo.SetSourceWeights(weights)
+		deps.SourceWeights = weights
+	}
+
+	if cfg.Privacy.ScrubPII {
+		deps.Audit = audit.NewScrubbingStore(deps.Audit)
+	}
+
+	// Record job and application writes for sync clients before other
+	// services take the job list service
+	var journal changes.Journal = changes.NewMemoryJournal(changes.DefaultJournalSize)
+	if pool != nil {
+		journal = changes.NewPostgresJournal(pool)
+	}
+	deps.JobListService = handlers.NewJournaledJobListService(deps.JobListService, journal)
+	deps.Sync = changes.NewService(journal, deps.JobListService)
+	if jobRepo != nil {
+		cleanupRunner := cleanup.NewRunner(jobRepo, journal, deps.Operations)
+		cleanupRunner.SetTaskEvents(taskEvents)
+		deps.JobCleanup = cleanupRunner
+
+		// Named scrape presets, queued on request or on their schedule
+		profiles := scrapeprofiles.NewService(scrapeprofiles.NewPostgresStore(pool), jobRepo)
+		deps.ScrapeProfiles = profiles
+		scrapeprofiles.NewScheduler(profiles, cfg.Scraping.ProfileCheckInterval, tenant.IDs(cfg.Tenancy)).Start(ctx)
+	}
+
+	// Strip personal details from resumes sent to LLM backends, by backend trust level
+	redactor, err := redact.NewRedactor(cfg.LLM.Redaction, cfg.LLM.DefaultBackend)
+	if err != nil {
+		logger.Fatal("Invalid LLM redaction config", zap.Error(err))
+	}
+	deps.Redaction = redactor
+
+	// Chat answers from the resume chunks indexed in Qdrant, reranked by the
+	// ML service cross-encoder unless disabled
+	vectors := vectorstore.New(cfg.Database.Qdrant)
+	resumeIndex := rag.NewResumeIndex(mlClient, vectors, cfg.Chat.ChunkSize)
+	var reranker rag.Reranker
+	if cfg.Chat.Rerank {
+		reranker = mlClient
+	}
+	llmClient := llm.NewClient(cfg.LLM)
+	llmClient.SetFallbackGuard(redactor.AllowsFallback)
+	if systemStats != nil {
+		llmClient.SetUsageRecorder(systemStats)
+	}
+	deps.LLMHealth = llmClient
+	var chatHistory rag.HistoryStore = rag.NewMemoryHistory()
+	if pool != nil {
+		chatHistory = rag.NewPostgresHistory(pool)
 	}
+	deps.ChatService = rag.NewChatService(resumeIndex, reranker, llmClient, redactor, chatHistory, cfg.Chat)
+	deps.Resume = resumeIndex
+	deps.ResumeIndex = resumeIndex
+
+	if jobRepo != nil {
+		// Queued scrapes run in the background; enrichers fill in company,
+		// deadline, contact and stack details before jobs are stored, then
+		// stored jobs are scored against the resume
+		registry, closeBrowser := newScraperRegistry(cfg.Scraping, geocoder)
+		defer closeBrowser()
+		registry.AddEnricher(h1b)
+		registry.AddEnricher(ratings)
+		registry.AddEnricher(deadline.NewExtractor())
+		registry.AddEnricher(contacts.NewExtractor())
+		registry.AddEnricher(analytics.NewStackDetector())
+
+		// Recruiter spam, ghost jobs and vague postings get a quality score;
+		// jobs stored before scoring was enabled are scored once at startup
+		classifier := quality.NewClassifier(cfg.Quality, nil)
+		registry.AddEnricher(classifier)
+		go func() {
+			scored, err := quality.NewPostgresStore(pool).Backfill(ctx, classifier)
+			if err != nil {
+				logger.Warn("Failed to backfill job quality scores", zap.Error(err))
+			} else if scored > 0 {
+				logger.Info("Backfilled job quality scores", zap.Int("jobs", scored))
+			}
+		}()
+
+		// Jobs stored before URLs were canonicalized at ingest are rewritten
+		// once, so re-scrapes match them by URL
+		go func() {
+			changed, err := jobRepo.CanonicalizeURLs(ctx)
+			if err != nil {
+				logger.Warn("Failed to canonicalize stored job URLs", zap.Error(err))
+			} else if changed > 0 {
+				logger.Info("Canonicalized stored job URLs", zap.Int("jobs", changed))
+			}
+		}()
+
+		scorer := scoring.NewScorer(mlClient, resumeIndex, cfg.Scoring)
+		scorer.SetTaskEvents(taskEvents)
+		scorer.SetStore(scoring.NewPostgresStore(pool))
+
+		scrapeWorker := scraper.NewWorker(registry, jobRepo, scraper.DefaultWorkerInterval)
+		scrapeWorker.OnStored(analytics.NewSkillRecorder(pool))
+		scrapeWorker.OnStored(scorer)
+		// Saved searches with notifications enabled are alerted about the new
+		// jobs, for every tenant, whether or not responses are cached
+		scrapeWorker.OnStored(scraper.EnricherFunc(func(ctx context.Context, jobs []*domain.Job) {
+			if len(jobs) == 0 {
+				return
+			}
+			for _, id := range tenant.IDs(cfg.Tenancy) {
+				tctx := tenant.WithTenant(ctx, config.TenantConfig{ID: id})
+				if _, err := notifications.AlertSavedSearches(tctx, jobRepo, time.Now()); err != nil {
+					logger.Warn("Failed to alert saved searches", zap.String("tenant", id), zap.Error(err))
+				}
+			}
+		}))
+		// Cached search and stats responses go stale once new jobs are stored
+		scrapeWorker.OnStored(scraper.EnricherFunc(func(ctx context.Context, jobs []*domain.Job) {
+			if len(jobs) > 0 {
+				deps.Cache.Emit(cache.EventScrapeCompleted)
+			}
+		}))
+		scrapeWorker.Start(ctx)
+	}
+
+	// Named resume versions, linked from applications by their resume_version label
+	var resumeStore resumes.Store = resumes.NewMemoryStore()
+	if pool != nil {
+		resumeStore = resumes.NewPostgresStore(pool)
+	}
+	deps.ResumeVersions = resumes.NewService(resumeStore, resumeIndex)
+
+	// Portable archives of the database, vector collections and files
+	deps.Backup = backup.NewService(pool, vectors, store, tenant.IDs(cfg.Tenancy))
+
+	// Interview questions reported per company
+	var questionStore questions.Store = questions.NewMemoryStore()
+	if pool != nil {
+		questionStore = questions.NewPostgresStore(pool)
+	}
+	companyQuestions := questions.NewService(questionStore)
+	if path := cfg.Interview.CompanyQuestionsPath; path != "" {
+		if imported, err := companyQuestions.ImportFile(path); err != nil {
+			logger.Warn("Failed to import company interview questions", zap.String("path", path), zap.Error(err))
+		} else {
+			logger.Info("Imported company interview questions", zap.Int("questions", imported))
+		}
+	}
+	deps.Questions = companyQuestions
+
+	// Batch cold outreach drafts, with company facts from the enrichment data
+	var researcher mailmerge.Researcher
+	if deps.InterviewService != nil {
+		researcher = deps.InterviewService
+	}
+	deps.MailMerge = mailmerge.NewMerger(mailcheck.NewChecker(store), researcher, h1b, ratings)
+	var variantStore tailor.Store = tailor.NewMemoryStore()
+	if pool != nil {
+		variantStore = tailor.NewPostgresStore(pool)
+	}
+	deps.ResumeVariants = tailor.NewService(tailor.NewTailorer(nil), variantStore, deps.JobListService)
+	deps.ResumeTailor = tailor.NewAdvisor(llmClient, redactor, deps.JobListService)
+	if retentionWorker != nil {
+		deps.Retention = retentionWorker
+	}
+
+	// Per-application checklists, read-only share links, starred jobs and
+	// the story bank
+	var (
+		checklistStore checklist.Store = checklist.NewMemoryStore()
+		shareStore     share.Store     = share.NewMemoryStore()
+		favoriteStore  favorites.Store = favorites.NewMemoryStore()
+		storyStore     stories.Store   = stories.NewMemoryStore()
+	)
+	if pool != nil {
+		checklistStore = checklist.NewPostgresStore(pool)
+		shareStore = share.NewPostgresStore(pool)
+		favoriteStore = favorites.NewPostgresStore(pool)
+		storyStore = stories.NewPostgresStore(pool)
+	}
+	deps.Checklists = checklist.NewService(checklistStore, deps.JobListService, cfg.Checklists)
+	deps.Share = share.NewService(shareStore, deps.JobListService)
+	deps.Favorites = favorites.NewService(favoriteStore, deps.JobListService)
+	deps.Stories = stories.NewBank(storyStore)
+
+	// Sensitive fields encrypted at rest
+	keyring, err := secrets.NewKeyring(cfg.Encryption)
+	if err != nil {
+		logger.Fatal("Failed to initialize encryption keys", zap.Error(err))
+	}
+	// Values encrypted under a temporary key can't be read after a restart,
+	// so it is only allowed while nothing encrypted is persisted
+	if keyring.Temporary() {
+		if pool != nil {
+			logger.Fatal("Encryption keys are required when a database is configured; set encryption.keys or ENCRYPTION_KEY")
+		}
+		logger.Warn("No encryption key configured; using a temporary key, encrypted values will not survive a restart")
+	}
+	var credentialStore secrets.CredentialStore = secrets.NewMemoryCredentialStore()
+	if pool != nil {
+		credentialStore = secrets.NewPostgresCredentialStore(pool)
+	}
+	credentials := secrets.NewCredentials(credentialStore, keyring)
+	deps.Credentials = credentials
+	rotationTargets := []secrets.RotationTarget{credentials}
+	if pool != nil {
+		rotationTargets = append(rotationTargets, secrets.NewContactFields(pool))
+	}
+	deps.KeyRotator = secrets.NewRotator(keyring, rotationTargets...)
+
+	// Tokens for saved search feeds
+	feedTokens, err := feed.NewSigner(cfg.Feeds.SigningKey)
+	if err != nil {
+		logger.Fatal("Failed to initialize feed tokens", zap.Error(err))
+	}
+	deps.FeedTokens = feedTokens
+
+	// Commute times from the saved home location
+	if cfg.Commute.Enabled {
+		router, err := commute.NewRouter(cfg.Commute)
+		if err != nil {
+			logger.Fatal("Failed to initialize commute routing", zap.Error(err))
+		}
+		var homeStore commute.Store = commute.NewMemoryStore()
+		if pool != nil {
+			homeStore = commute.NewPostgresStore(pool)
+		}
+		commuteService := commute.NewService(cfg.Commute, router, geocoder, homeStore)
+		if err := commuteService.SetDefaultHome(ctx, cfg.Commute.Home); err != nil {
+			logger.Warn("Failed to geocode the configured commute home", zap.Error(err))
+		}
+		deps.Commute = commuteService
+		if jobRepo != nil {
+			jobRepo.SetCommute(commuteService)
+		}
+	}
+
+	// Spaced-repetition review of practice questions
+	var reviewStore practice.Store = practice.NewMemoryStore()
+	if pool != nil {
+		reviewStore = practice.NewPostgresStore(pool)
+	}
+	reviews := practice.NewScheduler(reviewStore)
+	deps.Practice = reviews
+
+	// Job search goals, measured from application and practice activity
+	var goalStore goals.Store = goals.NewMemoryStore()
+	if pool != nil {
+		goalStore = goals.NewPostgresStore(pool)
+	}
+	deps.Goals = goals.NewTracker(deps.JobListService, reviews, goalStore)
+
+	// Speech-to-text for spoken practice answers
+	transcriber, err := transcribe.New(cfg.Transcription, cfg.LLM)
+	if err != nil {
+		logger.Fatal("Failed to initialize transcription", zap.Error(err))
+	}
+	if transcriber != nil {
+		deps.Transcriber = transcriber
+	}
+
+	// Weekly digest, archived to storage (email delivery needs a digest.Sender)
+	digestWorker := digest.NewWorker(digest.NewBuilder(deps.JobListService, cfg.Digest), cfg.Digest.Period, store, nil, tenant.IDs(cfg.Tenancy))
+	digestWorker.SetTaskEvents(taskEvents)
+	digestWorker.SetNotifier(notifications)
+	if cfg.Digest.Enabled {
+		digestWorker.Start(ctx)
+	}
+	deps.Digest = digestWorker
+
+	// Reminders for saved jobs nearing their application deadline
+	if cfg.Deadlines.Enabled {
+		deadline.NewReminderWorker(deps.JobListService, notifications, cfg.Deadlines, tenant.IDs(cfg.Tenancy)).Start(ctx)
+	}
+
+	// Follow-up email sequences
+	if cfg.Outreach.Enabled {
+		var outreachStore outreach.Store = outreach.NewMemoryStore()
+		if pool != nil {
+			outreachStore = outreach.NewPostgresStore(pool)
+		}
+		outreachService := outreach.NewService(outreachStore, deps.JobListService, deps.EmailService, cfg.Outreach, tenant.IDs(cfg.Tenancy))
+		outreachService.Start(ctx)
+		deps.Outreach = outreachService
+	}
+
+	// Per-capability readiness; features degrade when their dependency is down
+	deps.Readiness = newReadiness(cfg, deps, vectors)
 
 	// Setup routes
 	api.SetupRoutes(app, cfg, deps)
@@ -79,14 +581,84 @@ func main() {
 	addr := fmt.Sprintf("%s:%d", cfg.Server.Host, cfg.Server.Port)
 	logger.Info("Server starting",
 		zap.String("address", addr),
+		zap.Bool("tls", cfg.Server.TLS.Enabled),
+		zap.String("unix_socket", cfg.Server.UnixSocket),
 		zap.String("llm_backend", cfg.LLM.DefaultBackend),
 	)
 
-	if err := app.Listen(addr); err != nil {
+	if err := listen(app, cfg); err != nil {
 		logger.Fatal("Server failed to start", zap.Error(err))
 	}
 }
 
+// newDatabase connects the PostgreSQL pool and checks that it answers
+func newDatabase(ctx context.Context, cfg config.PostgresConfig) (*pgxpool.Pool, error) {
+	poolCfg, err := pgxpool.ParseConfig(cfg.DSN())
+	if err != nil {
+		return nil, fmt.Errorf("invalid database config: %w", err)
+	}
+	if cfg.PoolSize > 0 {
+		poolCfg.MaxConns = int32(cfg.PoolSize)
+	}
+	tenant.ConfigurePool(poolCfg)
+
+	pool, err := pgxpool.NewWithConfig(ctx, poolCfg)
+	if err != nil {
+		return nil, err
+	}
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := pool.Ping(pingCtx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+	return pool, nil
+}
+
+// newReadiness registers a probe for each external dependency. Only the
+// database is core; the rest disable the features that need them.
+func newReadiness(cfg *config.Config, deps *api.Dependencies, vectors *vectorstore.Store) *readiness.Checker {
+	checker := readiness.NewChecker(readiness.DefaultTTL)
+
+	db := readiness.Unconnected("database not connected")
+	if pool, ok := deps.DB.(interface{ Ping(context.Context) error }); ok {
+		db = pool.Ping
+	}
+	checker.Register(domain.CapabilityDB, true, db)
+
+	checker.Register(domain.CapabilityVectors, false, vectors.Ping)
+
+	ml := readiness.Unconnected("ML service not connected")
+	if deps.MLClient != nil {
+		ml = deps.MLClient.Ping
+	}
+	checker.Register(domain.CapabilityML, false, ml)
+
+	backend := cfg.LLM.DefaultBackend
+	llmReady := readiness.Configured(cfg.LLM.APIKey(backend), fmt.Sprintf("no API key configured for %s", backend))
+	if ready, ok := deps.LLMHealth.(interface{ Ready(context.Context) error }); ok {
+		llmReady = ready.Ready // the default backend or a fallback can serve requests
+	}
+	checker.Register(domain.CapabilityLLM, false, llmReady)
+
+	checker.Register(domain.CapabilityBrowser, false,
+		readiness.Executable("headless-shell", "google-chrome", "google-chrome-stable", "chromium", "chromium-browser"))
+	return checker
+}
+
+// newRatingStore creates the company rating store with the configured provider
+func newRatingStore(cfg config.RatingsConfig) (*enrichment.RatingStore, error) {
+	switch cfg.Provider {
+	case "":
+		return enrichment.NewRatingStore(cfg, nil), nil
+	case "indeed":
+		source := enrichment.NewIndeedRatingSource(cfg.BaseURL, cfg.UserAgent, cfg.Timeout)
+		return enrichment.NewRatingStore(cfg, source), nil
+	default:
+		return nil, fmt.Errorf("unknown ratings provider: %s", cfg.Provider)
+	}
+}
+
 // errorHandler handles errors globally
 func errorHandler(c *fiber.Ctx, err error) error {
 	// Default to 500
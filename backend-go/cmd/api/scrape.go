@@ -0,0 +1,55 @@
+package main
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/geo"
+	"github.com/resume-rag/backend/internal/scraper"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// newScraperRegistry registers the configured job board APIs and the HTML
+// scrapers. JavaScript sources share a browser pool when Chrome is
+// installed; the returned func closes it.
+func newScraperRegistry(cfg config.ScrapingConfig, geocoder geo.Geocoder) (*scraper.ScraperRegistry, func()) {
+	log := logger.Get()
+
+	registry := scraper.NewScraperRegistry()
+	registry.SetConcurrency(cfg)
+	registry.SetValidation(cfg.Validation)
+	registry.SetURLs(cfg.URLs)
+	registry.SetGeocoder(geocoder)
+
+	// API-backed scrapers register first so they win over HTML scraping
+	for _, s := range scraper.NewAPIScrapers(cfg.APIs, log) {
+		registry.Register(s)
+	}
+
+	var browser *scraper.BrowserPool
+	if scraper.ChromeInstalled() {
+		pool, err := scraper.NewBrowserPool(log, nil)
+		if err != nil {
+			logger.Warn("Failed to start browser pool, JavaScript sources use plain HTTP", zap.Error(err))
+		} else {
+			browser = pool
+		}
+	}
+	plain := scraper.NewHTTPFetcher(nil, "", log)
+
+	registry.Register(scraper.NewIndeedScraper(registry.Fetcher(domain.JobSourceIndeed, browser, plain), log))
+	registry.Register(scraper.NewLinkedInScraper(registry.Fetcher(domain.JobSourceLinkedIn, browser, plain), log))
+	registry.Register(scraper.NewDiceScraper(registry.Fetcher(domain.JobSourceDice, browser, plain), log))
+	registry.Register(scraper.NewWellfoundScraper(registry.Fetcher(domain.JobSourceWellfound, browser, plain), log))
+
+	if degraded := registry.Degraded(); len(degraded) > 0 {
+		logger.Warn("Chrome not available, JavaScript sources fetched over plain HTTP", zap.Any("sources", degraded))
+	}
+
+	return registry, func() {
+		if browser != nil {
+			browser.Close()
+		}
+	}
+}
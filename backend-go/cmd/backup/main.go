@@ -0,0 +1,102 @@
+// Command backup writes and restores portable archives of a deployment: the
+// Postgres data, snapshots of the Qdrant collections and the stored files.
+// Use it to move a self-hosted install to another machine.
+//
+//	backup [-config config.yaml] create FILE        # write an archive, like POST /api/admin/backup
+//	backup [-config config.yaml] restore -yes FILE  # replace this deployment's data with an archive
+//
+// restore empties every table before loading the archive; stop the API first.
+// The schema is migrated before loading, so an archive from an older release
+// restores into a newer one.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/backup"
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/storage"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/internal/vectorstore"
+)
+
+func main() {
+	configPath := flag.String("config", "", "Path to config file")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		fail("failed to load config: %v", err)
+	}
+
+	ctx := context.Background()
+	db, err := pgxpool.New(ctx, cfg.Database.Postgres.DSN())
+	if err != nil {
+		fail("invalid database config: %v", err)
+	}
+	defer db.Close()
+	files, err := storage.New(ctx, cfg.Storage)
+	if err != nil {
+		fail("failed to open file storage: %v", err)
+	}
+	service := backup.NewService(db, vectorstore.New(cfg.Database.Qdrant), files, tenant.IDs(cfg.Tenancy))
+
+	switch cmd := flag.Arg(0); cmd {
+	case "create":
+		path := flag.Arg(1)
+		if path == "" {
+			fail("usage: backup create FILE")
+		}
+		f, err := os.Create(path)
+		if err != nil {
+			fail("%v", err)
+		}
+		m, err := service.Backup(ctx, f)
+		if err == nil {
+			err = f.Close()
+		}
+		if err != nil {
+			f.Close()
+			os.Remove(path)
+			fail("%v", err)
+		}
+		fmt.Printf("wrote %s: schema version %d, %d tables, %d collections, %d files\n",
+			path, m.SchemaVersion, len(m.Tables), len(m.Collections), m.Files)
+
+	case "restore":
+		restore := flag.NewFlagSet("restore", flag.ExitOnError)
+		yes := restore.Bool("yes", false, "Confirm that this deployment's data is replaced")
+		_ = restore.Parse(flag.Args()[1:])
+		path := restore.Arg(0)
+		if path == "" {
+			fail("usage: backup restore -yes FILE")
+		}
+		if !*yes {
+			fail("restore replaces every table, the archived collections and files; run again with -yes to confirm")
+		}
+		f, err := os.Open(path)
+		if err != nil {
+			fail("%v", err)
+		}
+		defer f.Close()
+		m, err := service.Restore(ctx, f)
+		if err != nil {
+			fail("%v", err)
+		}
+		fmt.Printf("restored %s from %s: schema version %d, %d tables, %d collections, %d files\n",
+			path, m.CreatedAt.Format("2006-01-02 15:04:05"), m.SchemaVersion, len(m.Tables), len(m.Collections), m.Files)
+
+	default:
+		fail("unknown command %q (want create or restore)", cmd)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "backup: "+format+"\n", args...)
+	os.Exit(1)
+}
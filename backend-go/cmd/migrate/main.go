@@ -0,0 +1,86 @@
+// Command migrate manages the database schema using the migrations embedded
+// in the binary, for deployments that migrate before starting the API.
+//
+//	migrate [-config config.yaml]                   # apply pending migrations
+//	migrate [-config config.yaml] status            # list migrations and when they were applied
+//	migrate [-config config.yaml] baseline VERSION  # mark migrations up to VERSION as applied
+//
+// baseline is for databases created before migrations were tracked, e.g. by
+// loading the SQL files directly.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/migrations"
+)
+
+func main() {
+	configPath := flag.String("config", "", "Path to config file")
+	flag.Parse()
+
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		fail("failed to load config: %v", err)
+	}
+
+	ctx := context.Background()
+	db, err := pgxpool.New(ctx, cfg.Database.Postgres.DSN())
+	if err != nil {
+		fail("invalid database config: %v", err)
+	}
+	defer db.Close()
+
+	switch cmd := flag.Arg(0); cmd {
+	case "", "up":
+		applied, err := migrations.Up(ctx, db)
+		for _, m := range applied {
+			fmt.Printf("applied %s\n", m.Name)
+		}
+		if err != nil {
+			fail("%v", err)
+		}
+		if len(applied) == 0 {
+			fmt.Println("schema is up to date")
+		}
+
+	case "status":
+		statuses, err := migrations.List(ctx, db)
+		if err != nil {
+			fail("%v", err)
+		}
+		for _, s := range statuses {
+			if s.AppliedAt != nil {
+				fmt.Printf("applied %s  %s\n", s.AppliedAt.Format("2006-01-02 15:04:05"), s.Name)
+			} else {
+				fmt.Printf("pending                      %s\n", s.Name)
+			}
+		}
+
+	case "baseline":
+		version, err := strconv.Atoi(flag.Arg(1))
+		if err != nil {
+			fail("usage: migrate baseline VERSION")
+		}
+		marked, err := migrations.Baseline(ctx, db, version)
+		if err != nil {
+			fail("%v", err)
+		}
+		fmt.Printf("marked %d migrations as applied\n", marked)
+
+	default:
+		fail("unknown command %q (want up, status or baseline)", cmd)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "migrate: "+format+"\n", args...)
+	os.Exit(1)
+}
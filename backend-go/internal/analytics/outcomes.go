@@ -0,0 +1,227 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// minFindingSample is the smallest bucket a finding is drawn from
+const minFindingSample = 5
+
+// minFindingLift is how much better than average a bucket must do to be reported
+const minFindingLift = 1.25
+
+// applicationOutcome is one submitted application with the attributes being correlated
+type applicationOutcome struct {
+	matchScore  *float64
+	source      string
+	appliedAt   time.Time
+	postedAt    *time.Time
+	responded   bool
+	interviewed bool
+	offered     bool
+}
+
+// WhatWorks correlates application outcomes with match score, source, the
+// day of week applied and how soon after posting the application went out
+func (s *PostgresInsights) WhatWorks(ctx context.Context) (*domain.WhatWorksReport, error) {
+	rows, err := s.db.Query(ctx, `
+		WITH reached AS (
+			SELECT a.id,
+				array_agg(DISTINCT t.to_status::text) FILTER (WHERE t.to_status IS NOT NULL)
+					|| ARRAY[a.status::text] AS statuses
+			FROM applications a
+			LEFT JOIN application_timeline t ON t.application_id = a.id
+			WHERE a.deleted_at IS NULL AND a.applied_at IS NOT NULL
+			GROUP BY a.id
+		)
+		SELECT j.match_score, j.source::text, a.applied_at, j.posted_at,
+			r.statuses && ARRAY['phone_screen', 'technical', 'onsite', 'offer', 'accepted', 'rejected'],
+			r.statuses && ARRAY['phone_screen', 'technical', 'onsite', 'offer', 'accepted'],
+			r.statuses && ARRAY['offer', 'accepted']
+		FROM reached r
+		JOIN applications a ON a.id = r.id
+		JOIN jobs j ON j.id = a.job_id`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load application outcomes: %w", err)
+	}
+	defer rows.Close()
+
+	var outcomes []applicationOutcome
+	for rows.Next() {
+		var o applicationOutcome
+		if err := rows.Scan(&o.matchScore, &o.source, &o.appliedAt, &o.postedAt,
+			&o.responded, &o.interviewed, &o.offered); err != nil {
+			return nil, err
+		}
+		outcomes = append(outcomes, o)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return buildWhatWorks(outcomes), nil
+}
+
+// dimension assigns each outcome to a bucket label; "" skips the outcome
+type dimension struct {
+	name   string
+	labels []string // fixed bucket order; nil orders buckets by first appearance
+	label  func(o applicationOutcome) string
+}
+
+var dimensions = []dimension{
+	{
+		name:   "match_score",
+		labels: []string{"80-100", "60-79", "40-59", "0-39"},
+		label: func(o applicationOutcome) string {
+			if o.matchScore == nil {
+				return ""
+			}
+			switch score := *o.matchScore; {
+			case score >= 80:
+				return "80-100"
+			case score >= 60:
+				return "60-79"
+			case score >= 40:
+				return "40-59"
+			default:
+				return "0-39"
+			}
+		},
+	},
+	{
+		name:  "source",
+		label: func(o applicationOutcome) string { return o.source },
+	},
+	{
+		name:   "day_of_week",
+		labels: []string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"},
+		label:  func(o applicationOutcome) string { return o.appliedAt.Weekday().String() },
+	},
+	{
+		name:   "time_to_apply",
+		labels: []string{"same day", "1-3 days", "4-7 days", "8-14 days", "15+ days"},
+		label: func(o applicationOutcome) string {
+			if o.postedAt == nil {
+				return ""
+			}
+			switch days := int(o.appliedAt.Sub(*o.postedAt).Hours() / 24); {
+			case days < 1:
+				return "same day"
+			case days <= 3:
+				return "1-3 days"
+			case days <= 7:
+				return "4-7 days"
+			case days <= 14:
+				return "8-14 days"
+			default:
+				return "15+ days"
+			}
+		},
+	},
+}
+
+// buildWhatWorks aggregates outcomes per dimension and derives findings
+func buildWhatWorks(outcomes []applicationOutcome) *domain.WhatWorksReport {
+	report := &domain.WhatWorksReport{
+		Overall:    domain.OutcomeBucket{Label: "all"},
+		Dimensions: make([]domain.OutcomeDimension, 0, len(dimensions)),
+		Findings:   []string{},
+	}
+	for _, o := range outcomes {
+		addOutcome(&report.Overall, o)
+	}
+	finalizeBucket(&report.Overall)
+
+	for _, dim := range dimensions {
+		buckets := make(map[string]*domain.OutcomeBucket)
+		order := append([]string(nil), dim.labels...)
+		for _, o := range outcomes {
+			label := dim.label(o)
+			if label == "" {
+				continue
+			}
+			b, ok := buckets[label]
+			if !ok {
+				b = &domain.OutcomeBucket{Label: label}
+				buckets[label] = b
+				if dim.labels == nil {
+					order = append(order, label)
+				}
+			}
+			addOutcome(b, o)
+		}
+
+		result := domain.OutcomeDimension{Name: dim.name, Buckets: []domain.OutcomeBucket{}}
+		for _, label := range order {
+			if b, ok := buckets[label]; ok {
+				finalizeBucket(b)
+				result.Buckets = append(result.Buckets, *b)
+			}
+		}
+		report.Dimensions = append(report.Dimensions, result)
+
+		if finding := bestBucketFinding(dim.name, result.Buckets, report.Overall.ResponseRate); finding != "" {
+			report.Findings = append(report.Findings, finding)
+		}
+	}
+
+	return report
+}
+
+func addOutcome(b *domain.OutcomeBucket, o applicationOutcome) {
+	b.Applications++
+	if o.responded {
+		b.Responses++
+	}
+	if o.interviewed {
+		b.Interviews++
+	}
+	if o.offered {
+		b.Offers++
+	}
+}
+
+func finalizeBucket(b *domain.OutcomeBucket) {
+	if b.Applications == 0 {
+		return
+	}
+	b.ResponseRate = float64(b.Responses) / float64(b.Applications)
+	b.InterviewRate = float64(b.Interviews) / float64(b.Applications)
+}
+
+// bestBucketFinding describes the bucket with the highest response rate when it
+// clearly beats the overall rate on a meaningful sample
+func bestBucketFinding(dimension string, buckets []domain.OutcomeBucket, overall float64) string {
+	var best *domain.OutcomeBucket
+	for i := range buckets {
+		b := &buckets[i]
+		if b.Applications < minFindingSample {
+			continue
+		}
+		if best == nil || b.ResponseRate > best.ResponseRate {
+			best = b
+		}
+	}
+	if best == nil || overall == 0 || best.ResponseRate < overall*minFindingLift {
+		return ""
+	}
+
+	lift := best.ResponseRate / overall
+	switch dimension {
+	case "match_score":
+		return fmt.Sprintf("Jobs with a %s match score get responses %.1fx more often than average", best.Label, lift)
+	case "source":
+		return fmt.Sprintf("Applications through %s get responses %.1fx more often than average", best.Label, lift)
+	case "day_of_week":
+		return fmt.Sprintf("Applications sent on %s get responses %.1fx more often than average", best.Label, lift)
+	case "time_to_apply":
+		return fmt.Sprintf("Applying %s after posting gets responses %.1fx more often than average", best.Label, lift)
+	default:
+		return ""
+	}
+}
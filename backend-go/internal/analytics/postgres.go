@@ -0,0 +1,158 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// activeJobs restricts queries to non-deleted listings
+const activeJobs = `j.deleted_at IS NULL`
+
+// salaryExpr is the midpoint of the advertised range, or whichever bound is present
+const salaryExpr = `COALESCE((j.salary_min + j.salary_max) / 2, j.salary_min, j.salary_max)`
+
+// PostgresInsights computes job market analytics over the scraped corpus
+type PostgresInsights struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresInsights creates a Postgres-backed market insights provider
+func NewPostgresInsights(db *pgxpool.Pool) *PostgresInsights {
+	return &PostgresInsights{db: db}
+}
+
+// SalaryDistribution returns salary percentiles grouped by title or location
+func (s *PostgresInsights) SalaryDistribution(ctx context.Context, q domain.SalaryInsightQuery) ([]domain.SalaryInsight, error) {
+	group := "lower(j.title)"
+	if q.GroupBy == "location" {
+		group = "COALESCE(j.city || ', ' || j.state, j.location, 'unknown')"
+	}
+
+	conds := []string{activeJobs, salaryExpr + " IS NOT NULL"}
+	var args []interface{}
+	if q.Title != "" {
+		args = append(args, "%"+q.Title+"%")
+		conds = append(conds, "j.title ILIKE $"+strconv.Itoa(len(args)))
+	}
+	if q.Location != "" {
+		args = append(args, "%"+q.Location+"%")
+		n := strconv.Itoa(len(args))
+		conds = append(conds, "(j.location ILIKE $"+n+" OR j.city ILIKE $"+n+" OR j.state ILIKE $"+n+")")
+	}
+	args = append(args, q.Limit)
+
+	rows, err := s.db.Query(ctx, fmt.Sprintf(`
+		SELECT %[1]s AS grp, count(*),
+			min(%[2]s),
+			percentile_cont(0.25) WITHIN GROUP (ORDER BY %[2]s),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY %[2]s),
+			percentile_cont(0.75) WITHIN GROUP (ORDER BY %[2]s),
+			max(%[2]s)
+		FROM jobs j WHERE %[3]s
+		GROUP BY grp ORDER BY count(*) DESC LIMIT $%[4]d`,
+		group, salaryExpr, strings.Join(conds, " AND "), len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute salary distribution: %w", err)
+	}
+	defer rows.Close()
+
+	insights := []domain.SalaryInsight{}
+	for rows.Next() {
+		var si domain.SalaryInsight
+		var p25, median, p75 float64
+		if err := rows.Scan(&si.Group, &si.Jobs, &si.Min, &p25, &median, &p75, &si.Max); err != nil {
+			return nil, err
+		}
+		si.P25, si.Median, si.P75 = int(p25), int(median), int(p75)
+		insights = append(insights, si)
+	}
+	return insights, rows.Err()
+}
+
+// TopSkills returns the most requested skills over the last weeks with their weekly counts
+func (s *PostgresInsights) TopSkills(ctx context.Context, weeks, limit int) ([]domain.SkillDemandSeries, error) {
+	since := time.Now().AddDate(0, 0, -7*weeks)
+
+	rows, err := s.db.Query(ctx, `
+		WITH mentions AS (
+			SELECT skill, date_trunc('week', mentioned_at) AS week
+			FROM job_skill_mentions WHERE mentioned_at >= $1
+		),
+		top AS (
+			SELECT skill FROM mentions GROUP BY skill ORDER BY count(*) DESC, skill LIMIT $2
+		)
+		SELECT m.skill, m.week, count(*)
+		FROM mentions m JOIN top USING (skill)
+		GROUP BY m.skill, m.week
+		ORDER BY m.skill, m.week`, since, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute top skills: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSeries(rows)
+}
+
+// WorkArrangementBySource returns the remote/hybrid/onsite split per source
+func (s *PostgresInsights) WorkArrangementBySource(ctx context.Context) ([]domain.WorkArrangementInsight, error) {
+	rows, err := s.db.Query(ctx, `
+		SELECT j.source::text,
+			count(*) FILTER (WHERE j.location_type = 'remote'),
+			count(*) FILTER (WHERE j.location_type = 'hybrid'),
+			count(*) FILTER (WHERE j.location_type = 'onsite'),
+			count(*) FILTER (WHERE j.location_type IS NULL)
+		FROM jobs j WHERE `+activeJobs+`
+		GROUP BY j.source ORDER BY j.source`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute work arrangement split: %w", err)
+	}
+	defer rows.Close()
+
+	insights := []domain.WorkArrangementInsight{}
+	for rows.Next() {
+		var wa domain.WorkArrangementInsight
+		var source string
+		if err := rows.Scan(&source, &wa.Remote, &wa.Hybrid, &wa.Onsite, &wa.Unknown); err != nil {
+			return nil, err
+		}
+		wa.Source = domain.JobSource(source)
+		if known := wa.Remote + wa.Hybrid + wa.Onsite; known > 0 {
+			wa.RemoteRatio = float64(wa.Remote) / float64(known)
+		}
+		insights = append(insights, wa)
+	}
+	return insights, rows.Err()
+}
+
+// seriesRows is the subset of pgx.Rows used to scan skill series
+type seriesRows interface {
+	Next() bool
+	Scan(dest ...interface{}) error
+	Err() error
+}
+
+// scanSeries groups (skill, week, count) rows ordered by skill into series
+func scanSeries(rows seriesRows) ([]domain.SkillDemandSeries, error) {
+	series := []domain.SkillDemandSeries{}
+	for rows.Next() {
+		var skill string
+		var point domain.SkillDemandPoint
+		if err := rows.Scan(&skill, &point.WeekStart, &point.Count); err != nil {
+			return nil, err
+		}
+		if len(series) == 0 || series[len(series)-1].Skill != skill {
+			series = append(series, domain.SkillDemandSeries{Skill: skill, Points: []domain.SkillDemandPoint{}})
+		}
+		cur := &series[len(series)-1]
+		cur.Points = append(cur.Points, point)
+		cur.Total += point.Count
+	}
+	return series, rows.Err()
+}
@@ -0,0 +1,183 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// skillAliases maps common spellings onto one canonical skill name
+var skillAliases = map[string]string{
+	"golang":              "go",
+	"k8s":                 "kubernetes",
+	"js":                  "javascript",
+	"ts":                  "typescript",
+	"postgresql":          "postgres",
+	"node.js":             "node",
+	"nodejs":              "node",
+	"react.js":            "react",
+	"reactjs":             "react",
+	"amazon web services": "aws",
+	"gcp":                 "google cloud",
+	"ml":                  "machine learning",
+}
+
+// knownSkills is the vocabulary used to extract skills from descriptions
+// when a board does not list them explicitly
+var knownSkills = []string{
+	"go", "golang", "rust", "python", "java", "kotlin", "scala", "c++", "c#", "ruby", "php", "swift",
+	"javascript", "typescript", "node.js", "react", "vue", "angular", "svelte", "next.js",
+	"sql", "postgresql", "postgres", "mysql", "mongodb", "redis", "elasticsearch", "kafka", "spark",
+	"aws", "azure", "gcp", "kubernetes", "k8s", "docker", "terraform", "ansible", "linux",
+	"graphql", "grpc", "rest", "microservices", "ci/cd", "git",
+	"machine learning", "deep learning", "pytorch", "tensorflow", "llm", "nlp", "data engineering",
+}
+
+// NormalizeSkill lowercases a skill and maps aliases onto the canonical name
+func NormalizeSkill(skill string) string {
+	s := strings.ToLower(strings.TrimSpace(skill))
+	if canonical, ok := skillAliases[s]; ok {
+		return canonical
+	}
+	return s
+}
+
+// ExtractSkills finds known skills mentioned as whole words in free text
+func ExtractSkills(text string) []string {
+	lower := strings.ToLower(text)
+	seen := make(map[string]bool)
+	skills := make([]string, 0)
+	for _, known := range knownSkills {
+		skill := NormalizeSkill(known)
+		if !seen[skill] && containsWord(lower, known) {
+			seen[skill] = true
+			skills = append(skills, skill)
+		}
+	}
+	return skills
+}
+
+// containsWord reports whether word occurs in text bounded by non-word characters.
+// "+" and "#" count as word characters so "c" does not match inside "c++".
+func containsWord(text, word string) bool {
+	for from := 0; ; {
+		i := strings.Index(text[from:], word)
+		if i < 0 {
+			return false
+		}
+		start, end := from+i, from+i+len(word)
+		before := start == 0 || !isWordByte(text[start-1])
+		after := end == len(text) || !isWordByte(text[end])
+		if before && after {
+			return true
+		}
+		from = start + 1
+	}
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || b == '+' || b == '#' || (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9')
+}
+
+// JobSkills returns a job's normalized skills, extracting them from the
+// description when the board did not list any
+func JobSkills(job *domain.Job) []string {
+	if len(job.RequiredSkills) == 0 {
+		return ExtractSkills(job.Description)
+	}
+	seen := make(map[string]bool, len(job.RequiredSkills))
+	skills := make([]string, 0, len(job.RequiredSkills))
+	for _, raw := range job.RequiredSkills {
+		skill := NormalizeSkill(raw)
+		if skill != "" && !seen[skill] {
+			seen[skill] = true
+			skills = append(skills, skill)
+		}
+	}
+	return skills
+}
+
+// SkillRecorder stores timestamped skill mentions for freshly ingested jobs
+type SkillRecorder struct {
+	db *pgxpool.Pool
+}
+
+// NewSkillRecorder creates a recorder backed by the job_skill_mentions table
+func NewSkillRecorder(db *pgxpool.Pool) *SkillRecorder {
+	return &SkillRecorder{db: db}
+}
+
+// Record stores one mention per job and skill, timestamped when the job was first seen
+func (r *SkillRecorder) Record(ctx context.Context, jobs []*domain.Job) error {
+	batch := &pgx.Batch{}
+	for _, job := range jobs {
+		seenAt := job.ScrapedAt
+		if job.FirstSeenAt != nil {
+			seenAt = *job.FirstSeenAt
+		}
+		if seenAt.IsZero() {
+			seenAt = time.Now()
+		}
+		for _, skill := range JobSkills(job) {
+			batch.Queue(`
+				INSERT INTO job_skill_mentions (job_id, skill, mentioned_at)
+				VALUES ($1, $2, $3) ON CONFLICT (job_id, skill) DO NOTHING`,
+				job.ID, skill, seenAt)
+		}
+	}
+	if batch.Len() == 0 {
+		return nil
+	}
+
+	if err := r.db.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to record skill mentions: %w", err)
+	}
+	return nil
+}
+
+// Enrich records skill mentions for freshly ingested jobs. Jobs must already
+// have their IDs assigned.
+func (r *SkillRecorder) Enrich(ctx context.Context, jobs []*domain.Job) {
+	_ = r.Record(ctx, jobs) // Best effort; analytics must not block ingest
+}
+
+// SkillTrends returns weekly mention counts for the given skills over the last
+// weeks, including zero-count weeks so series line up
+func (s *PostgresInsights) SkillTrends(ctx context.Context, skills []string, weeks int) ([]domain.SkillDemandSeries, error) {
+	normalized := make([]string, 0, len(skills))
+	for _, skill := range skills {
+		if n := NormalizeSkill(skill); n != "" {
+			normalized = append(normalized, n)
+		}
+	}
+	if len(normalized) == 0 {
+		return []domain.SkillDemandSeries{}, nil
+	}
+
+	since := time.Now().AddDate(0, 0, -7*weeks)
+	rows, err := s.db.Query(ctx, `
+		WITH weeks AS (
+			SELECT generate_series(date_trunc('week', $2::timestamptz), date_trunc('week', now()), '1 week') AS week
+		),
+		skills AS (
+			SELECT unnest($1::text[]) AS skill
+		)
+		SELECT sk.skill, w.week, count(m.job_id)
+		FROM skills sk CROSS JOIN weeks w
+		LEFT JOIN job_skill_mentions m
+			ON m.skill = sk.skill AND date_trunc('week', m.mentioned_at) = w.week
+		GROUP BY sk.skill, w.week
+		ORDER BY sk.skill, w.week`, normalized, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute skill trends: %w", err)
+	}
+	defer rows.Close()
+
+	return scanSeries(rows)
+}
@@ -0,0 +1,272 @@
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Tech stack categories
+const (
+	StackLanguage  = "language"
+	StackFramework = "framework"
+	StackCloud     = "cloud"
+	StackDatabase  = "database"
+)
+
+// stackTerm is one spelling of a technology. Terms that are also ordinary
+// English words ("Go", "Swift", "Spring") are matched case-sensitively and
+// not at the start of a sentence, where any word is capitalised.
+type stackTerm struct {
+	spelling      string
+	tech          string
+	category      string
+	caseSensitive bool
+}
+
+// stackVocabulary lists the technologies detected in postings
+var stackVocabulary = []stackTerm{
+	{"Go", "go", StackLanguage, true},
+	{"golang", "go", StackLanguage, false},
+	{"rust", "rust", StackLanguage, false},
+	{"python", "python", StackLanguage, false},
+	{"java", "java", StackLanguage, false},
+	{"kotlin", "kotlin", StackLanguage, false},
+	{"scala", "scala", StackLanguage, false},
+	{"c++", "c++", StackLanguage, false},
+	{"c#", "c#", StackLanguage, false},
+	{"ruby", "ruby", StackLanguage, false},
+	{"php", "php", StackLanguage, false},
+	{"Swift", "swift", StackLanguage, true},
+	{"objective-c", "objective-c", StackLanguage, false},
+	{"javascript", "javascript", StackLanguage, false},
+	{"typescript", "typescript", StackLanguage, false},
+	{"elixir", "elixir", StackLanguage, false},
+	{"erlang", "erlang", StackLanguage, false},
+	{"haskell", "haskell", StackLanguage, false},
+	{"clojure", "clojure", StackLanguage, false},
+	{"Dart", "dart", StackLanguage, true},
+
+	{"react", "react", StackFramework, false},
+	{"react.js", "react", StackFramework, false},
+	{"reactjs", "react", StackFramework, false},
+	{"vue", "vue", StackFramework, false},
+	{"vue.js", "vue", StackFramework, false},
+	{"angular", "angular", StackFramework, false},
+	{"svelte", "svelte", StackFramework, false},
+	{"next.js", "next.js", StackFramework, false},
+	{"nextjs", "next.js", StackFramework, false},
+	{"node", "node", StackFramework, false},
+	{"node.js", "node", StackFramework, false},
+	{"nodejs", "node", StackFramework, false},
+	{"Express", "express", StackFramework, true},
+	{"nestjs", "nestjs", StackFramework, false},
+	{"django", "django", StackFramework, false},
+	{"flask", "flask", StackFramework, false},
+	{"fastapi", "fastapi", StackFramework, false},
+	{"Rails", "rails", StackFramework, true},
+	{"ruby on rails", "rails", StackFramework, false},
+	{"Spring", "spring", StackFramework, true},
+	{"spring boot", "spring", StackFramework, false},
+	{"laravel", "laravel", StackFramework, false},
+	{"symfony", "symfony", StackFramework, false},
+	{".net", ".net", StackFramework, false},
+	{"asp.net", ".net", StackFramework, false},
+	{"dotnet", ".net", StackFramework, false},
+	{"phoenix", "phoenix", StackFramework, false},
+	{"flutter", "flutter", StackFramework, false},
+	{"react native", "react native", StackFramework, false},
+	{"pytorch", "pytorch", StackFramework, false},
+	{"tensorflow", "tensorflow", StackFramework, false},
+
+	{"aws", "aws", StackCloud, false},
+	{"amazon web services", "aws", StackCloud, false},
+	{"azure", "azure", StackCloud, false},
+	{"gcp", "gcp", StackCloud, false},
+	{"google cloud", "gcp", StackCloud, false},
+	{"heroku", "heroku", StackCloud, false},
+	{"vercel", "vercel", StackCloud, false},
+	{"cloudflare", "cloudflare", StackCloud, false},
+	{"digitalocean", "digitalocean", StackCloud, false},
+
+	{"postgres", "postgres", StackDatabase, false},
+	{"postgresql", "postgres", StackDatabase, false},
+	{"mysql", "mysql", StackDatabase, false},
+	{"mariadb", "mariadb", StackDatabase, false},
+	{"sql server", "sql server", StackDatabase, false},
+	{"mssql", "sql server", StackDatabase, false},
+	{"sqlite", "sqlite", StackDatabase, false},
+	{"mongodb", "mongodb", StackDatabase, false},
+	{"mongo", "mongodb", StackDatabase, false},
+	{"redis", "redis", StackDatabase, false},
+	{"elasticsearch", "elasticsearch", StackDatabase, false},
+	{"dynamodb", "dynamodb", StackDatabase, false},
+	{"cassandra", "cassandra", StackDatabase, false},
+	{"clickhouse", "clickhouse", StackDatabase, false},
+	{"snowflake", "snowflake", StackDatabase, false},
+	{"bigquery", "bigquery", StackDatabase, false},
+}
+
+// stackAliases maps every lowercase spelling onto its canonical technology
+var stackAliases = func() map[string]string {
+	aliases := make(map[string]string, len(stackVocabulary))
+	for _, term := range stackVocabulary {
+		aliases[strings.ToLower(term.spelling)] = term.tech
+	}
+	return aliases
+}()
+
+// stackCategories maps each canonical technology onto its category
+var stackCategories = func() map[string]string {
+	categories := make(map[string]string, len(stackVocabulary))
+	for _, term := range stackVocabulary {
+		categories[term.tech] = term.category
+	}
+	return categories
+}()
+
+// NormalizeTech maps a technology name onto its canonical stack name, e.g.
+// "Golang" -> "go". Unknown names are lowercased.
+func NormalizeTech(tech string) string {
+	t := strings.ToLower(strings.TrimSpace(tech))
+	if canonical, ok := stackAliases[t]; ok {
+		return canonical
+	}
+	return t
+}
+
+// StackCategory returns the category of a canonical technology, or "" when unknown
+func StackCategory(tech string) string {
+	return stackCategories[tech]
+}
+
+// DetectStack finds the languages, frameworks, clouds and databases a posting
+// uses from its description and listed skills. It returns nil when none are found.
+func DetectStack(text string, skills []string) *domain.TechStack {
+	if len(skills) > 0 {
+		text += "\nSkills: " + strings.Join(skills, ", ")
+	}
+	lower := strings.ToLower(text)
+
+	stack := &domain.TechStack{}
+	seen := make(map[string]bool)
+	found := false
+	for _, term := range stackVocabulary {
+		if seen[term.tech] {
+			continue
+		}
+		if term.caseSensitive {
+			if !containsProperNoun(text, term.spelling) {
+				continue
+			}
+		} else if !containsWord(lower, term.spelling) {
+			continue
+		}
+
+		seen[term.tech] = true
+		found = true
+		switch term.category {
+		case StackLanguage:
+			stack.Languages = append(stack.Languages, term.tech)
+		case StackFramework:
+			stack.Frameworks = append(stack.Frameworks, term.tech)
+		case StackCloud:
+			stack.Clouds = append(stack.Clouds, term.tech)
+		case StackDatabase:
+			stack.Databases = append(stack.Databases, term.tech)
+		}
+	}
+	if !found {
+		return nil
+	}
+	return stack
+}
+
+// containsProperNoun reports whether word occurs in text as a whole word
+// somewhere other than the start of a sentence
+func containsProperNoun(text, word string) bool {
+	for from := 0; ; {
+		i := strings.Index(text[from:], word)
+		if i < 0 {
+			return false
+		}
+		start, end := from+i, from+i+len(word)
+		from = start + 1
+		if (start > 0 && isWordByte(text[start-1])) || (end < len(text) && isWordByte(text[end])) {
+			continue
+		}
+		prev := strings.TrimRight(text[:start], " \t(")
+		if prev == "" || strings.ContainsAny(prev[len(prev)-1:], ".!?\n") {
+			continue
+		}
+		return true
+	}
+}
+
+// StackDetector fills in the tech stack on freshly scraped jobs
+type StackDetector struct{}
+
+// NewStackDetector creates a tech stack detector
+func NewStackDetector() *StackDetector {
+	return &StackDetector{}
+}
+
+// Enrich detects each job's tech stack from its description and skills
+func (d *StackDetector) Enrich(ctx context.Context, jobs []*domain.Job) {
+	for _, job := range jobs {
+		if job.TechStack == nil {
+			job.TechStack = DetectStack(job.Description, job.RequiredSkills)
+		}
+	}
+}
+
+// CompanyStacks returns how often each technology appears in the postings of
+// companies whose name matches company, or of the companies with the most
+// postings when company is empty
+func (s *PostgresInsights) CompanyStacks(ctx context.Context, company string, limit int) ([]domain.CompanyStack, error) {
+	rows, err := s.db.Query(ctx, `
+		WITH companies AS (
+			SELECT c.id, c.name, count(*) AS jobs
+			FROM jobs j JOIN companies c ON c.id = j.company_id
+			WHERE `+activeJobs+` AND ($1 = '' OR c.name ILIKE '%' || $1 || '%')
+			GROUP BY c.id, c.name
+			ORDER BY jobs DESC, c.name
+			LIMIT $2
+		)
+		SELECT co.name, co.jobs, t.tech, count(*)
+		FROM companies co
+		JOIN jobs j ON j.company_id = co.id AND `+activeJobs+`
+		CROSS JOIN LATERAL unnest(j.tech_stack_terms) AS t(tech)
+		GROUP BY co.name, co.jobs, t.tech
+		ORDER BY co.jobs DESC, co.name, count(*) DESC, t.tech`,
+		company, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute company stacks: %w", err)
+	}
+	defer rows.Close()
+
+	stacks := []domain.CompanyStack{}
+	for rows.Next() {
+		var (
+			name       string
+			jobs, uses int
+			tech       string
+		)
+		if err := rows.Scan(&name, &jobs, &tech, &uses); err != nil {
+			return nil, err
+		}
+		if n := len(stacks); n == 0 || stacks[n-1].Company != name {
+			stacks = append(stacks, domain.CompanyStack{Company: name, Jobs: jobs})
+		}
+		cs := &stacks[len(stacks)-1]
+		cs.Stack = append(cs.Stack, domain.StackUsage{
+			Tech:     tech,
+			Category: StackCategory(tech),
+			Jobs:     uses,
+			Share:    float64(uses) / float64(jobs),
+		})
+	}
+	return stacks, rows.Err()
+}
@@ -0,0 +1,15 @@
+// Package ctxkeys holds the fiber.Ctx locals keys shared by middleware and
+// handlers, so neither package has to import the other for them.
+package ctxkeys
+
+// StrictJSON is the fiber.Ctx local that enables strict body decoding for a request
+const StrictJSON = "strict_json"
+
+// LLMRelease is the fiber.Ctx local holding a *func() that releases the
+// request's LLM queue slot. Streaming handlers take it over to hold the slot
+// until their response is written.
+const LLMRelease = "llm_release"
+
+// ClientIP is the fiber.Ctx local holding the client address resolved through
+// trusted proxies
+const ClientIP = "client_ip"
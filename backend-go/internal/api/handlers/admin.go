@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/cache"
+)
+
+// CacheWarmer pre-computes cached results
+type CacheWarmer interface {
+	WarmSavedSearches(ctx context.Context) (int, error)
+}
+
+// PayloadLogControl toggles request/response payload logging at runtime
+type PayloadLogControl interface {
+	SetEnabled(enabled bool)
+	SetSampleRate(rate float64)
+	SetRoute(route string, enabled bool)
+	Status() (enabled bool, sampleRate float64, routes []string)
+}
+
+// AdminHandler handles administrative API requests
+type AdminHandler struct {
+	cache      *cache.LRU
+	warmer     CacheWarmer
+	payloadLog PayloadLogControl
+}
+
+// NewAdminHandler creates a new admin handler
+func NewAdminHandler(c *cache.LRU, warmer CacheWarmer, payloadLog PayloadLogControl) *AdminHandler {
+	return &AdminHandler{cache: c, warmer: warmer, payloadLog: payloadLog}
+}
+
+// GetCacheStats handles GET /api/admin/cache/stats
+func (h *AdminHandler) GetCacheStats(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"enabled": h.cache != nil,
+		"stats":   h.cache.Stats(),
+	})
+}
+
+// FlushCache handles POST /api/admin/cache/flush
+func (h *AdminHandler) FlushCache(c *fiber.Ctx) error {
+	var req struct {
+		Prefix string `json:"prefix"`
+	}
+	if err := parseOptionalBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	prefix := c.Query("prefix", req.Prefix)
+	removed := h.cache.Flush(prefix)
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"prefix":  prefix,
+		"removed": removed,
+	})
+}
+
+// WarmCache handles POST /api/admin/cache/warm
+func (h *AdminHandler) WarmCache(c *fiber.Ctx) error {
+	if h.cache == nil || h.warmer == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error":   "cache_disabled",
+			"message": "Caching is disabled",
+		})
+	}
+
+	warmed, err := h.warmer.WarmSavedSearches(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "warm_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"warmed":  warmed,
+	})
+}
+
+// GetPayloadLogging handles GET /api/admin/payload-logging
+func (h *AdminHandler) GetPayloadLogging(c *fiber.Ctx) error {
+	if h.payloadLog == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error":   "unavailable",
+			"message": "Payload logging is not configured",
+		})
+	}
+
+	enabled, sampleRate, routes := h.payloadLog.Status()
+	return c.JSON(fiber.Map{
+		"enabled":     enabled,
+		"sample_rate": sampleRate,
+		"routes":      routes,
+	})
+}
+
+// UpdatePayloadLogging handles PUT /api/admin/payload-logging
+func (h *AdminHandler) UpdatePayloadLogging(c *fiber.Ctx) error {
+	if h.payloadLog == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error":   "unavailable",
+			"message": "Payload logging is not configured",
+		})
+	}
+
+	var req struct {
+		Enabled       *bool    `json:"enabled"`
+		SampleRate    *float64 `json:"sample_rate"`
+		EnableRoutes  []string `json:"enable_routes"`
+		DisableRoutes []string `json:"disable_routes"`
+	}
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	if req.SampleRate != nil && (*req.SampleRate < 0 || *req.SampleRate > 1) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": "sample_rate must be between 0 and 1",
+		})
+	}
+
+	if req.Enabled != nil {
+		h.payloadLog.SetEnabled(*req.Enabled)
+	}
+	if req.SampleRate != nil {
+		h.payloadLog.SetSampleRate(*req.SampleRate)
+	}
+	for _, route := range req.EnableRoutes {
+		h.payloadLog.SetRoute(route, true)
+	}
+	for _, route := range req.DisableRoutes {
+		h.payloadLog.SetRoute(route, false)
+	}
+
+	return h.GetPayloadLogging(c)
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// maxTrendSkills caps how many skills can be compared in one trends request
+const maxTrendSkills = 10
+
+// MarketInsights computes analytics over the scraped job corpus
+type MarketInsights interface {
+	SalaryDistribution(ctx context.Context, q domain.SalaryInsightQuery) ([]domain.SalaryInsight, error)
+	TopSkills(ctx context.Context, weeks, limit int) ([]domain.SkillDemandSeries, error)
+	SkillTrends(ctx context.Context, skills []string, weeks int) ([]domain.SkillDemandSeries, error)
+	WorkArrangementBySource(ctx context.Context) ([]domain.WorkArrangementInsight, error)
+	WhatWorks(ctx context.Context) (*domain.WhatWorksReport, error)
+	CompanyStacks(ctx context.Context, company string, limit int) ([]domain.CompanyStack, error)
+}
+
+// AnalyticsHandler handles job market analytics requests
+type AnalyticsHandler struct {
+	insights MarketInsights
+}
+
+// NewAnalyticsHandler creates a new analytics handler
+func NewAnalyticsHandler(insights MarketInsights) *AnalyticsHandler {
+	return &AnalyticsHandler{insights: insights}
+}
+
+// GetSalaries handles GET /api/analytics/salaries
+func (h *AnalyticsHandler) GetSalaries(c *fiber.Ctx) error {
+	q := domain.SalaryInsightQuery{
+		Title:    c.Query("title"),
+		Location: c.Query("location"),
+		GroupBy:  c.Query("group_by", "title"),
+		Limit:    clamp(c.QueryInt("limit", 20), 1, 100),
+	}
+	if q.GroupBy != "title" && q.GroupBy != "location" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": "group_by must be title or location",
+		})
+	}
+
+	insights, err := h.insights.SalaryDistribution(c.Context(), q)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"group_by": q.GroupBy,
+		"groups":   insights,
+	})
+}
+
+// GetTopSkills handles GET /api/analytics/skills/top
+func (h *AnalyticsHandler) GetTopSkills(c *fiber.Ctx) error {
+	weeks := clamp(c.QueryInt("weeks", 12), 1, 104)
+	limit := clamp(c.QueryInt("limit", 20), 1, 100)
+
+	series, err := h.insights.TopSkills(c.Context(), weeks, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"weeks":  weeks,
+		"skills": series,
+	})
+}
+
+// GetSkillTrends handles GET /api/analytics/skills/trends?skills=go,rust
+func (h *AnalyticsHandler) GetSkillTrends(c *fiber.Ctx) error {
+	var skills []string
+	for _, skill := range strings.Split(c.Query("skills"), ",") {
+		if skill = strings.TrimSpace(skill); skill != "" {
+			skills = append(skills, skill)
+		}
+	}
+	if len(skills) == 0 || len(skills) > maxTrendSkills {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": fmt.Sprintf("skills must list between 1 and %d comma-separated skills", maxTrendSkills),
+		})
+	}
+	weeks := clamp(c.QueryInt("weeks", 26), 1, 104)
+
+	series, err := h.insights.SkillTrends(c.Context(), skills, weeks)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"weeks":  weeks,
+		"skills": series,
+	})
+}
+
+// GetWorkArrangement handles GET /api/analytics/work-arrangement
+func (h *AnalyticsHandler) GetWorkArrangement(c *fiber.Ctx) error {
+	insights, err := h.insights.WorkArrangementBySource(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"sources": insights,
+	})
+}
+
+// GetCompanyStacks handles GET /api/analytics/stack/companies?company=acme
+func (h *AnalyticsHandler) GetCompanyStacks(c *fiber.Ctx) error {
+	company := strings.TrimSpace(c.Query("company"))
+	limit := clamp(c.QueryInt("limit", 20), 1, 100)
+
+	stacks, err := h.insights.CompanyStacks(c.Context(), company, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"companies": stacks,
+	})
+}
+
+// GetWhatWorks handles GET /api/analytics/what-works
+func (h *AnalyticsHandler) GetWhatWorks(c *fiber.Ctx) error {
+	report, err := h.insights.WhatWorks(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(report)
+}
+
+// clamp bounds v to [lo, hi]
+func clamp(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
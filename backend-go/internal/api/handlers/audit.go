@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/audit"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// AuditHandler handles audit log API requests
+type AuditHandler struct {
+	store audit.Store
+}
+
+// NewAuditHandler creates a new audit handler
+func NewAuditHandler(store audit.Store) *AuditHandler {
+	return &AuditHandler{store: store}
+}
+
+// GetAuditLog handles GET /api/admin/audit
+func (h *AuditHandler) GetAuditLog(c *fiber.Ctx) error {
+	q := domain.AuditQuery{
+		Actor:      c.Query("actor"),
+		Action:     c.Query("action"),
+		Resource:   c.Query("resource"),
+		ResourceID: c.Query("resource_id"),
+		Limit:      c.QueryInt("limit", 50),
+		Offset:     c.QueryInt("offset", 0),
+	}
+
+	for param, dst := range map[string]**time.Time{"since": &q.Since, "until": &q.Until} {
+		if v := c.Query(param); v != "" {
+			t, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "invalid_request",
+					"message": "Invalid " + param + " timestamp, expected RFC3339",
+				})
+			}
+			*dst = &t
+		}
+	}
+
+	result, err := h.store.Query(c.Context(), q)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(result)
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/backup"
+	"github.com/resume-rag/backend/internal/storage"
+)
+
+// BackupWriter writes backup archives of the deployment
+type BackupWriter interface {
+	Backup(ctx context.Context, w io.Writer) (*backup.Manifest, error)
+}
+
+// BackupHandler handles backup API requests
+type BackupHandler struct {
+	backups   BackupWriter
+	downloads *Downloads
+}
+
+// NewBackupHandler creates a new backup handler
+func NewBackupHandler(backups BackupWriter, downloads *Downloads) *BackupHandler {
+	return &BackupHandler{backups: backups, downloads: downloads}
+}
+
+// CreateBackup handles POST /api/admin/backup. The archive is built in a
+// temporary file, then stored under the backups prefix and returned as a
+// download link with its manifest.
+func (h *BackupHandler) CreateBackup(c *fiber.Ctx) error {
+	f, err := os.CreateTemp("", "backup-*.tar.gz")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "backup_failed",
+			"message": err.Error(),
+		})
+	}
+	archive := &tempFile{File: f}
+	defer archive.Close()
+
+	manifest, err := h.backups.Backup(c.UserContext(), f)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "backup_failed",
+			"message": err.Error(),
+		})
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err == nil {
+		_, err = f.Seek(0, io.SeekStart)
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "backup_failed",
+			"message": err.Error(),
+		})
+	}
+
+	key := storage.PrefixBackups + "resumeai-backup-" + time.Now().UTC().Format("20060102-150405") + ".tar.gz"
+	download, err := h.downloads.Publish(c.UserContext(), key, f, size, "application/gzip")
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "backup_failed",
+			"message": err.Error(),
+		})
+	}
+	return c.JSON(fiber.Map{
+		"download": download,
+		"manifest": manifest,
+	})
+}
+
+// tempFile is a temporary file removed when closed
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	os.Remove(f.Name())
+	return err
+}
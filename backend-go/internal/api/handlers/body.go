@@ -0,0 +1,200 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/api/ctxkeys"
+)
+
+// FieldError describes a single offending field in a strictly decoded body
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// StrictBodyError is returned when strict decoding finds unknown or mistyped fields
+type StrictBodyError struct {
+	Fields []FieldError
+}
+
+func (e *StrictBodyError) Error() string {
+	names := make([]string, len(e.Fields))
+	for i, f := range e.Fields {
+		names[i] = f.Field
+	}
+	return "invalid fields: " + strings.Join(names, ", ")
+}
+
+// parseBody decodes the request body into out.
+// In strict mode JSON bodies with unknown fields or type mismatches are rejected.
+func parseBody(c *fiber.Ctx, out interface{}) error {
+	strict, _ := c.Locals(ctxkeys.StrictJSON).(bool)
+	if !strict || !strings.HasPrefix(c.Get(fiber.HeaderContentType), fiber.MIMEApplicationJSON) {
+		return c.BodyParser(out)
+	}
+
+	body := bytes.TrimSpace(c.Body())
+	var fields []FieldError
+	checkFields(body, reflect.TypeOf(out), "", &fields)
+	if len(fields) > 0 {
+		sort.Slice(fields, func(i, j int) bool { return fields[i].Field < fields[j].Field })
+		return &StrictBodyError{Fields: fields}
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+// parseOptionalBody decodes a request body the handler can do without. An
+// empty body, or one that fails to decode outside strict mode, leaves out
+// unchanged; strict mode still rejects unknown and mistyped fields.
+func parseOptionalBody(c *fiber.Ctx, out interface{}) error {
+	if len(bytes.TrimSpace(c.Body())) == 0 {
+		return nil
+	}
+	err := parseBody(c, out)
+	var strictErr *StrictBodyError
+	if errors.As(err, &strictErr) {
+		return err
+	}
+	return nil
+}
+
+// invalidBody writes the error response for a body that failed to parse
+func invalidBody(c *fiber.Ctx, err error) error {
+	var strictErr *StrictBodyError
+	if errors.As(err, &strictErr) {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error":   "invalid_fields",
+			"message": "Request body contains unknown or invalid fields",
+			"fields":  strictErr.Fields,
+		})
+	}
+
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error":   "invalid_request",
+		"message": "Invalid request body",
+	})
+}
+
+var (
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// checkFields walks raw JSON against t, collecting unknown fields and type mismatches
+func checkFields(raw []byte, t reflect.Type, path string, errs *[]FieldError) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return
+	}
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	// Types with custom decoding (time.Time, uuid.UUID, ...) are checked as a whole
+	if reflect.PointerTo(t).Implements(jsonUnmarshalerType) || reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		checkLeaf(raw, t, path, errs)
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var obj map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			*errs = append(*errs, FieldError{Field: fieldPath(path), Reason: "expected object"})
+			return
+		}
+		known := jsonFields(t)
+		for key, value := range obj {
+			child := key
+			if path != "" {
+				child = path + "." + key
+			}
+			ft, ok := known[key]
+			if !ok {
+				*errs = append(*errs, FieldError{Field: child, Reason: "unknown field"})
+				continue
+			}
+			checkFields(value, ft, child, errs)
+		}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			checkLeaf(raw, t, path, errs)
+			return
+		}
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			*errs = append(*errs, FieldError{Field: fieldPath(path), Reason: "expected array"})
+			return
+		}
+		for i, item := range items {
+			checkFields(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i), errs)
+		}
+	default:
+		checkLeaf(raw, t, path, errs)
+	}
+}
+
+func checkLeaf(raw []byte, t reflect.Type, path string, errs *[]FieldError) {
+	if err := json.Unmarshal(raw, reflect.New(t).Interface()); err != nil {
+		*errs = append(*errs, FieldError{Field: fieldPath(path), Reason: "expected " + t.String()})
+	}
+}
+
+// jsonFields maps JSON names to field types for a struct, following encoding/json
+// tag rules. Fields of untagged embedded structs are promoted, and a field
+// declared on the outer struct wins over a promoted one of the same name.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type, t.NumField())
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				embedded = append(embedded, ft)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = f.Type
+	}
+
+	for _, et := range embedded {
+		for name, ft := range jsonFields(et) {
+			if _, ok := fields[name]; !ok {
+				fields[name] = ft
+			}
+		}
+	}
+	return fields
+}
+
+func fieldPath(path string) string {
+	if path == "" {
+		return "(body)"
+	}
+	return path
+}
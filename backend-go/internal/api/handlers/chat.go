@@ -1,56 +1,59 @@
 package handlers
 
 import (
+	"bufio"
 	"context"
+	"errors"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
 	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/rag"
 )
 
 // ChatService defines the interface for chat operations
 type ChatService interface {
 	Chat(ctx context.Context, req domain.ChatRequest) (*domain.ChatResponse, error)
+	ChatStream(ctx context.Context, req domain.ChatRequest, onText func(string) error) (*domain.ChatResponse, error)
 	GetSuggestions(ctx context.Context, mode domain.ChatMode) (*domain.ChatSuggestionsResponse, error)
 	GetHistory(ctx context.Context, sessionID *uuid.UUID, limit int) (*domain.ChatHistoryResponse, error)
 	ClearHistory(ctx context.Context, sessionID *uuid.UUID) error
+	SearchHistory(ctx context.Context, query string, limit int) (*domain.ChatSearchResponse, error)
 }
 
 // ChatHandler handles chat API requests
 type ChatHandler struct {
 	service ChatService
+	stories StoryRetriever
 }
 
-// NewChatHandler creates a new chat handler
-func NewChatHandler(service ChatService) *ChatHandler {
-	return &ChatHandler{service: service}
+// NewChatHandler creates a new chat handler. stories, when set, attaches
+// relevant story bank stories to chat and interview requests.
+func NewChatHandler(service ChatService, stories StoryRetriever) *ChatHandler {
+	return &ChatHandler{service: service, stories: stories}
 }
 
 // Chat handles POST /api/chat
 func (h *ChatHandler) Chat(c *fiber.Ctx) error {
 	var req domain.ChatRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error":   "invalid_request",
-			"message": "Invalid request body",
-		})
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
 	}
-
-	// Validate
-	if req.Message == "" {
+	if problem := h.prepare(c.Context(), &req); problem != "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error":   "invalid_request",
-			"message": "Message is required",
+			"message": problem,
 		})
 	}
 
-	// Set default mode
-	if req.Mode == "" {
-		req.Mode = domain.ChatModeChat
-	}
-
 	result, err := h.service.Chat(c.Context(), req)
+	if errors.Is(err, rag.ErrNoResume) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error":   "no_resume",
+			"message": "Upload a resume before chatting",
+		})
+	}
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error":   "chat_failed",
@@ -61,6 +64,75 @@ func (h *ChatHandler) Chat(c *fiber.Ctx) error {
 	return c.JSON(result)
 }
 
+// ChatStream handles POST /api/chat/stream. It takes the same request as Chat
+// and answers with server-sent events: a "token" event for each piece of the
+// answer as the LLM produces it, then a "done" event with the full chat
+// response, or an "error" event.
+func (h *ChatHandler) ChatStream(c *fiber.Ctx) error {
+	var req domain.ChatRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if problem := h.prepare(c.Context(), &req); problem != "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": problem,
+		})
+	}
+
+	// The stream is written after the handler returns, so it runs on the user
+	// context (which carries the tenant) and keeps the request's LLM queue slot
+	ctx := c.UserContext()
+	release := takeLLMSlot(c)
+
+	c.Set(fiber.HeaderContentType, "text/event-stream")
+	c.Set(fiber.HeaderCacheControl, "no-cache")
+	c.Set(fiber.HeaderConnection, "keep-alive")
+	c.Set("X-Accel-Buffering", "no")
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer release()
+
+		// A failed write means the client has gone; the error stops the LLM request
+		result, err := h.service.ChatStream(ctx, req, func(text string) error {
+			return writeEvent(w, "token", fiber.Map{"text": text})
+		})
+		switch {
+		case errors.Is(err, rag.ErrNoResume):
+			_ = writeEvent(w, "error", fiber.Map{"error": "no_resume", "message": "Upload a resume before chatting"})
+		case err != nil:
+			_ = writeEvent(w, "error", fiber.Map{"error": "chat_failed", "message": err.Error()})
+		default:
+			_ = writeEvent(w, "done", result)
+		}
+	})
+	return nil
+}
+
+// prepare validates a chat request, defaulting its mode, and attaches relevant
+// stories. It returns what is wrong with an invalid request.
+func (h *ChatHandler) prepare(ctx context.Context, req *domain.ChatRequest) string {
+	if req.Message == "" {
+		return "Message is required"
+	}
+	if req.Mode == "" {
+		req.Mode = domain.ChatModeChat
+	}
+	if !req.Mode.IsValid() {
+		return "Unknown chat mode"
+	}
+	if domain.GetModeProfile(req.Mode).RequiresJob && req.JobDescription == nil {
+		return "Job description is required for this mode"
+	}
+
+	// Ground behavioral answers in vetted stories rather than invented ones
+	if h.stories != nil && (req.Mode == domain.ChatModeInterview || req.Mode == domain.ChatModeChat) {
+		if matches, err := h.stories.Relevant(ctx, req.Message, maxStoryMatches); err == nil {
+			req.Stories = matches
+		}
+	}
+	return ""
+}
+
 // GetSuggestions handles GET /api/chat/suggestions
 func (h *ChatHandler) GetSuggestions(c *fiber.Ctx) error {
 	mode := domain.ChatMode(c.Query("mode", "chat"))
@@ -98,6 +170,28 @@ func (h *ChatHandler) GetHistory(c *fiber.Ctx) error {
 	return c.JSON(result)
 }
 
+// SearchHistory handles GET /api/chat/history/search
+func (h *ChatHandler) SearchHistory(c *fiber.Ctx) error {
+	query := c.Query("q")
+	if query == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": "Query parameter q is required",
+		})
+	}
+	limit := c.QueryInt("limit", 20)
+
+	result, err := h.service.SearchHistory(c.Context(), query, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "search_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(result)
+}
+
 // ClearHistory handles DELETE /api/chat/history
 func (h *ChatHandler) ClearHistory(c *fiber.Ctx) error {
 	var sessionID *uuid.UUID
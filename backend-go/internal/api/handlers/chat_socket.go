@@ -0,0 +1,385 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofiber/contrib/websocket"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/llm"
+	"github.com/resume-rag/backend/internal/rag"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+const (
+	// chatSocketIdle closes a socket the client has not sent anything on,
+	// typing signals and pings included
+	chatSocketIdle = 10 * time.Minute
+	// chatSocketWriteTimeout bounds writing one event to a slow client
+	chatSocketWriteTimeout = 10 * time.Second
+	// maxChatSocketMessage caps a client message, job description included
+	maxChatSocketMessage = 256 << 10
+
+	// chatSocketLocal carries a socket's state from Connect to the upgraded
+	// connection
+	chatSocketLocal = "chat_socket"
+)
+
+// LLMSlots queues requests for an LLM backend's capacity
+type LLMSlots interface {
+	Acquire(ctx context.Context, backend string) (func(), error)
+}
+
+// ChatSocketLimiter applies the chat message limits to socket messages
+type ChatSocketLimiter interface {
+	// Socket returns a check for the messages of a socket opened by c, which
+	// returns why a message is refused or "" when it is allowed
+	Socket(c *fiber.Ctx) func(session string) string
+}
+
+// ChatSocketHandler serves chat over a WebSocket. A socket holds one chat
+// session, so messages carry neither history nor a session ID; answers stream
+// back token by token and can be cancelled.
+//
+// Client messages are JSON objects with a type:
+//
+//	{"type": "message", "message": "...", "mode": "...", ...}  the fields of POST /api/chat
+//	{"type": "resume", "session_id": "..."}                    switch to an earlier session
+//	{"type": "cancel"}                                         stop the answer in progress
+//	{"type": "typing"}                                         keeps an idle socket open
+//
+// The server sends "session" (the session ID and its messages, on connect and
+// resume), "token", "done" (the full chat response), "cancelled" and "error"
+// events, as {"type": ..., ...}.
+type ChatSocketHandler struct {
+	chat    *ChatHandler
+	slots   LLMSlots          // may be nil
+	backend string            // default LLM backend, for queueing
+	limiter ChatSocketLimiter // may be nil
+	origins map[string]bool   // allowed browser origins; "*" allows any
+	upgrade fiber.Handler
+}
+
+// NewChatSocketHandler creates a chat socket handler. slots and limiter, when
+// set, apply the LLM queue and chat limits of POST /api/chat to each message.
+// Browsers may only connect from origins (the CORS allowlist).
+func NewChatSocketHandler(chat *ChatHandler, slots LLMSlots, backend string, limiter ChatSocketLimiter, origins []string) *ChatSocketHandler {
+	h := &ChatSocketHandler{chat: chat, slots: slots, backend: backend, limiter: limiter, origins: make(map[string]bool)}
+	for _, origin := range origins {
+		h.origins[origin] = true
+	}
+	h.upgrade = websocket.New(func(conn *websocket.Conn) {
+		s := conn.Locals(chatSocketLocal).(*chatSocket)
+		s.conn = conn
+		s.serve()
+	})
+	return h
+}
+
+// Connect handles GET /api/chat/ws. A session_id query parameter resumes that
+// session; otherwise the socket starts a new one.
+func (h *ChatSocketHandler) Connect(c *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(c) {
+		c.Set(fiber.HeaderUpgrade, "websocket")
+		return c.Status(fiber.StatusUpgradeRequired).JSON(fiber.Map{
+			"error":   "upgrade_required",
+			"message": "Connect with a WebSocket client",
+		})
+	}
+	// Browsers send cookies with cross-site socket requests, and CORS does
+	// not apply to them; clients other than browsers send no Origin
+	if origin := c.Get(fiber.HeaderOrigin); origin != "" && !h.origins["*"] && !h.origins[origin] {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error":   "forbidden_origin",
+			"message": "Origin not allowed",
+		})
+	}
+
+	session := uuid.New()
+	var history []domain.ChatMessage
+	if sid := c.Query("session_id"); sid != "" {
+		id, err := uuid.Parse(sid)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid_request",
+				"message": "Invalid session ID",
+			})
+		}
+		messages, found, err := h.sessionMessages(c.Context(), id)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "fetch_failed",
+				"message": err.Error(),
+			})
+		}
+		if !found {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error":   "not_found",
+				"message": "Chat session not found",
+			})
+		}
+		session, history = id, messages
+	}
+
+	// The socket outlives the handler, so it keeps the user context (which
+	// carries the tenant) and the limits of the connecting user, not c
+	s := &chatSocket{
+		handler: h,
+		ctx:     c.UserContext(),
+		session: session,
+		history: history,
+		allow:   func(string) string { return "" },
+	}
+	if h.limiter != nil {
+		s.allow = h.limiter.Socket(c)
+	}
+	c.Locals(chatSocketLocal, s)
+	return h.upgrade(c)
+}
+
+// sessionMessages returns the messages of a chat session and whether it exists
+func (h *ChatSocketHandler) sessionMessages(ctx context.Context, id uuid.UUID) ([]domain.ChatMessage, bool, error) {
+	result, err := h.chat.service.GetHistory(ctx, &id, 1)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, session := range result.Sessions {
+		if session.ID == id {
+			return session.Messages, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// chatSocket is one connected chat socket
+type chatSocket struct {
+	handler *ChatSocketHandler
+	ctx     context.Context
+	conn    *websocket.Conn
+	history []domain.ChatMessage // of the session on connect
+	allow   func(session string) string
+
+	writeMu sync.Mutex // one message at a time
+
+	mu      sync.Mutex
+	session uuid.UUID
+	cancel  context.CancelFunc // of the answer in progress, nil when idle
+	answers sync.WaitGroup
+}
+
+// chatSocketEvent is a message to the client
+type chatSocketEvent struct {
+	Type      string               `json:"type"`
+	SessionID string               `json:"session_id,omitempty"`
+	Messages  []domain.ChatMessage `json:"messages,omitempty"`
+	Text      string               `json:"text,omitempty"`
+	Response  *domain.ChatResponse `json:"response,omitempty"`
+	Error     string               `json:"error,omitempty"`
+	Message   string               `json:"message,omitempty"`
+}
+
+// serve reads client messages until the socket closes, then stops the
+// answer in progress and waits for it. A message over the size limit closes
+// the socket.
+func (s *chatSocket) serve() {
+	defer func() {
+		s.stop()
+		s.answers.Wait()
+	}()
+
+	history := s.history
+	if history == nil {
+		history = []domain.ChatMessage{}
+	}
+	if s.send(chatSocketEvent{Type: "session", SessionID: s.session.String(), Messages: history}) != nil {
+		return
+	}
+
+	s.conn.SetReadLimit(maxChatSocketMessage)
+	s.conn.SetPingHandler(func(data string) error {
+		_ = s.conn.SetReadDeadline(time.Now().Add(chatSocketIdle))
+		err := s.conn.WriteControl(websocket.PongMessage, []byte(data), time.Now().Add(chatSocketWriteTimeout))
+		if errors.Is(err, websocket.ErrCloseSent) {
+			return nil
+		}
+		return err
+	})
+
+	for {
+		_ = s.conn.SetReadDeadline(time.Now().Add(chatSocketIdle))
+		_, data, err := s.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		s.handle(data)
+	}
+}
+
+// handle acts on one client message
+func (s *chatSocket) handle(data []byte) {
+	var envelope struct {
+		Type      string `json:"type"`
+		SessionID string `json:"session_id"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		_ = s.sendError("invalid_request", "Messages must be JSON objects")
+		return
+	}
+
+	switch envelope.Type {
+	case "message":
+		var req domain.ChatRequest
+		if err := json.Unmarshal(data, &req); err != nil {
+			_ = s.sendError("invalid_request", err.Error())
+			return
+		}
+		s.answer(req)
+
+	case "resume":
+		id, err := uuid.Parse(envelope.SessionID)
+		if err != nil {
+			_ = s.sendError("invalid_request", "Invalid session ID")
+			return
+		}
+		if s.busy() {
+			_ = s.sendError("busy", "Wait for the answer in progress or cancel it")
+			return
+		}
+		messages, found, err := s.handler.sessionMessages(s.ctx, id)
+		switch {
+		case err != nil:
+			_ = s.sendError("fetch_failed", err.Error())
+		case !found:
+			_ = s.sendError("not_found", "Chat session not found")
+		default:
+			s.mu.Lock()
+			s.session = id
+			s.mu.Unlock()
+			_ = s.send(chatSocketEvent{Type: "session", SessionID: id.String(), Messages: messages})
+		}
+
+	case "cancel":
+		s.stop()
+
+	case "typing":
+		// Receiving it has already pushed back the idle deadline
+
+	default:
+		_ = s.sendError("invalid_request", "Unknown message type")
+	}
+}
+
+// answer validates a chat message and streams its answer from a goroutine,
+// so the socket keeps reading cancel signals meanwhile
+func (s *chatSocket) answer(req domain.ChatRequest) {
+	if problem := s.handler.chat.prepare(s.ctx, &req); problem != "" {
+		_ = s.sendError("invalid_request", problem)
+		return
+	}
+
+	s.mu.Lock()
+	if s.cancel != nil {
+		s.mu.Unlock()
+		_ = s.sendError("busy", "Wait for the answer in progress or cancel it")
+		return
+	}
+	session := s.session.String()
+	req.SessionID = &session
+	if reason := s.allow(session); reason != "" {
+		s.mu.Unlock()
+		_ = s.sendError("chat_rate_limited", reason)
+		return
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.cancel = cancel
+	s.answers.Add(1)
+	s.mu.Unlock()
+
+	go func() {
+		defer s.answers.Done()
+		defer func() {
+			cancel()
+			s.mu.Lock()
+			s.cancel = nil
+			s.mu.Unlock()
+		}()
+
+		release, err := s.acquire(ctx)
+		if err != nil {
+			switch {
+			case ctx.Err() != nil:
+				_ = s.send(chatSocketEvent{Type: "cancelled"})
+			case errors.Is(err, llm.ErrQueueFull) || errors.Is(err, llm.ErrQueueTimeout):
+				_ = s.sendError("llm_busy", "The language model is busy. Please try again shortly.")
+			default:
+				_ = s.sendError("chat_failed", err.Error())
+			}
+			return
+		}
+		defer release()
+
+		result, err := s.handler.chat.service.ChatStream(ctx, req, func(text string) error {
+			return s.send(chatSocketEvent{Type: "token", Text: text})
+		})
+		switch {
+		case ctx.Err() != nil:
+			_ = s.send(chatSocketEvent{Type: "cancelled"})
+		case errors.Is(err, rag.ErrNoResume):
+			_ = s.sendError("no_resume", "Upload a resume before chatting")
+		case err != nil:
+			_ = s.sendError("chat_failed", err.Error())
+		default:
+			_ = s.send(chatSocketEvent{Type: "done", Response: result})
+		}
+	}()
+}
+
+// acquire waits for a slot on the LLM backend of the socket's tenant
+func (s *chatSocket) acquire(ctx context.Context) (func(), error) {
+	if s.handler.slots == nil {
+		return func() {}, nil
+	}
+	backend := s.handler.backend
+	if t, ok := tenant.FromContext(s.ctx); ok && t.LLMBackend != "" {
+		backend = t.LLMBackend
+	}
+	return s.handler.slots.Acquire(ctx, backend)
+}
+
+// busy reports whether an answer is in progress
+func (s *chatSocket) busy() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cancel != nil
+}
+
+// stop cancels the answer in progress, if any
+func (s *chatSocket) stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+// send writes one event as a text message
+func (s *chatSocket) send(event chatSocketEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(chatSocketWriteTimeout))
+	return s.conn.WriteMessage(websocket.TextMessage, payload)
+}
+
+func (s *chatSocket) sendError(code, message string) error {
+	return s.send(chatSocketEvent{Type: "error", Error: code, Message: message})
+}
@@ -0,0 +1,150 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/mock/gomock"
+
+	"github.com/resume-rag/backend/internal/api/handlers"
+	"github.com/resume-rag/backend/internal/api/handlers/mocks"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/rag"
+)
+
+func chatApp(service handlers.ChatService) *fiber.App {
+	h := handlers.NewChatHandler(service, nil)
+	app := fiber.New()
+	app.Post("/chat", h.Chat)
+	app.Get("/history", h.GetHistory)
+	app.Get("/history/search", h.SearchHistory)
+	return app
+}
+
+func TestChat(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      string
+		wantMode  domain.ChatMode // mode the service is called with; empty when not called
+		err       error
+		status    int
+		errorCode string
+	}{
+		{name: "malformed body", body: `{"message":`, status: fiber.StatusBadRequest, errorCode: "invalid_request"},
+		{name: "missing message", body: `{"mode":"chat"}`, status: fiber.StatusBadRequest, errorCode: "invalid_request"},
+		{name: "unknown mode", body: `{"message":"hi","mode":"poetry"}`, status: fiber.StatusBadRequest, errorCode: "invalid_request"},
+		{name: "default mode", body: `{"message":"What did I build at my last job?"}`, wantMode: domain.ChatModeChat, status: fiber.StatusOK},
+		{
+			name: "no resume", body: `{"message":"hi"}`, wantMode: domain.ChatModeChat, err: rag.ErrNoResume,
+			status: fiber.StatusConflict, errorCode: "no_resume",
+		},
+		{
+			name: "service error", body: `{"message":"hi"}`, wantMode: domain.ChatModeChat, err: errors.New("llm timeout"),
+			status: fiber.StatusInternalServerError, errorCode: "chat_failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mocks.NewMockChatService(ctrl)
+			if tt.wantMode != "" {
+				service.EXPECT().Chat(gomock.Any(), gomock.Cond(func(x any) bool {
+					return x.(domain.ChatRequest).Mode == tt.wantMode
+				})).Return(&domain.ChatResponse{}, tt.err)
+			}
+
+			status, body := do(t, chatApp(service), fiber.MethodPost, "/chat", tt.body)
+			if status != tt.status {
+				t.Fatalf("status = %d, want %d (%v)", status, tt.status, body)
+			}
+			if tt.errorCode != "" && body["error"] != tt.errorCode {
+				t.Errorf("error = %v, want %s", body["error"], tt.errorCode)
+			}
+		})
+	}
+}
+
+func TestSearchHistory(t *testing.T) {
+	tests := []struct {
+		name   string
+		target string
+		limit  int // limit the service is called with; 0 when not called
+		status int
+	}{
+		{name: "missing query", target: "/history/search", status: fiber.StatusBadRequest},
+		{name: "default limit", target: "/history/search?q=kubernetes", limit: 20, status: fiber.StatusOK},
+		{name: "explicit limit", target: "/history/search?q=kubernetes&limit=5", limit: 5, status: fiber.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mocks.NewMockChatService(ctrl)
+			if tt.limit > 0 {
+				service.EXPECT().SearchHistory(gomock.Any(), "kubernetes", tt.limit).
+					Return(&domain.ChatSearchResponse{Query: "kubernetes"}, nil)
+			}
+
+			if status, body := do(t, chatApp(service), fiber.MethodGet, tt.target, ""); status != tt.status {
+				t.Fatalf("status = %d, want %d (%v)", status, tt.status, body)
+			}
+		})
+	}
+}
+
+func TestGetHistoryDefaultLimit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mocks.NewMockChatService(ctrl)
+	service.EXPECT().GetHistory(gomock.Any(), gomock.Nil(), 20).Return(&domain.ChatHistoryResponse{}, nil)
+
+	if status, body := do(t, chatApp(service), fiber.MethodGet, "/history", ""); status != fiber.StatusOK {
+		t.Fatalf("status = %d, want 200 (%v)", status, body)
+	}
+}
+
+func TestChatSocketRefusesBeforeUpgrade(t *testing.T) {
+	upgrade := map[string]string{
+		"Connection":            "Upgrade",
+		"Upgrade":               "websocket",
+		"Sec-WebSocket-Key":     "dGhlIHNhbXBsZSBub25jZQ==",
+		"Sec-WebSocket-Version": "13",
+	}
+	tests := []struct {
+		name    string
+		headers map[string]string
+		origin  string
+		status  int
+	}{
+		{name: "plain request", status: fiber.StatusUpgradeRequired},
+		{name: "origin not allowed", headers: upgrade, origin: "https://evil.example", status: fiber.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			h := handlers.NewChatSocketHandler(handlers.NewChatHandler(mocks.NewMockChatService(ctrl), nil),
+				nil, "", nil, []string{"https://app.example"})
+			app := fiber.New()
+			app.Get("/ws", h.Connect)
+
+			req := httptest.NewRequest(fiber.MethodGet, "/ws", nil)
+			for k, v := range tt.headers {
+				req.Header.Set(k, v)
+			}
+			if tt.origin != "" {
+				req.Header.Set(fiber.HeaderOrigin, tt.origin)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+			if resp.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.status)
+			}
+		})
+	}
+}
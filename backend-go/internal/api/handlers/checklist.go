@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/checklist"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ChecklistService keeps per-application checklists
+type ChecklistService interface {
+	Templates() map[string][]string
+	Get(ctx context.Context, appID uuid.UUID) (*domain.Checklist, error)
+	AddItem(ctx context.Context, appID uuid.UUID, in domain.ChecklistItemInput) (*domain.Checklist, error)
+	UpdateItem(ctx context.Context, appID uuid.UUID, itemID string, update domain.ChecklistItemUpdate) (*domain.Checklist, error)
+	DeleteItem(ctx context.Context, appID uuid.UUID, itemID string) (*domain.Checklist, error)
+	NextActions(ctx context.Context, apps []domain.Application, limit int) ([]domain.NextAction, error)
+}
+
+// ChecklistHandler handles application checklist requests
+type ChecklistHandler struct {
+	service ChecklistService
+}
+
+// NewChecklistHandler creates a new checklist handler
+func NewChecklistHandler(service ChecklistService) *ChecklistHandler {
+	return &ChecklistHandler{service: service}
+}
+
+// GetTemplates handles GET /api/job-list/checklist/templates
+func (h *ChecklistHandler) GetTemplates(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"templates": h.service.Templates(),
+	})
+}
+
+// GetChecklist handles GET /api/job-list/applications/:app_id/checklist
+func (h *ChecklistHandler) GetChecklist(c *fiber.Ctx) error {
+	appID, ok := applicationID(c)
+	if !ok {
+		return nil
+	}
+
+	list, err := h.service.Get(c.Context(), appID)
+	if err != nil {
+		return checklistFailed(c, err)
+	}
+
+	return c.JSON(list)
+}
+
+// AddItem handles POST /api/job-list/applications/:app_id/checklist/items
+func (h *ChecklistHandler) AddItem(c *fiber.Ctx) error {
+	appID, ok := applicationID(c)
+	if !ok {
+		return nil
+	}
+	var req domain.ChecklistItemInput
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	list, err := h.service.AddItem(c.Context(), appID, req)
+	if err != nil {
+		return checklistFailed(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(list)
+}
+
+// UpdateItem handles PUT /api/job-list/applications/:app_id/checklist/items/:item_id
+func (h *ChecklistHandler) UpdateItem(c *fiber.Ctx) error {
+	appID, ok := applicationID(c)
+	if !ok {
+		return nil
+	}
+	var req domain.ChecklistItemUpdate
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	list, err := h.service.UpdateItem(c.Context(), appID, c.Params("item_id"), req)
+	if err != nil {
+		return checklistFailed(c, err)
+	}
+
+	return c.JSON(list)
+}
+
+// DeleteItem handles DELETE /api/job-list/applications/:app_id/checklist/items/:item_id
+func (h *ChecklistHandler) DeleteItem(c *fiber.Ctx) error {
+	appID, ok := applicationID(c)
+	if !ok {
+		return nil
+	}
+
+	list, err := h.service.DeleteItem(c.Context(), appID, c.Params("item_id"))
+	if err != nil {
+		return checklistFailed(c, err)
+	}
+
+	return c.JSON(list)
+}
+
+// applicationID parses the app_id param, writing the error response when invalid
+func applicationID(c *fiber.Ctx) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Params("app_id"))
+	if err != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid application ID format",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// checklistFailed writes the error response for a failed checklist operation
+func checklistFailed(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, checklist.ErrApplicationNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Application not found",
+		})
+	case errors.Is(err, checklist.ErrItemNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Checklist item not found",
+		})
+	case errors.Is(err, checklist.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "checklist_failed",
+			"message": err.Error(),
+		})
+	}
+}
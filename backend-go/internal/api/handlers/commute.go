@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/commute"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// CommuteEstimator estimates commutes from the user's saved home location
+type CommuteEstimator interface {
+	Home(ctx context.Context) (*domain.CommuteHome, error)
+	SetHome(ctx context.Context, update domain.CommuteHomeUpdate) (*domain.CommuteHome, error)
+	Estimate(ctx context.Context, job *domain.Job, mode domain.CommuteMode) (*domain.CommuteTime, error)
+}
+
+// CommuteHandler handles commute API requests
+type CommuteHandler struct {
+	commute CommuteEstimator
+	jobs    JobListService
+}
+
+// NewCommuteHandler creates a new commute handler
+func NewCommuteHandler(commute CommuteEstimator, jobs JobListService) *CommuteHandler {
+	return &CommuteHandler{commute: commute, jobs: jobs}
+}
+
+// GetHome handles GET /api/commute/home
+func (h *CommuteHandler) GetHome(c *fiber.Ctx) error {
+	home, err := h.commute.Home(c.Context())
+	switch {
+	case errors.Is(err, commute.ErrNoHome):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "No home location saved",
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(home)
+}
+
+// SetHome handles PUT /api/commute/home
+func (h *CommuteHandler) SetHome(c *fiber.Ctx) error {
+	var req domain.CommuteHomeUpdate
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if req.Mode != "" && !req.Mode.Valid() {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "mode must be driving, transit, cycling or walking",
+		})
+	}
+
+	home, err := h.commute.SetHome(c.Context(), req)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "update_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(home)
+}
+
+// GetJobCommute handles GET /api/commute/jobs/:job_id?mode=transit
+func (h *CommuteHandler) GetJobCommute(c *fiber.Ctx) error {
+	jobID, err := uuid.Parse(c.Params("job_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid job ID format",
+		})
+	}
+	mode := domain.CommuteMode(c.Query("mode"))
+	if mode != "" && !mode.Valid() {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "mode must be driving, transit, cycling or walking",
+		})
+	}
+
+	job, err := h.jobs.GetJobDetails(c.Context(), jobID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Job not found",
+		})
+	}
+
+	estimate, err := h.commute.Estimate(c.Context(), job, mode)
+	switch {
+	case errors.Is(err, commute.ErrNoHome):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error":   "no_home_location",
+			"message": "Save a home location with PUT /api/commute/home first",
+		})
+	case errors.Is(err, commute.ErrNoLocation):
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error":   "no_job_location",
+			"message": "Job location could not be resolved",
+		})
+	case err != nil:
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"error":   "routing_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"job_id":  jobID,
+		"commute": estimate,
+	})
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/questions"
+)
+
+// CompanyQuestionService aggregates interview questions reported per company
+type CompanyQuestionService interface {
+	Report(ctx context.Context, company string, report domain.CompanyQuestionReport) (*domain.CompanyQuestion, error)
+	ForCompany(ctx context.Context, company string, filter domain.CompanyQuestionFilter) ([]domain.CompanyQuestion, error)
+	Import(r io.Reader) (int, error)
+	ImportFile(path string) (int, error)
+	PrepPlan(ctx context.Context, app *domain.Application, retriever questions.StoryRetriever) (*domain.PrepPlan, error)
+}
+
+// CompanyQuestionHandler handles company interview question and prep plan requests
+type CompanyQuestionHandler struct {
+	questions  CompanyQuestionService
+	jobs       JobListService
+	stories    StoryRetriever
+	importPath string
+}
+
+// NewCompanyQuestionHandler creates a company question handler. stories may
+// be nil, in which case prep plans carry no story suggestions.
+func NewCompanyQuestionHandler(questions CompanyQuestionService, jobs JobListService, stories StoryRetriever, importPath string) *CompanyQuestionHandler {
+	return &CompanyQuestionHandler{questions: questions, jobs: jobs, stories: stories, importPath: importPath}
+}
+
+// GetQuestions handles GET /api/interview/company/:company_name/questions?category=&round=&role=
+func (h *CompanyQuestionHandler) GetQuestions(c *fiber.Ctx) error {
+	company := c.Params("company_name")
+	list, err := h.questions.ForCompany(c.Context(), company, domain.CompanyQuestionFilter{
+		Category: c.Query("category"),
+		Round:    c.Query("round"),
+		Role:     c.Query("role"),
+		Limit:    clamp(c.QueryInt("limit", 50), 1, 200),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"company":   company,
+		"questions": list,
+		"total":     len(list),
+	})
+}
+
+// ReportQuestion handles POST /api/interview/company/:company_name/questions
+func (h *CompanyQuestionHandler) ReportQuestion(c *fiber.Ctx) error {
+	var req domain.CompanyQuestionReport
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	q, err := h.questions.Report(c.Context(), c.Params("company_name"), req)
+	switch {
+	case errors.Is(err, questions.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "report_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(q)
+}
+
+// GetPrepPlan handles GET /api/job-list/applications/:app_id/prep
+func (h *CompanyQuestionHandler) GetPrepPlan(c *fiber.Ctx) error {
+	appID, err := uuid.Parse(c.Params("app_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid application ID format",
+		})
+	}
+
+	app, err := h.jobs.GetApplication(c.Context(), appID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Application not found",
+		})
+	}
+
+	var retriever questions.StoryRetriever
+	if h.stories != nil {
+		retriever = h.stories
+	}
+	plan, err := h.questions.PrepPlan(c.Context(), app, retriever)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "prep_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(plan)
+}
+
+// ImportQuestions handles POST /api/admin/interview/questions/import.
+// Accepts a CSV (company,question[,category[,round[,role[,reports]]]]) upload
+// in the "file" field, or re-imports the configured file.
+func (h *CompanyQuestionHandler) ImportQuestions(c *fiber.Ctx) error {
+	imported, err := importCSV(c, h.questions.Import, h.questions.ImportFile, h.importPath)
+	if err != nil {
+		return importFailed(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success":  true,
+		"imported": imported,
+	})
+}
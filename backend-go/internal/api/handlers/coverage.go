@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/coverage"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ResumeProvider supplies the text of the active resume
+type ResumeProvider interface {
+	ResumeText(ctx context.Context) (string, error)
+}
+
+// CoverageHandler maps job requirements onto resume sections
+type CoverageHandler struct {
+	jobs   JobListService
+	resume ResumeProvider
+}
+
+// NewCoverageHandler creates a new coverage handler; resume may be nil, in
+// which case requests must include the resume text
+func NewCoverageHandler(jobs JobListService, resume ResumeProvider) *CoverageHandler {
+	return &CoverageHandler{jobs: jobs, resume: resume}
+}
+
+// GetCoverage handles POST /api/job-list/jobs/:job_id/coverage. It returns a
+// requirement-by-section matrix for rendering as a heatmap.
+func (h *CoverageHandler) GetCoverage(c *fiber.Ctx) error {
+	jobID, err := uuid.Parse(c.Params("job_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid job ID format",
+		})
+	}
+
+	var req domain.CoverageRequest
+	if len(c.Body()) > 0 {
+		if err := parseBody(c, &req); err != nil {
+			return invalidBody(c, err)
+		}
+	}
+
+	resumeText := strings.TrimSpace(req.ResumeText)
+	if resumeText == "" && h.resume != nil {
+		if resumeText, err = h.resume.ResumeText(c.Context()); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "resume_unavailable",
+				"message": err.Error(),
+			})
+		}
+	}
+	if resumeText == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "resume_text is required when no resume is active",
+		})
+	}
+
+	job, err := h.jobs.GetJobDetails(c.Context(), jobID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Job not found",
+		})
+	}
+
+	return c.JSON(coverage.ForJob(job, resumeText))
+}
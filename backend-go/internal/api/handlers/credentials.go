@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/secrets"
+)
+
+// CredentialService stores encrypted LLM API keys, OAuth tokens and SMTP credentials
+type CredentialService interface {
+	Set(ctx context.Context, name string, in domain.CredentialInput) (*domain.Credential, error)
+	List(ctx context.Context) ([]domain.Credential, error)
+	Delete(ctx context.Context, name string) error
+}
+
+// KeyRotator re-encrypts stored fields under the primary encryption key
+type KeyRotator interface {
+	Rotate(ctx context.Context) (*domain.KeyRotationReport, error)
+}
+
+// CredentialsHandler handles stored credential requests. Values are write-only.
+type CredentialsHandler struct {
+	service CredentialService
+	rotator KeyRotator
+}
+
+// NewCredentialsHandler creates a new credentials handler
+func NewCredentialsHandler(service CredentialService, rotator KeyRotator) *CredentialsHandler {
+	return &CredentialsHandler{service: service, rotator: rotator}
+}
+
+// ListCredentials handles GET /api/settings/credentials
+func (h *CredentialsHandler) ListCredentials(c *fiber.Ctx) error {
+	list, err := h.service.List(c.Context())
+	if err != nil {
+		return credentialFailed(c, err)
+	}
+	return c.JSON(fiber.Map{"credentials": list})
+}
+
+// SetCredential handles PUT /api/settings/credentials/:name
+func (h *CredentialsHandler) SetCredential(c *fiber.Ctx) error {
+	var req domain.CredentialInput
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	cred, err := h.service.Set(c.Context(), c.Params("name"), req)
+	if err != nil {
+		return credentialFailed(c, err)
+	}
+	return c.JSON(cred)
+}
+
+// DeleteCredential handles DELETE /api/settings/credentials/:name
+func (h *CredentialsHandler) DeleteCredential(c *fiber.Ctx) error {
+	if err := h.service.Delete(c.Context(), c.Params("name")); err != nil {
+		return credentialFailed(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// RotateKeys handles POST /api/admin/encryption/rotate
+func (h *CredentialsHandler) RotateKeys(c *fiber.Ctx) error {
+	report, err := h.rotator.Rotate(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "rotation_failed",
+			"message": err.Error(),
+		})
+	}
+	return c.JSON(report)
+}
+
+func credentialFailed(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, secrets.ErrNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Credential not found",
+		})
+	case errors.Is(err, secrets.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "credential_failed",
+			"message": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+const (
+	// dashboardMatchScore is the minimum score counted as a new match
+	dashboardMatchScore = 70.0
+	// dashboardTopMatches is how many new matches are included in full
+	dashboardTopMatches = 5
+	// dashboardNextActions is how many checklist next actions are listed
+	dashboardNextActions = 5
+	// dashboardScanLimit caps applications/recommendations scanned per request
+	dashboardScanLimit = 500
+	// scrapeStaleAfter marks scrape data as stale on the dashboard
+	scrapeStaleAfter = 24 * time.Hour
+)
+
+// closedStatuses are application statuses that no longer count as active
+var closedStatuses = map[domain.ApplicationStatus]bool{
+	domain.ApplicationStatusRejected:  true,
+	domain.ApplicationStatusWithdrawn: true,
+	domain.ApplicationStatusAccepted:  true,
+}
+
+// DashboardHandler aggregates the home-screen summary
+type DashboardHandler struct {
+	service    JobListService
+	goals      GoalTracker
+	checklists ChecklistService
+}
+
+// NewDashboardHandler creates a new dashboard handler. goals and checklists
+// may be nil, in which case the dashboard carries no goal progress or next
+// actions.
+func NewDashboardHandler(service JobListService, goals GoalTracker, checklists ChecklistService) *DashboardHandler {
+	return &DashboardHandler{service: service, goals: goals, checklists: checklists}
+}
+
+// GetDashboard handles GET /api/dashboard
+func (h *DashboardHandler) GetDashboard(c *fiber.Ctx) error {
+	ctx := c.Context()
+	now := time.Now()
+
+	var (
+		wg        sync.WaitGroup
+		apps      *domain.ApplicationListResponse
+		recs      []domain.JobRecommendation
+		reminders []domain.Application
+		jobStats  *domain.JobSearchStats
+		errs      [4]error
+	)
+
+	// Fetch independent sources concurrently
+	wg.Add(4)
+	go func() {
+		defer wg.Done()
+		apps, errs[0] = h.service.GetApplications(ctx, nil, dashboardScanLimit, 0)
+	}()
+	go func() {
+		defer wg.Done()
+		recs, errs[1] = h.service.GetRecommendations(ctx, dashboardScanLimit)
+	}()
+	go func() {
+		defer wg.Done()
+		reminders, errs[2] = h.service.GetDueReminders(ctx)
+	}()
+	go func() {
+		defer wg.Done()
+		jobStats, errs[3] = h.service.GetJobStats(ctx)
+	}()
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "fetch_failed",
+				"message": err.Error(),
+			})
+		}
+	}
+
+	weekStart := startOfWeek(now)
+	dashboard := domain.Dashboard{
+		ApplicationsByStatus: map[string]int{},
+		TopMatches:           []domain.JobBrief{},
+		DueReminders:         len(reminders),
+		GeneratedAt:          now,
+	}
+
+	for _, app := range apps.Applications {
+		dashboard.ApplicationsByStatus[string(app.Status)]++
+		if !closedStatuses[app.Status] {
+			dashboard.ActiveApplications++
+		}
+		for _, entry := range app.Timeline {
+			if entry.NewStatus == domain.ApplicationStatusInterview && !entry.ChangedAt.Before(weekStart) {
+				dashboard.InterviewsThisWeek++
+			}
+		}
+	}
+
+	for _, rec := range recs {
+		job := rec.Job
+		if job.MatchScore == nil || *job.MatchScore < dashboardMatchScore {
+			continue
+		}
+		if job.FirstSeenAt == nil || job.FirstSeenAt.Before(weekStart) {
+			continue
+		}
+		dashboard.NewMatches++
+		if len(dashboard.TopMatches) < dashboardTopMatches {
+			dashboard.TopMatches = append(dashboard.TopMatches, job)
+		}
+	}
+
+	dashboard.TotalJobs = jobStats.TotalJobsIndexed
+	dashboard.LastScrapeAt = jobStats.LastScrapeAt
+	dashboard.ScrapeStale = jobStats.LastScrapeAt == nil || now.Sub(*jobStats.LastScrapeAt) > scrapeStaleAfter
+
+	if h.goals != nil {
+		goals, err := h.goals.Progress(ctx, now)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "fetch_failed",
+				"message": err.Error(),
+			})
+		}
+		dashboard.Goals = goals
+	}
+
+	if h.checklists != nil {
+		actions, err := h.checklists.NextActions(ctx, apps.Applications, dashboardNextActions)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "fetch_failed",
+				"message": err.Error(),
+			})
+		}
+		dashboard.NextActions = actions
+	}
+
+	return c.JSON(dashboard)
+}
+
+// startOfWeek returns midnight on the Monday of t's week
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	y, m, d := t.AddDate(0, 0, -offset).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,66 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/digest"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// DigestPreviewer compiles the activity digest on demand
+type DigestPreviewer interface {
+	Preview(ctx context.Context) (*domain.Digest, error)
+}
+
+// DigestHandler handles digest API requests
+type DigestHandler struct {
+	digests DigestPreviewer
+}
+
+// NewDigestHandler creates a new digest handler
+func NewDigestHandler(digests DigestPreviewer) *DigestHandler {
+	return &DigestHandler{digests: digests}
+}
+
+// Preview handles GET /api/digest/preview?format=html|markdown|json
+func (h *DigestHandler) Preview(c *fiber.Ctx) error {
+	d, err := h.digests.Preview(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "digest_failed",
+			"message": err.Error(),
+		})
+	}
+
+	switch format := c.Query("format", "html"); format {
+	case "json":
+		return c.JSON(d)
+	case "markdown", "md":
+		body, err := digest.RenderMarkdown(d)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "render_failed",
+				"message": err.Error(),
+			})
+		}
+		c.Set(fiber.HeaderContentType, "text/markdown; charset=utf-8")
+		return c.SendString(body)
+	case "html":
+		body, err := digest.RenderHTML(d)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "render_failed",
+				"message": err.Error(),
+			})
+		}
+		c.Set(fiber.HeaderContentType, fiber.MIMETextHTMLCharsetUTF8)
+		return c.SendString(body)
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_format",
+			"message": "format must be html, markdown or json",
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// DeliverabilityChecker finds problems that should block an email from being sent
+type DeliverabilityChecker interface {
+	Check(ctx context.Context, msg domain.OutgoingEmail) []domain.DeliverabilityIssue
+}
+
+// EmailSender delivers email over SMTP, Gmail or another transport
+type EmailSender interface {
+	Send(ctx context.Context, msg domain.OutgoingEmail) error
+}
+
+// CheckEmail handles POST /api/email/check, reporting deliverability issues
+// without sending
+func (h *EmailHandler) CheckEmail(c *fiber.Ctx) error {
+	var msg domain.OutgoingEmail
+	if err := parseBody(c, &msg); err != nil {
+		return invalidBody(c, err)
+	}
+
+	issues := h.checker.Check(c.Context(), msg)
+	return c.JSON(fiber.Map{
+		"ok":     len(issues) == 0,
+		"issues": issues,
+	})
+}
+
+// SendEmail handles POST /api/email/send. The send is blocked with 422 and
+// the list of issues when deliverability checks fail.
+func (h *EmailHandler) SendEmail(c *fiber.Ctx) error {
+	var msg domain.OutgoingEmail
+	if err := parseBody(c, &msg); err != nil {
+		return invalidBody(c, err)
+	}
+
+	if issues := h.checker.Check(c.Context(), msg); len(issues) > 0 {
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+			"error":   "send_blocked",
+			"message": "Fix these issues before sending",
+			"issues":  issues,
+		})
+	}
+
+	if h.sender == nil {
+		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
+			"error":   "not_implemented",
+			"message": "No email sender is configured",
+		})
+	}
+
+	if err := h.sender.Send(c.Context(), msg); err != nil {
+		return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+			"error":   "send_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"to":      msg.To,
+	})
+}
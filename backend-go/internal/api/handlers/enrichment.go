@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/enrichment"
+)
+
+// H1BDataset is the importable H1B filing dataset used to flag visa sponsors
+type H1BDataset interface {
+	Import(r io.Reader) (int, error)
+	ImportFile(path string) (int, error)
+	Stats() enrichment.H1BStats
+}
+
+// CompanyRatings is the company rating store
+type CompanyRatings interface {
+	Import(r io.Reader) (int, error)
+	ImportFile(path string) (int, error)
+	Refresh(ctx context.Context) (int, error)
+	Stats() enrichment.RatingStats
+}
+
+// EnrichmentHandler handles company enrichment data imports
+type EnrichmentHandler struct {
+	h1b      H1BDataset
+	ratings  CompanyRatings
+	salaries SalaryBenchmarks
+	cfg      config.EnrichmentConfig
+}
+
+// NewEnrichmentHandler creates a new enrichment handler
+func NewEnrichmentHandler(h1b H1BDataset, ratings CompanyRatings, salaries SalaryBenchmarks, cfg config.EnrichmentConfig) *EnrichmentHandler {
+	return &EnrichmentHandler{h1b: h1b, ratings: ratings, salaries: salaries, cfg: cfg}
+}
+
+// GetH1BStats handles GET /api/admin/enrichment/h1b
+func (h *EnrichmentHandler) GetH1BStats(c *fiber.Ctx) error {
+	return c.JSON(h.h1b.Stats())
+}
+
+// ImportH1B handles POST /api/admin/enrichment/h1b/import.
+// Accepts a CSV upload in the "file" field, or re-imports the configured dataset.
+func (h *EnrichmentHandler) ImportH1B(c *fiber.Ctx) error {
+	employers, err := importCSV(c, h.h1b.Import, h.h1b.ImportFile, h.cfg.H1B.DatasetPath)
+	if err != nil {
+		return importFailed(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success":   true,
+		"employers": employers,
+		"stats":     h.h1b.Stats(),
+	})
+}
+
+// GetRatingStats handles GET /api/admin/enrichment/ratings
+func (h *EnrichmentHandler) GetRatingStats(c *fiber.Ctx) error {
+	return c.JSON(h.ratings.Stats())
+}
+
+// ImportRatings handles POST /api/admin/enrichment/ratings/import.
+// Accepts a CSV (company,rating[,review_count[,source]]) upload in the "file"
+// field, or re-imports the configured file.
+func (h *EnrichmentHandler) ImportRatings(c *fiber.Ctx) error {
+	imported, err := importCSV(c, h.ratings.Import, h.ratings.ImportFile, h.cfg.Ratings.ImportPath)
+	if err != nil {
+		return importFailed(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success":  true,
+		"imported": imported,
+		"stats":    h.ratings.Stats(),
+	})
+}
+
+// RefreshRatings handles POST /api/admin/enrichment/ratings/refresh
+func (h *EnrichmentHandler) RefreshRatings(c *fiber.Ctx) error {
+	updated, err := h.ratings.Refresh(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error":   "refresh_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"updated": updated,
+		"stats":   h.ratings.Stats(),
+	})
+}
+
+// GetSalaryStats handles GET /api/admin/enrichment/salaries
+func (h *EnrichmentHandler) GetSalaryStats(c *fiber.Ctx) error {
+	return c.JSON(h.salaries.Stats())
+}
+
+// ImportSalaries handles POST /api/admin/enrichment/salaries/import.
+// Accepts a salary survey CSV upload in the "file" field, or re-imports the
+// configured dataset.
+func (h *EnrichmentHandler) ImportSalaries(c *fiber.Ctx) error {
+	roles, err := importCSV(c, h.salaries.Import, h.salaries.ImportFile, h.cfg.Salaries.DatasetPath)
+	if err != nil {
+		return importFailed(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"roles":   roles,
+		"stats":   h.salaries.Stats(),
+	})
+}
+
+// errNoImportSource is returned when neither an upload nor a configured path is available
+var errNoImportSource = errors.New("upload a CSV in the 'file' field or configure an import path")
+
+// importCSV imports an uploaded "file" or falls back to the configured path
+func importCSV(c *fiber.Ctx, fromReader func(io.Reader) (int, error), fromFile func(string) (int, error), path string) (int, error) {
+	fh, err := c.FormFile("file")
+	if err != nil {
+		if path == "" {
+			return 0, errNoImportSource
+		}
+		return fromFile(path)
+	}
+
+	f, err := fh.Open()
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	return fromReader(f)
+}
+
+// importFailed writes the error response for a failed import
+func importFailed(c *fiber.Ctx, err error) error {
+	if errors.Is(err, errNoImportSource) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "missing_file",
+			"message": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+		"error":   "import_failed",
+		"message": err.Error(),
+	})
+}
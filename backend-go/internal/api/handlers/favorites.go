@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/favorites"
+)
+
+// FavoritesService stars jobs and promotes starred jobs to applications
+type FavoritesService interface {
+	Star(ctx context.Context, jobID uuid.UUID, in domain.FavoriteInput) (*domain.Favorite, error)
+	Unstar(ctx context.Context, jobID uuid.UUID) error
+	List(ctx context.Context, limit, offset int) (*domain.FavoriteList, error)
+	IDs(ctx context.Context) ([]uuid.UUID, error)
+	Promote(ctx context.Context, jobID uuid.UUID, req domain.FavoritePromotion) (*domain.Application, error)
+}
+
+// FavoritesHandler handles job favorite requests
+type FavoritesHandler struct {
+	service FavoritesService
+}
+
+// NewFavoritesHandler creates a new favorites handler
+func NewFavoritesHandler(service FavoritesService) *FavoritesHandler {
+	return &FavoritesHandler{service: service}
+}
+
+// ListFavorites handles GET /api/job-list/favorites
+// Query params: limit (default 50), offset
+func (h *FavoritesHandler) ListFavorites(c *fiber.Ctx) error {
+	limit := clamp(c.QueryInt("limit", 50), 1, 200)
+	offset := max(c.QueryInt("offset", 0), 0)
+
+	list, err := h.service.List(c.Context(), limit, offset)
+	if err != nil {
+		return favoriteFailed(c, err)
+	}
+	return c.JSON(list)
+}
+
+// Star handles PUT /api/job-list/jobs/:job_id/favorite
+func (h *FavoritesHandler) Star(c *fiber.Ctx) error {
+	jobID, ok := favoriteJobID(c)
+	if !ok {
+		return nil
+	}
+	var req domain.FavoriteInput
+	if len(c.Body()) > 0 {
+		if err := parseBody(c, &req); err != nil {
+			return invalidBody(c, err)
+		}
+	}
+
+	f, err := h.service.Star(c.Context(), jobID, req)
+	if err != nil {
+		return favoriteFailed(c, err)
+	}
+	return c.JSON(f)
+}
+
+// Unstar handles DELETE /api/job-list/jobs/:job_id/favorite
+func (h *FavoritesHandler) Unstar(c *fiber.Ctx) error {
+	jobID, ok := favoriteJobID(c)
+	if !ok {
+		return nil
+	}
+	if err := h.service.Unstar(c.Context(), jobID); err != nil {
+		return favoriteFailed(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// Promote handles POST /api/job-list/jobs/:job_id/favorite/promote
+func (h *FavoritesHandler) Promote(c *fiber.Ctx) error {
+	jobID, ok := favoriteJobID(c)
+	if !ok {
+		return nil
+	}
+	var req domain.FavoritePromotion
+	if len(c.Body()) > 0 {
+		if err := parseBody(c, &req); err != nil {
+			return invalidBody(c, err)
+		}
+	}
+
+	app, err := h.service.Promote(c.Context(), jobID, req)
+	if err != nil {
+		return favoriteFailed(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(app)
+}
+
+// favoriteJobID parses the job_id route parameter, writing a 400 when invalid
+func favoriteJobID(c *fiber.Ctx) (uuid.UUID, bool) {
+	jobID, err := uuid.Parse(c.Params("job_id"))
+	if err != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid job ID format",
+		})
+		return uuid.Nil, false
+	}
+	return jobID, true
+}
+
+// favoriteFailed writes the error response for a failed favorite operation
+func favoriteFailed(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, favorites.ErrJobNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Job not found",
+		})
+	case errors.Is(err, favorites.ErrNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Job is not a favorite",
+		})
+	case errors.Is(err, favorites.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "favorite_failed",
+			"message": err.Error(),
+		})
+	}
+}
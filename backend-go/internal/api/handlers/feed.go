@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/feed"
+)
+
+// JobFeedBuilder compiles feeds of newly seen jobs
+type JobFeedBuilder interface {
+	Build(ctx context.Context, since time.Time, limit int) (*domain.JobFeed, error)
+}
+
+// FeedHandler handles new-jobs feed requests
+type FeedHandler struct {
+	feeds JobFeedBuilder
+}
+
+// NewFeedHandler creates a new feed handler
+func NewFeedHandler(feeds JobFeedBuilder) *FeedHandler {
+	return &FeedHandler{feeds: feeds}
+}
+
+// GetFeed handles GET /api/job-list/feed
+// Query params: since (RFC 3339, default 24h ago), limit (default 50), format (json, rss)
+func (h *FeedHandler) GetFeed(c *fiber.Ctx) error {
+	since := time.Now().Add(-feed.DefaultWindow)
+	if raw := c.Query("since"); raw != "" {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid_since",
+				"message": "since must be an RFC 3339 timestamp, e.g. 2024-05-01T00:00:00Z",
+			})
+		}
+		since = t
+	}
+	limit := clamp(c.QueryInt("limit", 50), 1, feed.MaxLimit)
+
+	format := c.Query("format", "json")
+	if format != "json" && format != "rss" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_format",
+			"message": "format must be json or rss",
+		})
+	}
+
+	f, err := h.feeds.Build(c.Context(), since, limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "feed_failed",
+			"message": err.Error(),
+		})
+	}
+
+	if format == "json" {
+		return c.JSON(f)
+	}
+	body, err := feed.RenderRSS(feed.NewJobsChannel(f, c.BaseURL()), c.BaseURL())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "render_failed",
+			"message": err.Error(),
+		})
+	}
+	c.Set(fiber.HeaderContentType, "application/rss+xml; charset=utf-8")
+	return c.Send(body)
+}
+
+// FeedTokens issues and checks saved search feed tokens
+type FeedTokens interface {
+	Token(ctx context.Context, searchID uuid.UUID) string
+	Verify(ctx context.Context, searchID uuid.UUID, token string) bool
+}
+
+// SavedSearchSource runs saved searches
+type SavedSearchSource interface {
+	GetSavedSearches(ctx context.Context) ([]domain.SavedSearch, error)
+	Search(ctx context.Context, req domain.JobSearchRequest) (*domain.JobSearchResponse, error)
+}
+
+// SavedSearchFeedHandler publishes saved searches as token-protected feeds
+type SavedSearchFeedHandler struct {
+	searches SavedSearchSource
+	tokens   FeedTokens
+}
+
+// NewSavedSearchFeedHandler creates a new saved search feed handler
+func NewSavedSearchFeedHandler(searches SavedSearchSource, tokens FeedTokens) *SavedSearchFeedHandler {
+	return &SavedSearchFeedHandler{searches: searches, tokens: tokens}
+}
+
+// GetFeedLinks handles GET /api/job-list/saved-searches/:search_id/feed
+func (h *SavedSearchFeedHandler) GetFeedLinks(c *fiber.Ctx) error {
+	saved, ok := h.find(c)
+	if !ok {
+		return nil
+	}
+	if saved == nil {
+		return feedNotFound(c)
+	}
+
+	token := h.tokens.Token(c.Context(), saved.ID)
+	base := c.BaseURL() + "/api/job-list/saved-searches/" + saved.ID.String()
+	return c.JSON(fiber.Map{
+		"token":    token,
+		"rss_url":  base + "/feed.rss?token=" + token,
+		"atom_url": base + "/feed.atom?token=" + token,
+	})
+}
+
+// GetRSS handles GET /api/job-list/saved-searches/:search_id/feed.rss?token=
+func (h *SavedSearchFeedHandler) GetRSS(c *fiber.Ctx) error {
+	return h.render(c, "rss")
+}
+
+// GetAtom handles GET /api/job-list/saved-searches/:search_id/feed.atom?token=
+func (h *SavedSearchFeedHandler) GetAtom(c *fiber.Ctx) error {
+	return h.render(c, "atom")
+}
+
+// render runs the saved search and writes its results as a feed. A bad
+// token looks the same as a missing search.
+func (h *SavedSearchFeedHandler) render(c *fiber.Ctx, format string) error {
+	saved, ok := h.find(c)
+	if !ok {
+		return nil
+	}
+	if saved == nil || !h.tokens.Verify(c.Context(), saved.ID, c.Query("token")) {
+		return feedNotFound(c)
+	}
+
+	// The same request as cache warming, so warmed searches are served from cache
+	resp, err := h.searches.Search(c.Context(), SavedSearchRequest(*saved))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "feed_failed",
+			"message": err.Error(),
+		})
+	}
+
+	ch := feed.Channel{
+		Title:       "Saved search: " + saved.Name,
+		Description: "Best matches for your saved search " + saved.Name,
+		Link:        c.BaseURL() + c.OriginalURL(),
+		Updated:     time.Now(),
+		Jobs:        resp.Jobs,
+	}
+	render, contentType := feed.RenderRSS, "application/rss+xml; charset=utf-8"
+	if format == "atom" {
+		render, contentType = feed.RenderAtom, "application/atom+xml; charset=utf-8"
+	}
+	body, err := render(ch, c.BaseURL())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "render_failed",
+			"message": err.Error(),
+		})
+	}
+
+	c.Set(fiber.HeaderContentType, contentType)
+	c.Set("X-Robots-Tag", "noindex")
+	return c.Send(body)
+}
+
+// find returns the saved search named by the route, or nil when there is
+// none. When the lookup fails it writes the error response and returns false.
+func (h *SavedSearchFeedHandler) find(c *fiber.Ctx) (*domain.SavedSearch, bool) {
+	id, err := uuid.Parse(c.Params("search_id"))
+	if err != nil {
+		return nil, true
+	}
+	searches, err := h.searches.GetSavedSearches(c.Context())
+	if err != nil {
+		_ = c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+		return nil, false
+	}
+	for i := range searches {
+		if searches[i].ID == id {
+			return &searches[i], true
+		}
+	}
+	return nil, true
+}
+
+func feedNotFound(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+		"error":   "not_found",
+		"message": "Saved search feed not found",
+	})
+}
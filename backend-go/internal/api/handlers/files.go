@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"path"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/storage"
+)
+
+// SignedFileStore serves objects behind HMAC-signed URLs
+type SignedFileStore interface {
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Verify(key, expires, signature string) bool
+}
+
+// DownloadStore stores generated files and signs time-limited links to them
+type DownloadStore interface {
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	PresignedURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+}
+
+// Download is a stored file and its time-limited link
+type Download struct {
+	Key       string    `json:"key"`
+	URL       string    `json:"download_url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// Downloads publishes generated files as presigned download links
+type Downloads struct {
+	store  DownloadStore
+	expiry time.Duration
+}
+
+// NewDownloads creates a publisher whose links expire after expiry
+func NewDownloads(store DownloadStore, expiry time.Duration) *Downloads {
+	if expiry <= 0 {
+		expiry = 15 * time.Minute
+	}
+	return &Downloads{store: store, expiry: expiry}
+}
+
+// Publish stores r under key and returns a download link for it
+func (d *Downloads) Publish(ctx context.Context, key string, r io.Reader, size int64, contentType string) (*Download, error) {
+	if err := d.store.Put(ctx, key, r, size, contentType); err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(d.expiry)
+	url, err := d.store.PresignedURL(ctx, key, d.expiry)
+	if err != nil {
+		return nil, err
+	}
+	return &Download{Key: key, URL: url, ExpiresAt: expiresAt}, nil
+}
+
+// FilesHandler serves presigned downloads from local storage
+type FilesHandler struct {
+	store SignedFileStore
+}
+
+// NewFilesHandler creates a new files handler
+func NewFilesHandler(store SignedFileStore) *FilesHandler {
+	return &FilesHandler{store: store}
+}
+
+// Download handles GET /api/files/*
+func (h *FilesHandler) Download(c *fiber.Ctx) error {
+	key := c.Params("*")
+	if !h.store.Verify(key, c.Query("expires"), c.Query("signature")) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error":   "invalid_signature",
+			"message": "Download link is invalid or expired",
+		})
+	}
+
+	r, err := h.store.Get(c.Context(), key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error":   "not_found",
+				"message": "File not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	if contentType := mime.TypeByExtension(path.Ext(key)); contentType != "" {
+		c.Set(fiber.HeaderContentType, contentType)
+	}
+	c.Attachment(path.Base(key))
+	return c.SendStream(r)
+}
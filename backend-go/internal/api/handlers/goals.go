@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/goals"
+)
+
+// GoalTracker keeps job search goals and measures progress against them
+type GoalTracker interface {
+	List(ctx context.Context) ([]domain.Goal, error)
+	Create(ctx context.Context, in domain.GoalInput) (*domain.Goal, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Progress(ctx context.Context, now time.Time) (*domain.GoalSummary, error)
+}
+
+// GoalHandler handles job search goal requests
+type GoalHandler struct {
+	tracker GoalTracker
+}
+
+// NewGoalHandler creates a new goal handler
+func NewGoalHandler(tracker GoalTracker) *GoalHandler {
+	return &GoalHandler{tracker: tracker}
+}
+
+// GetProgress handles GET /api/goals, returning progress, streaks and nudges
+func (h *GoalHandler) GetProgress(c *fiber.Ctx) error {
+	summary, err := h.tracker.Progress(c.Context(), time.Now())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(summary)
+}
+
+// CreateGoal handles POST /api/goals
+func (h *GoalHandler) CreateGoal(c *fiber.Ctx) error {
+	var req domain.GoalInput
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	goal, err := h.tracker.Create(c.Context(), req)
+	switch {
+	case errors.Is(err, goals.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+			"metrics": domain.GoalMetrics,
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "create_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(goal)
+}
+
+// DeleteGoal handles DELETE /api/goals/:goal_id
+func (h *GoalHandler) DeleteGoal(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("goal_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid goal ID format",
+		})
+	}
+
+	err = h.tracker.Delete(c.Context(), id)
+	switch {
+	case errors.Is(err, goals.ErrNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Goal not found",
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "delete_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
@@ -1,9 +1,12 @@
 package handlers
 
 import (
+	"context"
+
 	"github.com/gofiber/fiber/v2"
 
 	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
 )
 
 const version = "2.0.0"
@@ -31,28 +34,21 @@ func HealthCheck(db interface{}) fiber.Handler {
 	}
 }
 
-// ReadinessCheck returns whether the service is ready to accept traffic
-func ReadinessCheck(db interface{}, mlClient interface{}) fiber.Handler {
-	return func(c *fiber.Ctx) error {
-		// Check database
-		if db == nil {
-			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
-				"status": "not_ready",
-				"reason": "Database not connected",
-			})
-		}
+// ReadinessReporter reports which dependencies are available
+type ReadinessReporter interface {
+	Report(ctx context.Context) domain.ReadinessReport
+}
 
-		// Check ML service
-		if mlClient == nil {
-			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
-				"status": "not_ready",
-				"reason": "ML service not connected",
-			})
+// ReadinessCheck returns whether the service is ready to accept traffic.
+// It is 503 only when a core capability is down; when other capabilities are
+// down the status is "degraded" and only the features needing them are off.
+func ReadinessCheck(checker ReadinessReporter) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		report := checker.Report(c.UserContext())
+		if report.Status == domain.NotReady {
+			return c.Status(fiber.StatusServiceUnavailable).JSON(report)
 		}
-
-		return c.JSON(fiber.Map{
-			"status": "ready",
-		})
+		return c.JSON(report)
 	}
 }
 
@@ -0,0 +1,112 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/cleanup"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// JobCleanupRunner moves jobs matching criteria to the trash in bulk
+type JobCleanupRunner interface {
+	Preview(ctx context.Context, criteria domain.JobCleanupCriteria) (int, error)
+	Start(ctx context.Context, owner string, criteria domain.JobCleanupCriteria) (*domain.JobCleanup, error)
+	Get(ctx context.Context, id uuid.UUID) (*domain.JobCleanup, error)
+}
+
+// JobCleanupHandler handles bulk job cleanup requests
+type JobCleanupHandler struct {
+	runner JobCleanupRunner
+	owner  func(c *fiber.Ctx) string
+}
+
+// NewJobCleanupHandler creates a new job cleanup handler. owner identifies
+// who may cancel a cleanup through the operations API.
+func NewJobCleanupHandler(runner JobCleanupRunner, owner func(c *fiber.Ctx) string) *JobCleanupHandler {
+	return &JobCleanupHandler{runner: runner, owner: owner}
+}
+
+// Cleanup handles POST /api/job-list/jobs/cleanup. With dry_run it returns
+// how many jobs would be trashed; otherwise the cleanup starts in the
+// background and its status is polled at /jobs/cleanup/:task_id.
+func (h *JobCleanupHandler) Cleanup(c *fiber.Ctx) error {
+	var req domain.JobCleanupRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	if req.IsEmpty() {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": "At least one of inactive_days, sources or below_match_score is required",
+		})
+	}
+	if req.InactiveDays != nil && *req.InactiveDays < 1 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": "inactive_days must be at least 1",
+		})
+	}
+	if req.BelowMatchScore != nil && (*req.BelowMatchScore <= 0 || *req.BelowMatchScore > 100) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": "below_match_score must be between 0 and 100",
+		})
+	}
+
+	if req.DryRun {
+		matched, err := h.runner.Preview(c.UserContext(), req.JobCleanupCriteria)
+		if err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "cleanup_failed",
+				"message": err.Error(),
+			})
+		}
+		return c.JSON(fiber.Map{
+			"dry_run":  true,
+			"criteria": req.JobCleanupCriteria,
+			"matched":  matched,
+		})
+	}
+
+	task, err := h.runner.Start(c.UserContext(), h.owner(c), req.JobCleanupCriteria)
+	if errors.Is(err, cleanup.ErrRunning) {
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error":   "cleanup_running",
+			"message": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "cleanup_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(task)
+}
+
+// GetCleanup handles GET /api/job-list/jobs/cleanup/:task_id
+func (h *JobCleanupHandler) GetCleanup(c *fiber.Ctx) error {
+	taskID, err := uuid.Parse(c.Params("task_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid task ID format",
+		})
+	}
+
+	task, err := h.runner.Get(c.UserContext(), taskID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Cleanup not found",
+		})
+	}
+
+	return c.JSON(task)
+}
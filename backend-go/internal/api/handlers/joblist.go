@@ -2,11 +2,15 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
+	"github.com/resume-rag/backend/internal/analytics"
 	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/geo"
 )
 
 // JobListService defines the interface for job list operations
@@ -15,6 +19,7 @@ type JobListService interface {
 	GetJobs(ctx context.Context, page, limit int, sortBy, sortOrder string, filters *domain.JobFilters) (*domain.JobSearchResponse, error)
 	GetJobDetails(ctx context.Context, jobID uuid.UUID) (*domain.Job, error)
 	GetRecommendations(ctx context.Context, limit int) ([]domain.JobRecommendation, error)
+	DeleteJob(ctx context.Context, jobID uuid.UUID) error
 
 	// Applications
 	GetApplications(ctx context.Context, status *domain.ApplicationStatus, limit, offset int) (*domain.ApplicationListResponse, error)
@@ -24,6 +29,11 @@ type JobListService interface {
 	DeleteApplication(ctx context.Context, appID uuid.UUID) error
 	GetDueReminders(ctx context.Context) ([]domain.Application, error)
 
+	// Trash (soft-deleted jobs and applications)
+	GetTrash(ctx context.Context) (*domain.TrashResponse, error)
+	RestoreJob(ctx context.Context, jobID uuid.UUID) (*domain.Job, error)
+	RestoreApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error)
+
 	// Cover letter
 	GenerateCoverLetter(ctx context.Context, jobID uuid.UUID, customPrompt *string) (*domain.CoverLetterResponse, error)
 
@@ -35,6 +45,7 @@ type JobListService interface {
 	// Scraping
 	TriggerScrape(ctx context.Context, keywords []string, location *string, sources []string) (*domain.ScrapeTask, error)
 	GetScrapeStatus(ctx context.Context, taskID uuid.UUID) (*domain.ScrapeTask, error)
+	CancelScrape(ctx context.Context, taskID uuid.UUID) (*domain.ScrapeTask, error)
 
 	// Statistics
 	GetJobStats(ctx context.Context) (*domain.JobSearchStats, error)
@@ -54,11 +65,8 @@ func NewJobListHandler(service JobListService) *JobListHandler {
 // Search handles POST /api/job-list/search
 func (h *JobListHandler) Search(c *fiber.Ctx) error {
 	var req domain.JobSearchRequest
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error":   "invalid_request",
-			"message": "Invalid request body",
-		})
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
 	}
 
 	// Validate
@@ -82,8 +90,14 @@ func (h *JobListHandler) Search(c *fiber.Ctx) error {
 	if req.SortOrder == "" {
 		req.SortOrder = "desc"
 	}
+	if req.Filters != nil && req.Filters.RadiusKm != nil && req.Filters.Near == nil {
+		return missingCenter(c)
+	}
 
 	result, err := h.service.Search(c.Context(), req)
+	if errors.Is(err, geo.ErrNotFound) || errors.Is(err, geo.ErrDisabled) {
+		return invalidCenter(c, err)
+	}
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error":   "search_failed",
@@ -98,25 +112,24 @@ func (h *JobListHandler) Search(c *fiber.Ctx) error {
 func (h *JobListHandler) GetJobs(c *fiber.Ctx) error {
 	page := c.QueryInt("page", 1)
 	limit := c.QueryInt("limit", 20)
-	sortBy := c.Query("sort_by", "posted_date")
 	sortOrder := c.Query("sort_order", "desc")
 
-	// Parse filters
-	var filters *domain.JobFilters
-	locationType := c.Query("location_type")
-	source := c.Query("source")
+	filters := parseJobFilters(c)
 
-	if locationType != "" || source != "" {
-		filters = &domain.JobFilters{}
-		if locationType != "" {
-			filters.LocationTypes = []domain.LocationType{domain.LocationType(locationType)}
-		}
-		if source != "" {
-			filters.Sources = []domain.JobSource{domain.JobSource(source)}
-		}
+	// Text queries rank by relevance unless a sort is requested
+	defaultSort := "posted_date"
+	if filters != nil && filters.Query != nil {
+		defaultSort = "relevance"
+	}
+	sortBy := c.Query("sort_by", defaultSort)
+	if c.Query("radius_km") != "" && c.Query("near") == "" {
+		return missingCenter(c)
 	}
 
 	result, err := h.service.GetJobs(c.Context(), page, limit, sortBy, sortOrder, filters)
+	if errors.Is(err, geo.ErrNotFound) || errors.Is(err, geo.ErrDisabled) {
+		return invalidCenter(c, err)
+	}
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error":   "fetch_failed",
@@ -127,6 +140,113 @@ func (h *JobListHandler) GetJobs(c *fiber.Ctx) error {
 	return c.JSON(result)
 }
 
+// missingCenter answers a radius filter without a location to measure from
+func missingCenter(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error":   "invalid_request",
+		"message": "radius_km needs near, the location to measure from",
+	})
+}
+
+// invalidCenter answers a radius filter whose location could not be geocoded
+func invalidCenter(c *fiber.Ctx, err error) error {
+	message := "Could not find the near location"
+	if errors.Is(err, geo.ErrDisabled) {
+		message = "Radius search needs geocoding, which is not enabled"
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error":   "invalid_location",
+		"message": message,
+	})
+}
+
+// parseJobFilters builds job filters from query parameters, or nil when none are set
+func parseJobFilters(c *fiber.Ctx) *domain.JobFilters {
+	filters := &domain.JobFilters{
+		GenuinelyNew:   c.QueryBool("genuinely_new", false),
+		SponsorsVisa:   c.QueryBool("sponsors_visa", false),
+		HideLowQuality: c.QueryBool("hide_low_quality", false),
+		NewGrad:        c.QueryBool("new_grad", false),
+		Favorites:      c.QueryBool("favorites", false),
+	}
+	set := filters.GenuinelyNew || filters.SponsorsVisa || filters.HideLowQuality || filters.NewGrad || filters.Favorites
+
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		filters.Query = &q
+		set = true
+	}
+	if locationType := c.Query("location_type"); locationType != "" {
+		filters.LocationTypes = []domain.LocationType{domain.LocationType(locationType)}
+		set = true
+	}
+	if source := c.Query("source"); source != "" {
+		filters.Sources = []domain.JobSource{domain.JobSource(source)}
+		set = true
+	}
+	// employment_type accepts a comma-separated list, e.g. contract,temp
+	for _, raw := range strings.Split(c.Query("employment_type"), ",") {
+		if t := domain.NormalizeEmploymentType(raw); t != "" {
+			filters.EmploymentTypes = append(filters.EmploymentTypes, t)
+			set = true
+		}
+	}
+	// season accepts a comma-separated list of program terms, e.g. summer 2025,fall 2025
+	for _, raw := range strings.Split(c.Query("season"), ",") {
+		if season := domain.NormalizeSeason(raw); season != "" {
+			filters.Seasons = append(filters.Seasons, season)
+			set = true
+		}
+	}
+	// stack and exclude_stack accept comma-separated technologies, e.g. stack=go,postgres&exclude_stack=php
+	for _, raw := range strings.Split(c.Query("stack"), ",") {
+		if tech := analytics.NormalizeTech(raw); tech != "" {
+			filters.Stack = append(filters.Stack, tech)
+			set = true
+		}
+	}
+	for _, raw := range strings.Split(c.Query("exclude_stack"), ",") {
+		if tech := analytics.NormalizeTech(raw); tech != "" {
+			filters.ExcludeStack = append(filters.ExcludeStack, tech)
+			set = true
+		}
+	}
+	if near := c.Query("near"); near != "" {
+		filters.Near = &near
+		if radius := c.QueryFloat("radius_km", 0); radius > 0 {
+			filters.RadiusKm = &radius
+		}
+		set = true
+	}
+	if country := c.Query("country"); country != "" {
+		filters.Countries = []string{strings.ToUpper(country)}
+		set = true
+	}
+	if state := c.Query("state"); state != "" {
+		filters.States = []string{state}
+		set = true
+	}
+	if days := c.QueryInt("closing_within_days", 0); days > 0 {
+		filters.ClosingWithin = &days
+		set = true
+	}
+	if minutes := c.QueryInt("max_commute_minutes", 0); minutes > 0 {
+		filters.MaxCommute = &minutes
+		if mode := domain.CommuteMode(c.Query("commute_mode")); mode.Valid() {
+			filters.CommuteMode = mode
+		}
+		set = true
+	}
+	if minRating := c.QueryFloat("min_rating", 0); minRating > 0 {
+		filters.MinRating = &minRating
+		set = true
+	}
+
+	if !set {
+		return nil
+	}
+	return filters
+}
+
 // GetJobDetails handles GET /api/job-list/jobs/:job_id
 func (h *JobListHandler) GetJobDetails(c *fiber.Ctx) error {
 	jobID, err := uuid.Parse(c.Params("job_id"))
@@ -148,6 +268,29 @@ func (h *JobListHandler) GetJobDetails(c *fiber.Ctx) error {
 	return c.JSON(job)
 }
 
+// DeleteJob handles DELETE /api/job-list/jobs/:job_id
+func (h *JobListHandler) DeleteJob(c *fiber.Ctx) error {
+	jobID, err := uuid.Parse(c.Params("job_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid job ID format",
+		})
+	}
+
+	if err := h.service.DeleteJob(c.Context(), jobID); err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Job not found",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"success": true,
+		"message": "Job moved to trash",
+	})
+}
+
 // GetRecommendations handles GET /api/job-list/recommendations
 func (h *JobListHandler) GetRecommendations(c *fiber.Ctx) error {
 	limit := c.QueryInt("limit", 10)
@@ -188,11 +331,8 @@ func (h *JobListHandler) GetApplications(c *fiber.Ctx) error {
 // CreateApplication handles POST /api/job-list/applications
 func (h *JobListHandler) CreateApplication(c *fiber.Ctx) error {
 	var req domain.ApplicationCreate
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error":   "invalid_request",
-			"message": "Invalid request body",
-		})
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
 	}
 
 	app, err := h.service.CreateApplication(c.Context(), req)
@@ -238,11 +378,8 @@ func (h *JobListHandler) UpdateApplication(c *fiber.Ctx) error {
 	}
 
 	var req domain.ApplicationUpdate
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error":   "invalid_request",
-			"message": "Invalid request body",
-		})
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
 	}
 
 	app, err := h.service.UpdateApplication(c.Context(), appID, req)
@@ -275,7 +412,7 @@ func (h *JobListHandler) DeleteApplication(c *fiber.Ctx) error {
 
 	return c.JSON(fiber.Map{
 		"success": true,
-		"message": "Application deleted",
+		"message": "Application moved to trash",
 	})
 }
 
@@ -292,6 +429,61 @@ func (h *JobListHandler) GetDueReminders(c *fiber.Ctx) error {
 	return c.JSON(apps)
 }
 
+// GetTrash handles GET /api/job-list/trash
+func (h *JobListHandler) GetTrash(c *fiber.Ctx) error {
+	trash, err := h.service.GetTrash(c.Context())
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(trash)
+}
+
+// RestoreJob handles POST /api/job-list/trash/jobs/:job_id/restore
+func (h *JobListHandler) RestoreJob(c *fiber.Ctx) error {
+	jobID, err := uuid.Parse(c.Params("job_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid job ID format",
+		})
+	}
+
+	job, err := h.service.RestoreJob(c.Context(), jobID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Job not found in trash",
+		})
+	}
+
+	return c.JSON(job)
+}
+
+// RestoreApplication handles POST /api/job-list/trash/applications/:app_id/restore
+func (h *JobListHandler) RestoreApplication(c *fiber.Ctx) error {
+	appID, err := uuid.Parse(c.Params("app_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid application ID format",
+		})
+	}
+
+	app, err := h.service.RestoreApplication(c.Context(), appID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Application not found in trash",
+		})
+	}
+
+	return c.JSON(app)
+}
+
 // GenerateCoverLetter handles POST /api/job-list/jobs/:job_id/cover-letter
 func (h *JobListHandler) GenerateCoverLetter(c *fiber.Ctx) error {
 	jobID, err := uuid.Parse(c.Params("job_id"))
@@ -305,9 +497,11 @@ func (h *JobListHandler) GenerateCoverLetter(c *fiber.Ctx) error {
 	var req struct {
 		CustomPrompt *string `json:"custom_prompt"`
 	}
-	_ = c.BodyParser(&req) // Optional body
+	if err := parseOptionalBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
 
-	result, err := h.service.GenerateCoverLetter(c.Context(), jobID, req.CustomPrompt)
+	result, err := h.service.GenerateCoverLetter(c.UserContext(), jobID, req.CustomPrompt)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error":   "generation_failed",
@@ -334,11 +528,8 @@ func (h *JobListHandler) GetSavedSearches(c *fiber.Ctx) error {
 // SaveSearch handles POST /api/job-list/saved-searches
 func (h *JobListHandler) SaveSearch(c *fiber.Ctx) error {
 	var req domain.SavedSearchCreate
-	if err := c.BodyParser(&req); err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
-			"error":   "invalid_request",
-			"message": "Invalid request body",
-		})
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
 	}
 
 	search, err := h.service.SaveSearch(c.Context(), req)
@@ -375,18 +566,29 @@ func (h *JobListHandler) DeleteSavedSearch(c *fiber.Ctx) error {
 	})
 }
 
+// scrapeRequest is the body of POST /api/job-list/scrape. Profile is read by
+// ScrapeProfileHandler.ProfileScrape ahead of TriggerScrape.
+type scrapeRequest struct {
+	Keywords []string `json:"keywords"`
+	Location *string  `json:"location"`
+	Sources  []string `json:"sources"`
+	Profile  string   `json:"profile"`
+}
+
 // TriggerScrape handles POST /api/job-list/scrape
 func (h *JobListHandler) TriggerScrape(c *fiber.Ctx) error {
-	var req struct {
-		Keywords []string  `json:"keywords"`
-		Location *string   `json:"location"`
-		Sources  []string  `json:"sources"`
-	}
+	var req scrapeRequest
 
 	// Also support query params
-	keywords := c.QueryArray("keywords")
+	keywords := queryList(c, "keywords")
 	if len(keywords) == 0 {
-		if err := c.BodyParser(&req); err != nil || len(req.Keywords) == 0 {
+		if err := parseBody(c, &req); err != nil {
+			var strictErr *StrictBodyError
+			if errors.As(err, &strictErr) {
+				return invalidBody(c, err)
+			}
+		}
+		if len(req.Keywords) == 0 {
 			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 				"error":   "invalid_request",
 				"message": "Keywords are required",
@@ -404,7 +606,7 @@ func (h *JobListHandler) TriggerScrape(c *fiber.Ctx) error {
 		locationPtr = &location
 	}
 
-	sources := c.QueryArray("sources")
+	sources := queryList(c, "sources")
 	if len(sources) == 0 {
 		sources = req.Sources
 	}
@@ -424,6 +626,20 @@ func (h *JobListHandler) TriggerScrape(c *fiber.Ctx) error {
 	})
 }
 
+// queryList returns a query parameter given repeatedly (?k=a&k=b), as a
+// comma-separated list (?k=a,b) or both, without blank entries
+func queryList(c *fiber.Ctx, key string) []string {
+	var values []string
+	for _, raw := range c.Context().QueryArgs().PeekMulti(key) {
+		for _, v := range strings.Split(string(raw), ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+	}
+	return values
+}
+
 // GetScrapeStatus handles GET /api/job-list/scrape/status/:task_id
 func (h *JobListHandler) GetScrapeStatus(c *fiber.Ctx) error {
 	taskID, err := uuid.Parse(c.Params("task_id"))
@@ -445,6 +661,34 @@ func (h *JobListHandler) GetScrapeStatus(c *fiber.Ctx) error {
 	return c.JSON(task)
 }
 
+// CancelScrape handles DELETE /api/job-list/scrape/:task_id
+func (h *JobListHandler) CancelScrape(c *fiber.Ctx) error {
+	taskID, err := uuid.Parse(c.Params("task_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid task ID format",
+		})
+	}
+
+	task, err := h.service.CancelScrape(c.Context(), taskID)
+	if err != nil {
+		var fe *fiber.Error
+		if errors.As(err, &fe) && fe.Code == fiber.StatusConflict {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error":   "already_finished",
+				"message": fe.Message,
+			})
+		}
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Task not found",
+		})
+	}
+
+	return c.JSON(task)
+}
+
 // GetJobStats handles GET /api/job-list/stats/jobs
 func (h *JobListHandler) GetJobStats(c *fiber.Ctx) error {
 	stats, err := h.service.GetJobStats(c.Context())
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/cache"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// SearchCachePrefix is the key prefix for cached search results
+const SearchCachePrefix = "search:"
+
+// CachedJobListService wraps a JobListService and caches search results.
+// Cache hits are reported through JobSearchResponse.Cached.
+type CachedJobListService struct {
+	JobListService
+	cache *cache.LRU
+}
+
+type cachedSearch struct {
+	response   domain.JobSearchResponse
+	withScores bool
+}
+
+// NewCachedJobListService creates a caching decorator around service
+func NewCachedJobListService(service JobListService, store *cache.LRU) *CachedJobListService {
+	return &CachedJobListService{JobListService: service, cache: store}
+}
+
+// Search returns cached results when available, otherwise delegates and stores the result
+func (s *CachedJobListService) Search(ctx context.Context, req domain.JobSearchRequest) (*domain.JobSearchResponse, error) {
+	key := searchCacheKey(ctx, req)
+
+	if v, ok := s.cache.Get(key); ok {
+		entry := v.(*cachedSearch)
+		if entry.withScores || !req.IncludeMatchScores {
+			resp := entry.response
+			resp.Cached = true
+			return &resp, nil
+		}
+	}
+
+	resp, err := s.JobListService.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.cache.Set(key, &cachedSearch{response: *resp, withScores: req.IncludeMatchScores})
+	return resp, nil
+}
+
+// WarmSavedSearches pre-computes results and match scores for every saved search
+func (s *CachedJobListService) WarmSavedSearches(ctx context.Context) (int, error) {
+	searches, err := s.GetSavedSearches(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	warmed := 0
+	for _, saved := range searches {
+		if ctx.Err() != nil {
+			return warmed, ctx.Err()
+		}
+
+		req := SavedSearchRequest(saved)
+		resp, err := s.JobListService.Search(ctx, req)
+		if err != nil {
+			logger.Warn("Failed to warm saved search",
+				zap.String("search_id", saved.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		s.cache.Set(searchCacheKey(ctx, req), &cachedSearch{response: *resp, withScores: true})
+		warmed++
+	}
+
+	logger.Info("Saved search cache warmed", zap.Int("warmed", warmed), zap.Int("total", len(searches)))
+	return warmed, nil
+}
+
+// SavedSearchRequest builds the search request a saved search runs with,
+// using the same defaults as the search handler so warmed entries are hit
+func SavedSearchRequest(saved domain.SavedSearch) domain.JobSearchRequest {
+	return domain.JobSearchRequest{
+		Query:              saved.Query,
+		Filters:            saved.Filters,
+		IncludeMatchScores: true,
+		Page:               1,
+		Limit:              20,
+		SortBy:             "match_score",
+		SortOrder:          "desc",
+	}
+}
+
+// searchCacheKey hashes a search request within the tenant of ctx, ignoring
+// whether match scores were requested
+func searchCacheKey(ctx context.Context, req domain.JobSearchRequest) string {
+	req.IncludeMatchScores = false
+	data, _ := json.Marshal(req)
+	sum := sha256.Sum256(data)
+	return SearchCachePrefix + tenant.ID(ctx) + ":" + hex.EncodeToString(sum[:16])
+}
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// FavoritesJobListService wraps a JobListService, applying the favorites
+// filter and marking starred jobs in listings
+type FavoritesJobListService struct {
+	JobListService
+	favorites FavoritesService
+}
+
+// NewFavoritesJobListService creates a decorator that applies favorites to job listings
+func NewFavoritesJobListService(service JobListService, favorites FavoritesService) *FavoritesJobListService {
+	return &FavoritesJobListService{JobListService: service, favorites: favorites}
+}
+
+// Search runs a search, restricted to starred jobs when filtered by favorites
+func (s *FavoritesJobListService) Search(ctx context.Context, req domain.JobSearchRequest) (*domain.JobSearchResponse, error) {
+	starred, err := s.starred(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Filters != nil && req.Filters.Favorites {
+		if len(starred) == 0 {
+			return emptyJobPage(req.Page, req.Limit, req.Filters), nil
+		}
+		filters := *req.Filters
+		filters.JobIDs = starredIDs(starred, filters.JobIDs)
+		req.Filters = &filters
+	}
+
+	resp, err := s.JobListService.Search(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return markFavorites(resp, starred), nil
+}
+
+// GetJobs lists jobs, restricted to starred jobs when filtered by favorites
+func (s *FavoritesJobListService) GetJobs(ctx context.Context, page, limit int, sortBy, sortOrder string, filters *domain.JobFilters) (*domain.JobSearchResponse, error) {
+	starred, err := s.starred(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if filters != nil && filters.Favorites {
+		if len(starred) == 0 {
+			return emptyJobPage(page, limit, filters), nil
+		}
+		f := *filters
+		f.JobIDs = starredIDs(starred, f.JobIDs)
+		filters = &f
+	}
+
+	resp, err := s.JobListService.GetJobs(ctx, page, limit, sortBy, sortOrder, filters)
+	if err != nil {
+		return nil, err
+	}
+	return markFavorites(resp, starred), nil
+}
+
+// starred returns the set of starred job IDs
+func (s *FavoritesJobListService) starred(ctx context.Context) (map[uuid.UUID]bool, error) {
+	ids, err := s.favorites.IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		set[id] = true
+	}
+	return set, nil
+}
+
+// starredIDs narrows requested job IDs to starred ones, or returns every
+// starred ID when none were requested
+func starredIDs(starred map[uuid.UUID]bool, requested []uuid.UUID) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(starred))
+	if len(requested) > 0 {
+		for _, id := range requested {
+			if starred[id] {
+				ids = append(ids, id)
+			}
+		}
+		return ids
+	}
+	for id := range starred {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// markFavorites flags starred jobs in a response. The response is copied so
+// cached results are not modified.
+func markFavorites(resp *domain.JobSearchResponse, starred map[uuid.UUID]bool) *domain.JobSearchResponse {
+	if len(starred) == 0 {
+		return resp
+	}
+	out := *resp
+	out.Jobs = make([]domain.JobBrief, len(resp.Jobs))
+	for i, job := range resp.Jobs {
+		job.Favorited = starred[job.ID]
+		out.Jobs[i] = job
+	}
+	return &out
+}
+
+// emptyJobPage is the response for a favorites filter with nothing starred
+func emptyJobPage(page, limit int, filters *domain.JobFilters) *domain.JobSearchResponse {
+	return &domain.JobSearchResponse{
+		Jobs:           []domain.JobBrief{},
+		Page:           page,
+		Limit:          limit,
+		ScrapeStatus:   domain.ScrapeStatusCompleted,
+		FiltersApplied: filters,
+	}
+}
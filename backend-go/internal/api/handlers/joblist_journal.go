@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// ChangeRecorder notes changes to jobs and applications for sync clients
+type ChangeRecorder interface {
+	Record(ctx context.Context, entity domain.ChangeEntity, id uuid.UUID, deleted bool) error
+}
+
+// JournaledJobListService wraps a JobListService, recording every job and
+// application write for incremental sync
+type JournaledJobListService struct {
+	JobListService
+	changes ChangeRecorder
+}
+
+// NewJournaledJobListService creates a decorator that records writes to changes
+func NewJournaledJobListService(service JobListService, changes ChangeRecorder) *JournaledJobListService {
+	return &JournaledJobListService{JobListService: service, changes: changes}
+}
+
+// DeleteJob moves a job to the trash
+func (s *JournaledJobListService) DeleteJob(ctx context.Context, jobID uuid.UUID) error {
+	if err := s.JobListService.DeleteJob(ctx, jobID); err != nil {
+		return err
+	}
+	s.record(ctx, domain.ChangeEntityJob, jobID, true)
+	return nil
+}
+
+// RestoreJob brings a job back from the trash
+func (s *JournaledJobListService) RestoreJob(ctx context.Context, jobID uuid.UUID) (*domain.Job, error) {
+	job, err := s.JobListService.RestoreJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	s.record(ctx, domain.ChangeEntityJob, jobID, false)
+	return job, nil
+}
+
+// CreateApplication creates an application
+func (s *JournaledJobListService) CreateApplication(ctx context.Context, req domain.ApplicationCreate) (*domain.Application, error) {
+	app, err := s.JobListService.CreateApplication(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	s.record(ctx, domain.ChangeEntityApplication, app.ID, false)
+	return app, nil
+}
+
+// UpdateApplication updates an application
+func (s *JournaledJobListService) UpdateApplication(ctx context.Context, appID uuid.UUID, req domain.ApplicationUpdate) (*domain.Application, error) {
+	app, err := s.JobListService.UpdateApplication(ctx, appID, req)
+	if err != nil {
+		return nil, err
+	}
+	s.record(ctx, domain.ChangeEntityApplication, appID, false)
+	return app, nil
+}
+
+// DeleteApplication moves an application to the trash
+func (s *JournaledJobListService) DeleteApplication(ctx context.Context, appID uuid.UUID) error {
+	if err := s.JobListService.DeleteApplication(ctx, appID); err != nil {
+		return err
+	}
+	s.record(ctx, domain.ChangeEntityApplication, appID, true)
+	return nil
+}
+
+// RestoreApplication brings an application back from the trash
+func (s *JournaledJobListService) RestoreApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error) {
+	app, err := s.JobListService.RestoreApplication(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	s.record(ctx, domain.ChangeEntityApplication, appID, false)
+	return app, nil
+}
+
+// record notes a change. The write already succeeded, so a failure is only
+// logged; sync clients pick the record up on its next change.
+func (s *JournaledJobListService) record(ctx context.Context, entity domain.ChangeEntity, id uuid.UUID, deleted bool) {
+	if err := s.changes.Record(ctx, entity, id, deleted); err != nil {
+		logger.Warn("Failed to record sync change",
+			zap.String("entity", string(entity)),
+			zap.String("id", id.String()),
+			zap.Error(err))
+	}
+}
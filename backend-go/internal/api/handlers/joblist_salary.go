@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// SalaryJobListService wraps a JobListService, comparing the salary on job
+// details with market benchmarks
+type SalaryJobListService struct {
+	JobListService
+	benchmarks SalaryBenchmarks
+}
+
+// NewSalaryJobListService creates a decorator that benchmarks job salaries
+func NewSalaryJobListService(service JobListService, benchmarks SalaryBenchmarks) *SalaryJobListService {
+	return &SalaryJobListService{JobListService: service, benchmarks: benchmarks}
+}
+
+// GetJobDetails returns a job with its salary benchmark. The job is copied so
+// cached results are not modified.
+func (s *SalaryJobListService) GetJobDetails(ctx context.Context, jobID uuid.UUID) (*domain.Job, error) {
+	job, err := s.JobListService.GetJobDetails(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	comparison, ok := s.benchmarks.CompareJob(job)
+	if !ok {
+		return job, nil
+	}
+	out := *job
+	out.SalaryBenchmark = comparison
+	return &out, nil
+}
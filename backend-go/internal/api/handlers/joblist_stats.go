@@ -0,0 +1,34 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// StatsProvider computes job and application statistics
+type StatsProvider interface {
+	GetJobStats(ctx context.Context) (*domain.JobSearchStats, error)
+	GetApplicationStats(ctx context.Context) (*domain.ApplicationStats, error)
+}
+
+// StatsJobListService wraps a JobListService and serves statistics from a StatsProvider
+type StatsJobListService struct {
+	JobListService
+	stats StatsProvider
+}
+
+// NewStatsJobListService creates a decorator that routes statistics to stats
+func NewStatsJobListService(service JobListService, stats StatsProvider) *StatsJobListService {
+	return &StatsJobListService{JobListService: service, stats: stats}
+}
+
+// GetJobStats returns aggregated job statistics
+func (s *StatsJobListService) GetJobStats(ctx context.Context) (*domain.JobSearchStats, error) {
+	return s.stats.GetJobStats(ctx)
+}
+
+// GetApplicationStats returns aggregated application statistics
+func (s *StatsJobListService) GetApplicationStats(ctx context.Context) (*domain.ApplicationStats, error) {
+	return s.stats.GetApplicationStats(ctx)
+}
@@ -0,0 +1,300 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/mock/gomock"
+
+	"github.com/resume-rag/backend/internal/api/handlers"
+	"github.com/resume-rag/backend/internal/api/handlers/mocks"
+	"github.com/resume-rag/backend/internal/cache"
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/geo"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// do sends a request to app and returns the status and decoded JSON body
+func do(t *testing.T, app *fiber.App, method, target, body string) (int, map[string]interface{}) {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, reader)
+	if body != "" {
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	resp, err := app.Test(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, target, err)
+	}
+	defer resp.Body.Close()
+
+	var decoded map[string]interface{}
+	raw, _ := io.ReadAll(resp.Body)
+	if len(raw) > 0 {
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			t.Fatalf("%s %s: response is not JSON: %s", method, target, raw)
+		}
+	}
+	return resp.StatusCode, decoded
+}
+
+func jobListApp(service handlers.JobListService) *fiber.App {
+	h := handlers.NewJobListHandler(service)
+	app := fiber.New()
+	app.Post("/search", h.Search)
+	app.Get("/jobs", h.GetJobs)
+	app.Get("/jobs/:job_id", h.GetJobDetails)
+	app.Post("/scrape", h.TriggerScrape)
+	return app
+}
+
+func TestSearch(t *testing.T) {
+	defaults := domain.JobSearchRequest{Page: 1, Limit: 20, SortBy: "match_score", SortOrder: "desc"}
+
+	tests := []struct {
+		name      string
+		body      string
+		want      *domain.JobSearchRequest // expected service request; nil when not called
+		err       error
+		status    int
+		errorCode string
+	}{
+		{name: "malformed body", body: `{"query":`, status: fiber.StatusBadRequest, errorCode: "invalid_request"},
+		{name: "no query or filters", body: `{}`, status: fiber.StatusBadRequest, errorCode: "invalid_request"},
+		{
+			name: "defaults", body: `{"query":"golang"}`,
+			want:   withQuery(defaults, "golang"),
+			status: fiber.StatusOK,
+		},
+		{
+			name: "explicit paging and sort", body: `{"query":"golang","page":3,"limit":50,"sort_by":"posted_date","sort_order":"asc"}`,
+			want:   &domain.JobSearchRequest{Query: strPtr("golang"), Page: 3, Limit: 50, SortBy: "posted_date", SortOrder: "asc"},
+			status: fiber.StatusOK,
+		},
+		{
+			name: "service error", body: `{"query":"golang"}`,
+			want:   withQuery(defaults, "golang"),
+			err:    errors.New("database unavailable"),
+			status: fiber.StatusInternalServerError, errorCode: "search_failed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mocks.NewMockJobListService(ctrl)
+			if tt.want != nil {
+				service.EXPECT().Search(gomock.Any(), *tt.want).Return(&domain.JobSearchResponse{}, tt.err)
+			}
+
+			status, body := do(t, jobListApp(service), fiber.MethodPost, "/search", tt.body)
+			if status != tt.status {
+				t.Fatalf("status = %d, want %d (%v)", status, tt.status, body)
+			}
+			if tt.errorCode != "" && body["error"] != tt.errorCode {
+				t.Errorf("error = %v, want %s", body["error"], tt.errorCode)
+			}
+		})
+	}
+}
+
+func TestGetJobsPagination(t *testing.T) {
+	tests := []struct {
+		name              string
+		target            string
+		page, limit       int
+		sortBy, sortOrder string
+	}{
+		{name: "defaults", target: "/jobs", page: 1, limit: 20, sortBy: "posted_date", sortOrder: "desc"},
+		{name: "explicit", target: "/jobs?page=4&limit=50&sort_by=salary&sort_order=asc", page: 4, limit: 50, sortBy: "salary", sortOrder: "asc"},
+		{name: "text query ranks by relevance", target: "/jobs?q=golang", page: 1, limit: 20, sortBy: "relevance", sortOrder: "desc"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mocks.NewMockJobListService(ctrl)
+			service.EXPECT().
+				GetJobs(gomock.Any(), tt.page, tt.limit, tt.sortBy, tt.sortOrder, gomock.Any()).
+				Return(&domain.JobSearchResponse{}, nil)
+
+			if status, body := do(t, jobListApp(service), fiber.MethodGet, tt.target, ""); status != fiber.StatusOK {
+				t.Fatalf("status = %d, want 200 (%v)", status, body)
+			}
+		})
+	}
+}
+
+func TestGetJobsError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mocks.NewMockJobListService(ctrl)
+	service.EXPECT().GetJobs(gomock.Any(), 1, 20, "posted_date", "desc", gomock.Nil()).
+		Return(nil, errors.New("database unavailable"))
+
+	status, body := do(t, jobListApp(service), fiber.MethodGet, "/jobs", "")
+	if status != fiber.StatusInternalServerError || body["error"] != "fetch_failed" {
+		t.Fatalf("got %d %v, want 500 fetch_failed", status, body)
+	}
+}
+
+func TestGetJobsRadius(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    string
+		near      string // center the service is called with; empty when not called
+		err       error
+		status    int
+		errorCode string
+	}{
+		{name: "radius without center", target: "/jobs?radius_km=25", status: fiber.StatusBadRequest, errorCode: "invalid_request"},
+		{name: "center", target: "/jobs?near=Austin,%20TX&radius_km=25", near: "Austin, TX", status: fiber.StatusOK},
+		{
+			name: "unknown center", target: "/jobs?near=Atlantis", near: "Atlantis", err: fmt.Errorf("geocode: %w", geo.ErrNotFound),
+			status: fiber.StatusBadRequest, errorCode: "invalid_location",
+		},
+		{
+			name: "geocoding disabled", target: "/jobs?near=Austin", near: "Austin", err: geo.ErrDisabled,
+			status: fiber.StatusBadRequest, errorCode: "invalid_location",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mocks.NewMockJobListService(ctrl)
+			if tt.near != "" {
+				service.EXPECT().GetJobs(gomock.Any(), 1, 20, "posted_date", "desc", gomock.Cond(func(x any) bool {
+					f := x.(*domain.JobFilters)
+					return f != nil && f.Near != nil && *f.Near == tt.near
+				})).Return(&domain.JobSearchResponse{}, tt.err)
+			}
+
+			status, body := do(t, jobListApp(service), fiber.MethodGet, tt.target, "")
+			if status != tt.status {
+				t.Fatalf("status = %d, want %d (%v)", status, tt.status, body)
+			}
+			if tt.errorCode != "" && body["error"] != tt.errorCode {
+				t.Errorf("error = %v, want %s", body["error"], tt.errorCode)
+			}
+		})
+	}
+}
+
+func TestCachedSearchPerTenant(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	service := mocks.NewMockJobListService(ctrl)
+	service.EXPECT().Search(gomock.Any(), gomock.Any()).Return(&domain.JobSearchResponse{Total: 1}, nil).Times(2)
+
+	cached := handlers.NewCachedJobListService(service, cache.New(config.CacheConfig{Enabled: true, TTL: time.Minute}))
+	req := domain.JobSearchRequest{Query: strPtr("golang"), Page: 1, Limit: 20}
+	for _, id := range []string{"acme", "globex", "acme"} {
+		ctx := tenant.WithTenant(context.Background(), config.TenantConfig{ID: id})
+		if _, err := cached.Search(ctx, req); err != nil {
+			t.Fatalf("search as %s: %v", id, err)
+		}
+	}
+}
+
+func TestGetJobDetails(t *testing.T) {
+	id := uuid.New()
+
+	tests := []struct {
+		name      string
+		target    string
+		found     bool
+		err       error
+		status    int
+		errorCode string
+	}{
+		{name: "invalid id", target: "/jobs/not-a-uuid", status: fiber.StatusBadRequest, errorCode: "invalid_id"},
+		{name: "not found", target: "/jobs/" + id.String(), err: errors.New("no rows"), status: fiber.StatusNotFound, errorCode: "not_found"},
+		{name: "found", target: "/jobs/" + id.String(), found: true, status: fiber.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mocks.NewMockJobListService(ctrl)
+			if tt.found || tt.err != nil {
+				var job *domain.Job
+				if tt.found {
+					job = &domain.Job{ID: id, Title: "Backend Engineer"}
+				}
+				service.EXPECT().GetJobDetails(gomock.Any(), id).Return(job, tt.err)
+			}
+
+			status, body := do(t, jobListApp(service), fiber.MethodGet, tt.target, "")
+			if status != tt.status {
+				t.Fatalf("status = %d, want %d (%v)", status, tt.status, body)
+			}
+			if tt.errorCode != "" && body["error"] != tt.errorCode {
+				t.Errorf("error = %v, want %s", body["error"], tt.errorCode)
+			}
+		})
+	}
+}
+
+func TestTriggerScrape(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   string
+		body     string
+		keywords []string
+		location *string
+		sources  []string
+		status   int
+	}{
+		{name: "no keywords", target: "/scrape", status: fiber.StatusBadRequest},
+		{name: "empty keywords param", target: "/scrape?keywords=", status: fiber.StatusBadRequest},
+		{
+			name: "repeated query params", target: "/scrape?keywords=golang&keywords=rust&sources=indeed&sources=dice",
+			keywords: []string{"golang", "rust"}, sources: []string{"indeed", "dice"}, status: fiber.StatusAccepted,
+		},
+		{
+			name: "comma-separated query params", target: "/scrape?keywords=golang,%20rust&sources=indeed,&location=Berlin",
+			keywords: []string{"golang", "rust"}, location: strPtr("Berlin"), sources: []string{"indeed"}, status: fiber.StatusAccepted,
+		},
+		{
+			name: "body", target: "/scrape", body: `{"keywords":["golang"],"location":"Remote","sources":["linkedin"]}`,
+			keywords: []string{"golang"}, location: strPtr("Remote"), sources: []string{"linkedin"}, status: fiber.StatusAccepted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			service := mocks.NewMockJobListService(ctrl)
+			if tt.keywords != nil {
+				service.EXPECT().TriggerScrape(gomock.Any(), tt.keywords, tt.location, tt.sources).
+					Return(&domain.ScrapeTask{ID: uuid.New(), Status: domain.ScrapeStatusQueued}, nil)
+			}
+
+			status, body := do(t, jobListApp(service), fiber.MethodPost, tt.target, tt.body)
+			if status != tt.status {
+				t.Fatalf("status = %d, want %d (%v)", status, tt.status, body)
+			}
+		})
+	}
+}
+
+func withQuery(req domain.JobSearchRequest, query string) *domain.JobSearchRequest {
+	req.Query = &query
+	return &req
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,28 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// LLMHealthMonitor reports the circuit breaker state of each LLM backend
+type LLMHealthMonitor interface {
+	Health() []domain.LLMBackendHealth
+}
+
+// LLMHealthHandler handles LLM backend health requests
+type LLMHealthHandler struct {
+	monitor LLMHealthMonitor
+}
+
+// NewLLMHealthHandler creates a new LLM backend health handler
+func NewLLMHealthHandler(monitor LLMHealthMonitor) *LLMHealthHandler {
+	return &LLMHealthHandler{monitor: monitor}
+}
+
+// GetBackends handles GET /api/admin/llm/backends: each backend's breaker
+// state, failures and how many requests it served as a fallback
+func (h *LLMHealthHandler) GetBackends(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"backends": h.monitor.Health()})
+}
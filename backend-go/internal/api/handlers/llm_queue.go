@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/llm"
+)
+
+// LLMQueueMonitor reports LLM concurrency limiter metrics
+type LLMQueueMonitor interface {
+	Stats() map[string]llm.QueueStats
+}
+
+// LLMQueueHandler handles LLM queue API requests
+type LLMQueueHandler struct {
+	monitor LLMQueueMonitor
+}
+
+// NewLLMQueueHandler creates a new LLM queue handler
+func NewLLMQueueHandler(monitor LLMQueueMonitor) *LLMQueueHandler {
+	return &LLMQueueHandler{monitor: monitor}
+}
+
+// GetQueueStats handles GET /api/admin/llm/queue
+func (h *LLMQueueHandler) GetQueueStats(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"backends": h.monitor.Stats(),
+	})
+}
@@ -0,0 +1,51 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/mailmerge"
+)
+
+// MailMerger drafts personalized emails for a batch of contacts
+type MailMerger interface {
+	Merge(ctx context.Context, req domain.MailMergeRequest) (*domain.MailMergeBatch, error)
+}
+
+// MailMergeHandler handles batch cold outreach requests
+type MailMergeHandler struct {
+	merger MailMerger
+}
+
+// NewMailMergeHandler creates a mail merge handler
+func NewMailMergeHandler(merger MailMerger) *MailMergeHandler {
+	return &MailMergeHandler{merger: merger}
+}
+
+// Merge handles POST /api/email/merge. Drafts are returned for review with
+// their deliverability issues; nothing is sent.
+func (h *MailMergeHandler) Merge(c *fiber.Ctx) error {
+	var req domain.MailMergeRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	batch, err := h.merger.Merge(c.UserContext(), req)
+	switch {
+	case errors.Is(err, mailmerge.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "merge_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(batch)
+}
@@ -0,0 +1,6 @@
+// Package mocks provides gomock stand-ins for the handler service interfaces
+// so handlers can be exercised without a database, ML service or LLM.
+// Regenerate with go generate after changing one of the interfaces.
+package mocks
+
+//go:generate mockgen -destination=services.go -package=mocks github.com/resume-rag/backend/internal/api/handlers ChatService,AnalyzerService,JobMatchService,InterviewService,EmailService,JobListService
@@ -0,0 +1,812 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/resume-rag/backend/internal/api/handlers (interfaces: ChatService,AnalyzerService,JobMatchService,InterviewService,EmailService,JobListService)
+//
+// Generated by this command:
+//
+//	mockgen -destination=services.go -package=mocks github.com/resume-rag/backend/internal/api/handlers ChatService,AnalyzerService,JobMatchService,InterviewService,EmailService,JobListService
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	uuid "github.com/google/uuid"
+	domain "github.com/resume-rag/backend/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChatService is a mock of ChatService interface.
+type MockChatService struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatServiceMockRecorder
+}
+
+// MockChatServiceMockRecorder is the mock recorder for MockChatService.
+type MockChatServiceMockRecorder struct {
+	mock *MockChatService
+}
+
+// NewMockChatService creates a new mock instance.
+func NewMockChatService(ctrl *gomock.Controller) *MockChatService {
+	mock := &MockChatService{ctrl: ctrl}
+	mock.recorder = &MockChatServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatService) EXPECT() *MockChatServiceMockRecorder {
+	return m.recorder
+}
+
+// Chat mocks base method.
+func (m *MockChatService) Chat(arg0 context.Context, arg1 domain.ChatRequest) (*domain.ChatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Chat", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Chat indicates an expected call of Chat.
+func (mr *MockChatServiceMockRecorder) Chat(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Chat", reflect.TypeOf((*MockChatService)(nil).Chat), arg0, arg1)
+}
+
+// ChatStream mocks base method.
+func (m *MockChatService) ChatStream(arg0 context.Context, arg1 domain.ChatRequest, arg2 func(string) error) (*domain.ChatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChatStream", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.ChatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChatStream indicates an expected call of ChatStream.
+func (mr *MockChatServiceMockRecorder) ChatStream(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChatStream", reflect.TypeOf((*MockChatService)(nil).ChatStream), arg0, arg1, arg2)
+}
+
+// ClearHistory mocks base method.
+func (m *MockChatService) ClearHistory(arg0 context.Context, arg1 *uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearHistory", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearHistory indicates an expected call of ClearHistory.
+func (mr *MockChatServiceMockRecorder) ClearHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearHistory", reflect.TypeOf((*MockChatService)(nil).ClearHistory), arg0, arg1)
+}
+
+// GetHistory mocks base method.
+func (m *MockChatService) GetHistory(arg0 context.Context, arg1 *uuid.UUID, arg2 int) (*domain.ChatHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.ChatHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockChatServiceMockRecorder) GetHistory(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockChatService)(nil).GetHistory), arg0, arg1, arg2)
+}
+
+// GetSuggestions mocks base method.
+func (m *MockChatService) GetSuggestions(arg0 context.Context, arg1 domain.ChatMode) (*domain.ChatSuggestionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSuggestions", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ChatSuggestionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSuggestions indicates an expected call of GetSuggestions.
+func (mr *MockChatServiceMockRecorder) GetSuggestions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSuggestions", reflect.TypeOf((*MockChatService)(nil).GetSuggestions), arg0, arg1)
+}
+
+// SearchHistory mocks base method.
+func (m *MockChatService) SearchHistory(arg0 context.Context, arg1 string, arg2 int) (*domain.ChatSearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.ChatSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchHistory indicates an expected call of SearchHistory.
+func (mr *MockChatServiceMockRecorder) SearchHistory(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchHistory", reflect.TypeOf((*MockChatService)(nil).SearchHistory), arg0, arg1, arg2)
+}
+
+// MockAnalyzerService is a mock of AnalyzerService interface.
+type MockAnalyzerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyzerServiceMockRecorder
+}
+
+// MockAnalyzerServiceMockRecorder is the mock recorder for MockAnalyzerService.
+type MockAnalyzerServiceMockRecorder struct {
+	mock *MockAnalyzerService
+}
+
+// NewMockAnalyzerService creates a new mock instance.
+func NewMockAnalyzerService(ctrl *gomock.Controller) *MockAnalyzerService {
+	mock := &MockAnalyzerService{ctrl: ctrl}
+	mock.recorder = &MockAnalyzerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyzerService) EXPECT() *MockAnalyzerServiceMockRecorder {
+	return m.recorder
+}
+
+// AnalyzeJob mocks base method.
+func (m *MockAnalyzerService) AnalyzeJob(arg0 context.Context, arg1 string, arg2 []string) (*domain.JobAnalysis, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnalyzeJob", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.JobAnalysis)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnalyzeJob indicates an expected call of AnalyzeJob.
+func (mr *MockAnalyzerServiceMockRecorder) AnalyzeJob(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnalyzeJob", reflect.TypeOf((*MockAnalyzerService)(nil).AnalyzeJob), arg0, arg1, arg2)
+}
+
+// ExtractKeywords mocks base method.
+func (m *MockAnalyzerService) ExtractKeywords(arg0 context.Context, arg1 string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractKeywords", arg0, arg1)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractKeywords indicates an expected call of ExtractKeywords.
+func (mr *MockAnalyzerServiceMockRecorder) ExtractKeywords(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractKeywords", reflect.TypeOf((*MockAnalyzerService)(nil).ExtractKeywords), arg0, arg1)
+}
+
+// MockJobMatchService is a mock of JobMatchService interface.
+type MockJobMatchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobMatchServiceMockRecorder
+}
+
+// MockJobMatchServiceMockRecorder is the mock recorder for MockJobMatchService.
+type MockJobMatchServiceMockRecorder struct {
+	mock *MockJobMatchService
+}
+
+// NewMockJobMatchService creates a new mock instance.
+func NewMockJobMatchService(ctrl *gomock.Controller) *MockJobMatchService {
+	mock := &MockJobMatchService{ctrl: ctrl}
+	mock.recorder = &MockJobMatchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobMatchService) EXPECT() *MockJobMatchServiceMockRecorder {
+	return m.recorder
+}
+
+// BatchMatch mocks base method.
+func (m *MockJobMatchService) BatchMatch(arg0 context.Context, arg1 []string) ([]domain.MatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchMatch", arg0, arg1)
+	ret0, _ := ret[0].([]domain.MatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchMatch indicates an expected call of BatchMatch.
+func (mr *MockJobMatchServiceMockRecorder) BatchMatch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchMatch", reflect.TypeOf((*MockJobMatchService)(nil).BatchMatch), arg0, arg1)
+}
+
+// ClearHistory mocks base method.
+func (m *MockJobMatchService) ClearHistory(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearHistory", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearHistory indicates an expected call of ClearHistory.
+func (mr *MockJobMatchServiceMockRecorder) ClearHistory(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearHistory", reflect.TypeOf((*MockJobMatchService)(nil).ClearHistory), arg0)
+}
+
+// GetAnalytics mocks base method.
+func (m *MockJobMatchService) GetAnalytics(arg0 context.Context) (*domain.MatchAnalytics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalytics", arg0)
+	ret0, _ := ret[0].(*domain.MatchAnalytics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalytics indicates an expected call of GetAnalytics.
+func (mr *MockJobMatchServiceMockRecorder) GetAnalytics(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalytics", reflect.TypeOf((*MockJobMatchService)(nil).GetAnalytics), arg0)
+}
+
+// GetHistory mocks base method.
+func (m *MockJobMatchService) GetHistory(arg0 context.Context, arg1 int) (*domain.MatchHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", arg0, arg1)
+	ret0, _ := ret[0].(*domain.MatchHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockJobMatchServiceMockRecorder) GetHistory(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockJobMatchService)(nil).GetHistory), arg0, arg1)
+}
+
+// GetMatchDetails mocks base method.
+func (m *MockJobMatchService) GetMatchDetails(arg0 context.Context, arg1 uuid.UUID) (*domain.MatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMatchDetails", arg0, arg1)
+	ret0, _ := ret[0].(*domain.MatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMatchDetails indicates an expected call of GetMatchDetails.
+func (mr *MockJobMatchServiceMockRecorder) GetMatchDetails(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMatchDetails", reflect.TypeOf((*MockJobMatchService)(nil).GetMatchDetails), arg0, arg1)
+}
+
+// MatchJob mocks base method.
+func (m *MockJobMatchService) MatchJob(arg0 context.Context, arg1 string) (*domain.MatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MatchJob", arg0, arg1)
+	ret0, _ := ret[0].(*domain.MatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MatchJob indicates an expected call of MatchJob.
+func (mr *MockJobMatchServiceMockRecorder) MatchJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MatchJob", reflect.TypeOf((*MockJobMatchService)(nil).MatchJob), arg0, arg1)
+}
+
+// MockInterviewService is a mock of InterviewService interface.
+type MockInterviewService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInterviewServiceMockRecorder
+}
+
+// MockInterviewServiceMockRecorder is the mock recorder for MockInterviewService.
+type MockInterviewServiceMockRecorder struct {
+	mock *MockInterviewService
+}
+
+// NewMockInterviewService creates a new mock instance.
+func NewMockInterviewService(ctrl *gomock.Controller) *MockInterviewService {
+	mock := &MockInterviewService{ctrl: ctrl}
+	mock.recorder = &MockInterviewServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInterviewService) EXPECT() *MockInterviewServiceMockRecorder {
+	return m.recorder
+}
+
+// EvaluatePractice mocks base method.
+func (m *MockInterviewService) EvaluatePractice(arg0 context.Context, arg1, arg2 string) (*domain.PracticeEvaluation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EvaluatePractice", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.PracticeEvaluation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EvaluatePractice indicates an expected call of EvaluatePractice.
+func (mr *MockInterviewServiceMockRecorder) EvaluatePractice(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EvaluatePractice", reflect.TypeOf((*MockInterviewService)(nil).EvaluatePractice), arg0, arg1, arg2)
+}
+
+// GenerateSTAR mocks base method.
+func (m *MockInterviewService) GenerateSTAR(arg0 context.Context, arg1 string) (*domain.STARAnswer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateSTAR", arg0, arg1)
+	ret0, _ := ret[0].(*domain.STARAnswer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateSTAR indicates an expected call of GenerateSTAR.
+func (mr *MockInterviewServiceMockRecorder) GenerateSTAR(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateSTAR", reflect.TypeOf((*MockInterviewService)(nil).GenerateSTAR), arg0, arg1)
+}
+
+// GetCategories mocks base method.
+func (m *MockInterviewService) GetCategories(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCategories", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCategories indicates an expected call of GetCategories.
+func (mr *MockInterviewServiceMockRecorder) GetCategories(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCategories", reflect.TypeOf((*MockInterviewService)(nil).GetCategories), arg0)
+}
+
+// GetCompanyResearch mocks base method.
+func (m *MockInterviewService) GetCompanyResearch(arg0 context.Context, arg1 string) (*domain.CompanyReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCompanyResearch", arg0, arg1)
+	ret0, _ := ret[0].(*domain.CompanyReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCompanyResearch indicates an expected call of GetCompanyResearch.
+func (mr *MockInterviewServiceMockRecorder) GetCompanyResearch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompanyResearch", reflect.TypeOf((*MockInterviewService)(nil).GetCompanyResearch), arg0, arg1)
+}
+
+// GetQuestions mocks base method.
+func (m *MockInterviewService) GetQuestions(arg0 context.Context, arg1, arg2 string, arg3, arg4 int) (*domain.QuestionSet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuestions", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*domain.QuestionSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuestions indicates an expected call of GetQuestions.
+func (mr *MockInterviewServiceMockRecorder) GetQuestions(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuestions", reflect.TypeOf((*MockInterviewService)(nil).GetQuestions), arg0, arg1, arg2, arg3, arg4)
+}
+
+// GetRoles mocks base method.
+func (m *MockInterviewService) GetRoles(arg0 context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoles", arg0)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoles indicates an expected call of GetRoles.
+func (mr *MockInterviewServiceMockRecorder) GetRoles(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockInterviewService)(nil).GetRoles), arg0)
+}
+
+// MockEmailService is a mock of EmailService interface.
+type MockEmailService struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailServiceMockRecorder
+}
+
+// MockEmailServiceMockRecorder is the mock recorder for MockEmailService.
+type MockEmailServiceMockRecorder struct {
+	mock *MockEmailService
+}
+
+// NewMockEmailService creates a new mock instance.
+func NewMockEmailService(ctrl *gomock.Controller) *MockEmailService {
+	mock := &MockEmailService{ctrl: ctrl}
+	mock.recorder = &MockEmailServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailService) EXPECT() *MockEmailServiceMockRecorder {
+	return m.recorder
+}
+
+// Generate mocks base method.
+func (m *MockEmailService) Generate(arg0 context.Context, arg1, arg2, arg3, arg4 string) (*domain.GeneratedEmail, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Generate", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(*domain.GeneratedEmail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Generate indicates an expected call of Generate.
+func (mr *MockEmailServiceMockRecorder) Generate(arg0, arg1, arg2, arg3, arg4 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockEmailService)(nil).Generate), arg0, arg1, arg2, arg3, arg4)
+}
+
+// MockJobListService is a mock of JobListService interface.
+type MockJobListService struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobListServiceMockRecorder
+}
+
+// MockJobListServiceMockRecorder is the mock recorder for MockJobListService.
+type MockJobListServiceMockRecorder struct {
+	mock *MockJobListService
+}
+
+// NewMockJobListService creates a new mock instance.
+func NewMockJobListService(ctrl *gomock.Controller) *MockJobListService {
+	mock := &MockJobListService{ctrl: ctrl}
+	mock.recorder = &MockJobListServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobListService) EXPECT() *MockJobListServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelScrape mocks base method.
+func (m *MockJobListService) CancelScrape(arg0 context.Context, arg1 uuid.UUID) (*domain.ScrapeTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScrape", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ScrapeTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScrape indicates an expected call of CancelScrape.
+func (mr *MockJobListServiceMockRecorder) CancelScrape(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScrape", reflect.TypeOf((*MockJobListService)(nil).CancelScrape), arg0, arg1)
+}
+
+// CreateApplication mocks base method.
+func (m *MockJobListService) CreateApplication(arg0 context.Context, arg1 domain.ApplicationCreate) (*domain.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApplication", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApplication indicates an expected call of CreateApplication.
+func (mr *MockJobListServiceMockRecorder) CreateApplication(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplication", reflect.TypeOf((*MockJobListService)(nil).CreateApplication), arg0, arg1)
+}
+
+// DeleteApplication mocks base method.
+func (m *MockJobListService) DeleteApplication(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApplication", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApplication indicates an expected call of DeleteApplication.
+func (mr *MockJobListServiceMockRecorder) DeleteApplication(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplication", reflect.TypeOf((*MockJobListService)(nil).DeleteApplication), arg0, arg1)
+}
+
+// DeleteJob mocks base method.
+func (m *MockJobListService) DeleteJob(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJob", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJob indicates an expected call of DeleteJob.
+func (mr *MockJobListServiceMockRecorder) DeleteJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJob", reflect.TypeOf((*MockJobListService)(nil).DeleteJob), arg0, arg1)
+}
+
+// DeleteSavedSearch mocks base method.
+func (m *MockJobListService) DeleteSavedSearch(arg0 context.Context, arg1 uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSavedSearch", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSavedSearch indicates an expected call of DeleteSavedSearch.
+func (mr *MockJobListServiceMockRecorder) DeleteSavedSearch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSavedSearch", reflect.TypeOf((*MockJobListService)(nil).DeleteSavedSearch), arg0, arg1)
+}
+
+// GenerateCoverLetter mocks base method.
+func (m *MockJobListService) GenerateCoverLetter(arg0 context.Context, arg1 uuid.UUID, arg2 *string) (*domain.CoverLetterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateCoverLetter", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.CoverLetterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateCoverLetter indicates an expected call of GenerateCoverLetter.
+func (mr *MockJobListServiceMockRecorder) GenerateCoverLetter(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateCoverLetter", reflect.TypeOf((*MockJobListService)(nil).GenerateCoverLetter), arg0, arg1, arg2)
+}
+
+// GetApplication mocks base method.
+func (m *MockJobListService) GetApplication(arg0 context.Context, arg1 uuid.UUID) (*domain.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplication", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplication indicates an expected call of GetApplication.
+func (mr *MockJobListServiceMockRecorder) GetApplication(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplication", reflect.TypeOf((*MockJobListService)(nil).GetApplication), arg0, arg1)
+}
+
+// GetApplicationStats mocks base method.
+func (m *MockJobListService) GetApplicationStats(arg0 context.Context) (*domain.ApplicationStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplicationStats", arg0)
+	ret0, _ := ret[0].(*domain.ApplicationStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplicationStats indicates an expected call of GetApplicationStats.
+func (mr *MockJobListServiceMockRecorder) GetApplicationStats(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplicationStats", reflect.TypeOf((*MockJobListService)(nil).GetApplicationStats), arg0)
+}
+
+// GetApplications mocks base method.
+func (m *MockJobListService) GetApplications(arg0 context.Context, arg1 *domain.ApplicationStatus, arg2, arg3 int) (*domain.ApplicationListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApplications", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.ApplicationListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApplications indicates an expected call of GetApplications.
+func (mr *MockJobListServiceMockRecorder) GetApplications(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApplications", reflect.TypeOf((*MockJobListService)(nil).GetApplications), arg0, arg1, arg2, arg3)
+}
+
+// GetDueReminders mocks base method.
+func (m *MockJobListService) GetDueReminders(arg0 context.Context) ([]domain.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueReminders", arg0)
+	ret0, _ := ret[0].([]domain.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueReminders indicates an expected call of GetDueReminders.
+func (mr *MockJobListServiceMockRecorder) GetDueReminders(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueReminders", reflect.TypeOf((*MockJobListService)(nil).GetDueReminders), arg0)
+}
+
+// GetJobDetails mocks base method.
+func (m *MockJobListService) GetJobDetails(arg0 context.Context, arg1 uuid.UUID) (*domain.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobDetails", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobDetails indicates an expected call of GetJobDetails.
+func (mr *MockJobListServiceMockRecorder) GetJobDetails(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobDetails", reflect.TypeOf((*MockJobListService)(nil).GetJobDetails), arg0, arg1)
+}
+
+// GetJobStats mocks base method.
+func (m *MockJobListService) GetJobStats(arg0 context.Context) (*domain.JobSearchStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobStats", arg0)
+	ret0, _ := ret[0].(*domain.JobSearchStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobStats indicates an expected call of GetJobStats.
+func (mr *MockJobListServiceMockRecorder) GetJobStats(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobStats", reflect.TypeOf((*MockJobListService)(nil).GetJobStats), arg0)
+}
+
+// GetJobs mocks base method.
+func (m *MockJobListService) GetJobs(arg0 context.Context, arg1, arg2 int, arg3, arg4 string, arg5 *domain.JobFilters) (*domain.JobSearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobs", arg0, arg1, arg2, arg3, arg4, arg5)
+	ret0, _ := ret[0].(*domain.JobSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobs indicates an expected call of GetJobs.
+func (mr *MockJobListServiceMockRecorder) GetJobs(arg0, arg1, arg2, arg3, arg4, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobs", reflect.TypeOf((*MockJobListService)(nil).GetJobs), arg0, arg1, arg2, arg3, arg4, arg5)
+}
+
+// GetRecommendations mocks base method.
+func (m *MockJobListService) GetRecommendations(arg0 context.Context, arg1 int) ([]domain.JobRecommendation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommendations", arg0, arg1)
+	ret0, _ := ret[0].([]domain.JobRecommendation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendations indicates an expected call of GetRecommendations.
+func (mr *MockJobListServiceMockRecorder) GetRecommendations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendations", reflect.TypeOf((*MockJobListService)(nil).GetRecommendations), arg0, arg1)
+}
+
+// GetSavedSearches mocks base method.
+func (m *MockJobListService) GetSavedSearches(arg0 context.Context) ([]domain.SavedSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSavedSearches", arg0)
+	ret0, _ := ret[0].([]domain.SavedSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSavedSearches indicates an expected call of GetSavedSearches.
+func (mr *MockJobListServiceMockRecorder) GetSavedSearches(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSavedSearches", reflect.TypeOf((*MockJobListService)(nil).GetSavedSearches), arg0)
+}
+
+// GetScrapeStatus mocks base method.
+func (m *MockJobListService) GetScrapeStatus(arg0 context.Context, arg1 uuid.UUID) (*domain.ScrapeTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScrapeStatus", arg0, arg1)
+	ret0, _ := ret[0].(*domain.ScrapeTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScrapeStatus indicates an expected call of GetScrapeStatus.
+func (mr *MockJobListServiceMockRecorder) GetScrapeStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScrapeStatus", reflect.TypeOf((*MockJobListService)(nil).GetScrapeStatus), arg0, arg1)
+}
+
+// GetTrash mocks base method.
+func (m *MockJobListService) GetTrash(arg0 context.Context) (*domain.TrashResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrash", arg0)
+	ret0, _ := ret[0].(*domain.TrashResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrash indicates an expected call of GetTrash.
+func (mr *MockJobListServiceMockRecorder) GetTrash(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrash", reflect.TypeOf((*MockJobListService)(nil).GetTrash), arg0)
+}
+
+// RestoreApplication mocks base method.
+func (m *MockJobListService) RestoreApplication(arg0 context.Context, arg1 uuid.UUID) (*domain.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreApplication", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreApplication indicates an expected call of RestoreApplication.
+func (mr *MockJobListServiceMockRecorder) RestoreApplication(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreApplication", reflect.TypeOf((*MockJobListService)(nil).RestoreApplication), arg0, arg1)
+}
+
+// RestoreJob mocks base method.
+func (m *MockJobListService) RestoreJob(arg0 context.Context, arg1 uuid.UUID) (*domain.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreJob", arg0, arg1)
+	ret0, _ := ret[0].(*domain.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreJob indicates an expected call of RestoreJob.
+func (mr *MockJobListServiceMockRecorder) RestoreJob(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreJob", reflect.TypeOf((*MockJobListService)(nil).RestoreJob), arg0, arg1)
+}
+
+// SaveSearch mocks base method.
+func (m *MockJobListService) SaveSearch(arg0 context.Context, arg1 domain.SavedSearchCreate) (*domain.SavedSearch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSearch", arg0, arg1)
+	ret0, _ := ret[0].(*domain.SavedSearch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveSearch indicates an expected call of SaveSearch.
+func (mr *MockJobListServiceMockRecorder) SaveSearch(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSearch", reflect.TypeOf((*MockJobListService)(nil).SaveSearch), arg0, arg1)
+}
+
+// Search mocks base method.
+func (m *MockJobListService) Search(arg0 context.Context, arg1 domain.JobSearchRequest) (*domain.JobSearchResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", arg0, arg1)
+	ret0, _ := ret[0].(*domain.JobSearchResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockJobListServiceMockRecorder) Search(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockJobListService)(nil).Search), arg0, arg1)
+}
+
+// TriggerScrape mocks base method.
+func (m *MockJobListService) TriggerScrape(arg0 context.Context, arg1 []string, arg2 *string, arg3 []string) (*domain.ScrapeTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerScrape", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*domain.ScrapeTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerScrape indicates an expected call of TriggerScrape.
+func (mr *MockJobListServiceMockRecorder) TriggerScrape(arg0, arg1, arg2, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerScrape", reflect.TypeOf((*MockJobListService)(nil).TriggerScrape), arg0, arg1, arg2, arg3)
+}
+
+// UpdateApplication mocks base method.
+func (m *MockJobListService) UpdateApplication(arg0 context.Context, arg1 uuid.UUID, arg2 domain.ApplicationUpdate) (*domain.Application, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateApplication", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*domain.Application)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateApplication indicates an expected call of UpdateApplication.
+func (mr *MockJobListServiceMockRecorder) UpdateApplication(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateApplication", reflect.TypeOf((*MockJobListService)(nil).UpdateApplication), arg0, arg1, arg2)
+}
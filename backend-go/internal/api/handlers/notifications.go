@@ -0,0 +1,60 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/notify"
+)
+
+// NotificationService keeps notification preferences and sends the
+// notifications they allow
+type NotificationService interface {
+	Preferences(ctx context.Context) (*domain.NotificationPreferences, error)
+	Update(ctx context.Context, in domain.NotificationPreferencesUpdate) (*domain.NotificationPreferences, error)
+	AlertSavedSearches(ctx context.Context, searches notify.SavedSearches, now time.Time) (int, error)
+}
+
+// NotificationHandler handles notification preference requests
+type NotificationHandler struct {
+	service NotificationService
+}
+
+// NewNotificationHandler creates a new notification handler
+func NewNotificationHandler(service NotificationService) *NotificationHandler {
+	return &NotificationHandler{service: service}
+}
+
+// GetPreferences handles GET /api/notifications/preferences
+func (h *NotificationHandler) GetPreferences(c *fiber.Ctx) error {
+	prefs, err := h.service.Preferences(c.Context())
+	if err != nil {
+		return serviceFailed(c, "notification_preferences_failed", err)
+	}
+	return c.JSON(prefs)
+}
+
+// UpdatePreferences handles PUT /api/notifications/preferences. Only the
+// fields sent are changed; events left out of channels keep their channels.
+func (h *NotificationHandler) UpdatePreferences(c *fiber.Ctx) error {
+	var req domain.NotificationPreferencesUpdate
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	prefs, err := h.service.Update(c.Context(), req)
+	if errors.Is(err, notify.ErrInvalid) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	}
+	if err != nil {
+		return serviceFailed(c, "notification_preferences_failed", err)
+	}
+	return c.JSON(prefs)
+}
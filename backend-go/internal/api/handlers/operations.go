@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// OperationRegistry lists and cancels running long-running operations
+type OperationRegistry interface {
+	List(owner string) []domain.Operation
+	Cancel(owner, id string) bool
+}
+
+// OperationsHandler handles requests about the caller's running operations
+type OperationsHandler struct {
+	registry OperationRegistry
+	owner    func(c *fiber.Ctx) string
+}
+
+// NewOperationsHandler creates a new operations handler. owner identifies
+// the caller the same way operations were registered.
+func NewOperationsHandler(registry OperationRegistry, owner func(c *fiber.Ctx) string) *OperationsHandler {
+	return &OperationsHandler{registry: registry, owner: owner}
+}
+
+// ListOperations handles GET /api/operations
+func (h *OperationsHandler) ListOperations(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"operations": h.registry.List(h.owner(c))})
+}
+
+// CancelOperation handles DELETE /api/operations/:operation_id
+func (h *OperationsHandler) CancelOperation(c *fiber.Ctx) error {
+	if !h.registry.Cancel(h.owner(c), c.Params("operation_id")) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Operation not found or already finished",
+		})
+	}
+	return c.SendStatus(fiber.StatusAccepted)
+}
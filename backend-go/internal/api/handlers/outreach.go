@@ -0,0 +1,180 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/outreach"
+)
+
+// OutreachService runs follow-up email sequences for applications
+type OutreachService interface {
+	Create(ctx context.Context, appID uuid.UUID, req domain.OutreachStart) (*domain.OutreachSequence, error)
+	ForApplication(ctx context.Context, appID uuid.UUID) ([]domain.OutreachSequence, error)
+	Pause(ctx context.Context, id uuid.UUID) (*domain.OutreachSequence, error)
+	Resume(ctx context.Context, id uuid.UUID) (*domain.OutreachSequence, error)
+	Cancel(ctx context.Context, id uuid.UUID) (*domain.OutreachSequence, error)
+	UpdateStep(ctx context.Context, id uuid.UUID, index int, req domain.OutreachStepUpdate) (*domain.OutreachSequence, error)
+}
+
+// OutreachHandler handles outreach sequence requests
+type OutreachHandler struct {
+	service OutreachService
+}
+
+// NewOutreachHandler creates an outreach sequence handler
+func NewOutreachHandler(service OutreachService) *OutreachHandler {
+	return &OutreachHandler{service: service}
+}
+
+// StartSequence handles POST /api/job-list/applications/:app_id/outreach.
+// An empty body starts the configured default sequence.
+func (h *OutreachHandler) StartSequence(c *fiber.Ctx) error {
+	appID, err := uuid.Parse(c.Params("app_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid application ID format",
+		})
+	}
+
+	var req domain.OutreachStart
+	if len(c.Body()) > 0 {
+		if err := parseBody(c, &req); err != nil {
+			return invalidBody(c, err)
+		}
+	}
+
+	seq, err := h.service.Create(c.Context(), appID, req)
+	if err != nil {
+		return outreachFailed(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(seq)
+}
+
+// ListSequences handles GET /api/job-list/applications/:app_id/outreach
+func (h *OutreachHandler) ListSequences(c *fiber.Ctx) error {
+	appID, err := uuid.Parse(c.Params("app_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid application ID format",
+		})
+	}
+
+	list, err := h.service.ForApplication(c.Context(), appID)
+	if err != nil {
+		return outreachFailed(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"sequences": list,
+	})
+}
+
+// PauseSequence handles POST /api/job-list/outreach/:sequence_id/pause
+func (h *OutreachHandler) PauseSequence(c *fiber.Ctx) error {
+	return h.apply(c, h.service.Pause)
+}
+
+// ResumeSequence handles POST /api/job-list/outreach/:sequence_id/resume
+func (h *OutreachHandler) ResumeSequence(c *fiber.Ctx) error {
+	return h.apply(c, h.service.Resume)
+}
+
+// CancelSequence handles DELETE /api/job-list/outreach/:sequence_id
+func (h *OutreachHandler) CancelSequence(c *fiber.Ctx) error {
+	return h.apply(c, h.service.Cancel)
+}
+
+// UpdateStep handles PUT /api/job-list/outreach/:sequence_id/steps/:step,
+// marking a step sent or skipped
+func (h *OutreachHandler) UpdateStep(c *fiber.Ctx) error {
+	id, ok := sequenceID(c)
+	if !ok {
+		return nil
+	}
+	index, err := strconv.Atoi(c.Params("step"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Step must be a number",
+		})
+	}
+	var req domain.OutreachStepUpdate
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	seq, err := h.service.UpdateStep(c.Context(), id, index, req)
+	if err != nil {
+		return outreachFailed(c, err)
+	}
+
+	return c.JSON(seq)
+}
+
+// apply runs a state change on the sequence named in the path
+func (h *OutreachHandler) apply(c *fiber.Ctx, change func(context.Context, uuid.UUID) (*domain.OutreachSequence, error)) error {
+	id, ok := sequenceID(c)
+	if !ok {
+		return nil
+	}
+
+	seq, err := change(c.Context(), id)
+	if err != nil {
+		return outreachFailed(c, err)
+	}
+
+	return c.JSON(seq)
+}
+
+// sequenceID parses the sequence_id param, writing the error response when invalid
+func sequenceID(c *fiber.Ctx) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Params("sequence_id"))
+	if err != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid sequence ID format",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// outreachFailed writes the error response for a failed outreach operation
+func outreachFailed(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, outreach.ErrNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Outreach sequence not found",
+		})
+	case errors.Is(err, outreach.ErrApplicationNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Application not found",
+		})
+	case errors.Is(err, outreach.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	case errors.Is(err, outreach.ErrRunning):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error":   "sequence_exists",
+			"message": err.Error(),
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "outreach_failed",
+			"message": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/transcribe"
+)
+
+// PracticeAudio handles POST /api/interview/practice/audio. The answer is an
+// audio upload in the "audio" field, transcribed by the configured provider,
+// or a client-side "transcript". It is evaluated like a written answer, with
+// delivery metrics (filler words, pace) added.
+func (h *InterviewHandler) PracticeAudio(c *fiber.Ctx) error {
+	var req domain.SpokenPracticeRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if req.Question == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "question is required",
+		})
+	}
+
+	transcript := &domain.Transcript{
+		Text:            strings.TrimSpace(req.Transcript),
+		DurationSeconds: req.DurationSeconds,
+	}
+	if fh, err := c.FormFile("audio"); err == nil {
+		if h.transcriber == nil {
+			return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
+				"error":   "transcription_disabled",
+				"message": "Audio transcription is not configured; send a transcript instead",
+			})
+		}
+		if h.maxAudio > 0 && fh.Size > int64(h.maxAudio) {
+			return c.Status(fiber.StatusRequestEntityTooLarge).JSON(fiber.Map{
+				"error":   "audio_too_large",
+				"message": "Audio exceeds the maximum upload size",
+			})
+		}
+
+		f, err := fh.Open()
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid_audio",
+				"message": err.Error(),
+			})
+		}
+		defer f.Close()
+
+		transcript, err = h.transcriber.Transcribe(c.Context(), f, fh.Filename)
+		switch {
+		case errors.Is(err, transcribe.ErrEmptyAudio):
+			return c.Status(fiber.StatusUnprocessableEntity).JSON(fiber.Map{
+				"error":   "no_speech",
+				"message": err.Error(),
+			})
+		case err != nil:
+			return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+				"error":   "transcription_failed",
+				"message": err.Error(),
+			})
+		}
+		if transcript.DurationSeconds == 0 {
+			transcript.DurationSeconds = req.DurationSeconds
+		}
+	}
+	if transcript.Text == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "an audio upload or a transcript is required",
+		})
+	}
+
+	return h.evaluate(c, req.Practice(transcript.Text), fiber.Map{
+		"transcript": transcript,
+		"delivery":   transcribe.Delivery(transcript.Text, transcript.DurationSeconds),
+	})
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/push"
+)
+
+// PushService keeps the browsers' Web Push subscriptions
+type PushService interface {
+	PublicKey() string
+	Subscribe(ctx context.Context, in domain.PushSubscriptionCreate, userAgent string) (*domain.PushSubscription, error)
+	Subscriptions(ctx context.Context) ([]domain.PushSubscription, error)
+	Unsubscribe(ctx context.Context, id uuid.UUID) error
+	SendTest(ctx context.Context, id uuid.UUID) (*domain.PushSubscription, error)
+}
+
+// PushHandler handles Web Push subscription requests
+type PushHandler struct {
+	service PushService
+}
+
+// NewPushHandler creates a new push handler
+func NewPushHandler(service PushService) *PushHandler {
+	return &PushHandler{service: service}
+}
+
+// GetPublicKey handles GET /api/push/vapid-public-key, the
+// applicationServerKey to pass to PushManager.subscribe
+func (h *PushHandler) GetPublicKey(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"public_key": h.service.PublicKey()})
+}
+
+// ListSubscriptions handles GET /api/push/subscriptions
+func (h *PushHandler) ListSubscriptions(c *fiber.Ctx) error {
+	subs, err := h.service.Subscriptions(c.Context())
+	if err != nil {
+		return serviceFailed(c, "push_failed", err)
+	}
+	return c.JSON(fiber.Map{"subscriptions": subs})
+}
+
+// Subscribe handles POST /api/push/subscriptions with the browser's
+// PushSubscription JSON
+func (h *PushHandler) Subscribe(c *fiber.Ctx) error {
+	var req domain.PushSubscriptionCreate
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	sub, err := h.service.Subscribe(c.Context(), req, c.Get(fiber.HeaderUserAgent))
+	if errors.Is(err, push.ErrInvalid) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	}
+	if err != nil {
+		return serviceFailed(c, "push_failed", err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(sub)
+}
+
+// Unsubscribe handles DELETE /api/push/subscriptions/:subscription_id
+func (h *PushHandler) Unsubscribe(c *fiber.Ctx) error {
+	id, ok := subscriptionID(c)
+	if !ok {
+		return invalidSubscriptionID(c)
+	}
+	if err := h.service.Unsubscribe(c.Context(), id); err != nil {
+		return pushFailed(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// SendTest handles POST /api/push/subscriptions/:subscription_id/test,
+// returning the subscription with the test's delivery record
+func (h *PushHandler) SendTest(c *fiber.Ctx) error {
+	id, ok := subscriptionID(c)
+	if !ok {
+		return invalidSubscriptionID(c)
+	}
+	sub, err := h.service.SendTest(c.Context(), id)
+	if err != nil {
+		return pushFailed(c, err)
+	}
+	return c.JSON(sub)
+}
+
+func subscriptionID(c *fiber.Ctx) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Params("subscription_id"))
+	return id, err == nil
+}
+
+func invalidSubscriptionID(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error":   "invalid_id",
+		"message": "Invalid subscription ID format",
+	})
+}
+
+// pushFailed writes the error response for a failed subscription request
+func pushFailed(c *fiber.Ctx, err error) error {
+	if errors.Is(err, push.ErrNotFound) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Push subscription not found",
+		})
+	}
+	return serviceFailed(c, "push_failed", err)
+}
@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ResumeRedactor strips personal details from resumes sent to LLM backends
+type ResumeRedactor interface {
+	Settings() *domain.RedactionSettings
+	Preview(ctx context.Context, backend, text string) *domain.RedactionPreview
+}
+
+// RedactionHandler shows what each LLM backend receives of the resume
+type RedactionHandler struct {
+	redactor ResumeRedactor
+	resume   ResumeProvider
+}
+
+// NewRedactionHandler creates a new redaction handler; resume may be nil, in
+// which case previews must include the resume text
+func NewRedactionHandler(redactor ResumeRedactor, resume ResumeProvider) *RedactionHandler {
+	return &RedactionHandler{redactor: redactor, resume: resume}
+}
+
+// GetSettings handles GET /api/privacy/redaction
+func (h *RedactionHandler) GetSettings(c *fiber.Ctx) error {
+	return c.JSON(h.redactor.Settings())
+}
+
+// Preview handles POST /api/privacy/redaction/preview
+func (h *RedactionHandler) Preview(c *fiber.Ctx) error {
+	var req domain.RedactionPreviewRequest
+	if len(c.Body()) > 0 {
+		if err := parseBody(c, &req); err != nil {
+			return invalidBody(c, err)
+		}
+	}
+
+	resumeText := strings.TrimSpace(req.ResumeText)
+	if resumeText == "" && h.resume != nil {
+		var err error
+		if resumeText, err = h.resume.ResumeText(c.Context()); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "resume_unavailable",
+				"message": err.Error(),
+			})
+		}
+	}
+	if resumeText == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "resume_text is required when no resume is active",
+		})
+	}
+
+	return c.JSON(h.redactor.Preview(c.Context(), strings.TrimSpace(req.Backend), resumeText))
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/rag"
+)
+
+// ResumeIndexer keeps the active resume that chat answers from
+type ResumeIndexer interface {
+	ResumeProvider
+	// Index makes text the active resume and returns its number of chunks
+	Index(ctx context.Context, text string) (int, error)
+}
+
+// ResumeHandler handles requests for the active resume
+type ResumeHandler struct {
+	index ResumeIndexer
+}
+
+// NewResumeHandler creates a new resume handler
+func NewResumeHandler(index ResumeIndexer) *ResumeHandler {
+	return &ResumeHandler{index: index}
+}
+
+// GetResume handles GET /api/resume
+func (h *ResumeHandler) GetResume(c *fiber.Ctx) error {
+	text, err := h.index.ResumeText(c.Context())
+	if errors.Is(err, rag.ErrNoResume) {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "No resume has been uploaded",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{"text": text})
+}
+
+// SetResume handles PUT /api/resume. The resume is chunked and embedded for
+// chat retrieval, replacing the previous one.
+func (h *ResumeHandler) SetResume(c *fiber.Ctx) error {
+	var req struct {
+		Text string `json:"text"`
+	}
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if strings.TrimSpace(req.Text) == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": "Resume text is required",
+		})
+	}
+
+	chunks, err := h.index.Index(c.Context(), req.Text)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "index_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{"chunks": chunks})
+}
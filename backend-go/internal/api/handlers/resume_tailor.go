@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tailor"
+)
+
+// ResumeTailorAdvisor asks an LLM how to tailor a resume to a job
+type ResumeTailorAdvisor interface {
+	Suggest(ctx context.Context, resume string, req domain.ResumeTailorRequest) (*domain.ResumeTailoring, error)
+}
+
+// ResumeTailorHandler handles resume tailoring requests
+type ResumeTailorHandler struct {
+	advisor ResumeTailorAdvisor
+	resume  ResumeProvider
+}
+
+// NewResumeTailorHandler creates a new resume tailoring handler; resume may
+// be nil, in which case requests must include the resume text
+func NewResumeTailorHandler(advisor ResumeTailorAdvisor, resume ResumeProvider) *ResumeTailorHandler {
+	return &ResumeTailorHandler{advisor: advisor, resume: resume}
+}
+
+// Tailor handles POST /api/resume/tailor
+func (h *ResumeTailorHandler) Tailor(c *fiber.Ctx) error {
+	var req domain.ResumeTailorRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	resume := strings.TrimSpace(req.ResumeText)
+	if resume == "" && h.resume != nil {
+		var err error
+		if resume, err = h.resume.ResumeText(c.Context()); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "resume_unavailable",
+				"message": err.Error(),
+			})
+		}
+	}
+	if resume == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "resume_text is required when no resume is active",
+		})
+	}
+
+	result, err := h.advisor.Suggest(c.Context(), resume, req)
+	switch {
+	case errors.Is(err, tailor.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	case errors.Is(err, tailor.ErrJobNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Job not found",
+		})
+	case errors.Is(err, tailor.ErrBadReply):
+		return invalidResult(c, err)
+	case err != nil:
+		return serviceFailed(c, "tailor_failed", err)
+	}
+	return c.JSON(result)
+}
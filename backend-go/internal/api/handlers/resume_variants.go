@@ -0,0 +1,168 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/storage"
+	"github.com/resume-rag/backend/internal/tailor"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// ResumeVariantService creates and stores resume variants tailored per application
+type ResumeVariantService interface {
+	Create(ctx context.Context, appID uuid.UUID, base string) (*domain.ResumeVariant, error)
+	Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVariant, error)
+	ListForApplication(ctx context.Context, appID uuid.UUID) ([]domain.ResumeVariant, error)
+}
+
+// ResumeVariantHandler handles tailored resume variant requests
+type ResumeVariantHandler struct {
+	variants  ResumeVariantService
+	resume    ResumeProvider
+	downloads *Downloads
+}
+
+// NewResumeVariantHandler creates a new resume variant handler; resume may be
+// nil, in which case requests must include the base resume text
+func NewResumeVariantHandler(variants ResumeVariantService, resume ResumeProvider, downloads *Downloads) *ResumeVariantHandler {
+	return &ResumeVariantHandler{variants: variants, resume: resume, downloads: downloads}
+}
+
+// CreateVariant handles POST /api/job-list/applications/:app_id/resume-variants
+func (h *ResumeVariantHandler) CreateVariant(c *fiber.Ctx) error {
+	appID, err := uuid.Parse(c.Params("app_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid application ID format",
+		})
+	}
+
+	var req domain.ResumeVariantCreate
+	if len(c.Body()) > 0 {
+		if err := parseBody(c, &req); err != nil {
+			return invalidBody(c, err)
+		}
+	}
+
+	base := strings.TrimSpace(req.ResumeText)
+	if base == "" && h.resume != nil {
+		if base, err = h.resume.ResumeText(c.Context()); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "resume_unavailable",
+				"message": err.Error(),
+			})
+		}
+	}
+	if base == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "resume_text is required when no resume is active",
+		})
+	}
+
+	variant, err := h.variants.Create(c.Context(), appID, base)
+	switch {
+	case errors.Is(err, tailor.ErrApplicationNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Application not found",
+		})
+	case err != nil:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "create_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(variant)
+}
+
+// ListVariants handles GET /api/job-list/applications/:app_id/resume-variants
+func (h *ResumeVariantHandler) ListVariants(c *fiber.Ctx) error {
+	appID, err := uuid.Parse(c.Params("app_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid application ID format",
+		})
+	}
+
+	variants, err := h.variants.ListForApplication(c.Context(), appID)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"variants": variants,
+	})
+}
+
+// GetVariant handles GET /api/job-list/resume-variants/:variant_id
+func (h *ResumeVariantHandler) GetVariant(c *fiber.Ctx) error {
+	variant, ok := h.lookup(c)
+	if !ok {
+		return nil
+	}
+	return c.JSON(variant)
+}
+
+// ExportDOCX handles GET /api/job-list/resume-variants/:variant_id/docx. The
+// document is stored under the resumes prefix and a download link returned.
+func (h *ResumeVariantHandler) ExportDOCX(c *fiber.Ctx) error {
+	variant, ok := h.lookup(c)
+	if !ok {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	if err := tailor.WriteDOCX(&buf, variant.Sections); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "export_failed",
+			"message": err.Error(),
+		})
+	}
+
+	key := storage.PrefixResumes + tenant.ID(c.UserContext()) + "/" + variant.ID.String() + "/resume-" + variant.Version + ".docx"
+	download, err := h.downloads.Publish(c.UserContext(), key, &buf, int64(buf.Len()), tailor.DOCXContentType)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "export_failed",
+			"message": err.Error(),
+		})
+	}
+	return c.JSON(download)
+}
+
+// lookup loads the variant named in the path. When it cannot, the error
+// response is written and ok is false.
+func (h *ResumeVariantHandler) lookup(c *fiber.Ctx) (variant *domain.ResumeVariant, ok bool) {
+	id, err := uuid.Parse(c.Params("variant_id"))
+	if err != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid resume variant ID format",
+		})
+		return nil, false
+	}
+
+	variant, err = h.variants.Get(c.Context(), id)
+	if err != nil {
+		_ = c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Resume variant not found",
+		})
+		return nil, false
+	}
+	return variant, true
+}
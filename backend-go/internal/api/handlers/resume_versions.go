@@ -0,0 +1,160 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/resumes"
+)
+
+// ResumeVersionService manages named resume versions and the active one
+type ResumeVersionService interface {
+	List(ctx context.Context) ([]domain.ResumeVersion, error)
+	Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVersion, error)
+	Create(ctx context.Context, in domain.ResumeVersionInput) (*domain.ResumeVersion, error)
+	Update(ctx context.Context, id uuid.UUID, in domain.ResumeVersionInput) (*domain.ResumeVersion, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Activate(ctx context.Context, id uuid.UUID) (*domain.ResumeVersion, error)
+}
+
+// ResumeVersionHandler handles resume version requests
+type ResumeVersionHandler struct {
+	service ResumeVersionService
+}
+
+// NewResumeVersionHandler creates a new resume version handler
+func NewResumeVersionHandler(service ResumeVersionService) *ResumeVersionHandler {
+	return &ResumeVersionHandler{service: service}
+}
+
+// ListVersions handles GET /api/resume/versions
+func (h *ResumeVersionHandler) ListVersions(c *fiber.Ctx) error {
+	versions, err := h.service.List(c.Context())
+	if err != nil {
+		return resumeVersionFailed(c, err)
+	}
+	return c.JSON(fiber.Map{"versions": versions})
+}
+
+// CreateVersion handles POST /api/resume/versions. The first version
+// becomes the active one.
+func (h *ResumeVersionHandler) CreateVersion(c *fiber.Ctx) error {
+	var req domain.ResumeVersionInput
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	v, err := h.service.Create(c.Context(), req)
+	if err != nil {
+		return resumeVersionFailed(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(v)
+}
+
+// GetVersion handles GET /api/resume/versions/:version_id
+func (h *ResumeVersionHandler) GetVersion(c *fiber.Ctx) error {
+	id, ok := resumeVersionID(c)
+	if !ok {
+		return nil
+	}
+
+	v, err := h.service.Get(c.Context(), id)
+	if err != nil {
+		return resumeVersionFailed(c, err)
+	}
+	return c.JSON(v)
+}
+
+// UpdateVersion handles PUT /api/resume/versions/:version_id
+func (h *ResumeVersionHandler) UpdateVersion(c *fiber.Ctx) error {
+	id, ok := resumeVersionID(c)
+	if !ok {
+		return nil
+	}
+	var req domain.ResumeVersionInput
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	v, err := h.service.Update(c.Context(), id, req)
+	if err != nil {
+		return resumeVersionFailed(c, err)
+	}
+	return c.JSON(v)
+}
+
+// DeleteVersion handles DELETE /api/resume/versions/:version_id
+func (h *ResumeVersionHandler) DeleteVersion(c *fiber.Ctx) error {
+	id, ok := resumeVersionID(c)
+	if !ok {
+		return nil
+	}
+
+	if err := h.service.Delete(c.Context(), id); err != nil {
+		return resumeVersionFailed(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// ActivateVersion handles POST /api/resume/versions/:version_id/activate.
+// The version's text replaces the resume chat and matching read.
+func (h *ResumeVersionHandler) ActivateVersion(c *fiber.Ctx) error {
+	id, ok := resumeVersionID(c)
+	if !ok {
+		return nil
+	}
+
+	v, err := h.service.Activate(c.Context(), id)
+	if err != nil {
+		return resumeVersionFailed(c, err)
+	}
+	return c.JSON(v)
+}
+
+// resumeVersionID parses the version_id param, writing the error response when invalid
+func resumeVersionID(c *fiber.Ctx) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Params("version_id"))
+	if err != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid resume version ID format",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// resumeVersionFailed writes the error response for a failed resume version operation
+func resumeVersionFailed(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, resumes.ErrNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Resume version not found",
+		})
+	case errors.Is(err, resumes.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	case errors.Is(err, resumes.ErrNameTaken):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error":   "name_taken",
+			"message": err.Error(),
+		})
+	case errors.Is(err, resumes.ErrActive):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error":   "version_active",
+			"message": err.Error(),
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "resume_version_failed",
+			"message": err.Error(),
+		})
+	}
+}
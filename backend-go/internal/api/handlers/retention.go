@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/retention"
+)
+
+// RetentionRunner applies data retention policies
+type RetentionRunner interface {
+	Run(ctx context.Context, dryRun bool) *retention.Report
+	LastRun() *retention.Report
+}
+
+// RetentionHandler handles data retention API requests
+type RetentionHandler struct {
+	runner RetentionRunner
+}
+
+// NewRetentionHandler creates a new retention handler
+func NewRetentionHandler(runner RetentionRunner) *RetentionHandler {
+	return &RetentionHandler{runner: runner}
+}
+
+// GetReport handles GET /api/admin/retention/report (dry run)
+func (h *RetentionHandler) GetReport(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{
+		"pending":  h.runner.Run(c.Context(), true),
+		"last_run": h.runner.LastRun(),
+	})
+}
+
+// RunCleanup handles POST /api/admin/retention/run
+func (h *RetentionHandler) RunCleanup(c *fiber.Ctx) error {
+	dryRun := c.QueryBool("dry_run", false)
+	return c.JSON(h.runner.Run(c.Context(), dryRun))
+}
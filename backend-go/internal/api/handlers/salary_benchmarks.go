@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"io"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/enrichment"
+)
+
+// SalaryBenchmarks compares posted salaries with an imported salary survey
+type SalaryBenchmarks interface {
+	Compare(title, location string, salaryMin, salaryMax *int) (*domain.SalaryComparison, bool)
+	CompareJob(job *domain.Job) (*domain.SalaryComparison, bool)
+	Import(r io.Reader) (int, error)
+	ImportFile(path string) (int, error)
+	Stats() enrichment.SalaryBenchmarkStats
+}
+
+// SalaryBenchmarkHandler handles market salary benchmark requests
+type SalaryBenchmarkHandler struct {
+	benchmarks SalaryBenchmarks
+	jobs       JobListService
+}
+
+// NewSalaryBenchmarkHandler creates a new salary benchmark handler
+func NewSalaryBenchmarkHandler(benchmarks SalaryBenchmarks, jobs JobListService) *SalaryBenchmarkHandler {
+	return &SalaryBenchmarkHandler{benchmarks: benchmarks, jobs: jobs}
+}
+
+// GetBenchmark handles GET /api/analytics/salary-benchmark?title=...&location=...
+// A posted range is compared when given as salary_min/salary_max, or taken
+// from the job named by job_id.
+func (h *SalaryBenchmarkHandler) GetBenchmark(c *fiber.Ctx) error {
+	title := c.Query("title")
+	location := c.Query("location")
+	var salaryMin, salaryMax *int
+	if v := c.QueryInt("salary_min", 0); v > 0 {
+		salaryMin = &v
+	}
+	if v := c.QueryInt("salary_max", 0); v > 0 {
+		salaryMax = &v
+	}
+
+	if raw := c.Query("job_id"); raw != "" {
+		jobID, err := uuid.Parse(raw)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid_id",
+				"message": "Invalid job ID format",
+			})
+		}
+		job, err := h.jobs.GetJobDetails(c.Context(), jobID)
+		if err != nil {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error":   "not_found",
+				"message": "Job not found",
+			})
+		}
+		if title == "" {
+			title = job.Title
+		}
+		if location == "" && job.Location != nil {
+			location = *job.Location
+		}
+		if salaryMin == nil && salaryMax == nil {
+			salaryMin, salaryMax = job.SalaryMin, job.SalaryMax
+		}
+	}
+
+	if title == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_request",
+			"message": "title or job_id is required",
+		})
+	}
+
+	comparison, ok := h.benchmarks.Compare(title, location, salaryMin, salaryMax)
+	if !ok {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "no_benchmark",
+			"message": "Not enough survey salaries for this title",
+		})
+	}
+
+	return c.JSON(comparison)
+}
@@ -0,0 +1,189 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/scrapeprofiles"
+)
+
+// ScrapeProfileService manages named scrape presets and queues scrapes from them
+type ScrapeProfileService interface {
+	List(ctx context.Context) ([]domain.ScrapeProfile, error)
+	Get(ctx context.Context, id uuid.UUID) (*domain.ScrapeProfile, error)
+	Create(ctx context.Context, in domain.ScrapeProfileInput) (*domain.ScrapeProfile, error)
+	Update(ctx context.Context, id uuid.UUID, in domain.ScrapeProfileInput) (*domain.ScrapeProfile, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	Run(ctx context.Context, name string) (*domain.ScrapeTask, error)
+	RunByID(ctx context.Context, id uuid.UUID) (*domain.ScrapeTask, error)
+}
+
+// ScrapeProfileHandler handles scrape profile requests
+type ScrapeProfileHandler struct {
+	service ScrapeProfileService
+}
+
+// NewScrapeProfileHandler creates a new scrape profile handler
+func NewScrapeProfileHandler(service ScrapeProfileService) *ScrapeProfileHandler {
+	return &ScrapeProfileHandler{service: service}
+}
+
+// ListProfiles handles GET /api/job-list/scrape-profiles
+func (h *ScrapeProfileHandler) ListProfiles(c *fiber.Ctx) error {
+	profiles, err := h.service.List(c.Context())
+	if err != nil {
+		return scrapeProfileFailed(c, err)
+	}
+	return c.JSON(fiber.Map{"profiles": profiles})
+}
+
+// CreateProfile handles POST /api/job-list/scrape-profiles
+func (h *ScrapeProfileHandler) CreateProfile(c *fiber.Ctx) error {
+	var req domain.ScrapeProfileInput
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	p, err := h.service.Create(c.Context(), req)
+	if err != nil {
+		return scrapeProfileFailed(c, err)
+	}
+	return c.Status(fiber.StatusCreated).JSON(p)
+}
+
+// GetProfile handles GET /api/job-list/scrape-profiles/:profile_id
+func (h *ScrapeProfileHandler) GetProfile(c *fiber.Ctx) error {
+	id, ok := scrapeProfileID(c)
+	if !ok {
+		return nil
+	}
+
+	p, err := h.service.Get(c.Context(), id)
+	if err != nil {
+		return scrapeProfileFailed(c, err)
+	}
+	return c.JSON(p)
+}
+
+// UpdateProfile handles PUT /api/job-list/scrape-profiles/:profile_id
+func (h *ScrapeProfileHandler) UpdateProfile(c *fiber.Ctx) error {
+	id, ok := scrapeProfileID(c)
+	if !ok {
+		return nil
+	}
+	var req domain.ScrapeProfileInput
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	p, err := h.service.Update(c.Context(), id, req)
+	if err != nil {
+		return scrapeProfileFailed(c, err)
+	}
+	return c.JSON(p)
+}
+
+// DeleteProfile handles DELETE /api/job-list/scrape-profiles/:profile_id
+func (h *ScrapeProfileHandler) DeleteProfile(c *fiber.Ctx) error {
+	id, ok := scrapeProfileID(c)
+	if !ok {
+		return nil
+	}
+
+	if err := h.service.Delete(c.Context(), id); err != nil {
+		return scrapeProfileFailed(c, err)
+	}
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// RunProfile handles POST /api/job-list/scrape-profiles/:profile_id/run
+func (h *ScrapeProfileHandler) RunProfile(c *fiber.Ctx) error {
+	id, ok := scrapeProfileID(c)
+	if !ok {
+		return nil
+	}
+
+	task, err := h.service.RunByID(c.Context(), id)
+	if err != nil {
+		return scrapeProfileFailed(c, err)
+	}
+	return scrapeQueued(c, task)
+}
+
+// ProfileScrape runs ahead of TriggerScrape on POST /api/job-list/scrape.
+// A request naming a profile (?profile= or "profile" in the body) is queued
+// with that profile's parameters; any other request is passed on.
+func (h *ScrapeProfileHandler) ProfileScrape(c *fiber.Ctx) error {
+	name := c.Query("profile")
+	if name == "" && len(c.Body()) > 0 {
+		var req scrapeRequest
+		// Malformed bodies are left for TriggerScrape to reject
+		if err := parseBody(c, &req); err == nil {
+			name = req.Profile
+		}
+	}
+	if strings.TrimSpace(name) == "" {
+		return c.Next()
+	}
+
+	task, err := h.service.Run(c.Context(), name)
+	if err != nil {
+		return scrapeProfileFailed(c, err)
+	}
+	return scrapeQueued(c, task)
+}
+
+// scrapeQueued writes the response for a scrape queued from a profile, in
+// the shape TriggerScrape uses
+func scrapeQueued(c *fiber.Ctx, task *domain.ScrapeTask) error {
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"task_id":    task.ID,
+		"status":     task.Status,
+		"profile_id": task.ProfileID,
+		"message":    "Scraping started",
+	})
+}
+
+// scrapeProfileID parses the profile_id param, writing the error response when invalid
+func scrapeProfileID(c *fiber.Ctx) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Params("profile_id"))
+	if err != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid scrape profile ID format",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// scrapeProfileFailed writes the error response for a failed scrape profile operation
+func scrapeProfileFailed(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, scrapeprofiles.ErrNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Scrape profile not found",
+		})
+	case errors.Is(err, scrapeprofiles.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	case errors.Is(err, scrapeprofiles.ErrNameTaken):
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error":   "name_taken",
+			"message": err.Error(),
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "scrape_profile_failed",
+			"message": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/share"
+)
+
+// ShareService manages read-only share links to the application pipeline
+type ShareService interface {
+	Create(ctx context.Context, in domain.ShareLinkCreate) (*domain.CreatedShareLink, error)
+	List(ctx context.Context) ([]domain.ShareLink, error)
+	Revoke(ctx context.Context, id uuid.UUID) (*domain.ShareLink, error)
+	View(ctx context.Context, token string) (*domain.SharedView, error)
+}
+
+// ShareHandler handles share link requests
+type ShareHandler struct {
+	service ShareService
+}
+
+// NewShareHandler creates a new share link handler
+func NewShareHandler(service ShareService) *ShareHandler {
+	return &ShareHandler{service: service}
+}
+
+// ListLinks handles GET /api/share-links
+func (h *ShareHandler) ListLinks(c *fiber.Ctx) error {
+	links, err := h.service.List(c.Context())
+	if err != nil {
+		return shareFailed(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"links":  links,
+		"fields": domain.ShareFields,
+	})
+}
+
+// CreateLink handles POST /api/share-links. The token is only returned here.
+func (h *ShareHandler) CreateLink(c *fiber.Ctx) error {
+	var req domain.ShareLinkCreate
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	link, err := h.service.Create(c.Context(), req)
+	if err != nil {
+		return shareFailed(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(link)
+}
+
+// RevokeLink handles DELETE /api/share-links/:link_id
+func (h *ShareHandler) RevokeLink(c *fiber.Ctx) error {
+	id, err := uuid.Parse(c.Params("link_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid share link ID format",
+		})
+	}
+
+	link, err := h.service.Revoke(c.Context(), id)
+	if err != nil {
+		return shareFailed(c, err)
+	}
+
+	return c.JSON(link)
+}
+
+// ViewShared handles GET /api/shared/:token, the public read-only view
+func (h *ShareHandler) ViewShared(c *fiber.Ctx) error {
+	view, err := h.service.View(c.Context(), c.Params("token"))
+	if err != nil {
+		return shareFailed(c, err)
+	}
+
+	c.Set(fiber.HeaderCacheControl, "private, no-store")
+	c.Set("X-Robots-Tag", "noindex")
+	return c.JSON(view)
+}
+
+// shareFailed writes the error response for a failed share link operation
+func shareFailed(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, share.ErrNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Share link not found",
+		})
+	case errors.Is(err, share.ErrApplicationNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Application not found",
+		})
+	case errors.Is(err, share.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+			"fields":  domain.ShareFields,
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "share_failed",
+			"message": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/ranking"
+)
+
+// SourceWeightStore holds the per-source weights applied to match scores
+// when search results and recommendations are ranked
+type SourceWeightStore interface {
+	All() []domain.SourceWeight
+	Set(source domain.JobSource, weight float64) error
+	Reset(source domain.JobSource) error
+}
+
+// SourceWeightHandler handles source ranking weight requests
+type SourceWeightHandler struct {
+	weights SourceWeightStore
+}
+
+// NewSourceWeightHandler creates a new source weight handler
+func NewSourceWeightHandler(weights SourceWeightStore) *SourceWeightHandler {
+	return &SourceWeightHandler{weights: weights}
+}
+
+// ListWeights handles GET /api/admin/scrapers/weights
+func (h *SourceWeightHandler) ListWeights(c *fiber.Ctx) error {
+	return c.JSON(fiber.Map{"weights": h.weights.All()})
+}
+
+// SetWeight handles PUT /api/admin/scrapers/weights/:source. The weight
+// overrides the configured one until it is reset or the server restarts.
+func (h *SourceWeightHandler) SetWeight(c *fiber.Ctx) error {
+	var req struct {
+		Weight *float64 `json:"weight"`
+	}
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if req.Weight == nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "weight is required",
+		})
+	}
+
+	if err := h.weights.Set(domain.JobSource(c.Params("source")), *req.Weight); err != nil {
+		return sourceWeightFailed(c, err)
+	}
+	return h.ListWeights(c)
+}
+
+// ResetWeight handles DELETE /api/admin/scrapers/weights/:source, restoring
+// the configured weight
+func (h *SourceWeightHandler) ResetWeight(c *fiber.Ctx) error {
+	if err := h.weights.Reset(domain.JobSource(c.Params("source"))); err != nil {
+		return sourceWeightFailed(c, err)
+	}
+	return h.ListWeights(c)
+}
+
+// sourceWeightFailed writes the error response for a failed weight change
+func sourceWeightFailed(c *fiber.Ctx, err error) error {
+	if errors.Is(err, ranking.ErrInvalid) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error":   "source_weight_failed",
+		"message": err.Error(),
+	})
+}
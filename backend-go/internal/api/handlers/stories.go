@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/stories"
+)
+
+// maxStoryMatches caps the stories attached to a generated answer
+const maxStoryMatches = 3
+
+// StoryRetriever finds story bank stories relevant to a question
+type StoryRetriever interface {
+	Relevant(ctx context.Context, question string, limit int) ([]domain.StoryMatch, error)
+}
+
+// StoryBank manages the user's vetted STAR stories
+type StoryBank interface {
+	StoryRetriever
+	Create(ctx context.Context, in domain.StoryInput) (*domain.Story, error)
+	Update(ctx context.Context, id uuid.UUID, in domain.StoryInput) (*domain.Story, error)
+	Get(ctx context.Context, id uuid.UUID) (*domain.Story, error)
+	List(ctx context.Context, competency domain.Competency) ([]domain.Story, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// StoryHandler handles story bank requests
+type StoryHandler struct {
+	bank StoryBank
+}
+
+// NewStoryHandler creates a new story bank handler
+func NewStoryHandler(bank StoryBank) *StoryHandler {
+	return &StoryHandler{bank: bank}
+}
+
+// ListStories handles GET /api/stories?competency=leadership
+func (h *StoryHandler) ListStories(c *fiber.Ctx) error {
+	competency := domain.Competency(c.Query("competency"))
+	if competency != "" && !competency.Valid() {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":        "validation_error",
+			"message":      "Unknown competency",
+			"competencies": domain.Competencies,
+		})
+	}
+
+	list, err := h.bank.List(c.Context(), competency)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"stories": list,
+		"total":   len(list),
+	})
+}
+
+// MatchStories handles GET /api/stories/match?question=...
+func (h *StoryHandler) MatchStories(c *fiber.Ctx) error {
+	question := c.Query("question")
+	if question == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "question is required",
+		})
+	}
+
+	matches, err := h.bank.Relevant(c.Context(), question, clamp(c.QueryInt("limit", maxStoryMatches), 1, 20))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"question":     question,
+		"competencies": stories.Classify(question),
+		"matches":      matches,
+	})
+}
+
+// CreateStory handles POST /api/stories
+func (h *StoryHandler) CreateStory(c *fiber.Ctx) error {
+	var req domain.StoryInput
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	story, err := h.bank.Create(c.Context(), req)
+	if err != nil {
+		return storyFailed(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(story)
+}
+
+// GetStory handles GET /api/stories/:story_id
+func (h *StoryHandler) GetStory(c *fiber.Ctx) error {
+	id, ok := storyID(c)
+	if !ok {
+		return nil
+	}
+
+	story, err := h.bank.Get(c.Context(), id)
+	if err != nil {
+		return storyFailed(c, err)
+	}
+
+	return c.JSON(story)
+}
+
+// UpdateStory handles PUT /api/stories/:story_id
+func (h *StoryHandler) UpdateStory(c *fiber.Ctx) error {
+	id, ok := storyID(c)
+	if !ok {
+		return nil
+	}
+	var req domain.StoryInput
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	story, err := h.bank.Update(c.Context(), id, req)
+	if err != nil {
+		return storyFailed(c, err)
+	}
+
+	return c.JSON(story)
+}
+
+// DeleteStory handles DELETE /api/stories/:story_id
+func (h *StoryHandler) DeleteStory(c *fiber.Ctx) error {
+	id, ok := storyID(c)
+	if !ok {
+		return nil
+	}
+
+	if err := h.bank.Delete(c.Context(), id); err != nil {
+		return storyFailed(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// storyID parses the story_id param, writing the error response when invalid
+func storyID(c *fiber.Ctx) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Params("story_id"))
+	if err != nil {
+		_ = c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid story ID format",
+		})
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// storyFailed writes the error response for a failed story bank operation
+func storyFailed(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, stories.ErrNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Story not found",
+		})
+	case errors.Is(err, stories.ErrInvalid):
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": err.Error(),
+		})
+	default:
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "story_failed",
+			"message": err.Error(),
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/api/ctxkeys"
+)
+
+// takeLLMSlot moves the release of the request's LLM queue slot from the
+// queue middleware to the caller, for responses written after the handler
+// returns. The returned func must be called once the response is done.
+func takeLLMSlot(c *fiber.Ctx) func() {
+	p, ok := c.Locals(ctxkeys.LLMRelease).(*func())
+	if !ok || p == nil {
+		return func() {}
+	}
+	release := *p
+	*p = func() {}
+	return release
+}
+
+// writeEvent writes one server-sent event with data as JSON and flushes it
+func writeEvent(w *bufio.Writer, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+	return w.Flush()
+}
@@ -2,17 +2,23 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"io"
+	"strings"
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
 
 	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/contacts"
 	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/practice"
+	"github.com/resume-rag/backend/internal/stories"
 )
 
 // AnalyzerService defines the interface for job analysis operations
 type AnalyzerService interface {
-	AnalyzeJob(ctx context.Context, jobDescription string, focusAreas []string) (interface{}, error)
+	AnalyzeJob(ctx context.Context, jobDescription string, focusAreas []string) (*domain.JobAnalysis, error)
 	ExtractKeywords(ctx context.Context, jobDescription string) ([]string, error)
 }
 
@@ -25,30 +31,66 @@ func NewAnalyzeHandler(service AnalyzerService) *AnalyzeHandler {
 	return &AnalyzeHandler{service: service}
 }
 
+// AnalyzeJob handles POST /api/analyze/job
 func (h *AnalyzeHandler) AnalyzeJob(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Analyze job endpoint not yet implemented",
-	})
+	var req domain.AnalyzeJobRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if strings.TrimSpace(req.JobDescription) == "" {
+		return jobDescriptionRequired(c)
+	}
+	if h.service == nil {
+		return notImplemented(c, "Analyze job endpoint not yet implemented")
+	}
+
+	analysis, err := h.service.AnalyzeJob(c.Context(), req.JobDescription, req.FocusAreas)
+	if err != nil {
+		return serviceFailed(c, "analysis_failed", err)
+	}
+	if err := analysis.Validate(); err != nil {
+		return invalidResult(c, err)
+	}
+
+	return c.JSON(analysis)
 }
 
+// ExtractKeywords handles POST /api/analyze/keywords
 func (h *AnalyzeHandler) ExtractKeywords(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Extract keywords endpoint not yet implemented",
+	var req domain.KeywordsRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if strings.TrimSpace(req.JobDescription) == "" {
+		return jobDescriptionRequired(c)
+	}
+	if h.service == nil {
+		return notImplemented(c, "Extract keywords endpoint not yet implemented")
+	}
+
+	keywords, err := h.service.ExtractKeywords(c.Context(), req.JobDescription)
+	if err != nil {
+		return serviceFailed(c, "extraction_failed", err)
+	}
+
+	return c.JSON(fiber.Map{
+		"keywords": keywords,
 	})
 }
 
 // JobMatchService defines the interface for job matching operations
 type JobMatchService interface {
-	MatchJob(ctx context.Context, jobDescription string) (interface{}, error)
-	BatchMatch(ctx context.Context, jobs []string) (interface{}, error)
-	GetHistory(ctx context.Context, limit int) (interface{}, error)
-	GetMatchDetails(ctx context.Context, matchID uuid.UUID) (interface{}, error)
-	GetAnalytics(ctx context.Context) (interface{}, error)
+	MatchJob(ctx context.Context, jobDescription string) (*domain.MatchResult, error)
+	BatchMatch(ctx context.Context, jobs []string) ([]domain.MatchResult, error)
+	GetHistory(ctx context.Context, limit int) (*domain.MatchHistory, error)
+	GetMatchDetails(ctx context.Context, matchID uuid.UUID) (*domain.MatchResult, error)
+	GetAnalytics(ctx context.Context) (*domain.MatchAnalytics, error)
 	ClearHistory(ctx context.Context) error
 }
 
+// maxBatchMatch caps the job descriptions in one batch match
+const maxBatchMatch = 20
+
 // JobsHandler handles jobs (matching) API requests
 type JobsHandler struct {
 	service JobMatchService
@@ -58,72 +100,185 @@ func NewJobsHandler(service JobMatchService) *JobsHandler {
 	return &JobsHandler{service: service}
 }
 
+// MatchJob handles POST /api/jobs/match
 func (h *JobsHandler) MatchJob(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Match job endpoint not yet implemented",
-	})
+	var req domain.MatchRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if strings.TrimSpace(req.JobDescription) == "" {
+		return jobDescriptionRequired(c)
+	}
+	if h.service == nil {
+		return notImplemented(c, "Match job endpoint not yet implemented")
+	}
+
+	match, err := h.service.MatchJob(c.Context(), req.JobDescription)
+	if err != nil {
+		return serviceFailed(c, "match_failed", err)
+	}
+	if err := match.Validate(); err != nil {
+		return invalidResult(c, err)
+	}
+
+	return c.JSON(match)
 }
 
+// BatchMatch handles POST /api/jobs/batch
 func (h *JobsHandler) BatchMatch(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Batch match endpoint not yet implemented",
+	var req domain.BatchMatchRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if len(req.Jobs) == 0 || len(req.Jobs) > maxBatchMatch {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": fmt.Sprintf("jobs must list 1 to %d job descriptions", maxBatchMatch),
+		})
+	}
+	if h.service == nil {
+		return notImplemented(c, "Batch match endpoint not yet implemented")
+	}
+
+	matches, err := h.service.BatchMatch(c.UserContext(), req.Jobs)
+	if err != nil {
+		return serviceFailed(c, "match_failed", err)
+	}
+	for i := range matches {
+		if err := matches[i].Validate(); err != nil {
+			return invalidResult(c, err)
+		}
+	}
+
+	return c.JSON(fiber.Map{
+		"matches": matches,
+		"total":   len(matches),
 	})
 }
 
+// GetHistory handles GET /api/jobs/history?limit=20
 func (h *JobsHandler) GetHistory(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Get history endpoint not yet implemented",
-	})
+	if h.service == nil {
+		return notImplemented(c, "Get history endpoint not yet implemented")
+	}
+
+	history, err := h.service.GetHistory(c.Context(), clamp(c.QueryInt("limit", 20), 1, 100))
+	if err != nil {
+		return serviceFailed(c, "fetch_failed", err)
+	}
+
+	return c.JSON(history)
 }
 
+// GetMatchDetails handles GET /api/jobs/history/:match_id
 func (h *JobsHandler) GetMatchDetails(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Get match details endpoint not yet implemented",
-	})
+	matchID, err := uuid.Parse(c.Params("match_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid match ID format",
+		})
+	}
+	if h.service == nil {
+		return notImplemented(c, "Get match details endpoint not yet implemented")
+	}
+
+	match, err := h.service.GetMatchDetails(c.Context(), matchID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "Match not found",
+		})
+	}
+
+	return c.JSON(match)
 }
 
+// GetAnalytics handles GET /api/jobs/analytics
 func (h *JobsHandler) GetAnalytics(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Get analytics endpoint not yet implemented",
-	})
+	if h.service == nil {
+		return notImplemented(c, "Get analytics endpoint not yet implemented")
+	}
+
+	analytics, err := h.service.GetAnalytics(c.Context())
+	if err != nil {
+		return serviceFailed(c, "fetch_failed", err)
+	}
+
+	return c.JSON(analytics)
 }
 
+// ClearHistory handles DELETE /api/jobs/history
 func (h *JobsHandler) ClearHistory(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Clear history endpoint not yet implemented",
-	})
+	if h.service == nil {
+		return notImplemented(c, "Clear history endpoint not yet implemented")
+	}
+
+	if err := h.service.ClearHistory(c.Context()); err != nil {
+		return serviceFailed(c, "clear_failed", err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
 }
 
 // InterviewService defines the interface for interview prep operations
 type InterviewService interface {
-	GetQuestions(ctx context.Context, category, role string, difficulty int, limit int) (interface{}, error)
+	GetQuestions(ctx context.Context, category, role string, difficulty int, limit int) (*domain.QuestionSet, error)
 	GetCategories(ctx context.Context) ([]string, error)
 	GetRoles(ctx context.Context) ([]string, error)
-	GenerateSTAR(ctx context.Context, prompt string) (interface{}, error)
-	EvaluatePractice(ctx context.Context, question, answer string) (interface{}, error)
-	GetCompanyResearch(ctx context.Context, companyName string) (interface{}, error)
+	GenerateSTAR(ctx context.Context, prompt string) (*domain.STARAnswer, error)
+	EvaluatePractice(ctx context.Context, question, answer string) (*domain.PracticeEvaluation, error)
+	GetCompanyResearch(ctx context.Context, companyName string) (*domain.CompanyReport, error)
+}
+
+// PracticeReviewer tracks practice scores and schedules weak questions for review
+type PracticeReviewer interface {
+	Record(ctx context.Context, req domain.PracticeRequest, score float64) (*domain.ReviewCard, error)
+	Queue(ctx context.Context, category string, limit int) (domain.ReviewQueue, error)
+}
+
+// Transcriber converts spoken practice answers to text
+type Transcriber interface {
+	Transcribe(ctx context.Context, audio io.Reader, filename string) (*domain.Transcript, error)
 }
 
 // InterviewHandler handles interview API requests
 type InterviewHandler struct {
-	service InterviewService
+	service     InterviewService
+	reviews     PracticeReviewer
+	transcriber Transcriber
+	maxAudio    int
+	stories     StoryRetriever
 }
 
-func NewInterviewHandler(service InterviewService) *InterviewHandler {
-	return &InterviewHandler{service: service}
+// NewInterviewHandler creates an interview handler. reviews, when set, records
+// practice scores for spaced-repetition review; transcriber, when set, accepts
+// spoken answers of up to maxAudio bytes; stories, when set, grounds STAR
+// answers in the story bank.
+func NewInterviewHandler(service InterviewService, reviews PracticeReviewer, transcriber Transcriber, maxAudio int, stories StoryRetriever) *InterviewHandler {
+	return &InterviewHandler{service: service, reviews: reviews, transcriber: transcriber, maxAudio: maxAudio, stories: stories}
 }
 
+// GetQuestions handles GET /api/interview/questions?category=&role=&difficulty=&limit=10
 func (h *InterviewHandler) GetQuestions(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Get questions endpoint not yet implemented",
-	})
+	difficulty := c.QueryInt("difficulty", 0)
+	if difficulty < 0 || difficulty > 5 {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "difficulty must be between 1 and 5",
+		})
+	}
+	if h.service == nil {
+		return notImplemented(c, "Get questions endpoint not yet implemented")
+	}
+
+	limit := clamp(c.QueryInt("limit", 10), 1, 50)
+	questions, err := h.service.GetQuestions(c.Context(), c.Query("category"), c.Query("role"), difficulty, limit)
+	if err != nil {
+		return serviceFailed(c, "fetch_failed", err)
+	}
+
+	return c.JSON(questions)
 }
 
 func (h *InterviewHandler) GetCategories(c *fiber.Ctx) error {
@@ -139,66 +294,310 @@ func (h *InterviewHandler) GetRoles(c *fiber.Ctx) error {
 	})
 }
 
+// GenerateSTAR handles POST /api/interview/star. Matching story bank stories
+// are passed to the generator so the answer reuses them.
 func (h *InterviewHandler) GenerateSTAR(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Generate STAR endpoint not yet implemented",
+	var req domain.STARRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if req.Prompt == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "prompt is required",
+		})
+	}
+
+	matches := []domain.StoryMatch{}
+	if h.stories != nil {
+		if found, err := h.stories.Relevant(c.Context(), req.Prompt, maxStoryMatches); err == nil {
+			matches = found
+		}
+	}
+
+	if h.service == nil {
+		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
+			"error":   "not_implemented",
+			"message": "Generate STAR endpoint not yet implemented",
+			"stories": matches,
+		})
+	}
+
+	answer, err := h.service.GenerateSTAR(c.Context(), stories.Prompt(req.Prompt, matches))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "generation_failed",
+			"message": err.Error(),
+		})
+	}
+	if err := answer.Validate(); err != nil {
+		return invalidResult(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"star":    answer,
+		"stories": matches,
 	})
 }
 
+// EvaluatePractice handles POST /api/interview/practice. The evaluation score
+// is recorded against the question to schedule its next review.
 func (h *InterviewHandler) EvaluatePractice(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Evaluate practice endpoint not yet implemented",
-	})
+	var req domain.PracticeRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if req.Question == "" || req.Answer == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "question and answer are required",
+		})
+	}
+
+	return h.evaluate(c, req, nil)
+}
+
+// evaluate scores an answer, records it for review and writes the response
+// with the extra fields added
+func (h *InterviewHandler) evaluate(c *fiber.Ctx, req domain.PracticeRequest, extra fiber.Map) error {
+	if h.service == nil {
+		resp := fiber.Map{
+			"error":   "not_implemented",
+			"message": "Evaluate practice endpoint not yet implemented",
+		}
+		for k, v := range extra {
+			resp[k] = v
+		}
+		return c.Status(fiber.StatusNotImplemented).JSON(resp)
+	}
+
+	evaluation, err := h.service.EvaluatePractice(c.Context(), req.Question, req.Answer)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "evaluation_failed",
+			"message": err.Error(),
+		})
+	}
+	if err := evaluation.Validate(); err != nil {
+		return invalidResult(c, err)
+	}
+
+	var card *domain.ReviewCard
+	if h.reviews != nil {
+		if card, err = h.reviews.Record(c.Context(), req, practice.Score(evaluation)); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error":   "review_failed",
+				"message": err.Error(),
+			})
+		}
+	}
+
+	resp := fiber.Map{
+		"evaluation": evaluation,
+		"review":     card,
+	}
+	for k, v := range extra {
+		resp[k] = v
+	}
+	return c.JSON(resp)
 }
 
+// GetReviewQueue handles GET /api/interview/review-queue?category=&limit=20
+func (h *InterviewHandler) GetReviewQueue(c *fiber.Ctx) error {
+	limit := clamp(c.QueryInt("limit", 20), 1, 100)
+	queue, err := h.reviews.Queue(c.Context(), c.Query("category"), limit)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "fetch_failed",
+			"message": err.Error(),
+		})
+	}
+	return c.JSON(queue)
+}
+
+// GetCompanyResearch handles GET /api/interview/company/:company_name
 func (h *InterviewHandler) GetCompanyResearch(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Get company research endpoint not yet implemented",
-	})
+	company := strings.TrimSpace(c.Params("company_name"))
+	if company == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "company_name is required",
+		})
+	}
+	if h.service == nil {
+		return notImplemented(c, "Get company research endpoint not yet implemented")
+	}
+
+	report, err := h.service.GetCompanyResearch(c.Context(), company)
+	if err != nil {
+		return serviceFailed(c, "research_failed", err)
+	}
+
+	return c.JSON(report)
 }
 
 // EmailService defines the interface for email generation operations
 type EmailService interface {
-	Generate(ctx context.Context, emailType, jobDescription string, tone, length string) (interface{}, error)
+	Generate(ctx context.Context, emailType, jobDescription string, tone, length string) (*domain.GeneratedEmail, error)
 }
 
 // EmailHandler handles email API requests
 type EmailHandler struct {
 	service EmailService
+	jobs    JobListService
+	checker DeliverabilityChecker
+	sender  EmailSender
 }
 
-func NewEmailHandler(service EmailService) *EmailHandler {
-	return &EmailHandler{service: service}
+// NewEmailHandler creates an email handler. jobs, when set, lets application
+// emails be drafted from a saved job and addressed to its recruiter; sender,
+// when set, delivers emails that pass checker.
+func NewEmailHandler(service EmailService, jobs JobListService, checker DeliverabilityChecker, sender EmailSender) *EmailHandler {
+	return &EmailHandler{service: service, jobs: jobs, checker: checker, sender: sender}
 }
 
+// emailTypes are the kinds of email the generator drafts
+var emailTypes = map[string]bool{"application": true, "followup": true, "thankyou": true}
+
+// Generate handles POST /api/email/generate; email_type defaults to application
 func (h *EmailHandler) Generate(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Generate email endpoint not yet implemented",
-	})
+	return h.generate(c, "")
 }
 
+// generate drafts an email of the given type, or of the type in the body
+// when emailType is empty
+func (h *EmailHandler) generate(c *fiber.Ctx, emailType string) error {
+	var req domain.EmailRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+	if emailType == "" {
+		emailType = req.EmailType
+	}
+	if emailType == "" {
+		emailType = "application"
+	}
+	if !emailTypes[emailType] {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "email_type must be application, followup or thankyou",
+		})
+	}
+	if strings.TrimSpace(req.JobDescription) == "" {
+		return jobDescriptionRequired(c)
+	}
+	if h.service == nil {
+		return notImplemented(c, "Generate email endpoint not yet implemented")
+	}
+
+	email, err := h.service.Generate(c.Context(), emailType, req.JobDescription, req.Tone, req.Length)
+	if err != nil {
+		return serviceFailed(c, "generation_failed", err)
+	}
+	if err := email.Validate(); err != nil {
+		return invalidResult(c, err)
+	}
+
+	return c.JSON(email)
+}
+
+// GenerateApplication handles POST /api/email/application. The recipient is
+// pre-filled from the job's recruiter contacts unless the request names one.
 func (h *EmailHandler) GenerateApplication(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Generate application email endpoint not yet implemented",
+	var req domain.ApplicationEmailRequest
+	if err := parseBody(c, &req); err != nil {
+		return invalidBody(c, err)
+	}
+
+	if req.JobID != nil && h.jobs != nil {
+		job, err := h.jobs.GetJobDetails(c.Context(), *req.JobID)
+		if err != nil {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error":   "not_found",
+				"message": "Job not found",
+			})
+		}
+		if req.JobDescription == "" {
+			req.JobDescription = job.Description
+		}
+		if req.RecipientEmail == "" {
+			if contact, ok := contacts.PrimaryEmail(job.Contacts); ok {
+				req.RecipientName, req.RecipientEmail = contact.Name, contact.Email
+			}
+		}
+	}
+
+	if req.JobDescription == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "validation_error",
+			"message": "job_description or job_id is required",
+		})
+	}
+
+	recipient := fiber.Map{"name": req.RecipientName, "email": req.RecipientEmail}
+	if h.service == nil {
+		return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
+			"error":     "not_implemented",
+			"message":   "Generate application email endpoint not yet implemented",
+			"recipient": recipient,
+		})
+	}
+
+	email, err := h.service.Generate(c.Context(), "application", req.JobDescription, req.Tone, req.Length)
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "generation_failed",
+			"message": err.Error(),
+		})
+	}
+	if err := email.Validate(); err != nil {
+		return invalidResult(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"email":     email,
+		"recipient": recipient,
 	})
 }
 
+// GenerateFollowup handles POST /api/email/followup
 func (h *EmailHandler) GenerateFollowup(c *fiber.Ctx) error {
-	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Generate followup email endpoint not yet implemented",
-	})
+	return h.generate(c, "followup")
 }
 
+// GenerateThankYou handles POST /api/email/thankyou
 func (h *EmailHandler) GenerateThankYou(c *fiber.Ctx) error {
+	return h.generate(c, "thankyou")
+}
+
+// notImplemented writes the response for an endpoint whose service is not configured
+func notImplemented(c *fiber.Ctx, message string) error {
 	return c.Status(fiber.StatusNotImplemented).JSON(fiber.Map{
-		"error": "not_implemented",
-		"message": "Generate thank you email endpoint not yet implemented",
+		"error":   "not_implemented",
+		"message": message,
+	})
+}
+
+// serviceFailed writes the response for a service call that returned an error
+func serviceFailed(c *fiber.Ctx, code string, err error) error {
+	return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		"error":   code,
+		"message": err.Error(),
+	})
+}
+
+// invalidResult writes the response for a service result that failed validation
+func invalidResult(c *fiber.Ctx, err error) error {
+	return c.Status(fiber.StatusBadGateway).JSON(fiber.Map{
+		"error":   "invalid_response",
+		"message": err.Error(),
+	})
+}
+
+func jobDescriptionRequired(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error":   "validation_error",
+		"message": "job_description is required",
 	})
 }
 
@@ -264,12 +663,27 @@ func (h *SettingsHandler) GetAvailableBackends(c *fiber.Ctx) error {
 type PlaceholderChatService struct{}
 
 func (s *PlaceholderChatService) Chat(ctx context.Context, req domain.ChatRequest) (*domain.ChatResponse, error) {
-	return &domain.ChatResponse{
+	resp := &domain.ChatResponse{
 		Response:   "This is a placeholder response. The service is not yet implemented.",
 		Mode:       req.Mode,
 		SearchMode: "none",
 		SessionID:  uuid.New().String(),
-	}, nil
+	}
+	if req.Mode == domain.ChatModeRewrite {
+		resp.Rewrites = []domain.BulletRewrite{}
+	}
+	return resp, nil
+}
+
+func (s *PlaceholderChatService) ChatStream(ctx context.Context, req domain.ChatRequest, onText func(string) error) (*domain.ChatResponse, error) {
+	resp, err := s.Chat(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if err := onText(resp.Response); err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 func (s *PlaceholderChatService) GetSuggestions(ctx context.Context, mode domain.ChatMode) (*domain.ChatSuggestionsResponse, error) {
@@ -290,6 +704,14 @@ func (s *PlaceholderChatService) ClearHistory(ctx context.Context, sessionID *uu
 	return nil
 }
 
+func (s *PlaceholderChatService) SearchHistory(ctx context.Context, query string, limit int) (*domain.ChatSearchResponse, error) {
+	return &domain.ChatSearchResponse{
+		Query:   query,
+		Results: []domain.ChatSearchResult{},
+		Total:   0,
+	}, nil
+}
+
 type PlaceholderJobListService struct{}
 
 func (s *PlaceholderJobListService) Search(ctx context.Context, req domain.JobSearchRequest) (*domain.JobSearchResponse, error) {
@@ -320,6 +742,10 @@ func (s *PlaceholderJobListService) GetJobDetails(ctx context.Context, jobID uui
 	return nil, fiber.NewError(fiber.StatusNotFound, "Job not found")
 }
 
+func (s *PlaceholderJobListService) DeleteJob(ctx context.Context, jobID uuid.UUID) error {
+	return fiber.NewError(fiber.StatusNotFound, "Job not found")
+}
+
 func (s *PlaceholderJobListService) GetRecommendations(ctx context.Context, limit int) ([]domain.JobRecommendation, error) {
 	return []domain.JobRecommendation{}, nil
 }
@@ -352,6 +778,21 @@ func (s *PlaceholderJobListService) GetDueReminders(ctx context.Context) ([]doma
 	return []domain.Application{}, nil
 }
 
+func (s *PlaceholderJobListService) GetTrash(ctx context.Context) (*domain.TrashResponse, error) {
+	return &domain.TrashResponse{
+		Items: []domain.TrashItem{},
+		Total: 0,
+	}, nil
+}
+
+func (s *PlaceholderJobListService) RestoreJob(ctx context.Context, jobID uuid.UUID) (*domain.Job, error) {
+	return nil, fiber.NewError(fiber.StatusNotFound, "Job not found in trash")
+}
+
+func (s *PlaceholderJobListService) RestoreApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error) {
+	return nil, fiber.NewError(fiber.StatusNotFound, "Application not found in trash")
+}
+
 func (s *PlaceholderJobListService) GenerateCoverLetter(ctx context.Context, jobID uuid.UUID, customPrompt *string) (*domain.CoverLetterResponse, error) {
 	return nil, fiber.NewError(fiber.StatusNotImplemented, "Not implemented")
 }
@@ -381,11 +822,16 @@ func (s *PlaceholderJobListService) GetScrapeStatus(ctx context.Context, taskID
 	return nil, fiber.NewError(fiber.StatusNotFound, "Task not found")
 }
 
+func (s *PlaceholderJobListService) CancelScrape(ctx context.Context, taskID uuid.UUID) (*domain.ScrapeTask, error) {
+	return nil, fiber.NewError(fiber.StatusNotFound, "Task not found")
+}
+
 func (s *PlaceholderJobListService) GetJobStats(ctx context.Context) (*domain.JobSearchStats, error) {
 	return &domain.JobSearchStats{
-		TotalJobsIndexed:   0,
-		JobsBySource:       map[string]int{},
-		JobsByLocationType: map[string]int{},
+		TotalJobsIndexed:     0,
+		JobsBySource:         map[string]int{},
+		JobsByLocationType:   map[string]int{},
+		JobsByEmploymentType: map[string]int{},
 	}, nil
 }
 
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/changes"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// SyncService pages through job and application changes
+type SyncService interface {
+	Changes(ctx context.Context, cursor string, limit int) (*domain.ChangeSet, error)
+}
+
+// SyncHandler handles incremental sync requests from offline clients
+type SyncHandler struct {
+	service SyncService
+}
+
+// NewSyncHandler creates a new sync handler
+func NewSyncHandler(service SyncService) *SyncHandler {
+	return &SyncHandler{service: service}
+}
+
+// GetChanges handles GET /api/sync
+// Query params: cursor (from the previous response, empty for a full sync), limit (default 200)
+func (h *SyncHandler) GetChanges(c *fiber.Ctx) error {
+	limit := clamp(c.QueryInt("limit", 200), 1, changes.MaxLimit)
+
+	set, err := h.service.Changes(c.Context(), c.Query("cursor"), limit)
+	if errors.Is(err, changes.ErrInvalidCursor) {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_cursor",
+			"message": "cursor must be one returned by a previous sync",
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error":   "sync_failed",
+			"message": err.Error(),
+		})
+	}
+	return c.JSON(set)
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// SystemStatsStore reports database-backed figures for the admin overview
+type SystemStatsStore interface {
+	DatabaseSize(ctx context.Context) (*domain.DatabaseSize, error)
+	ScrapeQueue(ctx context.Context) (domain.QueueDepth, error)
+	ScraperErrorRates(ctx context.Context, since time.Time) ([]domain.ScraperErrorRate, error)
+	LLMSpend(ctx context.Context, now time.Time) (*domain.LLMSpend, error)
+}
+
+// JobStatsSource provides job corpus statistics
+type JobStatsSource interface {
+	GetJobStats(ctx context.Context) (*domain.JobSearchStats, error)
+}
+
+// ActivityCounter counts distinct active users since a time
+type ActivityCounter interface {
+	ActiveUsers(ctx context.Context, since time.Time) (int, error)
+}
+
+// SystemStatsHandler serves the operator overview. Every source is optional;
+// a missing or failing source leaves its section out instead of failing the
+// whole overview.
+type SystemStatsHandler struct {
+	jobs     JobStatsSource
+	queues   LLMQueueMonitor
+	activity ActivityCounter
+	store    SystemStatsStore
+}
+
+// NewSystemStatsHandler creates a new system stats handler
+func NewSystemStatsHandler(jobs JobStatsSource, queues LLMQueueMonitor, activity ActivityCounter, store SystemStatsStore) *SystemStatsHandler {
+	return &SystemStatsHandler{jobs: jobs, queues: queues, activity: activity, store: store}
+}
+
+// GetSystemStats handles GET /api/admin/stats
+// Query params: days (scraper error window, default 7)
+func (h *SystemStatsHandler) GetSystemStats(c *fiber.Ctx) error {
+	ctx := c.Context()
+	now := time.Now()
+	since := now.AddDate(0, 0, -clamp(c.QueryInt("days", 7), 1, 90))
+
+	stats := &domain.SystemStats{
+		JobsBySource: map[string]int{},
+		Queues:       map[string]domain.QueueDepth{},
+		Unavailable:  map[string]string{},
+		GeneratedAt:  now,
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	section := func(name string, ready bool, reason string, fetch func() error) {
+		if !ready {
+			mu.Lock()
+			stats.Unavailable[name] = reason
+			mu.Unlock()
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := fetch()
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				stats.Unavailable[name] = err.Error()
+			}
+		}()
+	}
+
+	// Each fetch writes only its own fields, so they can run concurrently
+	noDB := "no database connected"
+	var scrapeQueue domain.QueueDepth
+	section("jobs", h.jobs != nil, "job statistics not configured", func() error {
+		jobStats, err := h.jobs.GetJobStats(ctx)
+		if err == nil {
+			stats.TotalJobs = jobStats.TotalJobsIndexed
+			if jobStats.JobsBySource != nil {
+				stats.JobsBySource = jobStats.JobsBySource
+			}
+		}
+		return err
+	})
+	section("database", h.store != nil, noDB, func() (err error) {
+		stats.Database, err = h.store.DatabaseSize(ctx)
+		return err
+	})
+	section("scrape_queue", h.store != nil, noDB, func() (err error) {
+		scrapeQueue, err = h.store.ScrapeQueue(ctx)
+		return err
+	})
+	section("scraper_runs", h.store != nil, noDB, func() (err error) {
+		stats.ScraperRuns, err = h.store.ScraperErrorRates(ctx, since)
+		return err
+	})
+	section("llm_spend", h.store != nil, noDB, func() (err error) {
+		stats.LLMSpend, err = h.store.LLMSpend(ctx, now)
+		return err
+	})
+	section("active_users", h.activity != nil, "audit log not configured", func() error {
+		active, err := h.activeUsers(ctx, now)
+		stats.ActiveUsers = active
+		return err
+	})
+	wg.Wait()
+
+	if _, failed := stats.Unavailable["scrape_queue"]; !failed {
+		stats.Queues["scrape"] = scrapeQueue
+	}
+	if h.queues != nil {
+		for backend, q := range h.queues.Stats() {
+			stats.Queues["llm:"+backend] = domain.QueueDepth{Active: q.Active, Queued: q.Queued}
+		}
+	}
+	return c.JSON(stats)
+}
+
+// activeUsers counts users over the last day, week and month
+func (h *SystemStatsHandler) activeUsers(ctx context.Context, now time.Time) (*domain.ActiveUsers, error) {
+	windows := []time.Time{now.AddDate(0, 0, -1), now.AddDate(0, 0, -7), now.AddDate(0, -1, 0)}
+	counts := make([]int, len(windows))
+	for i, since := range windows {
+		n, err := h.activity.ActiveUsers(ctx, since)
+		if err != nil {
+			return nil, err
+		}
+		counts[i] = n
+	}
+	return &domain.ActiveUsers{Day: counts[0], Week: counts[1], Month: counts[2]}, nil
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// TaskEventLog reads the shared timeline of background tasks
+type TaskEventLog interface {
+	List(ctx context.Context, taskID uuid.UUID) ([]domain.TaskEvent, error)
+}
+
+// TaskEventHandler handles task timeline requests
+type TaskEventHandler struct {
+	events TaskEventLog
+}
+
+// NewTaskEventHandler creates a new task event handler
+func NewTaskEventHandler(events TaskEventLog) *TaskEventHandler {
+	return &TaskEventHandler{events: events}
+}
+
+// GetTaskEvents handles GET /api/tasks/:task_id/events. Scrape, cleanup and
+// digest task IDs are accepted; a scrape's timeline includes the embedding
+// and matching of the jobs it found.
+func (h *TaskEventHandler) GetTaskEvents(c *fiber.Ctx) error {
+	taskID, err := uuid.Parse(c.Params("task_id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error":   "invalid_id",
+			"message": "Invalid task ID format",
+		})
+	}
+
+	events, err := h.events.List(c.Context(), taskID)
+	if err != nil {
+		return serviceFailed(c, "fetch_failed", err)
+	}
+	if len(events) == 0 {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error":   "not_found",
+			"message": "No events recorded for this task",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"task_id": taskID,
+		"events":  events,
+	})
+}
@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"path"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/audit"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// ActorLocal is the fiber.Ctx local holding the authenticated actor, when known
+const ActorLocal = "actor"
+
+// Audit records mutating requests (anything but GET/HEAD/OPTIONS) to the audit store.
+// idParam names the route parameter holding the resource ID, if any.
+func Audit(store audit.Store, resource, idParam string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		switch c.Method() {
+		case fiber.MethodGet, fiber.MethodHead, fiber.MethodOptions:
+			return c.Next()
+		}
+		if store == nil {
+			return c.Next()
+		}
+
+		err := c.Next()
+
+		entry := domain.AuditEntry{
+			ID:        uuid.New(),
+			Actor:     Actor(c),
+			Action:    auditAction(c, resource),
+			Resource:  resource,
+			Method:    c.Method(),
+			Path:      c.Path(),
+			Status:    c.Response().StatusCode(),
+			RequestID: c.GetRespHeader("X-Request-ID"),
+			IP:        ClientIP(c),
+			CreatedAt: time.Now(),
+		}
+		if idParam != "" {
+			if id := c.Params(idParam); id != "" {
+				entry.ResourceID = &id
+			}
+		}
+		if err != nil {
+			entry.Details = map[string]interface{}{"error": err.Error()}
+		}
+
+		if recErr := store.Record(c.Context(), entry); recErr != nil {
+			logger.Warn("Failed to record audit entry", zap.Error(recErr))
+		}
+		return err
+	}
+}
+
+// Actor returns the identity performing the request
+func Actor(c *fiber.Ctx) string {
+	if actor, ok := c.Locals(ActorLocal).(string); ok && actor != "" {
+		return actor
+	}
+	return "anonymous"
+}
+
+// auditAction derives the action from the method; admin actions use the last path segment
+func auditAction(c *fiber.Ctx, resource string) string {
+	switch c.Method() {
+	case fiber.MethodPut, fiber.MethodPatch:
+		return "update"
+	case fiber.MethodDelete:
+		return "delete"
+	}
+	if resource == "admin" {
+		return path.Base(c.Path())
+	}
+	return "create"
+}
@@ -0,0 +1,86 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/cache"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// cachedResponse is a response snapshot stored in the LRU
+type cachedResponse struct {
+	status      int
+	contentType string
+	body        []byte
+}
+
+// ResponseCache caches successful responses in the given LRU.
+// Requests with "Cache-Control: no-cache" skip the lookup but still refresh the entry.
+func ResponseCache(store *cache.LRU) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if store == nil {
+			return c.Next()
+		}
+
+		key := ResponseCacheKey(c)
+		bypass := strings.Contains(strings.ToLower(c.Get(fiber.HeaderCacheControl)), "no-cache")
+
+		if !bypass {
+			if v, ok := store.Get(key); ok {
+				resp := v.(*cachedResponse)
+				c.Set("X-Cache", "HIT")
+				c.Set(fiber.HeaderContentType, resp.contentType)
+				return c.Status(resp.status).Send(resp.body)
+			}
+		}
+
+		if err := c.Next(); err != nil {
+			return err
+		}
+
+		if c.Response().StatusCode() == fiber.StatusOK {
+			body := make([]byte, len(c.Response().Body()))
+			copy(body, c.Response().Body())
+			store.Set(key, &cachedResponse{
+				status:      fiber.StatusOK,
+				contentType: string(c.Response().Header.ContentType()),
+				body:        body,
+			})
+		}
+
+		if bypass {
+			c.Set("X-Cache", "BYPASS")
+		} else {
+			c.Set("X-Cache", "MISS")
+		}
+		return nil
+	}
+}
+
+// ResponseCacheKey builds the cache key for a request, scoped to its tenant.
+// Keys start with the request path so they can be invalidated by prefix.
+func ResponseCacheKey(c *fiber.Ctx) string {
+	key := c.OriginalURL() + "|" + c.Method() + "|" + tenant.ID(c.Context())
+	if body := c.Body(); len(body) > 0 {
+		sum := sha256.Sum256(body)
+		key += "|" + hex.EncodeToString(sum[:8])
+	}
+	return key
+}
+
+// InvalidateOn emits a cache event after a successful mutating request
+func InvalidateOn(store *cache.LRU, event cache.Event) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if err := c.Next(); err != nil {
+			return err
+		}
+		if status := c.Response().StatusCode(); status >= 200 && status < 300 {
+			store.Emit(event)
+		}
+		return nil
+	}
+}
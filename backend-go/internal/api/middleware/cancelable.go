@@ -0,0 +1,82 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"regexp"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/operations"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// OperationIDHeader names a cancelable operation. Clients may choose the ID
+// so they can cancel a request that has not answered yet; otherwise one is
+// generated and returned in the same header.
+const OperationIDHeader = "X-Operation-ID"
+
+// StatusClientClosedRequest is returned for operations cancelled by the caller
+const StatusClientClosedRequest = 499
+
+var validOperationID = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// OperationOwner identifies who may list and cancel an operation: the
+// tenant and actor, plus the IP for anonymous callers.
+func OperationOwner(c *fiber.Ctx) string {
+	owner := tenant.ID(c.UserContext()) + "|" + Actor(c)
+	if Actor(c) == "anonymous" {
+		owner += "|" + ClientIP(c)
+	}
+	return owner
+}
+
+// Cancelable registers the request as a cancelable operation of the given
+// kind. Handlers must use c.UserContext() for the cancellation to reach
+// their work. A cancelled operation responds 499 whatever the handler wrote.
+func Cancelable(registry *operations.Registry, kind string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if registry == nil {
+			return c.Next()
+		}
+
+		id := c.Get(OperationIDHeader)
+		if id == "" {
+			id = uuid.NewString()
+		} else if !validOperationID.MatchString(id) {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error":   "invalid_operation_id",
+				"message": "Operation ID must be 1-64 letters, digits, '-' or '_'",
+			})
+		}
+
+		ctx, done, err := registry.Start(c.UserContext(), OperationOwner(c), id, kind)
+		if errors.Is(err, operations.ErrDuplicate) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error":   "operation_exists",
+				"message": "An operation with this ID is already running",
+			})
+		}
+		if err != nil {
+			return err
+		}
+		defer done()
+
+		c.Set(OperationIDHeader, id)
+		parent := c.UserContext()
+		c.SetUserContext(ctx)
+		err = c.Next()
+		c.SetUserContext(parent)
+
+		if !errors.Is(context.Cause(ctx), operations.ErrCancelled) {
+			return err
+		}
+		c.Response().ResetBody()
+		return c.Status(StatusClientClosedRequest).JSON(fiber.Map{
+			"error":        "operation_cancelled",
+			"message":      "The operation was cancelled",
+			"operation_id": id,
+		})
+	}
+}
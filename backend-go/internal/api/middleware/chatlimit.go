@@ -0,0 +1,216 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// chatWindow is a fixed-window message count for one session or user
+type chatWindow struct {
+	start time.Time
+	count int
+}
+
+// chatScope is one chat limit (per session or per user) and its windows
+type chatScope struct {
+	name    string
+	header  string // quota header prefix
+	max     int
+	window  time.Duration
+	windows map[string]*chatWindow
+}
+
+// ChatLimiter caps chat messages per chat session and per user, separately
+// from the route rate limits. A message counts against both limits, and only
+// when both allow it.
+type ChatLimiter struct {
+	mu        sync.Mutex
+	scopes    []*chatScope
+	header    string
+	exempt    []string
+	lastSweep time.Time
+}
+
+// NewChatLimiter creates a chat limiter from the rate limit configuration.
+// It lets every message through when rate limiting or chat limits are disabled.
+func NewChatLimiter(cfg config.RateLimitConfig) *ChatLimiter {
+	l := &ChatLimiter{header: cfg.APIKeyHeader, exempt: cfg.ExemptAPIKeys, lastSweep: time.Now()}
+	if l.header == "" {
+		l.header = "X-API-Key"
+	}
+	if !cfg.Enabled || !cfg.Chat.Enabled {
+		return l
+	}
+	add := func(name, header string, max int, window time.Duration) {
+		if max <= 0 {
+			return
+		}
+		if window <= 0 {
+			window = time.Minute
+		}
+		l.scopes = append(l.scopes, &chatScope{name: name, header: header, max: max, window: window, windows: make(map[string]*chatWindow)})
+	}
+	add("session", "X-RateLimit-Session", cfg.Chat.SessionMax, cfg.Chat.SessionWindow)
+	add("user", "X-RateLimit-User", cfg.Chat.UserMax, cfg.Chat.UserWindow)
+	return l
+}
+
+// Handler returns the chat limiting middleware. Remaining quota is reported
+// in X-RateLimit-Session-* and X-RateLimit-User-* headers.
+func (l *ChatLimiter) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if len(l.scopes) == 0 || isExemptKey(c.Get(l.header), l.exempt) {
+			return c.Next()
+		}
+
+		keys := l.keys(chatUser(c), chatSessionID(c.Body()))
+		now := time.Now()
+		denied, retryAfter := l.take(keys, now)
+		l.setQuotaHeaders(c, keys, now)
+		if denied == nil {
+			return c.Next()
+		}
+
+		c.Set(fiber.HeaderRetryAfter, strconv.Itoa(retryAfter))
+		return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+			"error":          "chat_rate_limited",
+			"message":        fmt.Sprintf("Too many chat messages for this %s. Try again in %ds.", denied.name, retryAfter),
+			"scope":          denied.name,
+			"limit":          denied.max,
+			"window_seconds": int(denied.window.Seconds()),
+			"retry_after":    retryAfter,
+		})
+	}
+}
+
+// Socket returns a check for the messages of a chat socket opened by c, which
+// counts each message against the same limits as the chat route. The check
+// returns why a message is refused, or "" when it is allowed.
+func (l *ChatLimiter) Socket(c *fiber.Ctx) func(session string) string {
+	if len(l.scopes) == 0 || isExemptKey(c.Get(l.header), l.exempt) {
+		return func(string) string { return "" }
+	}
+	user := chatUser(c)
+	return func(session string) string {
+		denied, retryAfter := l.take(l.keys(user, session), time.Now())
+		if denied == nil {
+			return ""
+		}
+		return fmt.Sprintf("Too many chat messages for this %s. Try again in %ds.", denied.name, retryAfter)
+	}
+}
+
+// keys returns the scope keys of a message; messages without a session start
+// a new one, so only the user limit applies
+func (l *ChatLimiter) keys(user, session string) map[string]string {
+	keys := map[string]string{"user": user}
+	if session != "" {
+		keys["session"] = user + "|" + session
+	}
+	return keys
+}
+
+// chatUser identifies the sender of a chat message. Anonymous users are told
+// apart by IP.
+func chatUser(c *fiber.Ctx) string {
+	user := tenant.ID(c.UserContext()) + "|" + Actor(c)
+	if Actor(c) == "anonymous" {
+		user += "|" + ClientIP(c)
+	}
+	return user
+}
+
+// take counts a message against every scope with a key, unless one of them
+// is exhausted, in which case it returns that scope and the seconds until
+// its window resets
+func (l *ChatLimiter) take(keys map[string]string, now time.Time) (*chatScope, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.sweep(now)
+
+	var denied *chatScope
+	retryAfter := 0
+	windows := make([]*chatWindow, 0, len(l.scopes))
+	for _, s := range l.scopes {
+		key, ok := keys[s.name]
+		if !ok {
+			continue
+		}
+		w := s.windows[key]
+		if w == nil || now.Sub(w.start) >= s.window {
+			w = &chatWindow{start: now}
+			s.windows[key] = w
+		}
+		windows = append(windows, w)
+		if w.count >= s.max {
+			if wait := secondsUntil(w.start.Add(s.window), now); denied == nil || wait > retryAfter {
+				denied, retryAfter = s, wait
+			}
+		}
+	}
+	if denied == nil {
+		for _, w := range windows {
+			w.count++
+		}
+	}
+	return denied, retryAfter
+}
+
+// setQuotaHeaders reports the remaining quota of each scope with a key
+func (l *ChatLimiter) setQuotaHeaders(c *fiber.Ctx, keys map[string]string, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, s := range l.scopes {
+		key, ok := keys[s.name]
+		if !ok {
+			continue
+		}
+		w := s.windows[key]
+		if w == nil {
+			continue
+		}
+		c.Set(s.header+"-Limit", strconv.Itoa(s.max))
+		c.Set(s.header+"-Remaining", strconv.Itoa(max(s.max-w.count, 0)))
+		c.Set(s.header+"-Reset", strconv.Itoa(secondsUntil(w.start.Add(s.window), now)))
+	}
+}
+
+// sweep drops expired windows, at most once a minute. Caller holds l.mu.
+func (l *ChatLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < time.Minute {
+		return
+	}
+	l.lastSweep = now
+	for _, s := range l.scopes {
+		for key, w := range s.windows {
+			if now.Sub(w.start) >= s.window {
+				delete(s.windows, key)
+			}
+		}
+	}
+}
+
+// chatSessionID reads the session_id of a chat request body
+func chatSessionID(body []byte) string {
+	var req struct {
+		SessionID *string `json:"session_id"`
+	}
+	if err := json.Unmarshal(body, &req); err != nil || req.SessionID == nil {
+		return ""
+	}
+	return *req.SessionID
+}
+
+// secondsUntil rounds the time until t up to whole seconds
+func secondsUntil(t, now time.Time) int {
+	return int(math.Ceil(t.Sub(now).Seconds()))
+}
@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/api/ctxkeys"
+)
+
+// ResolveClientIP finds the client address of requests relayed by trusted
+// proxies. Proxies append the address they received from to header, so it is
+// read from the right, skipping trusted hops: entries further left were sent
+// by the client and can't be believed. trusted lists IPs and CIDRs.
+func ResolveClientIP(trusted []string, header string) (fiber.Handler, error) {
+	nets := make([]*net.IPNet, 0, len(trusted))
+	for _, entry := range trusted {
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+		nets = append(nets, n)
+	}
+	isTrusted := func(ip net.IP) bool {
+		for _, n := range nets {
+			if n.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return func(c *fiber.Ctx) error {
+		ip := c.Context().RemoteIP()
+		if isTrusted(ip) {
+			var hops []string
+			for _, v := range c.Request().Header.PeekAll(header) {
+				hops = append(hops, strings.Split(string(v), ",")...)
+			}
+			for i := len(hops) - 1; i >= 0; i-- {
+				hop := parseHop(hops[i])
+				if hop == nil {
+					// A proxy we trust relayed garbage; the last good hop is
+					// as far as the chain can be followed
+					break
+				}
+				ip = hop
+				if !isTrusted(hop) {
+					break
+				}
+			}
+		}
+		c.Locals(ctxkeys.ClientIP, ip.String())
+		return c.Next()
+	}, nil
+}
+
+// parseHop reads one forwarded address, which may carry a port
+func parseHop(s string) net.IP {
+	s = strings.TrimSpace(s)
+	if ip := net.ParseIP(s); ip != nil {
+		return ip
+	}
+	if host, _, err := net.SplitHostPort(s); err == nil {
+		return net.ParseIP(host)
+	}
+	return nil
+}
+
+// ClientIP returns the client address resolved by ResolveClientIP, or the
+// connection's peer address when no proxies are trusted
+func ClientIP(c *fiber.Ctx) string {
+	if ip, ok := c.Locals(ctxkeys.ClientIP).(string); ok {
+		return ip
+	}
+	return c.IP()
+}
@@ -0,0 +1,64 @@
+package middleware
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestResolveClientIP(t *testing.T) {
+	tests := []struct {
+		name      string
+		trusted   []string
+		forwarded []string
+		want      string
+	}{
+		// app.Test connects from 0.0.0.0
+		{name: "untrusted peer ignores the header", trusted: []string{"10.0.0.0/8"}, forwarded: []string{"203.0.113.7"}, want: "0.0.0.0"},
+		{name: "single hop", trusted: []string{"0.0.0.0"}, forwarded: []string{"203.0.113.7"}, want: "203.0.113.7"},
+		{name: "client-sent entries are skipped", trusted: []string{"0.0.0.0"}, forwarded: []string{"198.51.100.1, 203.0.113.7"}, want: "203.0.113.7"},
+		{name: "trusted hops are skipped", trusted: []string{"0.0.0.0", "10.0.0.0/8"}, forwarded: []string{"198.51.100.1, 203.0.113.7, 10.1.2.3"}, want: "203.0.113.7"},
+		{name: "repeated headers", trusted: []string{"0.0.0.0", "10.0.0.0/8"}, forwarded: []string{"198.51.100.1", "203.0.113.7, 10.1.2.3"}, want: "203.0.113.7"},
+		{name: "hop with port", trusted: []string{"0.0.0.0"}, forwarded: []string{"[2001:db8::1]:4711"}, want: "2001:db8::1"},
+		{name: "all hops trusted", trusted: []string{"0.0.0.0", "10.0.0.0/8"}, forwarded: []string{"10.9.9.9, 10.1.2.3"}, want: "10.9.9.9"},
+		{name: "garbage stops the walk", trusted: []string{"0.0.0.0", "10.0.0.0/8"}, forwarded: []string{"203.0.113.7, unknown, 10.1.2.3"}, want: "10.1.2.3"},
+		{name: "no header", trusted: []string{"0.0.0.0"}, want: "0.0.0.0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientIP, err := ResolveClientIP(tt.trusted, fiber.HeaderXForwardedFor)
+			if err != nil {
+				t.Fatal(err)
+			}
+			app := fiber.New()
+			app.Use(clientIP)
+			app.Get("/", func(c *fiber.Ctx) error { return c.SendString(ClientIP(c)) })
+
+			req := httptest.NewRequest(fiber.MethodGet, "/", nil)
+			for _, v := range tt.forwarded {
+				req.Header.Add(fiber.HeaderXForwardedFor, v)
+			}
+			resp, err := app.Test(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			got, _ := io.ReadAll(resp.Body)
+			if string(got) != tt.want {
+				t.Errorf("client IP = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveClientIPRejectsInvalidProxies(t *testing.T) {
+	if _, err := ResolveClientIP([]string{"10.0.0.0/33"}, fiber.HeaderXForwardedFor); err == nil {
+		t.Error("accepted an invalid CIDR")
+	}
+	if _, err := ResolveClientIP([]string{"proxy.internal"}, fiber.HeaderXForwardedFor); err == nil {
+		t.Error("accepted a hostname")
+	}
+}
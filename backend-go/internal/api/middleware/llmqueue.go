@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/api/ctxkeys"
+	"github.com/resume-rag/backend/internal/llm"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// LLMQueue holds requests to LLM-backed endpoints until the backend has capacity.
+// When the queue is full or the wait exceeds its bound, it responds 429 with Retry-After.
+// Tenants with their own LLM backend queue for that backend instead.
+func LLMQueue(limiter *llm.Limiter, backend string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if limiter == nil {
+			return c.Next()
+		}
+
+		backend := backend
+		if t, ok := tenant.FromContext(c.UserContext()); ok && t.LLMBackend != "" {
+			backend = t.LLMBackend
+		}
+
+		release, err := limiter.Acquire(c.UserContext(), backend)
+		if err != nil {
+			if !errors.Is(err, llm.ErrQueueFull) && !errors.Is(err, llm.ErrQueueTimeout) {
+				return err
+			}
+			retryAfter := limiter.RetryAfter(backend)
+			c.Set(fiber.HeaderRetryAfter, strconv.Itoa(int(retryAfter.Seconds())))
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"error":   "llm_busy",
+				"message": "The language model is busy. Please try again shortly.",
+				"backend": backend,
+			})
+		}
+		// Streaming handlers take over the release to hold the slot until
+		// their response is written
+		c.Locals(ctxkeys.LLMRelease, &release)
+		defer func() { release() }()
+
+		return c.Next()
+	}
+}
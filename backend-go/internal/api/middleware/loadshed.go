@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+const (
+	// latencyWeight is how much each response moves the latency average
+	latencyWeight = 0.1
+	// latencyStale is how long the latency average counts without new samples,
+	// so shedding ends once traffic stops completing slowly
+	latencyStale = 5 * time.Second
+)
+
+// LoadShedder tracks in-flight requests and response latency, and rejects
+// low-priority requests while either is over its threshold so that a scrape
+// storm can't starve the endpoints that matter. Protected paths such as
+// health checks are never shed and don't count towards the load.
+type LoadShedder struct {
+	cfg config.LoadShedConfig
+
+	inFlight  atomic.Int64
+	latency   atomic.Int64 // moving average, nanoseconds
+	sampledAt atomic.Int64 // unix nanoseconds of the last latency sample
+	shedding  atomic.Bool
+	shedTotal atomic.Int64
+}
+
+// NewLoadShedder creates a load shedder from configuration
+func NewLoadShedder(cfg config.LoadShedConfig) *LoadShedder {
+	return &LoadShedder{cfg: cfg}
+}
+
+// Handler returns the middleware
+func (s *LoadShedder) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		path := c.Path()
+		if matchesAny(path, s.cfg.Protected) {
+			return c.Next()
+		}
+
+		inFlight := s.inFlight.Add(1)
+		defer s.inFlight.Add(-1)
+
+		reason := s.overloaded(inFlight)
+		s.setShedding(reason)
+		if reason != "" && matchesAny(path, s.cfg.LowPriority) {
+			s.shedTotal.Add(1)
+			c.Set(fiber.HeaderRetryAfter, "5")
+			return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+				"error":   "overloaded",
+				"message": "Server is busy (" + reason + "). Please try again shortly.",
+			})
+		}
+
+		start := time.Now()
+		err := c.Next()
+		s.observe(time.Since(start))
+		return err
+	}
+}
+
+// overloaded returns which threshold is exceeded, or "" when neither is
+func (s *LoadShedder) overloaded(inFlight int64) string {
+	if s.cfg.MaxInFlight > 0 && inFlight > int64(s.cfg.MaxInFlight) {
+		return "too many requests in flight"
+	}
+	if s.cfg.MaxLatency > 0 && s.currentLatency() > s.cfg.MaxLatency {
+		return "responses are slow"
+	}
+	return ""
+}
+
+// setShedding logs when shedding starts and stops
+func (s *LoadShedder) setShedding(reason string) {
+	on := reason != ""
+	if s.shedding.Swap(on) == on {
+		return
+	}
+	if on {
+		logger.Warn("Load shedding started",
+			zap.String("reason", reason),
+			zap.Int64("in_flight", s.inFlight.Load()),
+			zap.Duration("latency", s.currentLatency()),
+		)
+	} else {
+		logger.Info("Load shedding stopped", zap.Int64("shed_total", s.shedTotal.Load()))
+	}
+}
+
+// observe folds a response time into the latency average, starting afresh
+// once the average has gone stale
+func (s *LoadShedder) observe(d time.Duration) {
+	fresh := s.currentLatency() == 0
+	for {
+		old := s.latency.Load()
+		next := int64(d)
+		if !fresh {
+			next = old + int64(latencyWeight*float64(int64(d)-old))
+		}
+		if s.latency.CompareAndSwap(old, next) {
+			break
+		}
+	}
+	s.sampledAt.Store(time.Now().UnixNano())
+}
+
+// currentLatency returns the latency average, or 0 when it is stale
+func (s *LoadShedder) currentLatency() time.Duration {
+	at := s.sampledAt.Load()
+	if at == 0 || time.Since(time.Unix(0, at)) > latencyStale {
+		return 0
+	}
+	return time.Duration(s.latency.Load())
+}
+
+// matchesAny reports whether path falls under any of the prefixes
+func matchesAny(path string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if matchesPrefix(path, p) {
+			return true
+		}
+	}
+	return false
+}
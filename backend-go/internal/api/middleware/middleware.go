@@ -5,23 +5,35 @@ import (
 
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
-	"github.com/gofiber/fiber/v2/middleware/limiter"
 	"github.com/gofiber/fiber/v2/middleware/recover"
 	"github.com/gofiber/fiber/v2/middleware/requestid"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
+	"github.com/resume-rag/backend/internal/api/ctxkeys"
 	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/tenant"
 	"github.com/resume-rag/backend/pkg/logger"
 )
 
-// Setup configures all middleware for the application
-func Setup(app *fiber.App, cfg *config.Config) {
+// Setup configures all middleware for the application.
+// The returned PayloadLogger can be reconfigured at runtime.
+func Setup(app *fiber.App, cfg *config.Config) *PayloadLogger {
 	// Recovery middleware (panic handler)
 	app.Use(recover.New(recover.Config{
 		EnableStackTrace: cfg.Server.Debug,
 	}))
 
+	// Client IPs behind trusted proxies, ahead of everything that logs or
+	// limits by IP
+	if len(cfg.Server.TrustedProxies) > 0 {
+		clientIP, err := ResolveClientIP(cfg.Server.TrustedProxies, cfg.Server.ProxyHeader)
+		if err != nil {
+			logger.Fatal("Invalid trusted proxy configuration", zap.Error(err))
+		}
+		app.Use(clientIP)
+	}
+
 	// Request ID middleware
 	app.Use(requestid.New(requestid.Config{
 		Generator: func() string {
@@ -35,31 +47,45 @@ func Setup(app *fiber.App, cfg *config.Config) {
 		AllowMethods:     joinStrings(cfg.CORS.AllowedMethods),
 		AllowHeaders:     joinStrings(cfg.CORS.AllowedHeaders),
 		AllowCredentials: true,
+		ExposeHeaders:    "ETag,X-Cache,Retry-After,X-Operation-ID,X-RateLimit-Session-Limit,X-RateLimit-Session-Remaining,X-RateLimit-Session-Reset,X-RateLimit-User-Limit,X-RateLimit-User-Remaining,X-RateLimit-User-Reset",
 		MaxAge:           cfg.CORS.MaxAge,
 	}))
 
-	// Rate limiting middleware
+	// Tenant resolution (header or subdomain), ahead of per-tenant rate limits
+	var tenants []config.TenantConfig
+	if cfg.Tenancy.Enabled {
+		registry, err := tenant.NewRegistry(cfg.Tenancy)
+		if err != nil {
+			logger.Fatal("Invalid tenancy configuration", zap.Error(err))
+		}
+		app.Use(Tenant(registry))
+		tenants = registry.Tenants()
+	}
+
+	// Rate limiting middleware (per-route tiers, per-tenant limits, exempt API keys)
 	if cfg.RateLimit.Enabled {
-		app.Use(limiter.New(limiter.Config{
-			Max:        cfg.RateLimit.RequestsPerMinute,
-			Expiration: time.Minute,
-			KeyGenerator: func(c *fiber.Ctx) string {
-				return c.IP()
-			},
-			LimitReached: func(c *fiber.Ctx) error {
-				return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
-					"error":   "rate_limit_exceeded",
-					"message": "Too many requests. Please try again later.",
-				})
-			},
-		}))
+		app.Use(RateLimit(cfg.RateLimit, tenants))
+	}
+
+	// Load shedding (low-priority routes get 503s while overloaded)
+	if cfg.LoadShed.Enabled {
+		app.Use(NewLoadShedder(cfg.LoadShed).Handler())
 	}
 
+	// Strict JSON body decoding (globally or per request via X-Strict-JSON)
+	app.Use(StrictJSON(cfg.Server.StrictJSON))
+
 	// Logging middleware
 	app.Use(RequestLogger(cfg.Server.Debug))
 
+	// Sampled payload logging (runtime toggleable)
+	payloadLogger := NewPayloadLogger(cfg.PayloadLog)
+	app.Use(payloadLogger.Handler())
+
 	// Timing middleware
 	app.Use(RequestTiming())
+
+	return payloadLogger
 }
 
 // RequestLogger returns a logging middleware
@@ -83,7 +109,7 @@ func RequestLogger(debug bool) fiber.Handler {
 			zap.String("path", c.Path()),
 			zap.Int("status", status),
 			zap.Duration("duration", duration),
-			zap.String("ip", c.IP()),
+			zap.String("ip", ClientIP(c)),
 		}
 
 		// Add user agent in debug mode
@@ -109,6 +135,16 @@ func RequestLogger(debug bool) fiber.Handler {
 	}
 }
 
+// StrictJSON enables strict request body decoding when configured or requested by the client
+func StrictJSON(enabled bool) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if enabled || c.Get("X-Strict-JSON") == "true" {
+			c.Locals(ctxkeys.StrictJSON, true)
+		}
+		return c.Next()
+	}
+}
+
 // RequestTiming adds timing headers to responses
 func RequestTiming() fiber.Handler {
 	return func(c *fiber.Ctx) error {
@@ -0,0 +1,162 @@
+package middleware
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/pii"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// maxRedactBytes is the largest body that is redacted and logged; JSON is
+// parsed whole so sensitive keys are found
+const maxRedactBytes = 1 << 20
+
+// PayloadLogger logs sampled, redacted request/response bodies for selected routes.
+// Routes and sample rate can be changed at runtime.
+type PayloadLogger struct {
+	mu         sync.RWMutex
+	enabled    bool
+	sampleRate float64
+	maxBytes   int
+	routes     map[string]bool
+}
+
+// NewPayloadLogger creates a payload logger from configuration
+func NewPayloadLogger(cfg config.PayloadLogConfig) *PayloadLogger {
+	p := &PayloadLogger{
+		enabled:    cfg.Enabled,
+		sampleRate: cfg.SampleRate,
+		maxBytes:   cfg.MaxBytes,
+		routes:     make(map[string]bool),
+	}
+	if p.maxBytes <= 0 {
+		p.maxBytes = 4096
+	}
+	for _, route := range cfg.Routes {
+		p.routes[route] = true
+	}
+	return p
+}
+
+// SetEnabled turns payload logging on or off
+func (p *PayloadLogger) SetEnabled(enabled bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.enabled = enabled
+}
+
+// SetSampleRate sets the fraction (0-1) of matching requests that are logged
+func (p *PayloadLogger) SetSampleRate(rate float64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.sampleRate = rate
+}
+
+// SetRoute enables or disables payload logging for a path prefix ("*" matches all)
+func (p *PayloadLogger) SetRoute(route string, enabled bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if enabled {
+		p.routes[route] = true
+	} else {
+		delete(p.routes, route)
+	}
+}
+
+// Status returns the current payload logging settings
+func (p *PayloadLogger) Status() (enabled bool, sampleRate float64, routes []string) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	for route := range p.routes {
+		routes = append(routes, route)
+	}
+	sort.Strings(routes)
+	return p.enabled, p.sampleRate, routes
+}
+
+// Handler returns the payload logging middleware
+func (p *PayloadLogger) Handler() fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if !p.shouldLog(c.Path()) {
+			return c.Next()
+		}
+
+		reqBody := p.redact(c.Body())
+		err := c.Next()
+
+		logger.Info("Request payload",
+			zap.String("request_id", c.GetRespHeader("X-Request-ID")),
+			zap.String("method", c.Method()),
+			zap.String("path", c.Path()),
+			zap.Int("status", c.Response().StatusCode()),
+			zap.String("request_body", reqBody),
+			zap.String("response_body", p.responseBody(c)),
+		)
+
+		return err
+	}
+}
+
+// responseBody returns the redacted response body. Streamed responses, such
+// as server-sent events, are not read: that would buffer the whole stream.
+func (p *PayloadLogger) responseBody(c *fiber.Ctx) string {
+	resp := c.Response()
+	if resp.IsBodyStream() || strings.HasPrefix(string(resp.Header.ContentType()), "text/event-stream") {
+		return "(streamed)"
+	}
+	return p.redact(resp.Body())
+}
+
+func (p *PayloadLogger) shouldLog(path string) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if !p.enabled || p.sampleRate <= 0 {
+		return false
+	}
+	matched := p.routes["*"]
+	for route := range p.routes {
+		if matched {
+			break
+		}
+		matched = strings.HasPrefix(path, route)
+	}
+	if !matched {
+		return false
+	}
+	return p.sampleRate >= 1 || rand.Float64() < p.sampleRate
+}
+
+// redact scrubs personal data and credentials from a body and caps its size
+func (p *PayloadLogger) redact(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	// Large bodies aren't parsed just to be cut down to maxBytes
+	if len(body) > maxRedactBytes {
+		return fmt.Sprintf("(%d bytes, not logged)", len(body))
+	}
+
+	var out string
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err == nil {
+		data, _ := json.Marshal(pii.ScrubValue(v))
+		out = string(data)
+	} else {
+		out = pii.Scrub(string(body))
+	}
+
+	if len(out) > p.maxBytes {
+		out = out[:p.maxBytes] + "...(truncated)"
+	}
+	return out
+}
@@ -0,0 +1,122 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/limiter"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// rateTier is a route-specific limit with its own bucket per client
+type rateTier struct {
+	rule    config.RouteRateLimit
+	handler fiber.Handler // nil when the route is unlimited
+}
+
+// RateLimit applies per-route rate limit tiers, falling back to the global
+// per-minute limit or the tenant's own. Clients are counted separately per
+// tenant. Requests carrying an exempt API key are never limited.
+func RateLimit(cfg config.RateLimitConfig, tenants []config.TenantConfig) fiber.Handler {
+	tiers := make([]rateTier, 0, len(cfg.Routes))
+	for _, rule := range cfg.Routes {
+		tier := rateTier{rule: rule}
+		if rule.Max > 0 {
+			tier.handler = newLimiter(rule.Max, rule.Window)
+		}
+		tiers = append(tiers, tier)
+	}
+	global := newLimiter(cfg.RequestsPerMinute, time.Minute)
+	tenantGlobal := make(map[string]fiber.Handler)
+	for _, t := range tenants {
+		if t.RequestsPerMinute > 0 {
+			tenantGlobal[t.ID] = newLimiter(t.RequestsPerMinute, time.Minute)
+		}
+	}
+
+	header := cfg.APIKeyHeader
+	if header == "" {
+		header = "X-API-Key"
+	}
+
+	return func(c *fiber.Ctx) error {
+		if isExemptKey(c.Get(header), cfg.ExemptAPIKeys) {
+			return c.Next()
+		}
+
+		if tier := matchTier(tiers, c.Method(), c.Path()); tier != nil {
+			if tier.handler == nil {
+				return c.Next()
+			}
+			return tier.handler(c)
+		}
+		if limit, ok := tenantGlobal[tenant.ID(c.UserContext())]; ok {
+			return limit(c)
+		}
+		return global(c)
+	}
+}
+
+// newLimiter creates a fixed-window limiter keyed by tenant and client IP
+func newLimiter(max int, window time.Duration) fiber.Handler {
+	if window <= 0 {
+		window = time.Minute
+	}
+	return limiter.New(limiter.Config{
+		Max:        max,
+		Expiration: window,
+		KeyGenerator: func(c *fiber.Ctx) string {
+			return tenant.ID(c.UserContext()) + "|" + ClientIP(c)
+		},
+		LimitReached: func(c *fiber.Ctx) error {
+			return c.Status(fiber.StatusTooManyRequests).JSON(fiber.Map{
+				"error":   "rate_limit_exceeded",
+				"message": "Too many requests. Please try again later.",
+			})
+		},
+	})
+}
+
+// matchTier returns the tier with the longest path prefix matching the request
+func matchTier(tiers []rateTier, method, path string) *rateTier {
+	var best *rateTier
+	for i := range tiers {
+		t := &tiers[i]
+		if t.rule.Method != "" && !strings.EqualFold(t.rule.Method, method) {
+			continue
+		}
+		if !matchesPrefix(path, t.rule.Path) {
+			continue
+		}
+		if best == nil || len(t.rule.Path) > len(best.rule.Path) {
+			best = t
+		}
+	}
+	return best
+}
+
+// matchesPrefix reports whether path equals prefix or is below it
+func matchesPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return true
+	}
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// isExemptKey compares the presented key against the exemption list in constant time
+func isExemptKey(key string, exempt []string) bool {
+	if key == "" {
+		return false
+	}
+	for _, k := range exempt {
+		if k != "" && subtle.ConstantTimeCompare([]byte(key), []byte(k)) == 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/readiness"
+)
+
+// Requires rejects requests with 503 while any of the capabilities they need
+// is unavailable, so one dependency being down only disables its features.
+func Requires(checker *readiness.Checker, capabilities ...domain.Capability) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if checker == nil {
+			return c.Next()
+		}
+
+		down := checker.Unavailable(c.UserContext(), capabilities...)
+		if len(down) == 0 {
+			return c.Next()
+		}
+
+		names := make([]string, len(down))
+		for i, s := range down {
+			names[i] = string(s.Name)
+		}
+		c.Set(fiber.HeaderRetryAfter, "30")
+		return c.Status(fiber.StatusServiceUnavailable).JSON(fiber.Map{
+			"error":        "capability_unavailable",
+			"message":      "This feature is temporarily unavailable (" + strings.Join(names, ", ") + " down)",
+			"capabilities": down,
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"errors"
+
+	"github.com/gofiber/fiber/v2"
+
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// tenantFreePaths are served without resolving a tenant
+var tenantFreePaths = []string{"/health", "/ready"}
+
+// Tenant resolves the request's tenant from the tenant header or subdomain
+// and carries it in the request context, where stores and the database pool
+// scope data to it. Health and readiness checks run without a tenant.
+func Tenant(registry *tenant.Registry) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if matchesAny(c.Path(), tenantFreePaths) {
+			return c.Next()
+		}
+
+		t, err := registry.Resolve(c.Get(registry.Header()), c.Hostname())
+		if err != nil {
+			if errors.Is(err, tenant.ErrRequired) {
+				return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+					"error":   "tenant_required",
+					"message": "Send the " + registry.Header() + " header or use your tenant's subdomain",
+				})
+			}
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error":   "unknown_tenant",
+				"message": err.Error(),
+			})
+		}
+
+		c.Locals(tenant.Key, t)
+		c.SetUserContext(tenant.WithTenant(c.UserContext(), t))
+		return c.Next()
+	}
+}
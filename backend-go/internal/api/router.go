@@ -1,41 +1,121 @@
 package api
 
 import (
+	"context"
+
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/fiber/v2/middleware/etag"
+	"go.uber.org/zap"
 
 	"github.com/resume-rag/backend/internal/api/handlers"
+	"github.com/resume-rag/backend/internal/api/middleware"
+	"github.com/resume-rag/backend/internal/audit"
+	"github.com/resume-rag/backend/internal/cache"
 	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/feed"
+	"github.com/resume-rag/backend/internal/llm"
+	"github.com/resume-rag/backend/internal/mailcheck"
+	"github.com/resume-rag/backend/internal/mlclient"
+	"github.com/resume-rag/backend/internal/operations"
+	"github.com/resume-rag/backend/internal/readiness"
+	"github.com/resume-rag/backend/internal/storage"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
 )
 
 // SetupRoutes configures all API routes
 func SetupRoutes(app *fiber.App, cfg *config.Config, deps *Dependencies) {
 	// Health check routes (no prefix)
 	app.Get("/health", handlers.HealthCheck(deps.DB))
-	app.Get("/ready", handlers.ReadinessCheck(deps.DB, deps.MLClient))
+	if deps.Readiness == nil {
+		deps.Readiness = readiness.NewChecker(readiness.DefaultTTL)
+	}
+	app.Get("/ready", handlers.ReadinessCheck(deps.Readiness))
 	app.Get("/", handlers.Root(cfg))
 
 	// API routes
 	api := app.Group("/api")
 
-	// Chat routes
+	// Response cache for expensive read endpoints (no-op when caching is disabled)
+	cached := middleware.ResponseCache(deps.Cache)
+	registerCacheHooks(deps.Cache)
+	applicationChanged := middleware.InvalidateOn(deps.Cache, cache.EventApplicationChanged)
+	savedSearchChanged := middleware.InvalidateOn(deps.Cache, cache.EventSavedSearchChanged)
+
+	// Audit trail for mutating operations
+	auditApplication := middleware.Audit(deps.Audit, "application", "app_id")
+	auditSavedSearch := middleware.Audit(deps.Audit, "saved_search", "search_id")
+	auditJob := middleware.Audit(deps.Audit, "job", "job_id")
+
+	// Bounded queueing for endpoints that call the LLM
+	llmQueued := middleware.LLMQueue(deps.LLMQueue, cfg.LLM.DefaultBackend)
+
+	// Features that need an unavailable dependency answer 503 instead of failing
+	needsLLM := middleware.Requires(deps.Readiness, domain.CapabilityLLM)
+	needsML := middleware.Requires(deps.Readiness, domain.CapabilityML, domain.CapabilityVectors)
+	needsRAG := middleware.Requires(deps.Readiness, domain.CapabilityLLM, domain.CapabilityVectors)
+
+	// Long-running requests callers can cancel through /api/operations
+	cancelable := func(kind string) fiber.Handler { return middleware.Cancelable(deps.Operations, kind) }
+
+	// Generated files (resume exports, backups) are returned as presigned links
+	downloads := handlers.NewDownloads(deps.Storage, cfg.Storage.URLExpiry)
+
+	// Chat routes; messages are also limited per chat session and per user
 	chat := api.Group("/chat")
-	chatHandler := handlers.NewChatHandler(deps.ChatService)
-	chat.Post("/", chatHandler.Chat)
-	chat.Get("/suggestions", chatHandler.GetSuggestions)
+	chatHandler := handlers.NewChatHandler(deps.ChatService, deps.Stories)
+	chatLimiter := middleware.NewChatLimiter(cfg.RateLimit)
+	chatLimited := chatLimiter.Handler()
+	chat.Post("/", needsRAG, chatLimited, llmQueued, chatHandler.Chat)
+	chat.Post("/stream", needsRAG, chatLimited, llmQueued, chatHandler.ChatStream)
+	var llmSlots handlers.LLMSlots
+	if deps.LLMQueue != nil {
+		llmSlots = deps.LLMQueue
+	}
+	chatSocketHandler := handlers.NewChatSocketHandler(chatHandler, llmSlots, cfg.LLM.DefaultBackend, chatLimiter, cfg.CORS.AllowedOrigins)
+	chat.Get("/ws", needsRAG, chatSocketHandler.Connect)
+	chat.Get("/suggestions", cached, chatHandler.GetSuggestions)
 	chat.Get("/history", chatHandler.GetHistory)
+	chat.Get("/history/search", chatHandler.SearchHistory)
 	chat.Delete("/history", chatHandler.ClearHistory)
 
+	// Active resume that chat retrieves from
+	if deps.ResumeIndex != nil {
+		resumeHandler := handlers.NewResumeHandler(deps.ResumeIndex)
+		api.Get("/resume", resumeHandler.GetResume)
+		api.Put("/resume", needsML, resumeHandler.SetResume)
+	}
+
+	// Named resume versions; activating one indexes it for chat and matching
+	if deps.ResumeVersions != nil {
+		versions := api.Group("/resume/versions")
+		versionHandler := handlers.NewResumeVersionHandler(deps.ResumeVersions)
+		versions.Get("/", versionHandler.ListVersions)
+		versions.Post("/", needsML, versionHandler.CreateVersion)
+		versions.Get("/:version_id", versionHandler.GetVersion)
+		versions.Put("/:version_id", needsML, versionHandler.UpdateVersion)
+		versions.Delete("/:version_id", versionHandler.DeleteVersion)
+		versions.Post("/:version_id/activate", needsML, versionHandler.ActivateVersion)
+	}
+
+	// LLM rewrite suggestions for fitting the resume to a job
+	if deps.ResumeTailor != nil {
+		tailorHandler := handlers.NewResumeTailorHandler(deps.ResumeTailor, deps.Resume)
+		api.Post("/resume/tailor", needsLLM, llmQueued, tailorHandler.Tailor)
+	}
+
 	// Analyze routes
 	analyze := api.Group("/analyze")
 	analyzeHandler := handlers.NewAnalyzeHandler(deps.AnalyzerService)
-	analyze.Post("/job", analyzeHandler.AnalyzeJob)
+	analyze.Post("/job", needsLLM, llmQueued, analyzeHandler.AnalyzeJob)
 	analyze.Post("/keywords", analyzeHandler.ExtractKeywords)
 
 	// Jobs routes (matching)
 	jobs := api.Group("/jobs")
 	jobsHandler := handlers.NewJobsHandler(deps.JobMatchService)
-	jobs.Post("/match", jobsHandler.MatchJob)
-	jobs.Post("/batch", jobsHandler.BatchMatch)
+	jobs.Post("/match", needsML, jobsHandler.MatchJob)
+	jobs.Post("/batch", needsML, cancelable("batch_match"), jobsHandler.BatchMatch)
 	jobs.Get("/history", jobsHandler.GetHistory)
 	jobs.Get("/history/:match_id", jobsHandler.GetMatchDetails)
 	jobs.Get("/analytics", jobsHandler.GetAnalytics)
@@ -43,72 +123,470 @@ func SetupRoutes(app *fiber.App, cfg *config.Config, deps *Dependencies) {
 
 	// Interview routes
 	interview := api.Group("/interview")
-	interviewHandler := handlers.NewInterviewHandler(deps.InterviewService)
+	interviewHandler := handlers.NewInterviewHandler(deps.InterviewService, deps.Practice, deps.Transcriber, cfg.Transcription.MaxAudioBytes, deps.Stories)
 	interview.Get("/questions", interviewHandler.GetQuestions)
 	interview.Get("/categories", interviewHandler.GetCategories)
 	interview.Get("/roles", interviewHandler.GetRoles)
-	interview.Post("/star", interviewHandler.GenerateSTAR)
-	interview.Post("/practice", interviewHandler.EvaluatePractice)
-	interview.Get("/company/:company_name", interviewHandler.GetCompanyResearch)
+	interview.Post("/star", needsLLM, llmQueued, interviewHandler.GenerateSTAR)
+	interview.Post("/practice", needsLLM, llmQueued, interviewHandler.EvaluatePractice)
+	interview.Post("/practice/audio", needsLLM, llmQueued, interviewHandler.PracticeAudio)
+	interview.Get("/company/:company_name", cached, interviewHandler.GetCompanyResearch)
+	if deps.Practice != nil {
+		interview.Get("/review-queue", interviewHandler.GetReviewQueue)
+	}
+
+	// Questions reported per company, merged into application prep plans
+	var questionHandler *handlers.CompanyQuestionHandler
+	if deps.Questions != nil {
+		questionHandler = handlers.NewCompanyQuestionHandler(deps.Questions, deps.JobListService, deps.Stories, cfg.Interview.CompanyQuestionsPath)
+		interview.Get("/company/:company_name/questions", questionHandler.GetQuestions)
+		interview.Post("/company/:company_name/questions", questionHandler.ReportQuestion)
+	}
+
+	// Story bank of vetted STAR stories
+	if deps.Stories != nil {
+		storyRoutes := api.Group("/stories")
+		storyHandler := handlers.NewStoryHandler(deps.Stories)
+		storyRoutes.Get("/", storyHandler.ListStories)
+		storyRoutes.Post("/", storyHandler.CreateStory)
+		storyRoutes.Get("/match", storyHandler.MatchStories)
+		storyRoutes.Get("/:story_id", storyHandler.GetStory)
+		storyRoutes.Put("/:story_id", storyHandler.UpdateStory)
+		storyRoutes.Delete("/:story_id", storyHandler.DeleteStory)
+	}
 
 	// Email routes
 	email := api.Group("/email")
-	emailHandler := handlers.NewEmailHandler(deps.EmailService)
-	email.Post("/generate", emailHandler.Generate)
-	email.Post("/application", emailHandler.GenerateApplication)
-	email.Post("/followup", emailHandler.GenerateFollowup)
-	email.Post("/thankyou", emailHandler.GenerateThankYou)
+	emailHandler := handlers.NewEmailHandler(deps.EmailService, deps.JobListService, mailcheck.NewChecker(deps.Storage), deps.EmailSender)
+	email.Post("/generate", needsLLM, llmQueued, emailHandler.Generate)
+	email.Post("/application", needsLLM, llmQueued, emailHandler.GenerateApplication)
+	email.Post("/followup", needsLLM, llmQueued, emailHandler.GenerateFollowup)
+	email.Post("/thankyou", needsLLM, llmQueued, emailHandler.GenerateThankYou)
+	email.Post("/check", emailHandler.CheckEmail)
+	email.Post("/send", emailHandler.SendEmail)
+	if deps.MailMerge != nil {
+		email.Post("/merge", cancelable("mail_merge"), llmQueued, handlers.NewMailMergeHandler(deps.MailMerge).Merge)
+	}
 
 	// Job List routes (search, applications, scraping)
 	jobList := api.Group("/job-list")
-	jobListHandler := handlers.NewJobListHandler(deps.JobListService)
+	var baseJobListService handlers.JobListService = deps.JobListService
+	if deps.Stats != nil {
+		baseJobListService = handlers.NewStatsJobListService(baseJobListService, deps.Stats)
+	}
+	jobListService := handlers.NewCachedJobListService(baseJobListService, deps.Cache)
+	var listingService handlers.JobListService = jobListService
+	if deps.Favorites != nil {
+		listingService = handlers.NewFavoritesJobListService(jobListService, deps.Favorites)
+	}
+	if deps.SalaryBenchmarks != nil {
+		listingService = handlers.NewSalaryJobListService(listingService, deps.SalaryBenchmarks)
+	}
+	jobListHandler := handlers.NewJobListHandler(listingService)
+
+	// Re-warm each tenant's saved searches once a scrape has invalidated their results
+	tenantIDs := tenant.IDs(cfg.Tenancy)
+	deps.Cache.Subscribe(cache.EventScrapeCompleted, func() {
+		go func() {
+			for _, id := range tenantIDs {
+				ctx := tenant.WithTenant(context.Background(), config.TenantConfig{ID: id})
+				if _, err := jobListService.WarmSavedSearches(ctx); err != nil {
+					logger.Warn("Failed to warm saved searches", zap.String("tenant", id), zap.Error(err))
+				}
+			}
+		}()
+	})
+
+	// ETag / If-None-Match support for polled read endpoints
+	conditional := etag.New()
 
 	// Search
 	jobList.Post("/search", jobListHandler.Search)
-	jobList.Get("/jobs", jobListHandler.GetJobs)
+	jobList.Get("/jobs", conditional, jobListHandler.GetJobs)
 	jobList.Get("/jobs/:job_id", jobListHandler.GetJobDetails)
-	jobList.Get("/recommendations", jobListHandler.GetRecommendations)
+	jobList.Delete("/jobs/:job_id", auditJob, jobListHandler.DeleteJob)
+
+	// Bulk cleanup: trash jobs matching criteria in the background
+	if deps.JobCleanup != nil {
+		cleanupHandler := handlers.NewJobCleanupHandler(deps.JobCleanup, middleware.OperationOwner)
+		jobList.Post("/jobs/cleanup", cleanupHandler.Cleanup)
+		jobList.Get("/jobs/cleanup/:task_id", cleanupHandler.GetCleanup)
+	}
+
+	// Jobs first seen since a timestamp, for incremental sync and RSS readers
+	feedHandler := handlers.NewFeedHandler(feed.NewBuilder(listingService))
+	jobList.Get("/feed", conditional, feedHandler.GetFeed)
+
+	// Favorites: starred jobs kept apart from applications
+	if deps.Favorites != nil {
+		favoritesHandler := handlers.NewFavoritesHandler(deps.Favorites)
+		auditFavorite := middleware.Audit(deps.Audit, "favorite", "job_id")
+		jobList.Get("/favorites", favoritesHandler.ListFavorites)
+		jobList.Put("/jobs/:job_id/favorite", auditFavorite, favoritesHandler.Star)
+		jobList.Delete("/jobs/:job_id/favorite", auditFavorite, favoritesHandler.Unstar)
+		jobList.Post("/jobs/:job_id/favorite/promote", auditFavorite, applicationChanged, favoritesHandler.Promote)
+	}
+
+	// Requirement-by-section resume coverage for a job
+	coverageHandler := handlers.NewCoverageHandler(jobListService, deps.Resume)
+	jobList.Post("/jobs/:job_id/coverage", coverageHandler.GetCoverage)
+
+	jobList.Get("/recommendations", conditional, jobListHandler.GetRecommendations)
 
 	// Applications
 	jobList.Get("/applications", jobListHandler.GetApplications)
-	jobList.Post("/applications", jobListHandler.CreateApplication)
+	jobList.Post("/applications", auditApplication, applicationChanged, jobListHandler.CreateApplication)
 	jobList.Get("/applications/reminders/due", jobListHandler.GetDueReminders)
 	jobList.Get("/applications/:app_id", jobListHandler.GetApplication)
-	jobList.Put("/applications/:app_id", jobListHandler.UpdateApplication)
-	jobList.Delete("/applications/:app_id", jobListHandler.DeleteApplication)
+	jobList.Put("/applications/:app_id", auditApplication, applicationChanged, jobListHandler.UpdateApplication)
+	jobList.Delete("/applications/:app_id", auditApplication, applicationChanged, jobListHandler.DeleteApplication)
+
+	// Resume variants tailored per application
+	if deps.ResumeVariants != nil {
+		variantHandler := handlers.NewResumeVariantHandler(deps.ResumeVariants, deps.Resume, downloads)
+		jobList.Get("/applications/:app_id/resume-variants", variantHandler.ListVariants)
+		jobList.Post("/applications/:app_id/resume-variants", auditApplication, applicationChanged, variantHandler.CreateVariant)
+		jobList.Get("/resume-variants/:variant_id", variantHandler.GetVariant)
+		jobList.Get("/resume-variants/:variant_id/docx", variantHandler.ExportDOCX)
+	}
+
+	if questionHandler != nil {
+		jobList.Get("/applications/:app_id/prep", questionHandler.GetPrepPlan)
+	}
+
+	// Checklists per application, from per-status templates
+	if deps.Checklists != nil {
+		checklistHandler := handlers.NewChecklistHandler(deps.Checklists)
+		jobList.Get("/checklist/templates", checklistHandler.GetTemplates)
+		jobList.Get("/applications/:app_id/checklist", checklistHandler.GetChecklist)
+		jobList.Post("/applications/:app_id/checklist/items", auditApplication, checklistHandler.AddItem)
+		jobList.Put("/applications/:app_id/checklist/items/:item_id", auditApplication, checklistHandler.UpdateItem)
+		jobList.Delete("/applications/:app_id/checklist/items/:item_id", auditApplication, checklistHandler.DeleteItem)
+	}
+
+	// Follow-up email sequences per application
+	if deps.Outreach != nil {
+		outreachHandler := handlers.NewOutreachHandler(deps.Outreach)
+		jobList.Get("/applications/:app_id/outreach", outreachHandler.ListSequences)
+		jobList.Post("/applications/:app_id/outreach", auditApplication, llmQueued, applicationChanged, outreachHandler.StartSequence)
+		jobList.Post("/outreach/:sequence_id/pause", outreachHandler.PauseSequence)
+		jobList.Post("/outreach/:sequence_id/resume", llmQueued, applicationChanged, outreachHandler.ResumeSequence)
+		jobList.Put("/outreach/:sequence_id/steps/:step", llmQueued, applicationChanged, outreachHandler.UpdateStep)
+		jobList.Delete("/outreach/:sequence_id", outreachHandler.CancelSequence)
+	}
+
+	// Trash (soft-deleted jobs and applications)
+	jobList.Get("/trash", jobListHandler.GetTrash)
+	jobList.Post("/trash/jobs/:job_id/restore", auditJob, jobListHandler.RestoreJob)
+	jobList.Post("/trash/applications/:app_id/restore", auditApplication, applicationChanged, jobListHandler.RestoreApplication)
 
 	// Cover letter
-	jobList.Post("/jobs/:job_id/cover-letter", jobListHandler.GenerateCoverLetter)
+	jobList.Post("/jobs/:job_id/cover-letter", needsLLM, cancelable("cover_letter"), llmQueued, jobListHandler.GenerateCoverLetter)
 
 	// Saved searches
 	jobList.Get("/saved-searches", jobListHandler.GetSavedSearches)
-	jobList.Post("/saved-searches", jobListHandler.SaveSearch)
-	jobList.Delete("/saved-searches/:search_id", jobListHandler.DeleteSavedSearch)
+	jobList.Post("/saved-searches", auditSavedSearch, savedSearchChanged, jobListHandler.SaveSearch)
+	jobList.Delete("/saved-searches/:search_id", auditSavedSearch, savedSearchChanged, jobListHandler.DeleteSavedSearch)
+
+	// Saved searches as RSS/Atom feeds; the feed URLs carry a token instead of a login
+	if deps.FeedTokens != nil {
+		savedSearchFeeds := handlers.NewSavedSearchFeedHandler(listingService, deps.FeedTokens)
+		jobList.Get("/saved-searches/:search_id/feed", savedSearchFeeds.GetFeedLinks)
+		jobList.Get("/saved-searches/:search_id/feed.rss", conditional, savedSearchFeeds.GetRSS)
+		jobList.Get("/saved-searches/:search_id/feed.atom", conditional, savedSearchFeeds.GetAtom)
+	}
 
-	// Scraping
-	jobList.Post("/scrape", jobListHandler.TriggerScrape)
+	// Scraping; a scrape can be queued from a named scrape profile
+	if deps.ScrapeProfiles != nil {
+		profileHandler := handlers.NewScrapeProfileHandler(deps.ScrapeProfiles)
+		jobList.Post("/scrape", profileHandler.ProfileScrape, jobListHandler.TriggerScrape)
+		jobList.Get("/scrape-profiles", profileHandler.ListProfiles)
+		jobList.Post("/scrape-profiles", profileHandler.CreateProfile)
+		jobList.Get("/scrape-profiles/:profile_id", profileHandler.GetProfile)
+		jobList.Put("/scrape-profiles/:profile_id", profileHandler.UpdateProfile)
+		jobList.Delete("/scrape-profiles/:profile_id", profileHandler.DeleteProfile)
+		jobList.Post("/scrape-profiles/:profile_id/run", profileHandler.RunProfile)
+	} else {
+		jobList.Post("/scrape", jobListHandler.TriggerScrape)
+	}
 	jobList.Get("/scrape/status/:task_id", jobListHandler.GetScrapeStatus)
+	jobList.Delete("/scrape/:task_id", jobListHandler.CancelScrape)
 
 	// Statistics
-	jobList.Get("/stats/jobs", jobListHandler.GetJobStats)
-	jobList.Get("/stats/applications", jobListHandler.GetApplicationStats)
+	jobList.Get("/stats/jobs", conditional, cached, jobListHandler.GetJobStats)
+	jobList.Get("/stats/applications", conditional, cached, jobListHandler.GetApplicationStats)
+
+	// Incremental sync for offline clients: changed jobs and applications since a cursor
+	if deps.Sync != nil {
+		api.Get("/sync", handlers.NewSyncHandler(deps.Sync).GetChanges)
+	}
+
+	// What each LLM backend receives of the resume after redaction
+	if deps.Redaction != nil {
+		redactionHandler := handlers.NewRedactionHandler(deps.Redaction, deps.Resume)
+		api.Get("/privacy/redaction", redactionHandler.GetSettings)
+		api.Post("/privacy/redaction/preview", redactionHandler.Preview)
+	}
+
+	// Digest routes
+	if deps.Digest != nil {
+		digestHandler := handlers.NewDigestHandler(deps.Digest)
+		api.Get("/digest/preview", digestHandler.Preview)
+	}
+
+	// Commute times from the saved home location
+	if deps.Commute != nil {
+		commute := api.Group("/commute")
+		commuteHandler := handlers.NewCommuteHandler(deps.Commute, jobListService)
+		homeChanged := middleware.InvalidateOn(deps.Cache, cache.EventCommuteHomeChanged)
+		commute.Get("/home", commuteHandler.GetHome)
+		commute.Put("/home", homeChanged, commuteHandler.SetHome)
+		commute.Get("/jobs/:job_id", commuteHandler.GetJobCommute)
+	}
+
+	// Job market analytics over the scraped corpus
+	analytics := api.Group("/analytics")
+	if deps.Insights != nil {
+		analyticsHandler := handlers.NewAnalyticsHandler(deps.Insights)
+		analytics.Get("/salaries", cached, analyticsHandler.GetSalaries)
+		analytics.Get("/skills/top", cached, analyticsHandler.GetTopSkills)
+		analytics.Get("/skills/trends", cached, analyticsHandler.GetSkillTrends)
+		analytics.Get("/work-arrangement", cached, analyticsHandler.GetWorkArrangement)
+		analytics.Get("/what-works", cached, analyticsHandler.GetWhatWorks)
+		analytics.Get("/stack/companies", cached, analyticsHandler.GetCompanyStacks)
+	}
+
+	// Posted salaries against an imported salary survey
+	if deps.SalaryBenchmarks != nil {
+		salaryHandler := handlers.NewSalaryBenchmarkHandler(deps.SalaryBenchmarks, jobListService)
+		analytics.Get("/salary-benchmark", salaryHandler.GetBenchmark)
+	}
+
+	// Dashboard (home screen summary)
+	dashboardHandler := handlers.NewDashboardHandler(jobListService, deps.Goals, deps.Checklists)
+	api.Get("/dashboard", conditional, dashboardHandler.GetDashboard)
+
+	// Job search goals and streaks
+	if deps.Goals != nil {
+		goalRoutes := api.Group("/goals")
+		goalHandler := handlers.NewGoalHandler(deps.Goals)
+		goalRoutes.Get("/", goalHandler.GetProgress)
+		goalRoutes.Post("/", goalHandler.CreateGoal)
+		goalRoutes.Delete("/:goal_id", goalHandler.DeleteGoal)
+	}
+
+	// Read-only share links for mentors and coaches; /shared/:token needs no account
+	if deps.Share != nil {
+		shareHandler := handlers.NewShareHandler(deps.Share)
+		shareLinks := api.Group("/share-links", middleware.Audit(deps.Audit, "share_link", "link_id"))
+		shareLinks.Get("/", shareHandler.ListLinks)
+		shareLinks.Post("/", shareHandler.CreateLink)
+		shareLinks.Delete("/:link_id", shareHandler.RevokeLink)
+		api.Get("/shared/:token", shareHandler.ViewShared)
+	}
 
 	// Settings routes
-	settings := api.Group("/settings")
+	settings := api.Group("/settings", middleware.Audit(deps.Audit, "settings", ""))
 	settingsHandler := handlers.NewSettingsHandler(cfg, deps.MLClient)
 	settings.Get("/", settingsHandler.GetSettings)
 	settings.Put("/", settingsHandler.UpdateSettings)
 	settings.Get("/backends", settingsHandler.GetAvailableBackends)
+
+	// Encrypted credentials (LLM API keys, OAuth tokens, SMTP); values are write-only
+	var credentialsHandler *handlers.CredentialsHandler
+	if deps.Credentials != nil {
+		credentialsHandler = handlers.NewCredentialsHandler(deps.Credentials, deps.KeyRotator)
+		settings.Get("/credentials", credentialsHandler.ListCredentials)
+		settings.Put("/credentials/:name", credentialsHandler.SetCredential)
+		settings.Delete("/credentials/:name", credentialsHandler.DeleteCredential)
+	}
+
+	// Running operations of the caller, and their cancellation
+	if deps.Operations != nil {
+		operationsHandler := handlers.NewOperationsHandler(deps.Operations, middleware.OperationOwner)
+		api.Get("/operations", operationsHandler.ListOperations)
+		api.Delete("/operations/:operation_id", operationsHandler.CancelOperation)
+	}
+
+	// Timeline of any background task: scrape, cleanup or digest run
+	if deps.TaskEvents != nil {
+		taskEventHandler := handlers.NewTaskEventHandler(deps.TaskEvents)
+		api.Get("/tasks/:task_id/events", taskEventHandler.GetTaskEvents)
+	}
+
+	// Notification preferences: channels per event, quiet hours, digest-only
+	if deps.Notifications != nil {
+		notificationHandler := handlers.NewNotificationHandler(deps.Notifications)
+		api.Get("/notifications/preferences", notificationHandler.GetPreferences)
+		api.Put("/notifications/preferences", notificationHandler.UpdatePreferences)
+	}
+
+	// Web Push subscriptions of the user's browsers
+	if deps.Push != nil {
+		pushHandler := handlers.NewPushHandler(deps.Push)
+		api.Get("/push/vapid-public-key", pushHandler.GetPublicKey)
+		api.Get("/push/subscriptions", pushHandler.ListSubscriptions)
+		api.Post("/push/subscriptions", pushHandler.Subscribe)
+		api.Delete("/push/subscriptions/:subscription_id", pushHandler.Unsubscribe)
+		api.Post("/push/subscriptions/:subscription_id/test", pushHandler.SendTest)
+	}
+
+	// Signed downloads for locally stored files (S3 serves presigned URLs directly)
+	if local, ok := deps.Storage.(*storage.LocalStorage); ok {
+		filesHandler := handlers.NewFilesHandler(local)
+		api.Get("/files/*", filesHandler.Download)
+	}
+
+	// Admin routes
+	admin := api.Group("/admin", middleware.Audit(deps.Audit, "admin", ""))
+	adminHandler := handlers.NewAdminHandler(deps.Cache, jobListService, deps.PayloadLogger)
+	admin.Get("/cache/stats", adminHandler.GetCacheStats)
+	admin.Post("/cache/flush", adminHandler.FlushCache)
+	admin.Post("/cache/warm", adminHandler.WarmCache)
+	admin.Get("/payload-logging", adminHandler.GetPayloadLogging)
+	admin.Put("/payload-logging", adminHandler.UpdatePayloadLogging)
+	if deps.LLMHealth != nil {
+		admin.Get("/llm/backends", handlers.NewLLMHealthHandler(deps.LLMHealth).GetBackends)
+	}
+	if deps.SourceWeights != nil {
+		weightsChanged := middleware.InvalidateOn(deps.Cache, cache.EventSourceWeightChanged)
+		weightHandler := handlers.NewSourceWeightHandler(deps.SourceWeights)
+		admin.Get("/scrapers/weights", weightHandler.ListWeights)
+		admin.Put("/scrapers/weights/:source", weightsChanged, weightHandler.SetWeight)
+		admin.Delete("/scrapers/weights/:source", weightsChanged, weightHandler.ResetWeight)
+	}
+	if credentialsHandler != nil && deps.KeyRotator != nil {
+		admin.Post("/encryption/rotate", credentialsHandler.RotateKeys)
+	}
+
+	// One-call operator overview; sections without a source are reported unavailable
+	var llmQueues handlers.LLMQueueMonitor
+	if deps.LLMQueue != nil {
+		llmQueues = deps.LLMQueue
+	}
+	systemStatsHandler := handlers.NewSystemStatsHandler(jobListService, llmQueues, deps.Audit, deps.SystemStats)
+	admin.Get("/stats", systemStatsHandler.GetSystemStats)
+
+	if deps.Audit != nil {
+		auditHandler := handlers.NewAuditHandler(deps.Audit)
+		admin.Get("/audit", auditHandler.GetAuditLog)
+	}
+
+	enrichmentHandler := handlers.NewEnrichmentHandler(deps.H1B, deps.Ratings, deps.SalaryBenchmarks, cfg.Enrichment)
+	if deps.H1B != nil {
+		admin.Get("/enrichment/h1b", enrichmentHandler.GetH1BStats)
+		admin.Post("/enrichment/h1b/import", enrichmentHandler.ImportH1B)
+	}
+	if deps.Ratings != nil {
+		admin.Get("/enrichment/ratings", enrichmentHandler.GetRatingStats)
+		admin.Post("/enrichment/ratings/import", enrichmentHandler.ImportRatings)
+		admin.Post("/enrichment/ratings/refresh", enrichmentHandler.RefreshRatings)
+	}
+	if deps.SalaryBenchmarks != nil {
+		admin.Get("/enrichment/salaries", enrichmentHandler.GetSalaryStats)
+		admin.Post("/enrichment/salaries/import", enrichmentHandler.ImportSalaries)
+	}
+
+	if questionHandler != nil {
+		admin.Post("/interview/questions/import", questionHandler.ImportQuestions)
+	}
+
+	if deps.LLMQueue != nil {
+		llmQueueHandler := handlers.NewLLMQueueHandler(deps.LLMQueue)
+		admin.Get("/llm/queue", llmQueueHandler.GetQueueStats)
+	}
+
+	if deps.Retention != nil {
+		retentionHandler := handlers.NewRetentionHandler(deps.Retention)
+		admin.Get("/retention/report", retentionHandler.GetReport)
+		admin.Post("/retention/run", retentionHandler.RunCleanup)
+	}
+
+	if deps.Backup != nil {
+		backupHandler := handlers.NewBackupHandler(deps.Backup, downloads)
+		admin.Post("/backup", cancelable("backup"), backupHandler.CreateBackup)
+	}
+}
+
+// registerCacheHooks maps domain events to the cached routes they make stale.
+// Services emit cache.EventScrapeCompleted once new jobs have been stored.
+func registerCacheHooks(store *cache.LRU) {
+	store.On(cache.EventScrapeCompleted,
+		handlers.SearchCachePrefix,
+		"/api/job-list/stats/jobs",
+		"/api/analytics",
+	)
+	store.On(cache.EventApplicationChanged,
+		handlers.SearchCachePrefix,
+		"/api/job-list/stats/applications",
+		"/api/analytics/what-works",
+	)
+	store.On(cache.EventSavedSearchChanged,
+		handlers.SearchCachePrefix,
+	)
+	// Search results carry commute times from the home location
+	store.On(cache.EventCommuteHomeChanged,
+		handlers.SearchCachePrefix,
+	)
+	// Search results are ordered by weighted match score
+	store.On(cache.EventSourceWeightChanged,
+		handlers.SearchCachePrefix,
+	)
 }
 
 // Dependencies holds all service dependencies for handlers
 type Dependencies struct {
 	DB               interface{} // Will be *pgxpool.Pool
-	MLClient         interface{} // Will be ML service gRPC client
+	MLClient         *mlclient.Client
 	ChatService      handlers.ChatService
 	AnalyzerService  handlers.AnalyzerService
 	JobMatchService  handlers.JobMatchService
 	InterviewService handlers.InterviewService
 	EmailService     handlers.EmailService
+	EmailSender      handlers.EmailSender
 	JobListService   handlers.JobListService
+	JobCleanup       handlers.JobCleanupRunner
+	Cache            *cache.LRU
+	PayloadLogger    handlers.PayloadLogControl
+	Storage          storage.Storage
+	Retention        handlers.RetentionRunner
+	Backup           handlers.BackupWriter
+	Audit            audit.Store
+	H1B              handlers.H1BDataset
+	Ratings          handlers.CompanyRatings
+	SalaryBenchmarks handlers.SalaryBenchmarks
+	Stats            handlers.StatsProvider
+	SystemStats      handlers.SystemStatsStore
+	Digest           handlers.DigestPreviewer
+	Insights         handlers.MarketInsights
+	LLMQueue         *llm.Limiter
+	LLMHealth        handlers.LLMHealthMonitor
+	Commute          handlers.CommuteEstimator
+	Resume           handlers.ResumeProvider
+	ResumeIndex      handlers.ResumeIndexer
+	ResumeVersions   handlers.ResumeVersionService
+	ScrapeProfiles   handlers.ScrapeProfileService
+	SourceWeights    handlers.SourceWeightStore
+	ResumeVariants   handlers.ResumeVariantService
+	ResumeTailor     handlers.ResumeTailorAdvisor
+	Practice         handlers.PracticeReviewer
+	Transcriber      handlers.Transcriber
+	Stories          handlers.StoryBank
+	Questions        handlers.CompanyQuestionService
+	Outreach         handlers.OutreachService
+	MailMerge        handlers.MailMerger
+	Goals            handlers.GoalTracker
+	Checklists       handlers.ChecklistService
+	Share            handlers.ShareService
+	Favorites        handlers.FavoritesService
+	FeedTokens       handlers.FeedTokens
+	Sync             handlers.SyncService
+	Redaction        handlers.ResumeRedactor
+	Credentials      handlers.CredentialService
+	KeyRotator       handlers.KeyRotator
+	Operations       *operations.Registry
+	TaskEvents       handlers.TaskEventLog
+	Notifications    handlers.NotificationService
+	Push             handlers.PushService
+	Readiness        *readiness.Checker
 }
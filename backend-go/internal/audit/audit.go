@@ -0,0 +1,110 @@
+package audit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// anonymousActor is recorded for requests without an authenticated actor
+const anonymousActor = "anonymous"
+
+// Store persists and queries audit entries
+type Store interface {
+	Record(ctx context.Context, entry domain.AuditEntry) error
+	Query(ctx context.Context, q domain.AuditQuery) (*domain.AuditLogResponse, error)
+	// ActiveUsers counts distinct actors with entries since the given time;
+	// anonymous requests are told apart by IP
+	ActiveUsers(ctx context.Context, since time.Time) (int, error)
+}
+
+// MemoryStore keeps the most recent audit entries in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	entries  []domain.AuditEntry
+	capacity int
+}
+
+// NewMemoryStore creates an in-memory store holding up to capacity entries
+func NewMemoryStore(capacity int) *MemoryStore {
+	if capacity <= 0 {
+		capacity = 10000
+	}
+	return &MemoryStore{capacity: capacity}
+}
+
+// Record appends an entry, dropping the oldest when full
+func (s *MemoryStore) Record(ctx context.Context, entry domain.AuditEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.entries = append(s.entries, entry)
+	if len(s.entries) > s.capacity {
+		s.entries = s.entries[len(s.entries)-s.capacity:]
+	}
+	return nil
+}
+
+// Query returns matching entries, newest first
+func (s *MemoryStore) Query(ctx context.Context, q domain.AuditQuery) (*domain.AuditLogResponse, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var matched []domain.AuditEntry
+	for i := len(s.entries) - 1; i >= 0; i-- {
+		if e := s.entries[i]; matches(e, q) {
+			matched = append(matched, e)
+		}
+	}
+
+	resp := &domain.AuditLogResponse{Entries: []domain.AuditEntry{}, Total: len(matched)}
+	if q.Offset < len(matched) {
+		end := len(matched)
+		if q.Limit > 0 && q.Offset+q.Limit < end {
+			end = q.Offset + q.Limit
+		}
+		resp.Entries = matched[q.Offset:end]
+	}
+	return resp, nil
+}
+
+// ActiveUsers counts distinct actors with entries since the given time
+func (s *MemoryStore) ActiveUsers(ctx context.Context, since time.Time) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	users := make(map[string]bool)
+	for i := len(s.entries) - 1; i >= 0 && !s.entries[i].CreatedAt.Before(since); i-- {
+		users[userKey(s.entries[i])] = true
+	}
+	return len(users), nil
+}
+
+// userKey identifies the user behind an entry
+func userKey(e domain.AuditEntry) string {
+	if e.Actor == anonymousActor {
+		return "ip:" + e.IP
+	}
+	return e.Actor
+}
+
+func matches(e domain.AuditEntry, q domain.AuditQuery) bool {
+	switch {
+	case q.Actor != "" && e.Actor != q.Actor:
+		return false
+	case q.Action != "" && e.Action != q.Action:
+		return false
+	case q.Resource != "" && e.Resource != q.Resource:
+		return false
+	case q.ResourceID != "" && (e.ResourceID == nil || *e.ResourceID != q.ResourceID):
+		return false
+	case q.Since != nil && e.CreatedAt.Before(*q.Since):
+		return false
+	case q.Until != nil && e.CreatedAt.After(*q.Until):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,106 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists audit entries in the audit_log table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed audit store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Record inserts an audit entry
+func (s *PostgresStore) Record(ctx context.Context, e domain.AuditEntry) error {
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO audit_log (id, actor, action, resource, resource_id, method, path, status, request_id, ip, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)`,
+		e.ID, e.Actor, e.Action, e.Resource, e.ResourceID, e.Method, e.Path, e.Status, e.RequestID, e.IP, e.Details, e.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record audit entry: %w", err)
+	}
+	return nil
+}
+
+// Query returns matching entries, newest first
+func (s *PostgresStore) Query(ctx context.Context, q domain.AuditQuery) (*domain.AuditLogResponse, error) {
+	var conds []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, strings.Replace(cond, "?", "$"+strconv.Itoa(len(args)), 1))
+	}
+
+	if q.Actor != "" {
+		add("actor = ?", q.Actor)
+	}
+	if q.Action != "" {
+		add("action = ?", q.Action)
+	}
+	if q.Resource != "" {
+		add("resource = ?", q.Resource)
+	}
+	if q.ResourceID != "" {
+		add("resource_id = ?", q.ResourceID)
+	}
+	if q.Since != nil {
+		add("created_at >= ?", *q.Since)
+	}
+	if q.Until != nil {
+		add("created_at <= ?", *q.Until)
+	}
+
+	where := ""
+	if len(conds) > 0 {
+		where = " WHERE " + strings.Join(conds, " AND ")
+	}
+
+	resp := &domain.AuditLogResponse{Entries: []domain.AuditEntry{}}
+	if err := s.db.QueryRow(ctx, "SELECT count(*) FROM audit_log"+where, args...).Scan(&resp.Total); err != nil {
+		return nil, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+
+	args = append(args, q.Limit, q.Offset)
+	rows, err := s.db.Query(ctx, fmt.Sprintf(`
+		SELECT id, actor, action, resource, resource_id, method, path, status, request_id, ip, details, created_at
+		FROM audit_log%s ORDER BY created_at DESC LIMIT $%d OFFSET $%d`, where, len(args)-1, len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit entries: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e domain.AuditEntry
+		if err := rows.Scan(&e.ID, &e.Actor, &e.Action, &e.Resource, &e.ResourceID, &e.Method, &e.Path,
+			&e.Status, &e.RequestID, &e.IP, &e.Details, &e.CreatedAt); err != nil {
+			return nil, err
+		}
+		resp.Entries = append(resp.Entries, e)
+	}
+	return resp, rows.Err()
+}
+
+// ActiveUsers counts distinct actors with entries since the given time
+func (s *PostgresStore) ActiveUsers(ctx context.Context, since time.Time) (int, error) {
+	var n int
+	err := s.db.QueryRow(ctx, `
+		SELECT count(DISTINCT CASE WHEN actor = $1 THEN 'ip:' || COALESCE(ip, '') ELSE actor END)
+		FROM audit_log WHERE created_at >= $2`, anonymousActor, since).Scan(&n)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count active users: %w", err)
+	}
+	return n, nil
+}
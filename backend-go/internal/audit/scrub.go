@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/pii"
+)
+
+// ScrubbingStore wraps a Store, stripping personal data and credentials from
+// entries before they are stored. The actor is kept so entries stay
+// attributable.
+type ScrubbingStore struct {
+	Store
+}
+
+// NewScrubbingStore creates a store that scrubs entries before recording them
+func NewScrubbingStore(store Store) *ScrubbingStore {
+	return &ScrubbingStore{Store: store}
+}
+
+// Record scrubs the entry's path and details, then records it
+func (s *ScrubbingStore) Record(ctx context.Context, entry domain.AuditEntry) error {
+	entry.Path = pii.Scrub(entry.Path)
+	if entry.Details != nil {
+		entry.Details = pii.ScrubValue(entry.Details).(map[string]interface{})
+	}
+	return s.Store.Record(ctx, entry)
+}
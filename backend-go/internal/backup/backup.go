@@ -0,0 +1,345 @@
+// Package backup writes and restores portable archives of a deployment: the
+// Postgres tables as CSV, snapshots of the Qdrant collections and the stored
+// files, in a gzipped tar led by a manifest. Archives hold data, not schema;
+// restoring applies the embedded migrations first, so an archive can be
+// restored by the same or a later release.
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/storage"
+	"github.com/resume-rag/backend/migrations"
+)
+
+// FormatVersion is the archive layout written by this release
+const FormatVersion = 1
+
+// Archive layout
+const (
+	manifestName = "manifest.json"
+	postgresDir  = "postgres/" // postgres/TABLE.csv, or postgres/TENANT/TABLE.csv for tenant-owned tables
+	qdrantDir    = "qdrant/"   // qdrant/COLLECTION.snapshot
+	filesDir     = "files/"    // files/KEY
+
+	// contentTypeRecord is the PAX header record holding a file's content type
+	contentTypeRecord = "RESUMEAI.content_type"
+)
+
+// Manifest describes an archive
+type Manifest struct {
+	Format        int       `json:"format"`
+	CreatedAt     time.Time `json:"created_at"`
+	SchemaVersion int       `json:"schema_version"` // latest applied migration; 0 without Postgres data
+	Tables        []string  `json:"tables"`         // in foreign key order
+	Tenants       []string  `json:"tenants"`        // tenants whose rows of row-level secured tables are included
+	Collections   []string  `json:"collections"`
+	Files         int       `json:"files"`
+}
+
+// VectorStore snapshots and restores Qdrant collections
+type VectorStore interface {
+	Collections(ctx context.Context) ([]string, error)
+	Snapshot(ctx context.Context, name string, w io.Writer) error
+	RestoreSnapshot(ctx context.Context, name string, r io.Reader) error
+}
+
+// Service backs up and restores a deployment. A nil database, vector store
+// or file storage is left out of backups.
+type Service struct {
+	db      *pgxpool.Pool
+	vectors VectorStore
+	files   storage.Storage
+	tenants []string
+}
+
+// NewService creates a backup service. tenants lists every tenant whose
+// rows of row-level secured tables are backed up.
+func NewService(db *pgxpool.Pool, vectors VectorStore, files storage.Storage, tenants []string) *Service {
+	return &Service{db: db, vectors: vectors, files: files, tenants: tenants}
+}
+
+// Backup writes an archive to w. Tables are read in one repeatable-read
+// transaction, so they are consistent with each other.
+func (s *Service) Backup(ctx context.Context, w io.Writer) (*Manifest, error) {
+	m := &Manifest{Format: FormatVersion, CreatedAt: time.Now().UTC(), Tenants: s.tenants}
+
+	var tx pgx.Tx
+	var tables []table
+	if s.db != nil {
+		var err error
+		tx, err = s.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+		if err != nil {
+			return nil, fmt.Errorf("failed to start backup transaction: %w", err)
+		}
+		defer func() { _ = tx.Rollback(context.Background()) }()
+
+		if m.SchemaVersion, err = schemaVersion(ctx, tx); err != nil {
+			return nil, err
+		}
+		if tables, err = listTables(ctx, tx); err != nil {
+			return nil, err
+		}
+		for _, t := range tables {
+			m.Tables = append(m.Tables, t.Name)
+		}
+	}
+	if s.vectors != nil {
+		var err error
+		if m.Collections, err = s.vectors.Collections(ctx); err != nil {
+			return nil, err
+		}
+	}
+	var objects []storage.ObjectInfo
+	if s.files != nil {
+		var err error
+		if objects, err = s.files.List(ctx, ""); err != nil {
+			return nil, fmt.Errorf("failed to list files: %w", err)
+		}
+		// Earlier backups are not part of the next one
+		objects = slices.DeleteFunc(objects, func(obj storage.ObjectInfo) bool {
+			return strings.HasPrefix(obj.Key, storage.PrefixBackups)
+		})
+		m.Files = len(objects)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	manifest, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err := writeEntry(tw, &tar.Header{Name: manifestName, Size: int64(len(manifest))}, strings.NewReader(string(manifest))); err != nil {
+		return nil, err
+	}
+
+	for _, t := range tables {
+		if !t.Secured {
+			if err := spoolEntry(tw, postgresDir+t.Name+".csv", func(w io.Writer) error { return copyOut(ctx, tx, t.Name, w) }); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		for _, tenantID := range s.tenants {
+			if err := setTenant(ctx, tx, tenantID); err != nil {
+				return nil, err
+			}
+			if err := spoolEntry(tw, postgresDir+tenantID+"/"+t.Name+".csv", func(w io.Writer) error { return copyOut(ctx, tx, t.Name, w) }); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, name := range m.Collections {
+		if err := spoolEntry(tw, qdrantDir+name+".snapshot", func(w io.Writer) error { return s.vectors.Snapshot(ctx, name, w) }); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, obj := range objects {
+		if err := s.addFile(ctx, tw, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	if err := gz.Close(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Restore replaces the deployment's data with an archive read from r: every
+// table is emptied and reloaded, the archived collections replace their
+// namesakes, and the archived files are written over existing ones. Restore
+// while the API is stopped.
+func (s *Service) Restore(ctx context.Context, r io.Reader) (*Manifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("not a backup archive: %w", err)
+	}
+	tr := tar.NewReader(gz)
+
+	m, err := readManifest(tr)
+	if err != nil {
+		return nil, err
+	}
+	if m.SchemaVersion > 0 {
+		if s.db == nil {
+			return nil, errors.New("archive holds Postgres data but no database is configured")
+		}
+		all, err := migrations.All()
+		if err != nil {
+			return nil, err
+		}
+		if latest := all[len(all)-1].Version; m.SchemaVersion > latest {
+			return nil, fmt.Errorf("archive schema version %d is newer than this release's %d; restore with a later release", m.SchemaVersion, latest)
+		}
+		if _, err := migrations.Up(ctx, s.db); err != nil {
+			return nil, fmt.Errorf("failed to migrate before restoring: %w", err)
+		}
+	}
+
+	// Tables come first in the archive and are loaded in one transaction,
+	// committed once the first entry of another kind is reached
+	var tables *loader
+	defer func() {
+		if tables != nil {
+			tables.rollback()
+		}
+	}()
+	commit := func() error {
+		if tables == nil {
+			return nil
+		}
+		err := tables.commit(ctx)
+		tables = nil
+		return err
+	}
+
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read archive: %w", err)
+		}
+
+		switch name := hdr.Name; {
+		case strings.HasPrefix(name, postgresDir):
+			if s.db == nil {
+				return nil, errors.New("archive holds Postgres data but no database is configured")
+			}
+			if tables == nil {
+				if tables, err = newLoader(ctx, s.db); err != nil {
+					return nil, err
+				}
+			}
+			if err := tables.load(ctx, strings.TrimPrefix(name, postgresDir), tr); err != nil {
+				return nil, err
+			}
+
+		case strings.HasPrefix(name, qdrantDir):
+			if err := commit(); err != nil {
+				return nil, err
+			}
+			if s.vectors == nil {
+				return nil, errors.New("archive holds vector collections but no vector store is configured")
+			}
+			collection := strings.TrimSuffix(strings.TrimPrefix(name, qdrantDir), ".snapshot")
+			if err := s.vectors.RestoreSnapshot(ctx, collection, tr); err != nil {
+				return nil, err
+			}
+
+		case strings.HasPrefix(name, filesDir):
+			if err := commit(); err != nil {
+				return nil, err
+			}
+			if s.files == nil {
+				return nil, errors.New("archive holds files but no file storage is configured")
+			}
+			key := strings.TrimPrefix(name, filesDir)
+			if err := s.files.Put(ctx, key, tr, hdr.Size, hdr.PAXRecords[contentTypeRecord]); err != nil {
+				return nil, fmt.Errorf("failed to restore file %s: %w", key, err)
+			}
+		}
+	}
+	if err := commit(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// addFile archives one stored file with its content type
+func (s *Service) addFile(ctx context.Context, tw *tar.Writer, obj storage.ObjectInfo) error {
+	rc, err := s.files.Get(ctx, obj.Key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil // deleted since it was listed
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read file %s: %w", obj.Key, err)
+	}
+	defer rc.Close()
+
+	hdr := &tar.Header{Name: filesDir + obj.Key, Size: obj.Size, ModTime: obj.LastModified}
+	if obj.ContentType != "" {
+		hdr.PAXRecords = map[string]string{contentTypeRecord: obj.ContentType}
+	}
+	if obj.Size < 0 {
+		return spoolEntry(tw, hdr.Name, func(w io.Writer) error {
+			_, err := io.Copy(w, rc)
+			return err
+		})
+	}
+	return writeEntry(tw, hdr, rc)
+}
+
+// readManifest reads the archive's first entry
+func readManifest(tr *tar.Reader) (*Manifest, error) {
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != manifestName {
+		return nil, errors.New("not a backup archive: manifest missing")
+	}
+	var m Manifest
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return nil, fmt.Errorf("invalid backup manifest: %w", err)
+	}
+	if m.Format != FormatVersion {
+		return nil, fmt.Errorf("unsupported backup format %d", m.Format)
+	}
+	return &m, nil
+}
+
+// writeEntry adds an entry of hdr.Size bytes read from r
+func writeEntry(tw *tar.Writer, hdr *tar.Header, r io.Reader) error {
+	hdr.Mode = 0o600
+	if hdr.ModTime.IsZero() {
+		hdr.ModTime = time.Now()
+	}
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err := io.Copy(tw, r)
+	return err
+}
+
+// spoolEntry adds an entry with the output of write, which is staged in a
+// temporary file because a tar header needs the size up front
+func spoolEntry(tw *tar.Writer, name string, write func(io.Writer) error) error {
+	f, err := os.CreateTemp("", "backup-entry-*")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		f.Close()
+		os.Remove(f.Name())
+	}()
+
+	if err := write(f); err != nil {
+		return err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	return writeEntry(tw, &tar.Header{Name: name, Size: size}, f)
+}
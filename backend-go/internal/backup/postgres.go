@@ -0,0 +1,251 @@
+package backup
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// table is a table of the schema
+type table struct {
+	Name    string
+	Secured bool // row-level security on the tenant: rows are read and written per tenant
+}
+
+// schemaVersion returns the latest applied migration
+func schemaVersion(ctx context.Context, tx pgx.Tx) (int, error) {
+	var version int
+	err := tx.QueryRow(ctx, `
+		SELECT CASE WHEN to_regclass('schema_migrations') IS NULL THEN 0
+		       ELSE (SELECT COALESCE(MAX(version), 0) FROM schema_migrations) END`).Scan(&version)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, nil
+}
+
+// listTables returns the tables of the current schema, except the migration
+// log, with every table after the tables it references
+func listTables(ctx context.Context, tx pgx.Tx) ([]table, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT c.relname, c.relrowsecurity
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		WHERE n.nspname = current_schema() AND c.relkind = 'r' AND NOT c.relispartition
+		  AND c.relname <> 'schema_migrations'
+		ORDER BY c.relname`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tables: %w", err)
+	}
+	var tables []table
+	for rows.Next() {
+		var t table
+		if err := rows.Scan(&t.Name, &t.Secured); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		tables = append(tables, t)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	rows, err = tx.Query(ctx, `
+		SELECT DISTINCT c.conrelid::regclass::text, c.confrelid::regclass::text
+		FROM pg_constraint c
+		JOIN pg_class t ON t.oid = c.conrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		WHERE c.contype = 'f' AND n.nspname = current_schema()`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list foreign keys: %w", err)
+	}
+	defer rows.Close()
+	references := make(map[string][]string)
+	for rows.Next() {
+		var from, to string
+		if err := rows.Scan(&from, &to); err != nil {
+			return nil, err
+		}
+		references[from] = append(references[from], to)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return dependencyOrder(tables, references), nil
+}
+
+// dependencyOrder sorts tables so each follows the tables it references.
+// Tables in a reference cycle keep their relative order.
+func dependencyOrder(tables []table, references map[string][]string) []table {
+	byName := make(map[string]table, len(tables))
+	for _, t := range tables {
+		byName[t.Name] = t
+	}
+
+	ordered := make([]table, 0, len(tables))
+	visited := make(map[string]bool, len(tables))
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		parents := references[name]
+		sort.Strings(parents)
+		for _, parent := range parents {
+			if _, ok := byName[parent]; ok {
+				visit(parent)
+			}
+		}
+		ordered = append(ordered, byName[name])
+	}
+	for _, t := range tables {
+		visit(t.Name)
+	}
+	return ordered
+}
+
+// copyOut writes a table's rows visible to the transaction as CSV with a header
+func copyOut(ctx context.Context, tx pgx.Tx, name string, w io.Writer) error {
+	sql := "COPY " + pgx.Identifier{name}.Sanitize() + " TO STDOUT WITH (FORMAT csv, HEADER true)"
+	if _, err := tx.Conn().PgConn().CopyTo(ctx, w, sql); err != nil {
+		return fmt.Errorf("failed to back up table %s: %w", name, err)
+	}
+	return nil
+}
+
+// setTenant scopes row-level secured tables to a tenant for the rest of the transaction
+func setTenant(ctx context.Context, tx pgx.Tx, tenantID string) error {
+	if _, err := tx.Exec(ctx, "SELECT set_config('app.tenant_id', $1, true)", tenantID); err != nil {
+		return fmt.Errorf("failed to switch to tenant %s: %w", tenantID, err)
+	}
+	return nil
+}
+
+// loader reloads tables in one transaction. Every table is emptied first and
+// user triggers are off while loading, so restored rows keep their
+// timestamps and versions; foreign keys are still checked.
+type loader struct {
+	tx     pgx.Tx
+	tables map[string]bool
+}
+
+// newLoader starts the restore transaction and empties every table
+func newLoader(ctx context.Context, db *pgxpool.Pool) (*loader, error) {
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start restore transaction: %w", err)
+	}
+	l := &loader{tx: tx, tables: make(map[string]bool)}
+
+	tables, err := listTables(ctx, tx)
+	if err != nil {
+		l.rollback()
+		return nil, err
+	}
+	names := make([]string, len(tables))
+	for i, t := range tables {
+		names[i] = pgx.Identifier{t.Name}.Sanitize()
+		l.tables[t.Name] = true
+		if _, err := tx.Exec(ctx, "ALTER TABLE "+names[i]+" DISABLE TRIGGER USER"); err != nil {
+			l.rollback()
+			return nil, fmt.Errorf("failed to disable triggers on %s: %w", t.Name, err)
+		}
+	}
+	if len(names) > 0 {
+		if _, err := tx.Exec(ctx, "TRUNCATE "+strings.Join(names, ", ")+" CASCADE"); err != nil {
+			l.rollback()
+			return nil, fmt.Errorf("failed to empty tables: %w", err)
+		}
+	}
+	return l, nil
+}
+
+// load inserts the rows of one archived table file, TABLE.csv or TENANT/TABLE.csv.
+// Rows go through a temporary table because COPY FROM cannot write to tables
+// with row-level security. Columns the archive lacks, e.g. ones added by a
+// later migration, get their defaults.
+func (l *loader) load(ctx context.Context, file string, r io.Reader) error {
+	name := strings.TrimSuffix(file, ".csv")
+	tenantID, name, secured := strings.Cut(name, "/")
+	if !secured {
+		name = tenantID
+	}
+	if !l.tables[name] {
+		return fmt.Errorf("archived table %s does not exist in this schema", name)
+	}
+	if secured {
+		if err := setTenant(ctx, l.tx, tenantID); err != nil {
+			return err
+		}
+	}
+
+	br := bufio.NewReader(r)
+	header, err := br.ReadString('\n')
+	if err != nil && header == "" {
+		return fmt.Errorf("archived table %s has no header", name)
+	}
+	columns, err := csv.NewReader(strings.NewReader(header)).Read()
+	if err != nil {
+		return fmt.Errorf("archived table %s has an invalid header: %w", name, err)
+	}
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = pgx.Identifier{c}.Sanitize()
+	}
+	list := strings.Join(quoted, ", ")
+	target := pgx.Identifier{name}.Sanitize()
+
+	steps := []func() error{
+		func() error {
+			_, err := l.tx.Exec(ctx, "CREATE TEMP TABLE restore_rows (LIKE "+target+" INCLUDING DEFAULTS) ON COMMIT DROP")
+			return err
+		},
+		func() error {
+			sql := "COPY restore_rows (" + list + ") FROM STDIN WITH (FORMAT csv, HEADER true)"
+			_, err := l.tx.Conn().PgConn().CopyFrom(ctx, io.MultiReader(strings.NewReader(header), br), sql)
+			return err
+		},
+		func() error {
+			_, err := l.tx.Exec(ctx, "INSERT INTO "+target+" ("+list+") SELECT "+list+" FROM restore_rows")
+			return err
+		},
+		func() error {
+			_, err := l.tx.Exec(ctx, "DROP TABLE restore_rows")
+			return err
+		},
+	}
+	for _, step := range steps {
+		if err := step(); err != nil {
+			return fmt.Errorf("failed to restore table %s: %w", file, err)
+		}
+	}
+	return nil
+}
+
+// commit turns triggers back on and commits the reloaded tables
+func (l *loader) commit(ctx context.Context) error {
+	defer l.rollback()
+	for name := range l.tables {
+		if _, err := l.tx.Exec(ctx, "ALTER TABLE "+pgx.Identifier{name}.Sanitize()+" ENABLE TRIGGER USER"); err != nil {
+			return fmt.Errorf("failed to enable triggers on %s: %w", name, err)
+		}
+	}
+	if err := l.tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit restored tables: %w", err)
+	}
+	return nil
+}
+
+// rollback abandons the restore transaction; after a commit it does nothing
+func (l *loader) rollback() {
+	_ = l.tx.Rollback(context.Background())
+}
@@ -0,0 +1,81 @@
+package cache
+
+import "container/list"
+
+// Event identifies a domain change that can make cached responses stale
+type Event string
+
+const (
+	EventScrapeCompleted     Event = "scrape_completed"
+	EventApplicationChanged  Event = "application_changed"
+	EventSavedSearchChanged  Event = "saved_search_changed"
+	EventCommuteHomeChanged  Event = "commute_home_changed"
+	EventSourceWeightChanged Event = "source_weight_changed"
+)
+
+// On registers key prefixes to invalidate whenever event is emitted
+func (c *LRU) On(event Event, prefixes ...string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.hooks == nil {
+		c.hooks = make(map[Event][]string)
+	}
+	c.hooks[event] = append(c.hooks[event], prefixes...)
+}
+
+// Subscribe registers fn to run after event has been emitted and its prefixes invalidated
+func (c *LRU) Subscribe(event Event, fn func()) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.subscribers == nil {
+		c.subscribers = make(map[Event][]func())
+	}
+	c.subscribers[event] = append(c.subscribers[event], fn)
+}
+
+// Emit invalidates all prefixes registered for event, notifies subscribers,
+// and returns the number of removed entries
+func (c *LRU) Emit(event Event) int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	prefixes := append([]string(nil), c.hooks[event]...)
+	subscribers := append([]func(){}, c.subscribers[event]...)
+	c.mu.Unlock()
+
+	removed := 0
+	for _, prefix := range prefixes {
+		removed += c.DeletePrefix(prefix)
+	}
+	for _, fn := range subscribers {
+		fn()
+	}
+	return removed
+}
+
+// Flush removes every entry, or only those matching prefix when it is non-empty
+func (c *LRU) Flush(prefix string) int {
+	if c == nil {
+		return 0
+	}
+	if prefix != "" {
+		return c.DeletePrefix(prefix)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := c.order.Len()
+	c.items = make(map[string]*list.Element)
+	c.order.Init()
+	return removed
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+)
+
+// LRU is a size-bounded, TTL-aware in-memory cache safe for concurrent use
+type LRU struct {
+	mu      sync.Mutex
+	maxSize int
+	ttl     time.Duration
+	items   map[string]*list.Element
+	order   *list.List
+	hooks   map[Event][]string
+
+	subscribers map[Event][]func()
+
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+type entry struct {
+	key       string
+	value     interface{}
+	expiresAt time.Time
+}
+
+// Stats holds cache hit/miss metrics
+type Stats struct {
+	Hits      uint64  `json:"hits"`
+	Misses    uint64  `json:"misses"`
+	Evictions uint64  `json:"evictions"`
+	Size      int     `json:"size"`
+	MaxSize   int     `json:"max_size"`
+	HitRate   float64 `json:"hit_rate"`
+}
+
+// New creates a new LRU cache from configuration.
+// Returns nil when caching is disabled; a nil *LRU is safe to use and never hits.
+func New(cfg config.CacheConfig) *LRU {
+	if !cfg.Enabled {
+		return nil
+	}
+	maxSize := cfg.MaxSize
+	if maxSize <= 0 {
+		maxSize = 1000
+	}
+	return &LRU{
+		maxSize: maxSize,
+		ttl:     cfg.TTL,
+		items:   make(map[string]*list.Element),
+		order:   list.New(),
+	}
+}
+
+// Get returns the cached value for key if present and not expired
+func (c *LRU) Get(key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+
+	e := el.Value.(*entry)
+	if !e.expiresAt.IsZero() && time.Now().After(e.expiresAt) {
+		c.removeElement(el)
+		c.misses++
+		return nil, false
+	}
+
+	c.order.MoveToFront(el)
+	c.hits++
+	return e.value, true
+}
+
+// Set stores a value using the default TTL
+func (c *LRU) Set(key string, value interface{}) {
+	c.SetWithTTL(key, value, c.defaultTTL())
+}
+
+// SetWithTTL stores a value with an explicit TTL (0 = no expiry)
+func (c *LRU) SetWithTTL(key string, value interface{}, ttl time.Duration) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*entry)
+		e.value = value
+		e.expiresAt = expiresAt
+		c.order.MoveToFront(el)
+		return
+	}
+
+	el := c.order.PushFront(&entry{key: key, value: value, expiresAt: expiresAt})
+	c.items[key] = el
+
+	for c.order.Len() > c.maxSize {
+		c.removeElement(c.order.Back())
+		c.evictions++
+	}
+}
+
+// Delete removes a key from the cache
+func (c *LRU) Delete(key string) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.items[key]; ok {
+		c.removeElement(el)
+	}
+}
+
+// DeletePrefix removes all keys starting with prefix and returns how many were removed
+func (c *LRU) DeletePrefix(prefix string) int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	removed := 0
+	for key, el := range c.items {
+		if strings.HasPrefix(key, prefix) {
+			c.removeElement(el)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Stats returns a snapshot of cache metrics
+func (c *LRU) Stats() Stats {
+	if c == nil {
+		return Stats{}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := Stats{
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+		Size:      c.order.Len(),
+		MaxSize:   c.maxSize,
+	}
+	if total := c.hits + c.misses; total > 0 {
+		stats.HitRate = float64(c.hits) / float64(total)
+	}
+	return stats
+}
+
+func (c *LRU) defaultTTL() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return c.ttl
+}
+
+func (c *LRU) removeElement(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*entry).key)
+}
@@ -0,0 +1,103 @@
+package changes
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// DefaultJournalSize is how many changes the in-memory journal keeps
+const DefaultJournalSize = 10000
+
+// ErrCursorExpired is returned when changes after a version are no longer kept
+var ErrCursorExpired = errors.New("sync cursor expired")
+
+// Journal records changes to jobs and applications under increasing versions
+type Journal interface {
+	// Record notes that a record changed, or was deleted
+	Record(ctx context.Context, entity domain.ChangeEntity, id uuid.UUID, deleted bool) error
+	// Since returns up to limit changes after version, oldest first, with
+	// only the latest change for each record. Records are not loaded.
+	Since(ctx context.Context, version int64, limit int) ([]domain.Change, error)
+}
+
+type changeKey struct {
+	entity domain.ChangeEntity
+	id     uuid.UUID
+}
+
+// MemoryJournal keeps the most recent changes in memory.
+// Used when no database is connected; only changes made through the API
+// are seen, and a cursor older than the oldest kept change expires.
+type MemoryJournal struct {
+	mu      sync.Mutex
+	size    int
+	version int64
+	floor   int64 // highest version dropped from the journal
+	entries []domain.Change
+	latest  map[changeKey]int64
+}
+
+// NewMemoryJournal creates an in-memory journal keeping up to size changes
+func NewMemoryJournal(size int) *MemoryJournal {
+	if size <= 0 {
+		size = DefaultJournalSize
+	}
+	return &MemoryJournal{size: size, latest: make(map[changeKey]int64)}
+}
+
+// Record appends a change under the next version
+func (m *MemoryJournal) Record(ctx context.Context, entity domain.ChangeEntity, id uuid.UUID, deleted bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.version++
+	m.entries = append(m.entries, domain.Change{
+		Entity:    entity,
+		ID:        id,
+		Version:   m.version,
+		Deleted:   deleted,
+		ChangedAt: time.Now(),
+	})
+	m.latest[changeKey{entity, id}] = m.version
+
+	if over := len(m.entries) - m.size; over > 0 {
+		for _, e := range m.entries[:over] {
+			key := changeKey{e.Entity, e.ID}
+			if m.latest[key] == e.Version {
+				delete(m.latest, key)
+			}
+		}
+		m.floor = m.entries[over-1].Version
+		m.entries = append([]domain.Change(nil), m.entries[over:]...)
+	}
+	return nil
+}
+
+// Since returns the changes after version, skipping ones superseded by a
+// later change to the same record
+func (m *MemoryJournal) Since(ctx context.Context, version int64, limit int) ([]domain.Change, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// A first sync gets whatever is still kept
+	if version > 0 && version < m.floor {
+		return nil, ErrCursorExpired
+	}
+	out := []domain.Change{}
+	for _, e := range m.entries {
+		if len(out) == limit {
+			break
+		}
+		if e.Version <= version || m.latest[changeKey{e.Entity, e.ID}] != e.Version {
+			continue
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
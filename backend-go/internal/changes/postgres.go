@@ -0,0 +1,59 @@
+package changes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresJournal reads changes from the sync versions the database keeps on
+// jobs and applications, and from tombstones left by purged rows
+type PostgresJournal struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresJournal creates a Postgres-backed journal
+func NewPostgresJournal(db *pgxpool.Pool) *PostgresJournal {
+	return &PostgresJournal{db: db}
+}
+
+// Record is a no-op; triggers bump versions on every write
+func (p *PostgresJournal) Record(ctx context.Context, entity domain.ChangeEntity, id uuid.UUID, deleted bool) error {
+	return nil
+}
+
+// Since returns the records written after version. Soft-deleted records are
+// reported as deleted.
+func (p *PostgresJournal) Since(ctx context.Context, version int64, limit int) ([]domain.Change, error) {
+	rows, err := p.db.Query(ctx, `
+		SELECT 'job', id, sync_version, deleted_at IS NOT NULL, COALESCE(deleted_at, updated_at)
+		FROM jobs WHERE sync_version > $1
+		UNION ALL
+		SELECT 'application', id, sync_version, deleted_at IS NOT NULL, COALESCE(deleted_at, updated_at)
+		FROM applications WHERE sync_version > $1
+		UNION ALL
+		SELECT entity, entity_id, sync_version, TRUE, deleted_at
+		FROM sync_tombstones WHERE sync_version > $1
+		ORDER BY 3
+		LIMIT $2`, version, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query changes: %w", err)
+	}
+	defer rows.Close()
+
+	out := []domain.Change{}
+	for rows.Next() {
+		var c domain.Change
+		var entity string
+		if err := rows.Scan(&entity, &c.ID, &c.Version, &c.Deleted, &c.ChangedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan change: %w", err)
+		}
+		c.Entity = domain.ChangeEntity(entity)
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
@@ -0,0 +1,87 @@
+package changes
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// MaxLimit bounds the changes returned per page
+const MaxLimit = 1000
+
+// ErrInvalidCursor is returned for a cursor this server did not issue
+var ErrInvalidCursor = errors.New("invalid sync cursor")
+
+// Source loads the current state of changed records
+type Source interface {
+	GetJobDetails(ctx context.Context, jobID uuid.UUID) (*domain.Job, error)
+	GetApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error)
+}
+
+// Service pages through the journal for sync clients
+type Service struct {
+	journal Journal
+	source  Source
+}
+
+// NewService creates a sync service
+func NewService(journal Journal, source Source) *Service {
+	return &Service{journal: journal, source: source}
+}
+
+// Changes returns the changes after cursor, with each changed record loaded.
+// An empty cursor starts from the beginning.
+func (s *Service) Changes(ctx context.Context, cursor string, limit int) (*domain.ChangeSet, error) {
+	var since int64
+	if cursor != "" {
+		v, err := strconv.ParseInt(cursor, 10, 64)
+		if err != nil || v < 0 {
+			return nil, ErrInvalidCursor
+		}
+		since = v
+	}
+
+	entries, err := s.journal.Since(ctx, since, limit+1)
+	if errors.Is(err, ErrCursorExpired) {
+		return &domain.ChangeSet{Changes: []domain.Change{}, Reset: true}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	set := &domain.ChangeSet{Changes: entries, Cursor: cursor}
+	if len(entries) > limit {
+		set.Changes = entries[:limit]
+		set.HasMore = true
+	}
+	for i := range set.Changes {
+		s.load(ctx, &set.Changes[i])
+	}
+	if n := len(set.Changes); n > 0 {
+		set.Cursor = strconv.FormatInt(set.Changes[n-1].Version, 10)
+	}
+	return set, nil
+}
+
+// load attaches a change's record. A record that can no longer be read was
+// removed after the change and is sent as a tombstone.
+func (s *Service) load(ctx context.Context, c *domain.Change) {
+	if c.Deleted {
+		return
+	}
+	var err error
+	switch c.Entity {
+	case domain.ChangeEntityJob:
+		c.Job, err = s.source.GetJobDetails(ctx, c.ID)
+	case domain.ChangeEntityApplication:
+		c.Application, err = s.source.GetApplication(ctx, c.ID)
+	}
+	if err != nil || (c.Job == nil && c.Application == nil) {
+		c.Job, c.Application = nil, nil
+		c.Deleted = true
+	}
+}
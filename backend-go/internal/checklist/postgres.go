@@ -0,0 +1,91 @@
+package checklist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists checklists in the application_checklists table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed checklist store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const checklistColumns = `application_id, items, dismissed, updated_at`
+
+// Get returns an application's checklist, or nil
+func (p *PostgresStore) Get(ctx context.Context, appID uuid.UUID) (*domain.Checklist, error) {
+	c, err := scanChecklist(p.db.QueryRow(ctx, `SELECT `+checklistColumns+` FROM application_checklists WHERE application_id = $1`, appID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checklist: %w", err)
+	}
+	return c, nil
+}
+
+// GetMany returns the saved checklists of the given applications
+func (p *PostgresStore) GetMany(ctx context.Context, appIDs []uuid.UUID) (map[uuid.UUID]*domain.Checklist, error) {
+	rows, err := p.db.Query(ctx, `SELECT `+checklistColumns+` FROM application_checklists WHERE application_id = ANY($1)`, appIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list checklists: %w", err)
+	}
+	defer rows.Close()
+
+	found := make(map[uuid.UUID]*domain.Checklist)
+	for rows.Next() {
+		c, err := scanChecklist(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan checklist: %w", err)
+		}
+		found[c.ApplicationID] = c
+	}
+	return found, rows.Err()
+}
+
+// Save inserts or replaces a checklist
+func (p *PostgresStore) Save(ctx context.Context, c *domain.Checklist) error {
+	items, err := json.Marshal(c.Items)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.db.Exec(ctx, `
+		INSERT INTO application_checklists (`+checklistColumns+`)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (application_id) DO UPDATE SET
+			items = EXCLUDED.items, dismissed = EXCLUDED.dismissed, updated_at = EXCLUDED.updated_at`,
+		c.ApplicationID, items, c.Dismissed, c.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save checklist: %w", err)
+	}
+	return nil
+}
+
+func scanChecklist(row pgx.Row) (*domain.Checklist, error) {
+	var (
+		c     domain.Checklist
+		items []byte
+	)
+	if err := row.Scan(&c.ApplicationID, &items, &c.Dismissed, &c.UpdatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(items, &c.Items); err != nil {
+		return nil, fmt.Errorf("invalid checklist items: %w", err)
+	}
+	return &c, nil
+}
@@ -0,0 +1,280 @@
+package checklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+var (
+	// ErrApplicationNotFound is returned when the application does not exist
+	ErrApplicationNotFound = errors.New("application not found")
+	// ErrItemNotFound is returned when a checklist item does not exist
+	ErrItemNotFound = errors.New("checklist item not found")
+	// ErrInvalid is returned for a malformed item
+	ErrInvalid = errors.New("invalid checklist item")
+)
+
+// stageRank orders statuses by how far along an application is
+var stageRank = map[domain.ApplicationStatus]int{
+	domain.ApplicationStatusSaved:     0,
+	domain.ApplicationStatusApplied:   1,
+	domain.ApplicationStatusScreening: 2,
+	domain.ApplicationStatusInterview: 3,
+	domain.ApplicationStatusOffer:     4,
+}
+
+// ApplicationService reads tracked applications
+type ApplicationService interface {
+	GetApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error)
+}
+
+// Service keeps application checklists, adding each status's template items
+// when an application reaches it
+type Service struct {
+	store     Store
+	apps      ApplicationService
+	templates map[string][]string
+}
+
+// NewService creates a checklist service
+func NewService(store Store, apps ApplicationService, cfg config.ChecklistConfig) *Service {
+	return &Service{store: store, apps: apps, templates: cfg.Templates}
+}
+
+// Templates returns the items added at each status
+func (s *Service) Templates() map[string][]string {
+	return s.templates
+}
+
+// Get returns an application's checklist, adding any template items for its
+// current status
+func (s *Service) Get(ctx context.Context, appID uuid.UUID) (*domain.Checklist, error) {
+	c, _, err := s.load(ctx, appID)
+	return c, err
+}
+
+// AddItem adds a custom item to an application's checklist
+func (s *Service) AddItem(ctx context.Context, appID uuid.UUID, in domain.ChecklistItemInput) (*domain.Checklist, error) {
+	title := strings.TrimSpace(in.Title)
+	if title == "" {
+		return nil, fmt.Errorf("%w: title is required", ErrInvalid)
+	}
+
+	c, app, err := s.load(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	c.Items = append(c.Items, domain.ChecklistItem{
+		ID:        "custom:" + uuid.NewString()[:8],
+		Title:     title,
+		Stage:     app.Status,
+		Custom:    true,
+		CreatedAt: now,
+	})
+	return c, s.save(ctx, c, now)
+}
+
+// UpdateItem renames an item or marks it done or open
+func (s *Service) UpdateItem(ctx context.Context, appID uuid.UUID, itemID string, update domain.ChecklistItemUpdate) (*domain.Checklist, error) {
+	if update.Title != nil && strings.TrimSpace(*update.Title) == "" {
+		return nil, fmt.Errorf("%w: title cannot be empty", ErrInvalid)
+	}
+
+	c, _, err := s.load(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	item := find(c, itemID)
+	if item == nil {
+		return nil, ErrItemNotFound
+	}
+
+	now := time.Now()
+	if update.Title != nil {
+		item.Title = strings.TrimSpace(*update.Title)
+	}
+	if update.Done != nil && *update.Done != item.Done {
+		item.Done = *update.Done
+		item.DoneAt = nil
+		if item.Done {
+			item.DoneAt = &now
+		}
+	}
+	return c, s.save(ctx, c, now)
+}
+
+// DeleteItem removes an item. Removed template items are not added again.
+func (s *Service) DeleteItem(ctx context.Context, appID uuid.UUID, itemID string) (*domain.Checklist, error) {
+	c, _, err := s.load(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := c.Items[:0]
+	var removed *domain.ChecklistItem
+	for i := range c.Items {
+		if c.Items[i].ID == itemID {
+			item := c.Items[i]
+			removed = &item
+			continue
+		}
+		items = append(items, c.Items[i])
+	}
+	if removed == nil {
+		return nil, ErrItemNotFound
+	}
+	c.Items = items
+	if !removed.Custom {
+		c.Dismissed = append(c.Dismissed, removed.ID)
+	}
+	return c, s.save(ctx, c, time.Now())
+}
+
+// NextActions returns the next open item on each active application, most
+// advanced and most recently updated applications first
+func (s *Service) NextActions(ctx context.Context, apps []domain.Application, limit int) ([]domain.NextAction, error) {
+	var active []domain.Application
+	ids := make([]uuid.UUID, 0, len(apps))
+	for _, app := range apps {
+		if _, ok := stageRank[app.Status]; ok {
+			active = append(active, app)
+			ids = append(ids, app.ID)
+		}
+	}
+	saved, err := s.store.GetMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(active, func(i, j int) bool {
+		a, b := active[i], active[j]
+		if stageRank[a.Status] != stageRank[b.Status] {
+			return stageRank[a.Status] > stageRank[b.Status]
+		}
+		return a.LastUpdated.After(b.LastUpdated)
+	})
+
+	actions := []domain.NextAction{}
+	for _, app := range active {
+		if limit > 0 && len(actions) >= limit {
+			break
+		}
+		c := saved[app.ID]
+		if c == nil {
+			c = &domain.Checklist{ApplicationID: app.ID, Items: []domain.ChecklistItem{}}
+		}
+		s.sync(c, app.Status, time.Now())
+		tally(c)
+
+		next := c.NextItem()
+		if next == nil {
+			continue
+		}
+		actions = append(actions, domain.NextAction{
+			ApplicationID: app.ID,
+			Job:           app.Job,
+			Status:        app.Status,
+			ItemID:        next.ID,
+			Title:         next.Title,
+			Remaining:     c.Total - c.Completed,
+		})
+	}
+	return actions, nil
+}
+
+// load returns an application's checklist with its template items added,
+// saving it when the template added anything
+func (s *Service) load(ctx context.Context, appID uuid.UUID) (*domain.Checklist, *domain.Application, error) {
+	app, err := s.apps.GetApplication(ctx, appID)
+	if err != nil {
+		return nil, nil, ErrApplicationNotFound
+	}
+	c, err := s.store.Get(ctx, appID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if c == nil {
+		c = &domain.Checklist{ApplicationID: appID, Items: []domain.ChecklistItem{}}
+	}
+
+	now := time.Now()
+	if s.sync(c, app.Status, now) {
+		if err := s.save(ctx, c, now); err != nil {
+			return nil, nil, err
+		}
+	}
+	tally(c)
+	return c, app, nil
+}
+
+// sync adds the template items for status that are missing and were not
+// dismissed, and reports whether any were added
+func (s *Service) sync(c *domain.Checklist, status domain.ApplicationStatus, now time.Time) bool {
+	added := false
+	for _, title := range s.templates[string(status)] {
+		id := string(status) + ":" + slug(title)
+		if find(c, id) != nil || contains(c.Dismissed, id) {
+			continue
+		}
+		c.Items = append(c.Items, domain.ChecklistItem{
+			ID:        id,
+			Title:     title,
+			Stage:     status,
+			CreatedAt: now,
+		})
+		added = true
+	}
+	return added
+}
+
+func (s *Service) save(ctx context.Context, c *domain.Checklist, now time.Time) error {
+	c.UpdatedAt = now
+	tally(c)
+	return s.store.Save(ctx, c)
+}
+
+// tally counts completed and total items
+func tally(c *domain.Checklist) {
+	c.Total, c.Completed = len(c.Items), 0
+	for _, item := range c.Items {
+		if item.Done {
+			c.Completed++
+		}
+	}
+}
+
+func find(c *domain.Checklist, id string) *domain.ChecklistItem {
+	for i := range c.Items {
+		if c.Items[i].ID == id {
+			return &c.Items[i]
+		}
+	}
+	return nil
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// slug reduces a title to lowercase words joined by hyphens
+func slug(title string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), "-")
+}
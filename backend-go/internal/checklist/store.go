@@ -0,0 +1,72 @@
+package checklist
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Store persists application checklists
+type Store interface {
+	// Get returns an application's checklist, or nil when none is saved
+	Get(ctx context.Context, appID uuid.UUID) (*domain.Checklist, error)
+	// GetMany returns the saved checklists of the given applications
+	GetMany(ctx context.Context, appIDs []uuid.UUID) (map[uuid.UUID]*domain.Checklist, error)
+	Save(ctx context.Context, c *domain.Checklist) error
+}
+
+// MemoryStore keeps checklists in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu         sync.RWMutex
+	checklists map[uuid.UUID]domain.Checklist
+}
+
+// NewMemoryStore creates an in-memory checklist store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checklists: make(map[uuid.UUID]domain.Checklist)}
+}
+
+// Get returns an application's checklist, or nil
+func (m *MemoryStore) Get(ctx context.Context, appID uuid.UUID) (*domain.Checklist, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	c, ok := m.checklists[appID]
+	if !ok {
+		return nil, nil
+	}
+	c = clone(c)
+	return &c, nil
+}
+
+// GetMany returns the saved checklists of the given applications
+func (m *MemoryStore) GetMany(ctx context.Context, appIDs []uuid.UUID) (map[uuid.UUID]*domain.Checklist, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	found := make(map[uuid.UUID]*domain.Checklist)
+	for _, id := range appIDs {
+		if c, ok := m.checklists[id]; ok {
+			c = clone(c)
+			found[id] = &c
+		}
+	}
+	return found, nil
+}
+
+// Save inserts or replaces a checklist
+func (m *MemoryStore) Save(ctx context.Context, c *domain.Checklist) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.checklists[c.ApplicationID] = clone(*c)
+	return nil
+}
+
+// clone copies c so stored items are not shared with callers
+func clone(c domain.Checklist) domain.Checklist {
+	c.Items = append([]domain.ChecklistItem(nil), c.Items...)
+	c.Dismissed = append([]string(nil), c.Dismissed...)
+	return c
+}
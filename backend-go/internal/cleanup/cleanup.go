@@ -0,0 +1,229 @@
+// Package cleanup moves jobs matching operator criteria to the trash in bulk,
+// in the background, so the corpus can be kept lean without raw SQL. Trashed
+// jobs are purged later by the retention worker.
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/operations"
+	"github.com/resume-rag/backend/internal/taskevents"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+const (
+	// batchSize is how many jobs one statement moves to the trash
+	batchSize = 500
+	// historySize is how many cleanups are kept for status polling
+	historySize = 50
+	// operationKind names cleanups in the operations registry
+	operationKind = "job_cleanup"
+)
+
+var (
+	// ErrRunning is returned when a cleanup is started while another runs
+	ErrRunning = errors.New("a job cleanup is already running")
+	// ErrNotFound is returned for unknown cleanups
+	ErrNotFound = errors.New("job cleanup not found")
+)
+
+// Store selects and trashes jobs
+type Store interface {
+	CountCleanupJobs(ctx context.Context, criteria domain.JobCleanupCriteria) (int, error)
+	TrashCleanupBatch(ctx context.Context, criteria domain.JobCleanupCriteria, limit int) ([]uuid.UUID, error)
+}
+
+// ChangeRecorder notes trashed jobs for sync clients
+type ChangeRecorder interface {
+	Record(ctx context.Context, entity domain.ChangeEntity, id uuid.UUID, deleted bool) error
+}
+
+type task struct {
+	tenant string
+	info   domain.JobCleanup
+}
+
+// Runner runs one cleanup at a time and remembers recent ones. Running
+// cleanups are registered as operations, so their owner can cancel them.
+type Runner struct {
+	store    Store
+	changes  ChangeRecorder
+	registry *operations.Registry
+	events   *taskevents.Log
+
+	mu      sync.Mutex
+	running bool
+	tasks   map[uuid.UUID]*task
+	order   []uuid.UUID
+}
+
+// NewRunner creates a cleanup runner. changes and registry may be nil.
+func NewRunner(store Store, changes ChangeRecorder, registry *operations.Registry) *Runner {
+	return &Runner{
+		store:    store,
+		changes:  changes,
+		registry: registry,
+		tasks:    make(map[uuid.UUID]*task),
+	}
+}
+
+// SetTaskEvents records each cleanup's steps on the shared task timeline
+func (r *Runner) SetTaskEvents(events *taskevents.Log) {
+	r.events = events
+}
+
+// Preview returns how many jobs a cleanup with criteria would trash
+func (r *Runner) Preview(ctx context.Context, criteria domain.JobCleanupCriteria) (int, error) {
+	return r.store.CountCleanupJobs(ctx, criteria)
+}
+
+// Start counts the jobs matching criteria and trashes them in the background.
+// The cleanup runs as the caller's tenant and is owned by owner.
+func (r *Runner) Start(ctx context.Context, owner string, criteria domain.JobCleanupCriteria) (*domain.JobCleanup, error) {
+	r.mu.Lock()
+	if r.running {
+		r.mu.Unlock()
+		return nil, ErrRunning
+	}
+	r.running = true
+	r.mu.Unlock()
+
+	cleanup, err := r.start(ctx, owner, criteria)
+	if err != nil {
+		r.mu.Lock()
+		r.running = false
+		r.mu.Unlock()
+		return nil, err
+	}
+	return cleanup, nil
+}
+
+func (r *Runner) start(ctx context.Context, owner string, criteria domain.JobCleanupCriteria) (*domain.JobCleanup, error) {
+	matched, err := r.store.CountCleanupJobs(ctx, criteria)
+	if err != nil {
+		return nil, err
+	}
+
+	// The request context ends with the response, so the work gets its own
+	// context carrying only the tenant
+	runCtx := context.Background()
+	if t, ok := tenant.FromContext(ctx); ok {
+		runCtx = tenant.WithTenant(runCtx, t)
+	}
+	id := uuid.New()
+	done := func() {}
+	if r.registry != nil {
+		runCtx, done, err = r.registry.Start(runCtx, owner, id.String(), operationKind)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t := &task{
+		tenant: tenant.ID(ctx),
+		info: domain.JobCleanup{
+			ID:        id,
+			Criteria:  criteria,
+			Status:    domain.JobCleanupRunning,
+			Matched:   matched,
+			StartedAt: time.Now(),
+		},
+	}
+	r.mu.Lock()
+	r.tasks[id] = t
+	r.order = append(r.order, id)
+	if len(r.order) > historySize {
+		delete(r.tasks, r.order[0])
+		r.order = r.order[1:]
+	}
+	info := t.info
+	r.mu.Unlock()
+
+	r.events.Record(ctx, id, domain.TaskKindCleanup, domain.TaskEventStarted, "Job cleanup started",
+		map[string]interface{}{"matched": matched})
+	go r.run(runCtx, t, done)
+	return &info, nil
+}
+
+// Get returns one of the caller's tenant's cleanups
+func (r *Runner) Get(ctx context.Context, id uuid.UUID) (*domain.JobCleanup, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	t, ok := r.tasks[id]
+	if !ok || t.tenant != tenant.ID(ctx) {
+		return nil, ErrNotFound
+	}
+	info := t.info
+	return &info, nil
+}
+
+// run trashes batches until none match, the cleanup is cancelled or a batch fails
+func (r *Runner) run(ctx context.Context, t *task, done func()) {
+	defer done()
+
+	var err error
+	for ctx.Err() == nil {
+		var ids []uuid.UUID
+		ids, err = r.store.TrashCleanupBatch(ctx, t.info.Criteria, batchSize)
+		if err != nil || len(ids) == 0 {
+			break
+		}
+		if r.changes != nil {
+			for _, id := range ids {
+				_ = r.changes.Record(ctx, domain.ChangeEntityJob, id, true)
+			}
+		}
+		r.mu.Lock()
+		t.info.Trashed += len(ids)
+		trashed := t.info.Trashed
+		r.mu.Unlock()
+		r.events.Record(ctx, t.info.ID, domain.TaskKindCleanup, domain.TaskEventProgress, "Batch moved to trash",
+			map[string]interface{}{"trashed": trashed, "matched": t.info.Matched})
+	}
+
+	r.mu.Lock()
+	now := time.Now()
+	t.info.FinishedAt = &now
+	switch {
+	case errors.Is(context.Cause(ctx), operations.ErrCancelled):
+		t.info.Status = domain.JobCleanupCancelled
+	case err != nil:
+		msg := err.Error()
+		t.info.Error = &msg
+		t.info.Status = domain.JobCleanupFailed
+	default:
+		t.info.Status = domain.JobCleanupCompleted
+	}
+	r.running = false
+	info := t.info
+	r.mu.Unlock()
+
+	// The final event is recorded even when the cleanup was cancelled
+	data := map[string]interface{}{"matched": info.Matched, "trashed": info.Trashed}
+	eventCtx := context.WithoutCancel(ctx)
+	switch info.Status {
+	case domain.JobCleanupCancelled:
+		r.events.Record(eventCtx, info.ID, domain.TaskKindCleanup, domain.TaskEventCancelled, "Job cleanup cancelled", data)
+	case domain.JobCleanupFailed:
+		r.events.Record(eventCtx, info.ID, domain.TaskKindCleanup, domain.TaskEventFailed, "Job cleanup failed: "+*info.Error, data)
+	default:
+		r.events.Record(eventCtx, info.ID, domain.TaskKindCleanup, domain.TaskEventCompleted, "Job cleanup completed", data)
+	}
+
+	logger.Info("Job cleanup finished",
+		zap.String("id", info.ID.String()),
+		zap.String("status", string(info.Status)),
+		zap.Int("matched", info.Matched),
+		zap.Int("trashed", info.Trashed),
+		zap.Error(err),
+	)
+}
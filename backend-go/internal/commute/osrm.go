@@ -0,0 +1,85 @@
+package commute
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// osrmProfiles maps commute modes onto OSRM routing profiles. OSRM has no
+// transit routing, so transit falls back to the straight-line estimate.
+var osrmProfiles = map[domain.CommuteMode]string{
+	domain.CommuteDriving: "driving",
+	domain.CommuteCycling: "cycling",
+	domain.CommuteWalking: "walking",
+}
+
+// OSRMRouter routes via an Open Source Routing Machine server
+type OSRMRouter struct {
+	baseURL  string
+	client   *http.Client
+	fallback Router
+}
+
+type osrmResponse struct {
+	Code   string `json:"code"`
+	Routes []struct {
+		Duration float64 `json:"duration"` // seconds
+		Distance float64 `json:"distance"` // meters
+	} `json:"routes"`
+}
+
+// NewOSRMRouter creates an OSRM router
+func NewOSRMRouter(cfg config.CommuteConfig) *OSRMRouter {
+	return &OSRMRouter{
+		baseURL:  strings.TrimRight(cfg.BaseURL, "/"),
+		client:   &http.Client{Timeout: cfg.Timeout},
+		fallback: EstimateRouter{},
+	}
+}
+
+// Route returns the fastest route from from to to
+func (r *OSRMRouter) Route(ctx context.Context, from, to domain.GeoLocation, mode domain.CommuteMode) (*domain.CommuteTime, error) {
+	profile, ok := osrmProfiles[mode]
+	if !ok {
+		return r.fallback.Route(ctx, from, to, mode)
+	}
+
+	url := fmt.Sprintf("%s/route/v1/%s/%f,%f;%f,%f?overview=false",
+		r.baseURL, profile, from.Longitude, from.Latitude, to.Longitude, to.Latitude)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("route request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("route request failed: status %d", resp.StatusCode)
+	}
+
+	var result osrmResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode route response: %w", err)
+	}
+	if result.Code != "Ok" || len(result.Routes) == 0 {
+		return nil, fmt.Errorf("no route found: %s", result.Code)
+	}
+
+	route := result.Routes[0]
+	return &domain.CommuteTime{
+		Mode:       mode,
+		Minutes:    int(math.Round(route.Duration / 60)),
+		DistanceKm: math.Round(route.Distance/100) / 10,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package commute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists the home location in the commute_homes table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed home store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Home returns the saved home location
+func (p *PostgresStore) Home(ctx context.Context) (*domain.CommuteHome, error) {
+	var home domain.CommuteHome
+	loc := &home.Location
+	err := p.db.QueryRow(ctx, `SELECT address, city, state, country, latitude, longitude, mode, updated_at FROM commute_homes`).
+		Scan(&home.Address, &loc.City, &loc.State, &loc.Country, &loc.Latitude, &loc.Longitude, &home.Mode, &home.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNoHome
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load home location: %w", err)
+	}
+	return &home, nil
+}
+
+// SaveHome replaces the home location
+func (p *PostgresStore) SaveHome(ctx context.Context, home *domain.CommuteHome) error {
+	loc := home.Location
+	_, err := p.db.Exec(ctx, `
+		INSERT INTO commute_homes (address, city, state, country, latitude, longitude, mode, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		ON CONFLICT (tenant_id) DO UPDATE SET
+			address = EXCLUDED.address, city = EXCLUDED.city, state = EXCLUDED.state, country = EXCLUDED.country,
+			latitude = EXCLUDED.latitude, longitude = EXCLUDED.longitude,
+			mode = EXCLUDED.mode, updated_at = EXCLUDED.updated_at`,
+		home.Address, loc.City, loc.State, loc.Country, loc.Latitude, loc.Longitude, string(home.Mode), home.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save home location: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package commute
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/resume-rag/backend/internal/cache"
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/geo"
+)
+
+// Router estimates travel time between two points
+type Router interface {
+	Route(ctx context.Context, from, to domain.GeoLocation, mode domain.CommuteMode) (*domain.CommuteTime, error)
+}
+
+// NewRouter creates the routing provider selected by configuration, wrapped in a cache
+func NewRouter(cfg config.CommuteConfig) (Router, error) {
+	var r Router
+	switch cfg.Provider {
+	case "", "estimate":
+		r = EstimateRouter{}
+	case "osrm":
+		r = NewOSRMRouter(cfg)
+	default:
+		return nil, fmt.Errorf("unknown commute provider: %s", cfg.Provider)
+	}
+
+	return NewCachedRouter(r, cache.New(config.CacheConfig{
+		Enabled: true,
+		TTL:     cfg.CacheTTL,
+		MaxSize: cfg.CacheSize,
+	})), nil
+}
+
+// detourFactor converts straight-line distance into typical road distance
+const detourFactor = 1.3
+
+// modeSpeeds are door-to-door average speeds in km/h, with fixed overhead
+// minutes for parking, waiting for transit and so on
+var modeSpeeds = map[domain.CommuteMode]struct {
+	kmh      float64
+	overhead float64
+}{
+	domain.CommuteDriving: {kmh: 35, overhead: 5},
+	domain.CommuteTransit: {kmh: 18, overhead: 10},
+	domain.CommuteCycling: {kmh: 15, overhead: 2},
+	domain.CommuteWalking: {kmh: 5, overhead: 0},
+}
+
+// EstimateRouter approximates commutes from straight-line distance and
+// average speeds. It needs no external service, and backs up providers that
+// do not support a mode.
+type EstimateRouter struct{}
+
+// Route estimates travel time from from to to
+func (EstimateRouter) Route(ctx context.Context, from, to domain.GeoLocation, mode domain.CommuteMode) (*domain.CommuteTime, error) {
+	speed, ok := modeSpeeds[mode]
+	if !ok {
+		return nil, fmt.Errorf("unsupported commute mode: %s", mode)
+	}
+
+	distance := geo.DistanceKm(from, to) * detourFactor
+	minutes := distance/speed.kmh*60 + speed.overhead
+	return &domain.CommuteTime{
+		Mode:       mode,
+		Minutes:    int(math.Round(minutes)),
+		DistanceKm: math.Round(distance*10) / 10,
+		Estimated:  true,
+	}, nil
+}
+
+// CachedRouter memoizes routes, since every listing refresh asks for the same pairs
+type CachedRouter struct {
+	next  Router
+	cache *cache.LRU
+}
+
+// NewCachedRouter wraps a router with a cache
+func NewCachedRouter(next Router, c *cache.LRU) *CachedRouter {
+	return &CachedRouter{next: next, cache: c}
+}
+
+// Route returns the cached route or computes and caches it
+func (r *CachedRouter) Route(ctx context.Context, from, to domain.GeoLocation, mode domain.CommuteMode) (*domain.CommuteTime, error) {
+	// ~10m precision so nearby geocodes of the same address share an entry
+	key := fmt.Sprintf("commute:%s:%.4f,%.4f:%.4f,%.4f", mode, from.Latitude, from.Longitude, to.Latitude, to.Longitude)
+
+	if v, ok := r.cache.Get(key); ok {
+		copied := *v.(*domain.CommuteTime)
+		return &copied, nil
+	}
+
+	t, err := r.next.Route(ctx, from, to, mode)
+	if err != nil {
+		return nil, err
+	}
+
+	r.cache.Set(key, t)
+	copied := *t
+	return &copied, nil
+}
@@ -0,0 +1,184 @@
+package commute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/geo"
+)
+
+var (
+	// ErrNoHome is returned when no home location has been saved
+	ErrNoHome = errors.New("no home location saved")
+	// ErrNoLocation is returned for jobs that cannot be placed on a map
+	ErrNoLocation = errors.New("job has no geocoded location")
+	// ErrNoGeocoder is returned when a home address is given but geocoding is disabled
+	ErrNoGeocoder = errors.New("geocoding is disabled; provide latitude and longitude")
+)
+
+// Service estimates commutes from the user's saved home location
+type Service struct {
+	router   Router
+	geocoder geo.Geocoder
+	store    Store
+	mode     domain.CommuteMode
+
+	mu       sync.RWMutex
+	fallback *domain.CommuteHome // configured home, for users who saved none
+}
+
+// NewService creates a commute service. geocoder may be nil, in which case
+// homes must be given as coordinates and only pre-geocoded jobs are routed.
+func NewService(cfg config.CommuteConfig, router Router, geocoder geo.Geocoder, store Store) *Service {
+	mode := domain.CommuteMode(cfg.DefaultMode)
+	if !mode.Valid() {
+		mode = domain.CommuteTransit
+	}
+	return &Service{router: router, geocoder: geocoder, store: store, mode: mode}
+}
+
+// SetDefaultHome geocodes the configured home address, used until a user
+// saves a home of their own
+func (s *Service) SetDefaultHome(ctx context.Context, address string) error {
+	if address == "" {
+		return nil
+	}
+	home, err := s.locate(ctx, domain.CommuteHomeUpdate{Address: address})
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.fallback = home
+	s.mu.Unlock()
+	return nil
+}
+
+// Home returns the saved home location, else the configured one, else ErrNoHome
+func (s *Service) Home(ctx context.Context) (*domain.CommuteHome, error) {
+	home, err := s.store.Home(ctx)
+	if !errors.Is(err, ErrNoHome) {
+		return home, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.fallback == nil {
+		return nil, ErrNoHome
+	}
+	fallback := *s.fallback
+	return &fallback, nil
+}
+
+// SetHome saves a new home location, geocoding the address unless
+// coordinates are given
+func (s *Service) SetHome(ctx context.Context, update domain.CommuteHomeUpdate) (*domain.CommuteHome, error) {
+	home, err := s.locate(ctx, update)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.SaveHome(ctx, home); err != nil {
+		return nil, err
+	}
+	return home, nil
+}
+
+// locate builds a home location from an update
+func (s *Service) locate(ctx context.Context, update domain.CommuteHomeUpdate) (*domain.CommuteHome, error) {
+	mode := update.Mode
+	if mode == "" {
+		mode = s.mode
+	}
+	if !mode.Valid() {
+		return nil, fmt.Errorf("unsupported commute mode: %s", mode)
+	}
+
+	home := &domain.CommuteHome{
+		Address:   strings.TrimSpace(update.Address),
+		Mode:      mode,
+		UpdatedAt: time.Now(),
+	}
+	switch {
+	case update.Latitude != nil && update.Longitude != nil:
+		home.Location = domain.GeoLocation{Latitude: *update.Latitude, Longitude: *update.Longitude}
+	case home.Address == "":
+		return nil, errors.New("address or latitude and longitude are required")
+	case s.geocoder == nil:
+		return nil, ErrNoGeocoder
+	default:
+		loc, err := s.geocoder.Geocode(ctx, home.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to geocode home address: %w", err)
+		}
+		home.Location = *loc
+	}
+	return home, nil
+}
+
+// Estimate returns the commute from home to job. An empty mode uses the
+// home location's default.
+func (s *Service) Estimate(ctx context.Context, job *domain.Job, mode domain.CommuteMode) (*domain.CommuteTime, error) {
+	home, err := s.Home(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.estimate(ctx, home, job, mode)
+}
+
+func (s *Service) estimate(ctx context.Context, home *domain.CommuteHome, job *domain.Job, mode domain.CommuteMode) (*domain.CommuteTime, error) {
+	if mode == "" {
+		mode = home.Mode
+	}
+
+	dest := job.Geo
+	if dest == nil {
+		if job.Location == nil || !geo.Geocodable(*job.Location) || s.geocoder == nil {
+			return nil, ErrNoLocation
+		}
+		loc, err := s.geocoder.Geocode(ctx, *job.Location)
+		if err != nil {
+			return nil, ErrNoLocation
+		}
+		dest = loc
+	}
+
+	return s.router.Route(ctx, home.Location, *dest, mode)
+}
+
+// Filter annotates jobs with their commute and drops onsite and hybrid jobs
+// beyond filters.MaxCommute. Remote jobs and jobs that cannot be routed are
+// kept, since a missing estimate is not evidence of a long commute.
+func (s *Service) Filter(ctx context.Context, jobs []*domain.Job, filters *domain.JobFilters) []*domain.Job {
+	home, err := s.Home(ctx)
+	if err != nil {
+		return jobs
+	}
+	var mode domain.CommuteMode
+	maxMinutes := 0
+	if filters != nil {
+		mode = filters.CommuteMode
+		if filters.MaxCommute != nil {
+			maxMinutes = *filters.MaxCommute
+		}
+	}
+
+	kept := jobs[:0]
+	for _, job := range jobs {
+		if job.LocationType != nil && *job.LocationType == domain.LocationTypeRemote {
+			kept = append(kept, job)
+			continue
+		}
+		if t, err := s.estimate(ctx, home, job, mode); err == nil {
+			job.Commute = t
+			if maxMinutes > 0 && t.Minutes > maxMinutes {
+				continue
+			}
+		}
+		kept = append(kept, job)
+	}
+	return kept
+}
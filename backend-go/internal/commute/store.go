@@ -0,0 +1,47 @@
+package commute
+
+import (
+	"context"
+	"sync"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Store persists the saved home location
+type Store interface {
+	// Home returns the saved home location, or ErrNoHome
+	Home(ctx context.Context) (*domain.CommuteHome, error)
+	SaveHome(ctx context.Context, home *domain.CommuteHome) error
+}
+
+// MemoryStore keeps the home location in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	home *domain.CommuteHome
+}
+
+// NewMemoryStore creates an in-memory home store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Home returns the saved home location
+func (m *MemoryStore) Home(ctx context.Context) (*domain.CommuteHome, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.home == nil {
+		return nil, ErrNoHome
+	}
+	home := *m.home
+	return &home, nil
+}
+
+// SaveHome replaces the home location
+func (m *MemoryStore) SaveHome(ctx context.Context, home *domain.CommuteHome) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *home
+	m.home = &saved
+	return nil
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -17,7 +18,31 @@ type Config struct {
 	LLM       LLMConfig       `yaml:"llm"`
 	Cache     CacheConfig     `yaml:"cache"`
 	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	LoadShed  LoadShedConfig  `yaml:"load_shed"`
+	Tenancy   TenancyConfig   `yaml:"tenancy"`
 	CORS      CORSConfig      `yaml:"cors"`
+
+	PayloadLog PayloadLogConfig `yaml:"payload_log"`
+	Privacy    PrivacyConfig    `yaml:"privacy"`
+	Encryption EncryptionConfig `yaml:"encryption"`
+	Storage    StorageConfig    `yaml:"storage"`
+	Retention  RetentionConfig  `yaml:"retention"`
+	Geo        GeoConfig        `yaml:"geo"`
+	Enrichment EnrichmentConfig `yaml:"enrichment"`
+	Quality    QualityConfig    `yaml:"quality"`
+	Digest     DigestConfig     `yaml:"digest"`
+	Feeds      FeedConfig       `yaml:"feeds"`
+	Scoring    ScoringConfig    `yaml:"scoring"`
+	Scraping   ScrapingConfig   `yaml:"scraping"`
+	Deadlines  DeadlineConfig   `yaml:"deadlines"`
+	Commute    CommuteConfig    `yaml:"commute"`
+	Push       PushConfig       `yaml:"push"`
+
+	Transcription TranscriptionConfig `yaml:"transcription"`
+	Interview     InterviewConfig     `yaml:"interview"`
+	Outreach      OutreachConfig      `yaml:"outreach"`
+	Checklists    ChecklistConfig     `yaml:"checklists"`
+	Chat          ChatConfig          `yaml:"chat"`
 }
 
 type ServerConfig struct {
@@ -26,6 +51,39 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration `yaml:"read_timeout"`
 	WriteTimeout time.Duration `yaml:"write_timeout"`
 	Debug        bool          `yaml:"debug"`
+
+	// TrustedProxies lists proxy IPs/CIDRs whose ProxyHeader is trusted for the client IP
+	TrustedProxies []string `yaml:"trusted_proxies"`
+	ProxyHeader    string   `yaml:"proxy_header"`
+
+	TLS TLSConfig `yaml:"tls"`
+
+	// UnixSocket listens on a Unix domain socket instead of Host:Port when set
+	UnixSocket     string `yaml:"unix_socket"`
+	UnixSocketMode string `yaml:"unix_socket_mode"`
+
+	// SystemdActivation uses the listener passed by systemd socket activation when present
+	SystemdActivation bool `yaml:"systemd_activation"`
+
+	// StrictJSON rejects request bodies with unknown fields or type mismatches
+	StrictJSON bool `yaml:"strict_json"`
+}
+
+// TLSConfig configures optional TLS termination in the API process
+type TLSConfig struct {
+	Enabled  bool   `yaml:"enabled"`
+	CertFile string `yaml:"cert_file"`
+	KeyFile  string `yaml:"key_file"`
+
+	// Autocert obtains certificates from Let's Encrypt for Hosts
+	Autocert bool     `yaml:"autocert"`
+	Hosts    []string `yaml:"hosts"`
+	CacheDir string   `yaml:"cache_dir"`
+	Email    string   `yaml:"email"`
+
+	// RedirectHTTP serves HTTP->HTTPS redirects (and ACME challenges) on HTTPPort
+	RedirectHTTP bool `yaml:"redirect_http"`
+	HTTPPort     int  `yaml:"http_port"`
 }
 
 type DatabaseConfig struct {
@@ -44,6 +102,10 @@ type PostgresConfig struct {
 }
 
 func (p PostgresConfig) DSN() string {
+	// DATABASE_URL is stored whole in Host
+	if strings.Contains(p.Host, "://") {
+		return p.Host
+	}
 	return "postgres://" + p.User + ":" + p.Password + "@" + p.Host + ":" +
 		strconv.Itoa(p.Port) + "/" + p.Database + "?sslmode=" + p.SSLMode
 }
@@ -57,7 +119,19 @@ type QdrantConfig struct {
 type MLServiceConfig struct {
 	Host    string        `yaml:"host"`
 	Port    int           `yaml:"port"`
-	Timeout time.Duration `yaml:"timeout"`
+	Timeout time.Duration `yaml:"timeout"` // deadline for each call attempt
+	TLS     bool          `yaml:"tls"`
+	// MaxMessageSize caps response size in bytes; batch embeddings outgrow gRPC's 4MB default
+	MaxMessageSize int           `yaml:"max_message_size"`
+	Retry          MLRetryConfig `yaml:"retry"`
+}
+
+// MLRetryConfig retries ML service calls that failed because the service was
+// unavailable, overloaded or too slow, with exponential backoff and jitter
+type MLRetryConfig struct {
+	MaxAttempts int           `yaml:"max_attempts"` // attempts per call, including the first (1 = no retries)
+	BaseDelay   time.Duration `yaml:"base_delay"`   // delay before the first retry, doubled for each further one
+	MaxDelay    time.Duration `yaml:"max_delay"`
 }
 
 func (m MLServiceConfig) Address() string {
@@ -65,26 +139,116 @@ func (m MLServiceConfig) Address() string {
 }
 
 type LLMConfig struct {
-	DefaultBackend string        `yaml:"default_backend"`
-	Groq           GroqConfig    `yaml:"groq"`
-	OpenAI         OpenAIConfig  `yaml:"openai"`
-	Claude         ClaudeConfig  `yaml:"claude"`
-	Timeout        time.Duration `yaml:"timeout"`
+	DefaultBackend string         `yaml:"default_backend"`
+	Groq           GroqConfig     `yaml:"groq"`
+	OpenAI         OpenAIConfig   `yaml:"openai"`
+	Claude         ClaudeConfig   `yaml:"claude"`
+	Timeout        time.Duration  `yaml:"timeout"`
+	MaxTokens      int            `yaml:"max_tokens"`  // reply limit when a request sets none
+	Temperature    float64        `yaml:"temperature"` // sampling temperature when a request sets none
+	Queue          LLMQueueConfig `yaml:"queue"`
+
+	// Fallback is the order backends are tried in when the requested one
+	// fails or its breaker is open; backends without an API key are skipped
+	Fallback []string         `yaml:"fallback"`
+	Breaker  LLMBreakerConfig `yaml:"breaker"`
+
+	Redaction RedactionConfig `yaml:"redaction"`
+}
+
+// LLMBreakerConfig configures the per-backend circuit breaker. A backend
+// failing FailureThreshold requests in a row is skipped for Cooldown, then
+// given one trial request.
+type LLMBreakerConfig struct {
+	FailureThreshold int           `yaml:"failure_threshold"` // 0 disables the breaker
+	Cooldown         time.Duration `yaml:"cooldown"`
+}
+
+// APIKey returns the API key configured for a backend (groq, openai, claude)
+func (l LLMConfig) APIKey(backend string) string {
+	switch backend {
+	case "groq":
+		return l.Groq.APIKey
+	case "openai":
+		return l.OpenAI.APIKey
+	case "claude":
+		return l.Claude.APIKey
+	}
+	return ""
+}
+
+// BackendTimeout returns a backend's request timeout, falling back to Timeout
+func (l LLMConfig) BackendTimeout(backend string) time.Duration {
+	var timeout time.Duration
+	switch backend {
+	case "groq":
+		timeout = l.Groq.Timeout
+	case "openai":
+		timeout = l.OpenAI.Timeout
+	case "claude":
+		timeout = l.Claude.Timeout
+	}
+	if timeout > 0 {
+		return timeout
+	}
+	return l.Timeout
+}
+
+// Cost returns the USD cost of a request to backend from its pricing
+func (l LLMConfig) Cost(backend string, inputTokens, outputTokens int) float64 {
+	var p LLMPricing
+	switch backend {
+	case "groq":
+		p = l.Groq.Pricing
+	case "openai":
+		p = l.OpenAI.Pricing
+	case "claude":
+		p = l.Claude.Pricing
+	}
+	return (float64(inputTokens)*p.Input + float64(outputTokens)*p.Output) / 1e6
+}
+
+// LLMPricing is a backend's price in USD per million tokens, for spend reporting
+type LLMPricing struct {
+	Input  float64 `yaml:"input"`
+	Output float64 `yaml:"output"`
+}
+
+// RedactionConfig strips personal details from resume text before it is sent
+// to an LLM backend, depending on how far the backend is trusted
+type RedactionConfig struct {
+	Enabled      bool                `yaml:"enabled"`
+	DefaultTrust string              `yaml:"default_trust"` // trust level of backends not listed in Trust
+	Trust        map[string]string   `yaml:"trust"`         // backend -> trust level
+	Levels       map[string][]string `yaml:"levels"`        // trust level -> fields stripped (name, email, phone, address, references)
+}
+
+// LLMQueueConfig bounds concurrent requests per LLM backend
+type LLMQueueConfig struct {
+	MaxConcurrent int           `yaml:"max_concurrent"` // in-flight requests per backend
+	MaxQueue      int           `yaml:"max_queue"`      // waiting requests before rejecting with 429
+	MaxWait       time.Duration `yaml:"max_wait"`       // longest a request waits for a slot
 }
 
 type GroqConfig struct {
-	APIKey string `yaml:"api_key"`
-	Model  string `yaml:"model"`
+	APIKey  string        `yaml:"api_key"`
+	Model   string        `yaml:"model"`
+	Timeout time.Duration `yaml:"timeout"` // 0 = llm.timeout
+	Pricing LLMPricing    `yaml:"pricing"`
 }
 
 type OpenAIConfig struct {
-	APIKey string `yaml:"api_key"`
-	Model  string `yaml:"model"`
+	APIKey  string        `yaml:"api_key"`
+	Model   string        `yaml:"model"`
+	Timeout time.Duration `yaml:"timeout"` // 0 = llm.timeout
+	Pricing LLMPricing    `yaml:"pricing"`
 }
 
 type ClaudeConfig struct {
-	APIKey string `yaml:"api_key"`
-	Model  string `yaml:"model"`
+	APIKey  string        `yaml:"api_key"`
+	Model   string        `yaml:"model"`
+	Timeout time.Duration `yaml:"timeout"` // 0 = llm.timeout
+	Pricing LLMPricing    `yaml:"pricing"`
 }
 
 type CacheConfig struct {
@@ -94,9 +258,414 @@ type CacheConfig struct {
 }
 
 type RateLimitConfig struct {
-	Enabled           bool `yaml:"enabled"`
-	RequestsPerMinute int  `yaml:"requests_per_minute"`
-	Burst             int  `yaml:"burst"`
+	Enabled           bool             `yaml:"enabled"`
+	RequestsPerMinute int              `yaml:"requests_per_minute"`
+	Burst             int              `yaml:"burst"`
+	Routes            []RouteRateLimit `yaml:"routes"`
+	APIKeyHeader      string           `yaml:"api_key_header"`
+	ExemptAPIKeys     []string         `yaml:"exempt_api_keys"`
+	Chat              ChatRateLimit    `yaml:"chat"`
+}
+
+// ChatRateLimit caps chat messages per chat session and per user, on top of
+// the route tiers, so one runaway client tab can't drain the LLM budget.
+// A max of 0 disables that limit.
+type ChatRateLimit struct {
+	Enabled       bool          `yaml:"enabled"`
+	SessionMax    int           `yaml:"session_max"`
+	SessionWindow time.Duration `yaml:"session_window"`
+	UserMax       int           `yaml:"user_max"`
+	UserWindow    time.Duration `yaml:"user_window"`
+}
+
+// LoadShedConfig controls shedding of low-priority requests under load.
+// Shedding starts when either threshold is exceeded; 0 disables a threshold.
+type LoadShedConfig struct {
+	Enabled     bool          `yaml:"enabled"`
+	MaxInFlight int           `yaml:"max_in_flight"` // concurrent requests
+	MaxLatency  time.Duration `yaml:"max_latency"`   // moving average response time
+	LowPriority []string      `yaml:"low_priority"`  // path prefixes shed first
+	Protected   []string      `yaml:"protected"`     // path prefixes never shed or counted
+}
+
+// TenancyConfig enables serving several tenants (e.g. a bootcamp cohort) from
+// one deployment. The tenant comes from the request header, or from the
+// subdomain of BaseDomain (acme.example.com -> acme).
+type TenancyConfig struct {
+	Enabled    bool           `yaml:"enabled"`
+	Header     string         `yaml:"header"`
+	BaseDomain string         `yaml:"base_domain"`
+	Default    string         `yaml:"default"` // tenant for requests naming none; empty rejects them
+	Tenants    []TenantConfig `yaml:"tenants"`
+}
+
+// TenantConfig is a tenant and its overrides of the deployment defaults
+type TenantConfig struct {
+	ID                string `yaml:"id"` // lowercase letters, digits and dashes
+	Name              string `yaml:"name"`
+	RequestsPerMinute int    `yaml:"requests_per_minute"` // 0 = global limit
+	LLMBackend        string `yaml:"llm_backend"`         // empty = llm.default_backend
+}
+
+// RouteRateLimit overrides the global rate limit for a path prefix
+type RouteRateLimit struct {
+	Path   string        `yaml:"path"`   // prefix, e.g. /api/chat
+	Method string        `yaml:"method"` // empty matches any method
+	Max    int           `yaml:"max"`    // 0 = unlimited
+	Window time.Duration `yaml:"window"`
+}
+
+// StorageConfig selects and configures the file/artifact store
+type StorageConfig struct {
+	Driver    string             `yaml:"driver"` // local, s3
+	URLExpiry time.Duration      `yaml:"url_expiry"`
+	Local     LocalStorageConfig `yaml:"local"`
+	S3        S3StorageConfig    `yaml:"s3"`
+}
+
+type LocalStorageConfig struct {
+	Path       string `yaml:"path"`
+	BaseURL    string `yaml:"base_url"`
+	SigningKey string `yaml:"signing_key"`
+}
+
+type S3StorageConfig struct {
+	Endpoint  string `yaml:"endpoint"`
+	Region    string `yaml:"region"`
+	Bucket    string `yaml:"bucket"`
+	AccessKey string `yaml:"access_key"`
+	SecretKey string `yaml:"secret_key"`
+	UseSSL    bool   `yaml:"use_ssl"`
+}
+
+// RetentionConfig sets how long data is kept before the cleanup worker purges it.
+// A zero duration keeps data forever.
+type RetentionConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	Interval        time.Duration `yaml:"interval"`
+	InactiveJobs    time.Duration `yaml:"inactive_jobs"`
+	ChatSessions    time.Duration `yaml:"chat_sessions"`
+	ScrapeArtifacts time.Duration `yaml:"scrape_artifacts"`
+	Exports         time.Duration `yaml:"exports"`
+	Trash           time.Duration `yaml:"trash"`
+}
+
+// GeoConfig configures geocoding of job locations on ingest
+type GeoConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	Provider        string        `yaml:"provider"`
+	BaseURL         string        `yaml:"base_url"`
+	UserAgent       string        `yaml:"user_agent"`
+	Timeout         time.Duration `yaml:"timeout"`
+	CacheTTL        time.Duration `yaml:"cache_ttl"`
+	CacheSize       int           `yaml:"cache_size"`
+	DefaultRadiusKm float64       `yaml:"default_radius_km"`
+}
+
+// EnrichmentConfig configures third-party data used to enrich companies
+type EnrichmentConfig struct {
+	H1B      H1BConfig             `yaml:"h1b"`
+	Ratings  RatingsConfig         `yaml:"ratings"`
+	Salaries SalaryBenchmarkConfig `yaml:"salaries"`
+}
+
+// H1BConfig configures the public H1B filing dataset import
+type H1BConfig struct {
+	DatasetPath    string `yaml:"dataset_path"`
+	EmployerColumn string `yaml:"employer_column"`
+	StatusColumn   string `yaml:"status_column"`
+	YearColumn     string `yaml:"year_column"`
+	// MinFilings is the number of certified filings needed to flag a likely sponsor
+	MinFilings int `yaml:"min_filings"`
+}
+
+// RatingsConfig configures company rating import and periodic refresh
+type RatingsConfig struct {
+	// Provider fetches ratings periodically ("indeed"); empty relies on imports only
+	Provider        string        `yaml:"provider"`
+	BaseURL         string        `yaml:"base_url"`
+	UserAgent       string        `yaml:"user_agent"`
+	Timeout         time.Duration `yaml:"timeout"`
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+	MaxAge          time.Duration `yaml:"max_age"`
+	ImportPath      string        `yaml:"import_path"`
+}
+
+// SalaryBenchmarkConfig configures the public salary survey import (e.g. the
+// Stack Overflow Developer Survey results CSV)
+type SalaryBenchmarkConfig struct {
+	DatasetPath string `yaml:"dataset_path"`
+	// Source names the dataset in benchmark responses
+	Source         string `yaml:"source"`
+	TitleColumn    string `yaml:"title_column"` // may hold several ";"-separated roles
+	LocationColumn string `yaml:"location_column"`
+	SalaryColumn   string `yaml:"salary_column"` // yearly compensation
+	// Currency of the salary column; only jobs posted in it are compared
+	Currency string `yaml:"currency"`
+	// MinSamples is the number of salaries a benchmark needs to be reported
+	MinSamples int `yaml:"min_samples"`
+}
+
+// QualityConfig configures spam and low-quality posting detection
+// ScrapingConfig bounds scraper concurrency for small hosts
+type ScrapingConfig struct {
+	// MaxConcurrentSources is how many sources scrape at once (0 = all)
+	MaxConcurrentSources int `yaml:"max_concurrent_sources"`
+	// DefaultMaxPages is the concurrent page limit for sources without an override (0 = unlimited)
+	DefaultMaxPages int `yaml:"default_max_pages"`
+	// Sources overrides limits per source, keyed by source name (linkedin, greenhouse, ...)
+	Sources map[string]SourceScrapingConfig `yaml:"sources"`
+	// Retry is the retry policy for page and API fetches
+	Retry ScrapeRetryConfig `yaml:"retry"`
+	// Validation rejects broken parses before they are stored
+	Validation ScrapeValidationConfig `yaml:"validation"`
+	// URLs configures how job URLs are canonicalized before deduplication
+	URLs ScrapeURLConfig `yaml:"urls"`
+	// APIs holds credentials for official job board APIs; configured APIs are used instead of HTML scraping
+	APIs JobAPIsConfig `yaml:"apis"`
+	// ProfileCheckInterval is how often scheduled scrape profiles are checked for a due run (0 disables schedules)
+	ProfileCheckInterval time.Duration `yaml:"profile_check_interval"`
+}
+
+// JobAPIsConfig configures API-based job sources
+type JobAPIsConfig struct {
+	Timeout  time.Duration  `yaml:"timeout"`
+	Adzuna   AdzunaConfig   `yaml:"adzuna"`
+	USAJobs  USAJobsConfig  `yaml:"usajobs"`
+	Jooble   JoobleConfig   `yaml:"jooble"`
+	Remotive RemotiveConfig `yaml:"remotive"`
+	Simplify SimplifyConfig `yaml:"simplify"`
+	Ashby    AshbyConfig    `yaml:"ashby"`
+	Workable WorkableConfig `yaml:"workable"`
+	// CareerPages are company career sites scraped directly (Greenhouse, Lever, Workday, Ashby, Workable)
+	CareerPages []CareerPageConfig `yaml:"career_pages"`
+}
+
+type AdzunaConfig struct {
+	AppID   string `yaml:"app_id"`
+	AppKey  string `yaml:"app_key"`
+	Country string `yaml:"country"` // two-letter country code, e.g. us, gb
+}
+
+type USAJobsConfig struct {
+	APIKey string `yaml:"api_key"`
+	Email  string `yaml:"email"` // email registered with the API key
+}
+
+type JoobleConfig struct {
+	APIKey string `yaml:"api_key"`
+}
+
+type RemotiveConfig struct {
+	Enabled bool `yaml:"enabled"` // public API, no key required
+}
+
+// SimplifyConfig points at the community-maintained internship and new-grad
+// listings (SimplifyJobs GitHub repositories)
+type SimplifyConfig struct {
+	Enabled        bool   `yaml:"enabled"`
+	InternshipsURL string `yaml:"internships_url"`
+	NewGradURL     string `yaml:"new_grad_url"`
+}
+
+// AshbyConfig lists the Ashby job boards to read (jobs.ashbyhq.com/<board>)
+type AshbyConfig struct {
+	Boards []string `yaml:"boards"` // public API, no key required
+}
+
+// WorkableConfig lists the Workable accounts to read (apply.workable.com/<account>)
+type WorkableConfig struct {
+	Accounts []string `yaml:"accounts"` // public API, no key required
+}
+
+// CareerPageConfig is a company careers URL to track. The hosting platform
+// is detected from the URL or from the job board embedded in the page.
+type CareerPageConfig struct {
+	Company string `yaml:"company"` // display name; derived from the board name when empty
+	URL     string `yaml:"url"`
+}
+
+// ScrapeRetryConfig retries fetches that failed with a timeout or network
+// error, with exponential backoff and jitter
+type ScrapeRetryConfig struct {
+	MaxAttempts int           `yaml:"max_attempts"` // attempts per fetch, including the first (1 = no retries)
+	BaseDelay   time.Duration `yaml:"base_delay"`   // delay before the first retry, doubled for each further one
+	MaxDelay    time.Duration `yaml:"max_delay"`
+}
+
+// ScrapeValidationConfig sets the checks scraped jobs must pass to be stored.
+// Jobs without a title or URL, or with a minimum salary above the maximum,
+// are always rejected. Jobs listed without any description are kept, since
+// several boards only show it on the job page.
+type ScrapeValidationConfig struct {
+	MinDescriptionLength int `yaml:"min_description_length"` // 0 = no minimum
+}
+
+// ScrapeURLConfig configures job URL canonicalization. Tracking parameters
+// are always stripped; links through the ResolveRedirects redirectors (a
+// host, optionally with a path prefix, e.g. adzuna.com/land) are followed to
+// the posting first.
+type ScrapeURLConfig struct {
+	ResolveRedirects []string      `yaml:"resolve_redirects"`
+	Timeout          time.Duration `yaml:"timeout"`
+}
+
+// SourceScrapingConfig holds per-source scraping limits
+type SourceScrapingConfig struct {
+	MaxConcurrentPages int `yaml:"max_concurrent_pages"`
+	// RequiresJS overrides whether the source's pages are rendered in Chrome
+	// or fetched over plain HTTP (unset = built-in default for the source)
+	RequiresJS *bool `yaml:"requires_js"`
+	// Weight scales the source's jobs in match and relevance ranking, e.g.
+	// below 1 for boards full of agency posts (0 = 1, no change)
+	Weight float64 `yaml:"weight"`
+}
+
+// DeadlineConfig controls reminders for saved jobs with an application deadline
+type DeadlineConfig struct {
+	Enabled      bool          `yaml:"enabled"`
+	ReminderLead time.Duration `yaml:"reminder_lead"` // remind this long before the deadline
+	Interval     time.Duration `yaml:"interval"`      // how often saved jobs are checked
+}
+
+// PushConfig configures Web Push notifications to subscribed browsers
+type PushConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// VAPIDPrivateKey is the base64url P-256 private key identifying the
+	// server; empty uses a random per-process key, so browsers must
+	// subscribe again after each restart
+	VAPIDPrivateKey string        `yaml:"vapid_private_key"`
+	Subject         string        `yaml:"subject"` // mailto: or https: contact for push services
+	TTL             time.Duration `yaml:"ttl"`     // how long push services keep undelivered messages
+	Timeout         time.Duration `yaml:"timeout"`
+}
+
+// CommuteConfig configures commute-time estimates from the saved home location
+type CommuteConfig struct {
+	Enabled     bool          `yaml:"enabled"`
+	Provider    string        `yaml:"provider"` // estimate, osrm
+	BaseURL     string        `yaml:"base_url"`
+	Timeout     time.Duration `yaml:"timeout"`
+	CacheTTL    time.Duration `yaml:"cache_ttl"`
+	CacheSize   int           `yaml:"cache_size"`
+	DefaultMode string        `yaml:"default_mode"` // driving, transit, cycling, walking
+	Home        string        `yaml:"home"`         // initial home address, until one is saved
+}
+
+// TranscriptionConfig configures speech-to-text for spoken practice answers
+type TranscriptionConfig struct {
+	Enabled       bool          `yaml:"enabled"`
+	Provider      string        `yaml:"provider"` // openai, groq (any OpenAI-compatible transcription API)
+	BaseURL       string        `yaml:"base_url"`
+	APIKey        string        `yaml:"api_key"` // defaults to the provider's LLM API key
+	Model         string        `yaml:"model"`
+	Language      string        `yaml:"language"` // ISO-639-1 hint; empty auto-detects
+	Timeout       time.Duration `yaml:"timeout"`
+	MaxAudioBytes int           `yaml:"max_audio_bytes"`
+}
+
+// InterviewConfig configures interview preparation data
+type InterviewConfig struct {
+	// CompanyQuestionsPath is a CSV of questions reported per company
+	// (company,question[,category[,round[,role[,reports]]]]) loaded at startup
+	CompanyQuestionsPath string `yaml:"company_questions_path"`
+}
+
+// OutreachConfig configures follow-up email sequences for applications
+type OutreachConfig struct {
+	Enabled  bool                 `yaml:"enabled"`
+	Interval time.Duration        `yaml:"interval"` // how often due steps are drafted
+	Tone     string               `yaml:"tone"`
+	Length   string               `yaml:"length"`
+	Steps    []OutreachStepConfig `yaml:"steps"` // default sequence
+}
+
+// OutreachStepConfig is one step of the default outreach sequence
+type OutreachStepConfig struct {
+	Name      string        `yaml:"name"`
+	EmailType string        `yaml:"email_type"` // application, followup, thankyou
+	After     time.Duration `yaml:"after"`      // offset from the start of the sequence
+}
+
+// ChecklistConfig configures per-application checklists
+type ChecklistConfig struct {
+	// Templates lists the items added when an application reaches each
+	// status (saved, applied, screening, interview, offer)
+	Templates map[string][]string `yaml:"templates"`
+}
+
+// ChatConfig tunes resume chat: how the resume is chunked for retrieval and
+// how much of the conversation is sent back to the LLM
+type ChatConfig struct {
+	ChunkSize    int  `yaml:"chunk_size"`    // longest resume chunk in characters
+	HistoryTurns int  `yaml:"history_turns"` // earlier messages of the session included in the prompt
+	Rerank       bool `yaml:"rerank"`        // rerank retrieved chunks with the ML service's cross-encoder
+}
+
+// ScoringConfig tunes batch embedding and resume match scoring
+type ScoringConfig struct {
+	BatchSize   int `yaml:"batch_size"`  // texts per embedding request
+	Concurrency int `yaml:"concurrency"` // embedding requests in flight
+	// MinSimilarity and MaxSimilarity map cosine similarity onto the 0-100 score
+	MinSimilarity float64 `yaml:"min_similarity"`
+	MaxSimilarity float64 `yaml:"max_similarity"`
+}
+
+type QualityConfig struct {
+	// MinScore is the quality score below which hide_low_quality drops a job
+	MinScore int `yaml:"min_score"`
+	// GhostJobAge flags jobs that stay open this long
+	GhostJobAge time.Duration `yaml:"ghost_job_age"`
+	// LLMReviewBelow sends borderline scores (MinScore <= score < LLMReviewBelow) to the LLM when one is available
+	LLMReviewBelow int `yaml:"llm_review_below"`
+}
+
+// DigestConfig configures the periodic activity digest
+type DigestConfig struct {
+	Enabled bool `yaml:"enabled"`
+	// Period is both the digest schedule and the window it summarizes
+	Period        time.Duration `yaml:"period"`
+	MinMatchScore float64       `yaml:"min_match_score"`
+	MaxJobs       int           `yaml:"max_jobs"`
+}
+
+// FeedConfig configures the RSS/Atom feeds of saved searches
+type FeedConfig struct {
+	// SigningKey derives the feed tokens; empty uses a random per-process key.
+	// Changing it revokes every feed URL.
+	SigningKey string `yaml:"signing_key"`
+}
+
+// PayloadLogConfig configures sampled request/response body logging
+type PayloadLogConfig struct {
+	Enabled    bool     `yaml:"enabled"`
+	SampleRate float64  `yaml:"sample_rate"`
+	MaxBytes   int      `yaml:"max_bytes"`
+	Routes     []string `yaml:"routes"`
+}
+
+// PrivacyConfig controls scrubbing of personal data from what the API keeps
+type PrivacyConfig struct {
+	// ScrubPII strips emails, phone numbers and API keys from logs, audit
+	// entries and recorded scrape pages
+	ScrubPII bool `yaml:"scrub_pii"`
+}
+
+// EncryptionConfig holds the keys sensitive fields (credentials, contact
+// details) are encrypted with at rest. Old keys stay listed after a rotation
+// so values written under them can still be read.
+type EncryptionConfig struct {
+	// PrimaryKey is the ID of the key new values are encrypted with
+	PrimaryKey string                `yaml:"primary_key"`
+	Keys       []EncryptionKeyConfig `yaml:"keys"`
+}
+
+// EncryptionKeyConfig is one AES-256 key, given inline or read from a file
+// such as a mounted secret
+type EncryptionKeyConfig struct {
+	ID      string `yaml:"id"`
+	Key     string `yaml:"key"`      // base64-encoded 32-byte key
+	KeyFile string `yaml:"key_file"` // file holding the base64-encoded key
 }
 
 type CORSConfig struct {
@@ -137,6 +706,13 @@ func defaultConfig() *Config {
 			ReadTimeout:  30 * time.Second,
 			WriteTimeout: 30 * time.Second,
 			Debug:        false,
+			ProxyHeader:  "X-Forwarded-For",
+			TLS: TLSConfig{
+				CacheDir: "./data/certs",
+				HTTPPort: 80,
+			},
+			UnixSocketMode:    "0660",
+			SystemdActivation: true,
 		},
 		Database: DatabaseConfig{
 			Postgres: PostgresConfig{
@@ -155,9 +731,15 @@ func defaultConfig() *Config {
 			},
 		},
 		MLService: MLServiceConfig{
-			Host:    "localhost",
-			Port:    50051,
-			Timeout: 10 * time.Second,
+			Host:           "localhost",
+			Port:           50051,
+			Timeout:        10 * time.Second,
+			MaxMessageSize: 16 << 20,
+			Retry: MLRetryConfig{
+				MaxAttempts: 3,
+				BaseDelay:   200 * time.Millisecond,
+				MaxDelay:    2 * time.Second,
+			},
 		},
 		LLM: LLMConfig{
 			DefaultBackend: "groq",
@@ -170,7 +752,27 @@ func defaultConfig() *Config {
 			Claude: ClaudeConfig{
 				Model: "claude-sonnet-4-20250514",
 			},
-			Timeout: 60 * time.Second,
+			Timeout:     60 * time.Second,
+			MaxTokens:   1024,
+			Temperature: 0.3,
+			Queue: LLMQueueConfig{
+				MaxConcurrent: 4,
+				MaxQueue:      16,
+				MaxWait:       15 * time.Second,
+			},
+			Breaker: LLMBreakerConfig{
+				FailureThreshold: 3,
+				Cooldown:         30 * time.Second,
+			},
+			Redaction: RedactionConfig{
+				DefaultTrust: "standard",
+				Trust:        map[string]string{},
+				Levels: map[string][]string{
+					"trusted":   {},
+					"standard":  {"address", "phone", "references"},
+					"untrusted": {"name", "email", "phone", "address", "references"},
+				},
+			},
 		},
 		Cache: CacheConfig{
 			Enabled: true,
@@ -181,6 +783,37 @@ func defaultConfig() *Config {
 			Enabled:           true,
 			RequestsPerMinute: 60,
 			Burst:             10,
+			Routes: []RouteRateLimit{
+				{Path: "/health", Max: 0},
+				{Path: "/ready", Max: 0},
+				{Path: "/api/chat", Max: 10, Window: time.Minute},
+				{Path: "/api/job-list/scrape", Method: "POST", Max: 2, Window: time.Hour},
+			},
+			APIKeyHeader: "X-API-Key",
+			Chat: ChatRateLimit{
+				Enabled:       true,
+				SessionMax:    6,
+				SessionWindow: time.Minute,
+				UserMax:       60,
+				UserWindow:    time.Hour,
+			},
+		},
+		LoadShed: LoadShedConfig{
+			Enabled:     true,
+			MaxInFlight: 200,
+			MaxLatency:  2 * time.Second,
+			LowPriority: []string{
+				"/api/job-list/stats",
+				"/api/chat/suggestions",
+				"/api/jobs/analytics",
+				"/api/analytics",
+				"/api/dashboard",
+			},
+			Protected: []string{"/health", "/ready"},
+		},
+		Tenancy: TenancyConfig{
+			Enabled: false,
+			Header:  "X-Tenant-ID",
 		},
 		CORS: CORSConfig{
 			AllowedOrigins: []string{"http://localhost:5173", "http://localhost:3000"},
@@ -188,6 +821,160 @@ func defaultConfig() *Config {
 			AllowedHeaders: []string{"*"},
 			MaxAge:         600,
 		},
+		PayloadLog: PayloadLogConfig{
+			Enabled:    false,
+			SampleRate: 0.1,
+			MaxBytes:   4096,
+		},
+		Privacy: PrivacyConfig{
+			ScrubPII: true,
+		},
+		Retention: RetentionConfig{
+			Enabled:         true,
+			Interval:        24 * time.Hour,
+			InactiveJobs:    90 * 24 * time.Hour,
+			ChatSessions:    180 * 24 * time.Hour,
+			ScrapeArtifacts: 7 * 24 * time.Hour,
+			Exports:         7 * 24 * time.Hour,
+			Trash:           30 * 24 * time.Hour,
+		},
+		Geo: GeoConfig{
+			Provider:        "nominatim",
+			BaseURL:         "https://nominatim.openstreetmap.org",
+			UserAgent:       "ResumeAI/2.0",
+			Timeout:         5 * time.Second,
+			CacheTTL:        30 * 24 * time.Hour,
+			CacheSize:       5000,
+			DefaultRadiusKm: 50,
+		},
+		Enrichment: EnrichmentConfig{
+			H1B: H1BConfig{
+				EmployerColumn: "EMPLOYER_NAME",
+				StatusColumn:   "CASE_STATUS",
+				YearColumn:     "DECISION_DATE",
+				MinFilings:     3,
+			},
+			Ratings: RatingsConfig{
+				BaseURL:         "https://www.indeed.com",
+				UserAgent:       "Mozilla/5.0 (compatible; ResumeAI/2.0)",
+				Timeout:         10 * time.Second,
+				RefreshInterval: 24 * time.Hour,
+				MaxAge:          30 * 24 * time.Hour,
+			},
+			Salaries: SalaryBenchmarkConfig{
+				Source:         "Stack Overflow Developer Survey",
+				TitleColumn:    "DevType",
+				LocationColumn: "Country",
+				SalaryColumn:   "ConvertedCompYearly",
+				Currency:       "USD",
+				MinSamples:     20,
+			},
+		},
+		Quality: QualityConfig{
+			MinScore:       50,
+			GhostJobAge:    90 * 24 * time.Hour,
+			LLMReviewBelow: 75,
+		},
+		Scraping: ScrapingConfig{
+			MaxConcurrentSources: 2,
+			DefaultMaxPages:      2,
+			Sources: map[string]SourceScrapingConfig{
+				"linkedin": {MaxConcurrentPages: 1},
+			},
+			Retry: ScrapeRetryConfig{
+				MaxAttempts: 3,
+				BaseDelay:   time.Second,
+				MaxDelay:    15 * time.Second,
+			},
+			Validation: ScrapeValidationConfig{MinDescriptionLength: 50},
+			URLs: ScrapeURLConfig{
+				ResolveRedirects: []string{"lnkd.in", "bit.ly", "t.co", "adzuna.com/land", "adzuna.co.uk/land"},
+				Timeout:          10 * time.Second,
+			},
+			APIs: JobAPIsConfig{
+				Timeout: 30 * time.Second,
+				Adzuna:  AdzunaConfig{Country: "us"},
+				Simplify: SimplifyConfig{
+					InternshipsURL: "https://raw.githubusercontent.com/SimplifyJobs/Summer2025-Internships/dev/.github/scripts/listings.json",
+					NewGradURL:     "https://raw.githubusercontent.com/SimplifyJobs/New-Grad-Positions/dev/.github/scripts/listings.json",
+				},
+			},
+			ProfileCheckInterval: 5 * time.Minute,
+		},
+		Deadlines: DeadlineConfig{
+			Enabled:      true,
+			ReminderLead: 72 * time.Hour,
+			Interval:     6 * time.Hour,
+		},
+		Push: PushConfig{
+			Enabled: true,
+			TTL:     24 * time.Hour,
+			Timeout: 10 * time.Second,
+		},
+		Commute: CommuteConfig{
+			Provider:    "estimate",
+			BaseURL:     "https://router.project-osrm.org",
+			Timeout:     5 * time.Second,
+			CacheTTL:    7 * 24 * time.Hour,
+			CacheSize:   10000,
+			DefaultMode: "transit",
+		},
+		Transcription: TranscriptionConfig{
+			Provider:      "openai",
+			BaseURL:       "https://api.openai.com/v1",
+			Model:         "whisper-1",
+			Timeout:       60 * time.Second,
+			MaxAudioBytes: 25 << 20,
+		},
+		Outreach: OutreachConfig{
+			Enabled:  true,
+			Interval: time.Hour,
+			Tone:     "professional",
+			Length:   "short",
+			Steps: []OutreachStepConfig{
+				{Name: "apply", EmailType: "application"},
+				{Name: "follow_up", EmailType: "followup", After: 5 * 24 * time.Hour},
+				{Name: "second_follow_up", EmailType: "followup", After: 10 * 24 * time.Hour},
+			},
+		},
+		Checklists: ChecklistConfig{
+			Templates: map[string][]string{
+				"saved":     {"Tailor resume", "Write cover letter", "Research company"},
+				"applied":   {"Find a referral or recruiter contact", "Schedule a follow-up"},
+				"screening": {"Prepare your pitch", "Research the interviewers"},
+				"interview": {"Prep likely questions", "Prepare STAR stories", "Send thank-you notes"},
+				"offer":     {"Compare offers", "Research salary bands", "Negotiate"},
+			},
+		},
+		Chat: ChatConfig{
+			ChunkSize:    800,
+			HistoryTurns: 6,
+			Rerank:       true,
+		},
+		Scoring: ScoringConfig{
+			BatchSize:     64,
+			Concurrency:   4,
+			MinSimilarity: 0.15,
+			MaxSimilarity: 0.75,
+		},
+		Digest: DigestConfig{
+			Enabled:       true,
+			Period:        7 * 24 * time.Hour,
+			MinMatchScore: 70,
+			MaxJobs:       10,
+		},
+		Storage: StorageConfig{
+			Driver:    "local",
+			URLExpiry: 15 * time.Minute,
+			Local: LocalStorageConfig{
+				Path: "./data/storage",
+			},
+			S3: S3StorageConfig{
+				Endpoint: "localhost:9000",
+				Region:   "us-east-1",
+				Bucket:   "resume-rag",
+			},
+		},
 	}
 }
 
@@ -204,6 +991,86 @@ func (c *Config) loadFromEnv() {
 	if v := os.Getenv("DEBUG"); v == "true" {
 		c.Server.Debug = true
 	}
+	if v := os.Getenv("STRICT_JSON"); v == "true" {
+		c.Server.StrictJSON = true
+	}
+	if v := os.Getenv("TRUSTED_PROXIES"); v != "" {
+		c.Server.TrustedProxies = splitList(v)
+	}
+	if v := os.Getenv("PROXY_HEADER"); v != "" {
+		c.Server.ProxyHeader = v
+	}
+	if v := os.Getenv("UNIX_SOCKET"); v != "" {
+		c.Server.UnixSocket = v
+	}
+	if v := os.Getenv("UNIX_SOCKET_MODE"); v != "" {
+		c.Server.UnixSocketMode = v
+	}
+	if v := os.Getenv("TLS_ENABLED"); v == "true" {
+		c.Server.TLS.Enabled = true
+	}
+	if v := os.Getenv("TLS_CERT_FILE"); v != "" {
+		c.Server.TLS.CertFile = v
+	}
+	if v := os.Getenv("TLS_KEY_FILE"); v != "" {
+		c.Server.TLS.KeyFile = v
+	}
+	if v := os.Getenv("TLS_AUTOCERT_HOSTS"); v != "" {
+		c.Server.TLS.Autocert = true
+		c.Server.TLS.Hosts = splitList(v)
+	}
+	if v := os.Getenv("TLS_AUTOCERT_EMAIL"); v != "" {
+		c.Server.TLS.Email = v
+	}
+
+	// Rate limiting
+	if v := os.Getenv("RATE_LIMIT_EXEMPT_KEYS"); v != "" {
+		c.RateLimit.ExemptAPIKeys = splitList(v)
+	}
+
+	// Chat rate limits
+	if v := os.Getenv("CHAT_RATE_LIMIT_ENABLED"); v != "" {
+		c.RateLimit.Chat.Enabled = v == "true"
+	}
+	if v := os.Getenv("CHAT_RATE_LIMIT_SESSION_MAX"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			c.RateLimit.Chat.SessionMax = n
+		}
+	}
+	if v := os.Getenv("CHAT_RATE_LIMIT_USER_MAX"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			c.RateLimit.Chat.UserMax = n
+		}
+	}
+	if v := os.Getenv("CHAT_RERANK"); v != "" {
+		c.Chat.Rerank = v == "true"
+	}
+
+	// Load shedding
+	if v := os.Getenv("LOAD_SHED_ENABLED"); v != "" {
+		c.LoadShed.Enabled = v == "true"
+	}
+	if v := os.Getenv("LOAD_SHED_MAX_IN_FLIGHT"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			c.LoadShed.MaxInFlight = n
+		}
+	}
+	if v := os.Getenv("LOAD_SHED_MAX_LATENCY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			c.LoadShed.MaxLatency = d
+		}
+	}
+
+	// Tenancy
+	if v := os.Getenv("TENANCY_ENABLED"); v != "" {
+		c.Tenancy.Enabled = v == "true"
+	}
+	if v := os.Getenv("TENANCY_BASE_DOMAIN"); v != "" {
+		c.Tenancy.BaseDomain = v
+	}
+	if v := os.Getenv("TENANCY_DEFAULT"); v != "" {
+		c.Tenancy.Default = v
+	}
 
 	// Database
 	if v := os.Getenv("POSTGRES_HOST"); v != "" {
@@ -248,11 +1115,178 @@ func (c *Config) loadFromEnv() {
 			c.MLService.Port = port
 		}
 	}
+	if v := os.Getenv("ML_SERVICE_TLS"); v == "true" {
+		c.MLService.TLS = true
+	}
+
+	// Saved search feeds
+	if v := os.Getenv("FEED_SIGNING_KEY"); v != "" {
+		c.Feeds.SigningKey = v
+	}
+
+	// Web Push
+	if v := os.Getenv("PUSH_VAPID_PRIVATE_KEY"); v != "" {
+		c.Push.VAPIDPrivateKey = v
+	}
+	if v := os.Getenv("PUSH_SUBJECT"); v != "" {
+		c.Push.Subject = v
+	}
+
+	// Privacy
+	if v := os.Getenv("PII_SCRUB_ENABLED"); v != "" {
+		c.Privacy.ScrubPII = v == "true"
+	}
+
+	// Encryption at rest; an environment key becomes the primary key
+	if v, f := os.Getenv("ENCRYPTION_KEY"), os.Getenv("ENCRYPTION_KEY_FILE"); v != "" || f != "" {
+		id := os.Getenv("ENCRYPTION_KEY_ID")
+		if id == "" {
+			id = "env"
+		}
+		c.Encryption.Keys = append(c.Encryption.Keys, EncryptionKeyConfig{ID: id, Key: v, KeyFile: f})
+		c.Encryption.PrimaryKey = id
+	}
+
+	// Storage
+	if v := os.Getenv("STORAGE_DRIVER"); v != "" {
+		c.Storage.Driver = v
+	}
+	if v := os.Getenv("STORAGE_SIGNING_KEY"); v != "" {
+		c.Storage.Local.SigningKey = v
+	}
+	if v := os.Getenv("S3_ENDPOINT"); v != "" {
+		c.Storage.S3.Endpoint = v
+	}
+	if v := os.Getenv("S3_BUCKET"); v != "" {
+		c.Storage.S3.Bucket = v
+	}
+	if v := os.Getenv("S3_ACCESS_KEY"); v != "" {
+		c.Storage.S3.AccessKey = v
+	}
+	if v := os.Getenv("S3_SECRET_KEY"); v != "" {
+		c.Storage.S3.SecretKey = v
+	}
+
+	// Geocoding
+	if v := os.Getenv("GEO_ENABLED"); v == "true" {
+		c.Geo.Enabled = true
+	}
+	if v := os.Getenv("GEO_BASE_URL"); v != "" {
+		c.Geo.BaseURL = v
+	}
+
+	// Commute estimates
+	if v := os.Getenv("COMMUTE_ENABLED"); v == "true" {
+		c.Commute.Enabled = true
+	}
+	if v := os.Getenv("COMMUTE_PROVIDER"); v != "" {
+		c.Commute.Provider = v
+	}
+	if v := os.Getenv("COMMUTE_BASE_URL"); v != "" {
+		c.Commute.BaseURL = v
+	}
+	if v := os.Getenv("COMMUTE_HOME"); v != "" {
+		c.Commute.Home = v
+	}
+
+	// Transcription
+	if v := os.Getenv("TRANSCRIPTION_ENABLED"); v == "true" {
+		c.Transcription.Enabled = true
+	}
+	if v := os.Getenv("TRANSCRIPTION_PROVIDER"); v != "" {
+		c.Transcription.Provider = v
+	}
+	if v := os.Getenv("TRANSCRIPTION_BASE_URL"); v != "" {
+		c.Transcription.BaseURL = v
+	}
+	if v := os.Getenv("TRANSCRIPTION_API_KEY"); v != "" {
+		c.Transcription.APIKey = v
+	}
+	if v := os.Getenv("TRANSCRIPTION_MODEL"); v != "" {
+		c.Transcription.Model = v
+	}
+
+	// Interview prep
+	if v := os.Getenv("INTERVIEW_QUESTIONS_PATH"); v != "" {
+		c.Interview.CompanyQuestionsPath = v
+	}
+
+	// Outreach sequences
+	if v := os.Getenv("OUTREACH_ENABLED"); v != "" {
+		c.Outreach.Enabled = v == "true"
+	}
+
+	// Enrichment
+	if v := os.Getenv("H1B_DATASET_PATH"); v != "" {
+		c.Enrichment.H1B.DatasetPath = v
+	}
+	if v := os.Getenv("RATINGS_PROVIDER"); v != "" {
+		c.Enrichment.Ratings.Provider = v
+	}
+	if v := os.Getenv("RATINGS_IMPORT_PATH"); v != "" {
+		c.Enrichment.Ratings.ImportPath = v
+	}
+	if v := os.Getenv("SALARY_BENCHMARK_PATH"); v != "" {
+		c.Enrichment.Salaries.DatasetPath = v
+	}
+
+	// Scraping
+	if v := os.Getenv("SCRAPE_MAX_CONCURRENT_SOURCES"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			c.Scraping.MaxConcurrentSources = n
+		}
+	}
+	if v := os.Getenv("SCRAPE_RETRY_MAX_ATTEMPTS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			c.Scraping.Retry.MaxAttempts = n
+		}
+	}
+	if v := os.Getenv("SCRAPE_MIN_DESCRIPTION_LENGTH"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil {
+			c.Scraping.Validation.MinDescriptionLength = n
+		}
+	}
+	if v := os.Getenv("ADZUNA_APP_ID"); v != "" {
+		c.Scraping.APIs.Adzuna.AppID = v
+	}
+	if v := os.Getenv("ADZUNA_APP_KEY"); v != "" {
+		c.Scraping.APIs.Adzuna.AppKey = v
+	}
+	if v := os.Getenv("USAJOBS_API_KEY"); v != "" {
+		c.Scraping.APIs.USAJobs.APIKey = v
+	}
+	if v := os.Getenv("USAJOBS_EMAIL"); v != "" {
+		c.Scraping.APIs.USAJobs.Email = v
+	}
+	if v := os.Getenv("JOOBLE_API_KEY"); v != "" {
+		c.Scraping.APIs.Jooble.APIKey = v
+	}
+	if v := os.Getenv("REMOTIVE_ENABLED"); v == "true" {
+		c.Scraping.APIs.Remotive.Enabled = true
+	}
+	if v := os.Getenv("SIMPLIFY_ENABLED"); v == "true" {
+		c.Scraping.APIs.Simplify.Enabled = true
+	}
+	if v := os.Getenv("ASHBY_BOARDS"); v != "" {
+		c.Scraping.APIs.Ashby.Boards = splitList(v)
+	}
+	if v := os.Getenv("WORKABLE_ACCOUNTS"); v != "" {
+		c.Scraping.APIs.Workable.Accounts = splitList(v)
+	}
+	if v := os.Getenv("CAREER_PAGE_URLS"); v != "" {
+		c.Scraping.APIs.CareerPages = nil
+		for _, u := range splitList(v) {
+			c.Scraping.APIs.CareerPages = append(c.Scraping.APIs.CareerPages, CareerPageConfig{URL: u})
+		}
+	}
 
 	// LLM
 	if v := os.Getenv("LLM_BACKEND"); v != "" {
 		c.LLM.DefaultBackend = v
 	}
+	if v := os.Getenv("LLM_FALLBACK"); v != "" {
+		c.LLM.Fallback = splitList(v)
+	}
 	if v := os.Getenv("GROQ_API_KEY"); v != "" {
 		c.LLM.Groq.APIKey = v
 	}
@@ -262,4 +1296,23 @@ func (c *Config) loadFromEnv() {
 	if v := os.Getenv("ANTHROPIC_API_KEY"); v != "" {
 		c.LLM.Claude.APIKey = v
 	}
+
+	// LLM redaction
+	if v := os.Getenv("LLM_REDACTION_ENABLED"); v != "" {
+		c.LLM.Redaction.Enabled = v == "true"
+	}
+	if v := os.Getenv("LLM_REDACTION_DEFAULT_TRUST"); v != "" {
+		c.LLM.Redaction.DefaultTrust = v
+	}
+}
+
+// splitList splits a comma-separated environment value, dropping empty items
+func splitList(v string) []string {
+	var items []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
@@ -0,0 +1,147 @@
+package contacts
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// maxContacts caps how many contacts are kept per posting
+const maxContacts = 5
+
+// nameWindow is how far past a contact name an email may appear and still be
+// attributed to that person
+const nameWindow = 80
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`)
+
+	// "jane [at] acme [dot] com" / "jane (at) acme (dot) com" / "jane AT acme DOT com"
+	obfuscatedAt  = regexp.MustCompile(`\s*[\[({<]\s*(?i:at)\s*[\])}>]\s*|\s+AT\s+`)
+	obfuscatedDot = regexp.MustCompile(`\s*[\[({<]\s*(?i:dot)\s*[\])}>]\s*|\s+DOT\s+`)
+
+	// A capitalised two or three word name following a contact cue
+	namePattern = regexp.MustCompile(`(?:(?i:contact|reach\s+out\s+to|e-?mail|send\s+(?:your\s+)?(?:resume|cv|application)s?\s+to|questions\s+to|recruiter|hiring\s+manager|talent\s+partner)[:,]?\s+)([A-Z][a-z]+(?:[\s\-][A-Z][a-z']+){1,2})\b`)
+)
+
+// nonNames are capitalised words that follow contact cues but are not names
+var nonNames = map[string]bool{
+	"us": true, "our": true, "the": true, "human": true, "resources": true,
+	"hiring": true, "team": true, "talent": true, "recruiting": true,
+	"careers": true, "jobs": true, "info": true, "please": true, "me": true,
+}
+
+// ignoredEmails are mailbox prefixes that never reach a person
+var ignoredEmails = []string{"noreply", "no-reply", "donotreply", "do-not-reply", "mailer-daemon"}
+
+// Extract returns the recruiter contacts found in a posting's text. Emails
+// written as "name [at] domain [dot] com" are recognised, and a name is paired
+// with an email that closely follows it.
+func Extract(text string) []domain.JobContact {
+	if text == "" {
+		return nil
+	}
+	text = obfuscatedDot.ReplaceAllString(obfuscatedAt.ReplaceAllString(text, "@"), ".")
+
+	emails := emailPattern.FindAllStringIndex(text, -1)
+	paired := make(map[int]bool, len(emails))
+	seen := make(map[string]bool)
+	var contacts []domain.JobContact
+
+	add := func(c domain.JobContact) {
+		key := strings.ToLower(c.Email)
+		if key == "" {
+			key = "name:" + strings.ToLower(c.Name)
+		}
+		if seen[key] || len(contacts) >= maxContacts {
+			return
+		}
+		seen[key] = true
+		contacts = append(contacts, c)
+	}
+
+	for _, m := range namePattern.FindAllStringSubmatchIndex(text, -1) {
+		name := text[m[2]:m[3]]
+		if !plausibleName(name) {
+			continue
+		}
+		c := domain.JobContact{Name: name}
+		for i, loc := range emails {
+			if paired[i] || loc[0] < m[3] {
+				continue
+			}
+			if loc[0]-m[3] > nameWindow {
+				break
+			}
+			if email := text[loc[0]:loc[1]]; usable(email) {
+				c.Email = email
+				paired[i] = true
+			}
+			break
+		}
+		add(c)
+	}
+
+	for i, loc := range emails {
+		if email := text[loc[0]:loc[1]]; !paired[i] && usable(email) {
+			add(domain.JobContact{Email: email})
+		}
+	}
+	return contacts
+}
+
+func plausibleName(name string) bool {
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool { return r == ' ' || r == '-' }) {
+		if nonNames[strings.ToLower(word)] {
+			return false
+		}
+	}
+	return true
+}
+
+// usable rejects automated mailboxes and asset names like logo@2x.png
+func usable(email string) bool {
+	lower := strings.ToLower(email)
+	for _, prefix := range ignoredEmails {
+		if strings.HasPrefix(lower, prefix) {
+			return false
+		}
+	}
+	for _, ext := range []string{".png", ".jpg", ".jpeg", ".gif", ".svg", ".webp"} {
+		if strings.HasSuffix(lower, ext) {
+			return false
+		}
+	}
+	return true
+}
+
+// PrimaryEmail returns the first contact with an email address, if any
+func PrimaryEmail(contacts []domain.JobContact) (domain.JobContact, bool) {
+	for _, c := range contacts {
+		if c.Email != "" {
+			return c, true
+		}
+	}
+	return domain.JobContact{}, false
+}
+
+// Extractor fills in recruiter contacts on freshly scraped jobs
+type Extractor struct{}
+
+// NewExtractor creates a contact extractor
+func NewExtractor() *Extractor {
+	return &Extractor{}
+}
+
+// Enrich parses contacts from job descriptions; jobs that already carry
+// contacts from their source are left alone
+func (e *Extractor) Enrich(ctx context.Context, jobs []*domain.Job) {
+	for _, job := range jobs {
+		if len(job.Contacts) > 0 {
+			continue
+		}
+		job.Contacts = Extract(job.Description)
+	}
+}
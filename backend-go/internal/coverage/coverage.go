@@ -0,0 +1,295 @@
+package coverage
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/analytics"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Coverage level thresholds on a requirement's best section score
+const (
+	strongThreshold  = 0.6
+	partialThreshold = 0.3
+)
+
+// skillWeight is how much more a known skill counts than an ordinary keyword
+const skillWeight = 2.0
+
+// maxRequirements caps the rows of the matrix
+const maxRequirements = 30
+
+// sectionHeadings are the resume headings recognised when splitting sections
+var sectionHeadings = map[string]string{
+	"summary":                 "Summary",
+	"professional summary":    "Summary",
+	"profile":                 "Summary",
+	"objective":               "Summary",
+	"about me":                "Summary",
+	"experience":              "Experience",
+	"work experience":         "Experience",
+	"professional experience": "Experience",
+	"employment":              "Experience",
+	"employment history":      "Experience",
+	"work history":            "Experience",
+	"skills":                  "Skills",
+	"technical skills":        "Skills",
+	"core competencies":       "Skills",
+	"technologies":            "Skills",
+	"projects":                "Projects",
+	"personal projects":       "Projects",
+	"selected projects":       "Projects",
+	"education":               "Education",
+	"certifications":          "Certifications",
+	"certificates":            "Certifications",
+	"licenses":                "Certifications",
+	"publications":            "Publications",
+	"awards":                  "Awards",
+	"volunteer":               "Volunteering",
+	"volunteering":            "Volunteering",
+	"leadership":              "Leadership",
+	"references":              "References",
+	"referees":                "References",
+}
+
+// bulletPattern matches list items in job descriptions
+var bulletPattern = regexp.MustCompile(`^\s*(?:[-*•·▪◦]|\d+[.)])\s+(.+)$`)
+
+// requirementCue matches sentences that state a requirement
+var requirementCue = regexp.MustCompile(`(?i)\b(\d+\+?\s*(years|yrs)|experience (with|in)|proficien(t|cy)|` +
+	`degree in|knowledge of|familiar(ity)? with|must have|you have|strong|ability to|understanding of)\b`)
+
+var stopwords = map[string]bool{
+	"a": true, "an": true, "and": true, "or": true, "the": true, "of": true, "in": true, "on": true,
+	"to": true, "for": true, "with": true, "as": true, "at": true, "by": true, "from": true, "is": true,
+	"are": true, "be": true, "you": true, "your": true, "we": true, "our": true, "will": true, "can": true,
+	"have": true, "has": true, "must": true, "should": true, "able": true, "ability": true, "strong": true,
+	"experience": true, "years": true, "year": true, "yrs": true, "plus": true, "etc": true, "using": true,
+	"knowledge": true, "understanding": true, "familiarity": true, "familiar": true, "proficiency": true,
+	"proficient": true, "including": true, "such": true, "like": true, "other": true, "work": true,
+	"working": true, "excellent": true, "good": true, "solid": true, "preferred": true, "required": true,
+	"bonus": true, "nice": true, "least": true, "more": true, "similar": true, "related": true, "field": true,
+	"skills": true, "skill": true, "who": true, "that": true, "this": true, "their": true, "within": true,
+}
+
+// SplitSections splits resume text into headed sections. Text before the
+// first recognised heading is kept as a "Header" section.
+func SplitSections(text string) []domain.ResumeSection {
+	var sections []domain.ResumeSection
+	current := domain.ResumeSection{Name: "Header"}
+	var body []string
+
+	flush := func() {
+		current.Text = strings.TrimSpace(strings.Join(body, "\n"))
+		if current.Text != "" {
+			sections = append(sections, current)
+		}
+		body = body[:0]
+	}
+
+	for _, line := range strings.Split(text, "\n") {
+		if name, ok := Heading(line); ok {
+			flush()
+			current = domain.ResumeSection{Name: name}
+			continue
+		}
+		body = append(body, line)
+	}
+	flush()
+	return sections
+}
+
+// Heading reports whether a line is a section heading and returns its canonical name
+func Heading(line string) (string, bool) {
+	s := strings.ToLower(strings.TrimSpace(line))
+	s = strings.Trim(s, ":#*_=- \t")
+	s = strings.Join(strings.Fields(strings.ReplaceAll(s, "&", " and ")), " ")
+	if name, ok := sectionHeadings[s]; ok {
+		return name, true
+	}
+	return "", false
+}
+
+// Requirements returns the requirements of a job: its listed requirements,
+// else requirement-like bullets and sentences from the description, else its skills
+func Requirements(job *domain.Job) []string {
+	if len(job.Requirements) > 0 {
+		return limit(job.Requirements)
+	}
+
+	var bullets, sentences []string
+	for _, line := range strings.Split(job.Description, "\n") {
+		if m := bulletPattern.FindStringSubmatch(line); m != nil {
+			if item := strings.TrimSpace(m[1]); requirementCue.MatchString(item) || len(analytics.ExtractSkills(item)) > 0 {
+				bullets = append(bullets, item)
+			}
+			continue
+		}
+		for _, sentence := range strings.FieldsFunc(line, func(r rune) bool { return r == '.' || r == ';' }) {
+			if sentence = strings.TrimSpace(sentence); requirementCue.MatchString(sentence) {
+				sentences = append(sentences, sentence)
+			}
+		}
+	}
+	switch {
+	case len(bullets) > 0:
+		return limit(bullets)
+	case len(sentences) > 0:
+		return limit(sentences)
+	default:
+		return limit(analytics.JobSkills(job))
+	}
+}
+
+func limit(items []string) []string {
+	if len(items) > maxRequirements {
+		return items[:maxRequirements]
+	}
+	return items
+}
+
+// keyword is one term a requirement asks for, matched by its stem
+type keyword struct {
+	term   string
+	stem   string
+	weight float64
+	skill  bool
+}
+
+// keywords returns the skills and content words of a requirement. Words that
+// belong to a detected skill are not counted twice.
+func keywords(requirement string) []keyword {
+	var kws []keyword
+	seen := make(map[string]bool)
+	for _, skill := range analytics.ExtractSkills(requirement) {
+		seen[skill] = true
+		for _, word := range words(skill) {
+			seen[stem(word)] = true
+		}
+		kws = append(kws, keyword{term: skill, stem: skill, weight: skillWeight, skill: true})
+	}
+	for _, word := range words(requirement) {
+		if key := stem(word); !seen[key] && !seen[analytics.NormalizeSkill(word)] {
+			seen[key] = true
+			kws = append(kws, keyword{term: word, stem: key, weight: 1})
+		}
+	}
+	return kws
+}
+
+// words returns the lowercase content words of text
+func words(text string) []string {
+	var out []string
+	for _, w := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '+' || r == '#')
+	}) {
+		if len(w) < 2 || stopwords[w] || strings.Trim(w, "0123456789+") == "" {
+			continue
+		}
+		out = append(out, w)
+	}
+	return out
+}
+
+// stem strips common English suffixes so "deployed" matches "deploying"
+func stem(w string) string {
+	for _, suffix := range []string{"ing", "ed", "es", "s"} {
+		if len(w) > len(suffix)+3 && strings.HasSuffix(w, suffix) && !strings.HasSuffix(w, "ss") {
+			return strings.TrimSuffix(w, suffix)
+		}
+	}
+	return w
+}
+
+// sectionTerms indexes the skills and words of one section
+type sectionTerms struct {
+	skills map[string]bool
+	words  map[string]bool
+}
+
+func indexSection(text string) sectionTerms {
+	t := sectionTerms{skills: make(map[string]bool), words: make(map[string]bool)}
+	for _, skill := range analytics.ExtractSkills(text) {
+		t.skills[skill] = true
+	}
+	for _, word := range words(text) {
+		t.words[stem(word)] = true
+	}
+	return t
+}
+
+// Build maps each requirement onto the resume sections that address it
+func Build(requirements []string, sections []domain.ResumeSection) domain.CoverageMatrix {
+	matrix := domain.CoverageMatrix{
+		Sections:     make([]string, len(sections)),
+		Requirements: make([]domain.RequirementCoverage, 0, len(requirements)),
+		Uncovered:    []string{},
+	}
+	indexed := make([]sectionTerms, len(sections))
+	for i, section := range sections {
+		matrix.Sections[i] = section.Name
+		indexed[i] = indexSection(section.Text)
+	}
+
+	var total float64
+	for _, requirement := range requirements {
+		kws := keywords(requirement)
+		row := domain.RequirementCoverage{
+			Requirement: requirement,
+			Keywords:    make([]string, len(kws)),
+			Cells:       make([]domain.SectionCoverage, len(sections)),
+			Level:       domain.CoverageNone,
+		}
+		var weight float64
+		for i, kw := range kws {
+			row.Keywords[i] = kw.term
+			weight += kw.weight
+		}
+
+		for i, terms := range indexed {
+			cell := domain.SectionCoverage{Section: sections[i].Name}
+			var matched float64
+			for _, kw := range kws {
+				if (kw.skill && terms.skills[kw.term]) || (!kw.skill && terms.words[kw.stem]) {
+					matched += kw.weight
+					cell.Matched = append(cell.Matched, kw.term)
+				}
+			}
+			if weight > 0 {
+				cell.Score = round2(matched / weight)
+			}
+			row.Cells[i] = cell
+			if cell.Score > row.Score {
+				row.Score, row.Best = cell.Score, cell.Section
+			}
+		}
+
+		switch {
+		case row.Score >= strongThreshold:
+			row.Level = domain.CoverageStrong
+		case row.Score >= partialThreshold:
+			row.Level = domain.CoveragePartial
+		default:
+			matrix.Uncovered = append(matrix.Uncovered, requirement)
+		}
+		total += row.Score
+		matrix.Requirements = append(matrix.Requirements, row)
+	}
+
+	if len(requirements) > 0 {
+		matrix.Overall = round2(total / float64(len(requirements)))
+	}
+	return matrix
+}
+
+// ForJob builds the coverage matrix of a resume against a job
+func ForJob(job *domain.Job, resumeText string) domain.CoverageMatrix {
+	matrix := Build(Requirements(job), SplitSections(resumeText))
+	matrix.JobID = job.ID
+	return matrix
+}
+
+func round2(f float64) float64 {
+	return float64(int(f*100+0.5)) / 100
+}
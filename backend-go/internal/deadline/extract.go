@@ -0,0 +1,124 @@
+package deadline
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// cuePattern finds phrases that introduce an application deadline. The date
+// itself is parsed from the text that follows the cue.
+var cuePattern = regexp.MustCompile(`(?i)\b(?:apply\s+(?:by|before|no\s+later\s+than)|application\s+deadline|deadline\s+(?:to\s+apply|for\s+applications)|deadline|applications?\s+(?:close|closes|closing|are\s+due|due|(?:will\s+be\s+)?accepted\s+(?:until|through))|closing\s+date|accepting\s+applications\s+(?:until|through)|(?:open|posted)\s+until)\b[\s:\-–—]*(?:(?:on|is|by|until|of)\s+)?(?:(?:mon|tues?|wed(?:nes)?|thu(?:rs)?|fri|sat(?:ur)?|sun)(?:day)?,?\s+)?`)
+
+const month = `(jan(?:uary)?|feb(?:ruary)?|mar(?:ch)?|apr(?:il)?|may|june?|july?|aug(?:ust)?|sep(?:t(?:ember)?)?|oct(?:ober)?|nov(?:ember)?|dec(?:ember)?)\.?`
+
+var (
+	// March 15, 2025 / Mar 15th 2025 / March 15
+	monthDay = regexp.MustCompile(`(?i)^` + month + `\s+(\d{1,2})(?:st|nd|rd|th)?(?:,?\s+(\d{4}))?\b`)
+	// 15 March 2025 / 15th of March
+	dayMonth = regexp.MustCompile(`(?i)^(\d{1,2})(?:st|nd|rd|th)?\s+(?:of\s+)?` + month + `(?:,?\s+(\d{4}))?\b`)
+	// 2025-03-15
+	isoDate = regexp.MustCompile(`^(\d{4})-(\d{2})-(\d{2})\b`)
+	// 03/15/2025 / 3/15/25 / 3/15 (US order)
+	slashDate = regexp.MustCompile(`^(\d{1,2})/(\d{1,2})(?:/(\d{2,4}))?\b`)
+)
+
+var months = map[string]time.Month{
+	"jan": time.January, "feb": time.February, "mar": time.March, "apr": time.April,
+	"may": time.May, "jun": time.June, "jul": time.July, "aug": time.August,
+	"sep": time.September, "oct": time.October, "nov": time.November, "dec": time.December,
+}
+
+// Extract returns the first application deadline stated in text, as the end
+// of that day in UTC. Dates without a year are placed on or after ref.
+func Extract(text string, ref time.Time) *time.Time {
+	for _, loc := range cuePattern.FindAllStringIndex(text, -1) {
+		if d := parseDate(text[loc[1]:], ref); d != nil {
+			return d
+		}
+	}
+	return nil
+}
+
+// parseDate parses a date at the start of s
+func parseDate(s string, ref time.Time) *time.Time {
+	var (
+		y, d int
+		m    time.Month
+	)
+	switch {
+	case monthDay.MatchString(s):
+		g := monthDay.FindStringSubmatch(s)
+		m, d, y = months[strings.ToLower(g[1][:3])], atoi(g[2]), atoi(g[3])
+	case dayMonth.MatchString(s):
+		g := dayMonth.FindStringSubmatch(s)
+		d, m, y = atoi(g[1]), months[strings.ToLower(g[2][:3])], atoi(g[3])
+	case isoDate.MatchString(s):
+		g := isoDate.FindStringSubmatch(s)
+		y, m, d = atoi(g[1]), time.Month(atoi(g[2])), atoi(g[3])
+	case slashDate.MatchString(s):
+		g := slashDate.FindStringSubmatch(s)
+		m, d, y = time.Month(atoi(g[1])), atoi(g[2]), atoi(g[3])
+		if y > 0 && y < 100 {
+			y += 2000
+		}
+	default:
+		return nil
+	}
+	if m < time.January || m > time.December || d < 1 || d > 31 {
+		return nil
+	}
+
+	yearGiven := y > 0
+	if !yearGiven {
+		y = ref.Year()
+	}
+	t := time.Date(y, m, d, 23, 59, 59, 0, time.UTC)
+	if t.Day() != d {
+		return nil // e.g. February 30
+	}
+	if !yearGiven && t.Before(ref) {
+		t = t.AddDate(1, 0, 0)
+	}
+	return &t
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// Extractor fills in application deadlines on freshly scraped jobs
+type Extractor struct{}
+
+// NewExtractor creates a deadline extractor
+func NewExtractor() *Extractor {
+	return &Extractor{}
+}
+
+// Enrich parses deadlines from job descriptions; jobs that already carry a
+// deadline from their source are left alone
+func (e *Extractor) Enrich(ctx context.Context, jobs []*domain.Job) {
+	for _, job := range jobs {
+		if job.Deadline != nil {
+			continue
+		}
+		job.Deadline = Extract(job.Description, referenceTime(job))
+	}
+}
+
+// referenceTime is when the posting was written, for resolving dates without a year
+func referenceTime(job *domain.Job) time.Time {
+	switch {
+	case job.PostedDate != nil:
+		return *job.PostedDate
+	case !job.ScrapedAt.IsZero():
+		return job.ScrapedAt
+	default:
+		return time.Now()
+	}
+}
@@ -0,0 +1,131 @@
+package deadline
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// reminderPageSize is how many saved applications are loaded per request
+const reminderPageSize = 100
+
+// ApplicationService lists and updates tracked applications
+type ApplicationService interface {
+	GetApplications(ctx context.Context, status *domain.ApplicationStatus, limit, offset int) (*domain.ApplicationListResponse, error)
+	UpdateApplication(ctx context.Context, appID uuid.UUID, req domain.ApplicationUpdate) (*domain.Application, error)
+}
+
+// Notifier delivers a notification on the channels the user chose for it
+type Notifier interface {
+	Notify(ctx context.Context, n domain.Notification) error
+}
+
+// ReminderWorker sets reminders on saved jobs whose application deadline is near
+type ReminderWorker struct {
+	service  ApplicationService
+	notifier Notifier
+	cfg      config.DeadlineConfig
+	tenants  []string
+}
+
+// NewReminderWorker creates a deadline reminder worker for the given tenants.
+// notifier may be nil, in which case reminders are only set on the
+// applications.
+func NewReminderWorker(service ApplicationService, notifier Notifier, cfg config.DeadlineConfig, tenants []string) *ReminderWorker {
+	return &ReminderWorker{service: service, notifier: notifier, cfg: cfg, tenants: tenants}
+}
+
+// Start runs the worker on the configured interval until ctx is cancelled
+func (w *ReminderWorker) Start(ctx context.Context) {
+	if w.cfg.Interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(w.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, id := range w.tenants {
+					tctx := tenant.WithTenant(ctx, config.TenantConfig{ID: id})
+					if n, err := w.Run(tctx, time.Now()); err != nil {
+						logger.Warn("Deadline reminder run failed", zap.String("tenant", id), zap.Error(err))
+					} else if n > 0 {
+						logger.Info("Created deadline reminders", zap.String("tenant", id), zap.Int("count", n))
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Run sets a reminder ReminderLead before the deadline on every saved
+// application that closes before the next run would catch it, and returns how
+// many reminders were set. Earlier reminders the user chose are kept.
+func (w *ReminderWorker) Run(ctx context.Context, now time.Time) (int, error) {
+	status := domain.ApplicationStatusSaved
+	horizon := now.Add(w.cfg.ReminderLead + w.cfg.Interval)
+
+	created := 0
+	for offset := 0; ; offset += reminderPageSize {
+		page, err := w.service.GetApplications(ctx, &status, reminderPageSize, offset)
+		if err != nil {
+			return created, err
+		}
+
+		for _, app := range page.Applications {
+			deadline := app.Job.Deadline
+			if deadline == nil || deadline.Before(now) || deadline.After(horizon) {
+				continue
+			}
+
+			remindAt := deadline.Add(-w.cfg.ReminderLead)
+			if remindAt.Before(now) {
+				remindAt = now
+			}
+			if app.ReminderDate != nil && !app.ReminderDate.After(remindAt) {
+				continue
+			}
+
+			if _, err := w.service.UpdateApplication(ctx, app.ID, domain.ApplicationUpdate{ReminderDate: &remindAt}); err != nil {
+				logger.Warn("Failed to set deadline reminder", zap.String("application_id", app.ID.String()), zap.Error(err))
+				continue
+			}
+			created++
+			w.notify(ctx, app, *deadline, now)
+		}
+
+		if len(page.Applications) < reminderPageSize {
+			return created, nil
+		}
+	}
+}
+
+// notify tells the user about a reminder that was just set
+func (w *ReminderWorker) notify(ctx context.Context, app domain.Application, deadline, now time.Time) {
+	if w.notifier == nil {
+		return
+	}
+	n := domain.Notification{
+		Event:     domain.NotificationReminder,
+		Title:     fmt.Sprintf("Applications for %s at %s close soon", app.Job.Title, app.Job.CompanyName),
+		Body:      "The application deadline is " + deadline.Format("Mon, Jan 2 15:04 MST") + ".",
+		URL:       "/job-list",
+		CreatedAt: now,
+	}
+	if err := w.notifier.Notify(ctx, n); err != nil {
+		logger.Warn("Failed to send deadline reminder", zap.String("application_id", app.ID.String()), zap.Error(err))
+	}
+}
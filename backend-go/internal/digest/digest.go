@@ -0,0 +1,164 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// maxApplications caps how many applications are scanned for activity
+const maxApplications = 500
+
+// Source provides the data a digest summarizes; JobListService satisfies it
+type Source interface {
+	GetRecommendations(ctx context.Context, limit int) ([]domain.JobRecommendation, error)
+	GetApplications(ctx context.Context, status *domain.ApplicationStatus, limit, offset int) (*domain.ApplicationListResponse, error)
+	GetApplicationStats(ctx context.Context) (*domain.ApplicationStats, error)
+}
+
+// Builder compiles digests, remembering the previous skill gaps to report trends
+type Builder struct {
+	source Source
+	cfg    config.DigestConfig
+
+	mu           sync.Mutex
+	lastSkillGap map[string]bool
+}
+
+// NewBuilder creates a digest builder
+func NewBuilder(source Source, cfg config.DigestConfig) *Builder {
+	return &Builder{source: source, cfg: cfg}
+}
+
+// Build compiles the digest for the period ending at now. When record is
+// true the skill gaps become the baseline for the next digest's trend.
+func (b *Builder) Build(ctx context.Context, now time.Time, record bool) (*domain.Digest, error) {
+	d := &domain.Digest{
+		PeriodStart:       now.Add(-b.cfg.Period),
+		PeriodEnd:         now,
+		NewMatches:        []domain.JobBrief{},
+		UpcomingReminders: []domain.Application{},
+		SkillGaps:         []domain.SkillGapTrend{},
+		GeneratedAt:       now,
+	}
+
+	if err := b.addMatches(ctx, d); err != nil {
+		return nil, err
+	}
+	if err := b.addApplications(ctx, d, now); err != nil {
+		return nil, err
+	}
+	if err := b.addSkillGaps(ctx, d, record); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// addMatches adds recommended jobs first seen in the period above the score threshold
+func (b *Builder) addMatches(ctx context.Context, d *domain.Digest) error {
+	recs, err := b.source.GetRecommendations(ctx, b.cfg.MaxJobs*3)
+	if err != nil {
+		return fmt.Errorf("failed to load recommendations: %w", err)
+	}
+
+	for _, rec := range recs {
+		job := rec.Job
+		if job.MatchScore == nil || *job.MatchScore < b.cfg.MinMatchScore {
+			continue
+		}
+		seen := job.FirstSeenAt
+		if seen == nil {
+			seen = job.PostedDate
+		}
+		if seen == nil || seen.Before(d.PeriodStart) {
+			continue
+		}
+		d.NewMatches = append(d.NewMatches, job)
+	}
+
+	sort.SliceStable(d.NewMatches, func(i, j int) bool {
+		return *d.NewMatches[i].MatchScore > *d.NewMatches[j].MatchScore
+	})
+	if len(d.NewMatches) > b.cfg.MaxJobs {
+		d.NewMatches = d.NewMatches[:b.cfg.MaxJobs]
+	}
+	return nil
+}
+
+// addApplications counts status changes in the period and collects reminders due within the next period
+func (b *Builder) addApplications(ctx context.Context, d *domain.Digest, now time.Time) error {
+	apps, err := b.source.GetApplications(ctx, nil, maxApplications, 0)
+	if err != nil {
+		return fmt.Errorf("failed to load applications: %w", err)
+	}
+
+	inPeriod := func(t time.Time) bool {
+		return !t.Before(d.PeriodStart) && !t.After(d.PeriodEnd)
+	}
+	horizon := now.Add(b.cfg.Period)
+
+	for _, app := range apps.Applications {
+		if app.AppliedDate != nil && inPeriod(*app.AppliedDate) {
+			d.Activity.Applied++
+		}
+		for _, entry := range app.Timeline {
+			if !inPeriod(entry.ChangedAt) {
+				continue
+			}
+			d.Activity.StatusChanges++
+			switch entry.NewStatus {
+			case domain.ApplicationStatusInterview:
+				d.Activity.Interviews++
+			case domain.ApplicationStatusOffer:
+				d.Activity.Offers++
+			case domain.ApplicationStatusRejected:
+				d.Activity.Rejections++
+			}
+		}
+		if app.ReminderDate != nil && app.ReminderDate.Before(horizon) {
+			d.UpcomingReminders = append(d.UpcomingReminders, app)
+		}
+	}
+
+	sort.SliceStable(d.UpcomingReminders, func(i, j int) bool {
+		return d.UpcomingReminders[i].ReminderDate.Before(*d.UpcomingReminders[j].ReminderDate)
+	})
+	return nil
+}
+
+// addSkillGaps reports commonly missing skills, marking ones new since the last digest
+func (b *Builder) addSkillGaps(ctx context.Context, d *domain.Digest, record bool) error {
+	stats, err := b.source.GetApplicationStats(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load application stats: %w", err)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	current := make(map[string]bool, len(stats.TopMissingSkills))
+	for _, skill := range stats.TopMissingSkills {
+		current[skill] = true
+		d.SkillGaps = append(d.SkillGaps, domain.SkillGapTrend{
+			Skill: skill,
+			New:   b.lastSkillGap != nil && !b.lastSkillGap[skill],
+		})
+	}
+	for skill := range b.lastSkillGap {
+		if !current[skill] {
+			d.ResolvedSkillGaps = append(d.ResolvedSkillGaps, skill)
+		}
+	}
+	sort.Strings(d.ResolvedSkillGaps)
+
+	if record {
+		b.lastSkillGap = current
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package digest
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"text/template"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+var funcs = map[string]interface{}{
+	"date":  formatDate,
+	"score": formatScore,
+}
+
+var markdownTemplate = template.Must(template.New("digest.md").Funcs(funcs).Parse(
+	`# Your weekly job search digest
+_{{date .PeriodStart}} – {{date .PeriodEnd}}_
+
+## New high-match jobs
+{{- if .NewMatches}}
+{{range .NewMatches}}- **{{.Title}}** at {{.CompanyName}} — match {{score .MatchScore}}
+{{end}}{{else}}
+No new high-match jobs this week.
+{{end}}
+## Application activity
+- Applied: {{.Activity.Applied}}
+- Status changes: {{.Activity.StatusChanges}}
+- Interviews: {{.Activity.Interviews}}
+- Offers: {{.Activity.Offers}}
+- Rejections: {{.Activity.Rejections}}
+
+## Upcoming reminders
+{{- if .UpcomingReminders}}
+{{range .UpcomingReminders}}- {{date .ReminderDate}}: {{.Job.Title}} at {{.Job.CompanyName}} ({{.Status}})
+{{end}}{{else}}
+Nothing scheduled.
+{{end}}
+## Skill gaps
+{{- if .SkillGaps}}
+{{range .SkillGaps}}- {{.Skill}}{{if .New}} _(new)_{{end}}
+{{end}}{{else}}
+No recurring skill gaps.
+{{end}}
+{{- if .ResolvedSkillGaps}}
+Closed since last digest: {{range $i, $s := .ResolvedSkillGaps}}{{if $i}}, {{end}}{{$s}}{{end}}
+{{end}}`))
+
+var htmlTemplate = htmltemplate.Must(htmltemplate.New("digest.html").Funcs(funcs).Parse(
+	`<!DOCTYPE html>
+<html>
+<body style="font-family: -apple-system, Helvetica, Arial, sans-serif; max-width: 640px; margin: 0 auto; color: #1f2937;">
+<h1>Your weekly job search digest</h1>
+<p style="color: #6b7280;">{{date .PeriodStart}} – {{date .PeriodEnd}}</p>
+
+<h2>New high-match jobs</h2>
+{{if .NewMatches}}<ul>
+{{range .NewMatches}}<li><strong>{{.Title}}</strong> at {{.CompanyName}} — match {{score .MatchScore}}</li>
+{{end}}</ul>{{else}}<p>No new high-match jobs this week.</p>{{end}}
+
+<h2>Application activity</h2>
+<table cellpadding="4">
+<tr><td>Applied</td><td>{{.Activity.Applied}}</td></tr>
+<tr><td>Status changes</td><td>{{.Activity.StatusChanges}}</td></tr>
+<tr><td>Interviews</td><td>{{.Activity.Interviews}}</td></tr>
+<tr><td>Offers</td><td>{{.Activity.Offers}}</td></tr>
+<tr><td>Rejections</td><td>{{.Activity.Rejections}}</td></tr>
+</table>
+
+<h2>Upcoming reminders</h2>
+{{if .UpcomingReminders}}<ul>
+{{range .UpcomingReminders}}<li>{{date .ReminderDate}}: {{.Job.Title}} at {{.Job.CompanyName}} ({{.Status}})</li>
+{{end}}</ul>{{else}}<p>Nothing scheduled.</p>{{end}}
+
+<h2>Skill gaps</h2>
+{{if .SkillGaps}}<ul>
+{{range .SkillGaps}}<li>{{.Skill}}{{if .New}} <em>(new)</em>{{end}}</li>
+{{end}}</ul>{{else}}<p>No recurring skill gaps.</p>{{end}}
+{{if .ResolvedSkillGaps}}<p>Closed since last digest: {{range $i, $s := .ResolvedSkillGaps}}{{if $i}}, {{end}}{{$s}}{{end}}</p>{{end}}
+</body>
+</html>
+`))
+
+// RenderMarkdown renders a digest as Markdown
+func RenderMarkdown(d *domain.Digest) (string, error) {
+	var buf bytes.Buffer
+	if err := markdownTemplate.Execute(&buf, d); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// RenderHTML renders a digest as an HTML email body
+func RenderHTML(d *domain.Digest) (string, error) {
+	var buf bytes.Buffer
+	if err := htmlTemplate.Execute(&buf, d); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// formatDate formats a time or time pointer for display
+func formatDate(v interface{}) string {
+	switch t := v.(type) {
+	case time.Time:
+		return t.Format("Jan 2, 2006")
+	case *time.Time:
+		if t != nil {
+			return t.Format("Jan 2, 2006")
+		}
+	}
+	return ""
+}
+
+// formatScore formats an optional match score as a percentage
+func formatScore(score *float64) string {
+	if score == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.0f%%", *score)
+}
@@ -0,0 +1,172 @@
+package digest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/storage"
+	"github.com/resume-rag/backend/internal/taskevents"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// Sender delivers a rendered digest, e.g. by email
+type Sender interface {
+	Send(ctx context.Context, subject, html, markdown string) error
+}
+
+// Notifier delivers a notification on the channels the user chose for it
+type Notifier interface {
+	Notify(ctx context.Context, n domain.Notification) error
+}
+
+// Worker compiles and delivers the digest on a schedule, one per tenant
+type Worker struct {
+	builder  *Builder
+	interval time.Duration
+	store    storage.Storage
+	sender   Sender
+	notifier Notifier
+	events   *taskevents.Log
+	tenants  []string
+
+	mu   sync.Mutex
+	last map[string]*domain.Digest // by tenant
+}
+
+// NewWorker creates a digest worker for the given tenants. Digests are
+// archived to store when set and delivered through sender when set.
+func NewWorker(builder *Builder, interval time.Duration, store storage.Storage, sender Sender, tenants []string) *Worker {
+	return &Worker{
+		builder:  builder,
+		interval: interval,
+		store:    store,
+		sender:   sender,
+		tenants:  tenants,
+		last:     make(map[string]*domain.Digest),
+	}
+}
+
+// SetTaskEvents records each digest run's steps on the shared task timeline
+func (w *Worker) SetTaskEvents(events *taskevents.Log) {
+	w.events = events
+}
+
+// SetNotifier delivers digests as notifications, following the user's
+// notification preferences, instead of through the sender
+func (w *Worker) SetNotifier(notifier Notifier) {
+	w.notifier = notifier
+}
+
+// Start runs the digest on the configured interval until ctx is cancelled
+func (w *Worker) Start(ctx context.Context) {
+	if w.interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, id := range w.tenants {
+					tctx := tenant.WithTenant(ctx, config.TenantConfig{ID: id})
+					if _, err := w.Run(tctx); err != nil {
+						logger.Warn("Digest generation failed", zap.String("tenant", id), zap.Error(err))
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Run compiles, archives and delivers the digest of the tenant of ctx
+func (w *Worker) Run(ctx context.Context) (*domain.Digest, error) {
+	taskID := uuid.New()
+	w.events.Record(ctx, taskID, domain.TaskKindDigest, domain.TaskEventStarted, "Digest run started", nil)
+	d, err := w.run(ctx)
+	if err != nil {
+		w.events.Record(ctx, taskID, domain.TaskKindDigest, domain.TaskEventFailed, err.Error(), nil)
+		return d, err
+	}
+	d.TaskID = &taskID
+	w.events.Record(ctx, taskID, domain.TaskKindDigest, domain.TaskEventCompleted, "Digest delivered", map[string]interface{}{
+		"new_matches": len(d.NewMatches), "reminders": len(d.UpcomingReminders),
+	})
+	return d, nil
+}
+
+func (w *Worker) run(ctx context.Context) (*domain.Digest, error) {
+	d, err := w.builder.Build(ctx, time.Now(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	html, err := RenderHTML(d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render digest: %w", err)
+	}
+	markdown, err := RenderMarkdown(d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render digest: %w", err)
+	}
+
+	if w.store != nil {
+		base := storage.PrefixExports + "digests/" + tenant.ID(ctx) + "/" + d.PeriodEnd.Format("2006-01-02")
+		if err := w.store.Put(ctx, base+".html", strings.NewReader(html), int64(len(html)), "text/html"); err != nil {
+			logger.Warn("Failed to archive digest", zap.Error(err))
+		}
+		if err := w.store.Put(ctx, base+".md", strings.NewReader(markdown), int64(len(markdown)), "text/markdown"); err != nil {
+			logger.Warn("Failed to archive digest", zap.Error(err))
+		}
+	}
+
+	subject := "Your weekly job search digest: " + d.PeriodEnd.Format("Jan 2")
+	switch {
+	case w.notifier != nil:
+		n := domain.Notification{Event: domain.NotificationDigest, Title: subject, Body: markdown, URL: "/job-list", CreatedAt: d.GeneratedAt}
+		if err := w.notifier.Notify(ctx, n); err != nil {
+			return d, fmt.Errorf("failed to send digest: %w", err)
+		}
+	case w.sender != nil:
+		if err := w.sender.Send(ctx, subject, html, markdown); err != nil {
+			return d, fmt.Errorf("failed to send digest: %w", err)
+		}
+	}
+
+	w.mu.Lock()
+	w.last[tenant.ID(ctx)] = d
+	w.mu.Unlock()
+
+	logger.Info("Digest generated",
+		zap.String("tenant", tenant.ID(ctx)),
+		zap.Int("new_matches", len(d.NewMatches)),
+		zap.Int("reminders", len(d.UpcomingReminders)),
+	)
+	return d, nil
+}
+
+// Preview compiles the current digest without archiving, sending, or
+// advancing the skill-gap baseline
+func (w *Worker) Preview(ctx context.Context) (*domain.Digest, error) {
+	return w.builder.Build(ctx, time.Now(), false)
+}
+
+// Last returns the most recently delivered digest of the tenant of ctx, or nil
+func (w *Worker) Last(ctx context.Context) *domain.Digest {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.last[tenant.ID(ctx)]
+}
@@ -0,0 +1,113 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AnalyzeJobRequest asks how well the resume fits a job description
+type AnalyzeJobRequest struct {
+	JobDescription string   `json:"job_description"`
+	FocusAreas     []string `json:"focus_areas,omitempty"` // e.g. skills, experience, education
+}
+
+// SkillMatch is a job requirement and whether the resume shows it
+type SkillMatch struct {
+	Item           string  `json:"item"`
+	Matched        bool    `json:"matched"`
+	ResumeEvidence *string `json:"resume_evidence,omitempty"`
+}
+
+// GapStatus is how fully the resume covers a requirement
+type GapStatus string
+
+const (
+	GapMet     GapStatus = "met"
+	GapPartial GapStatus = "partial"
+	GapMissing GapStatus = "missing"
+)
+
+// RequirementGap is a requirement the resume covers only partly or not at all
+type RequirementGap struct {
+	Requirement string    `json:"requirement"`
+	Status      GapStatus `json:"status"`
+	Suggestion  *string   `json:"suggestion,omitempty"`
+}
+
+// JobAnalysis is the fit between the resume and one job description
+type JobAnalysis struct {
+	MatchScore       float64          `json:"match_score"` // 0-100
+	MatchingSkills   []SkillMatch     `json:"matching_skills"`
+	Gaps             []RequirementGap `json:"gaps"`
+	KeywordsToAdd    []string         `json:"keywords_to_add"`
+	Suggestions      []string         `json:"suggestions"`
+	Summary          string           `json:"summary"`
+	ProcessingTimeMs *int             `json:"processing_time_ms,omitempty"`
+}
+
+// Validate checks the analysis is well formed
+func (a *JobAnalysis) Validate() error {
+	if a.MatchScore < 0 || a.MatchScore > 100 {
+		return fmt.Errorf("match_score %.1f is outside 0-100", a.MatchScore)
+	}
+	for _, gap := range a.Gaps {
+		switch gap.Status {
+		case GapMet, GapPartial, GapMissing:
+		default:
+			return fmt.Errorf("gap %q has unknown status %q", gap.Requirement, gap.Status)
+		}
+	}
+	return nil
+}
+
+// KeywordsRequest asks for the keywords in a job description
+type KeywordsRequest struct {
+	JobDescription string `json:"job_description"`
+}
+
+// MatchRequest scores the resume against one job description
+type MatchRequest struct {
+	JobDescription string `json:"job_description"`
+}
+
+// BatchMatchRequest scores the resume against several job descriptions
+type BatchMatchRequest struct {
+	Jobs []string `json:"jobs"`
+}
+
+// MatchResult is a saved job match
+type MatchResult struct {
+	ID        uuid.UUID   `json:"id"`
+	JobTitle  string      `json:"job_title,omitempty"`
+	Company   string      `json:"company,omitempty"`
+	Analysis  JobAnalysis `json:"analysis"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// Validate checks the match is well formed
+func (m *MatchResult) Validate() error {
+	return m.Analysis.Validate()
+}
+
+// MatchHistory is a page of saved matches, newest first
+type MatchHistory struct {
+	Matches []MatchResult `json:"matches"`
+	Total   int           `json:"total"`
+}
+
+// SkillCount is how often a skill came up across matches
+type SkillCount struct {
+	Skill string `json:"skill"`
+	Count int    `json:"count"`
+}
+
+// MatchAnalytics summarizes saved matches
+type MatchAnalytics struct {
+	TotalMatches int          `json:"total_matches"`
+	AverageScore float64      `json:"average_score"`
+	BestScore    float64      `json:"best_score"`
+	CommonGaps   []SkillCount `json:"common_gaps"`   // requirements most often missing
+	StrongSkills []SkillCount `json:"strong_skills"` // skills most often matched
+}
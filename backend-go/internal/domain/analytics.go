@@ -0,0 +1,118 @@
+package domain
+
+import "time"
+
+// SalaryInsight summarizes advertised salaries for one group of jobs
+type SalaryInsight struct {
+	Group  string `json:"group"`
+	Jobs   int    `json:"jobs"`
+	Min    int    `json:"min"`
+	P25    int    `json:"p25"`
+	Median int    `json:"median"`
+	P75    int    `json:"p75"`
+	Max    int    `json:"max"`
+}
+
+// SalaryInsightQuery filters and groups the salary distribution
+type SalaryInsightQuery struct {
+	Title    string `json:"title,omitempty"`
+	Location string `json:"location,omitempty"`
+	GroupBy  string `json:"group_by"` // title, location
+	Limit    int    `json:"limit"`
+}
+
+// SalaryBenchmark is the market salary distribution for a role, from an
+// imported salary survey
+type SalaryBenchmark struct {
+	Title    string `json:"title"`
+	Location string `json:"location,omitempty"` // empty when benchmarked across all locations
+	Source   string `json:"source"`
+	Currency string `json:"currency"`
+	Samples  int    `json:"samples"`
+	P10      int    `json:"p10"`
+	P25      int    `json:"p25"`
+	Median   int    `json:"median"`
+	P75      int    `json:"p75"`
+	P90      int    `json:"p90"`
+}
+
+// SalaryMarketPosition places a posted salary against its benchmark
+type SalaryMarketPosition string
+
+const (
+	SalaryBelowMarket SalaryMarketPosition = "below_market" // midpoint under the 25th percentile
+	SalaryAtMarket    SalaryMarketPosition = "at_market"
+	SalaryAboveMarket SalaryMarketPosition = "above_market" // midpoint over the 75th percentile
+)
+
+// SalaryComparison compares a posted salary range with market percentiles.
+// Percentile and Position are only set when a range was posted.
+type SalaryComparison struct {
+	Benchmark  SalaryBenchmark      `json:"benchmark"`
+	PostedMin  *int                 `json:"posted_min,omitempty"`
+	PostedMax  *int                 `json:"posted_max,omitempty"`
+	Percentile *int                 `json:"percentile,omitempty"` // market percentile of the posted midpoint
+	Position   SalaryMarketPosition `json:"position,omitempty"`
+}
+
+// SkillDemandPoint is the number of jobs mentioning a skill in one week
+type SkillDemandPoint struct {
+	WeekStart time.Time `json:"week_start"`
+	Count     int       `json:"count"`
+}
+
+// SkillDemandSeries is a skill's weekly demand over time
+type SkillDemandSeries struct {
+	Skill  string             `json:"skill"`
+	Total  int                `json:"total"`
+	Points []SkillDemandPoint `json:"points"`
+}
+
+// WorkArrangementInsight is the remote/hybrid/onsite split for one source
+type WorkArrangementInsight struct {
+	Source      JobSource `json:"source"`
+	Remote      int       `json:"remote"`
+	Hybrid      int       `json:"hybrid"`
+	Onsite      int       `json:"onsite"`
+	Unknown     int       `json:"unknown"`
+	RemoteRatio float64   `json:"remote_ratio"`
+}
+
+// StackUsage is how many of a company's jobs use one technology
+type StackUsage struct {
+	Tech     string  `json:"tech"`
+	Category string  `json:"category"` // language, framework, cloud, database
+	Jobs     int     `json:"jobs"`
+	Share    float64 `json:"share"` // fraction of the company's jobs
+}
+
+// CompanyStack summarizes the technologies across one company's postings
+type CompanyStack struct {
+	Company string       `json:"company"`
+	Jobs    int          `json:"jobs"`
+	Stack   []StackUsage `json:"stack"`
+}
+
+// OutcomeBucket is the application outcome rate for one value of a dimension
+type OutcomeBucket struct {
+	Label         string  `json:"label"`
+	Applications  int     `json:"applications"`
+	Responses     int     `json:"responses"`
+	Interviews    int     `json:"interviews"`
+	Offers        int     `json:"offers"`
+	ResponseRate  float64 `json:"response_rate"`
+	InterviewRate float64 `json:"interview_rate"`
+}
+
+// OutcomeDimension breaks application outcomes down by one attribute
+type OutcomeDimension struct {
+	Name    string          `json:"name"` // match_score, source, day_of_week, time_to_apply
+	Buckets []OutcomeBucket `json:"buckets"`
+}
+
+// WhatWorksReport correlates application outcomes with how and where the user applied
+type WhatWorksReport struct {
+	Overall    OutcomeBucket      `json:"overall"`
+	Dimensions []OutcomeDimension `json:"dimensions"`
+	Findings   []string           `json:"findings"`
+}
@@ -28,11 +28,17 @@ type Application struct {
 	AppliedDate   *time.Time        `json:"applied_date,omitempty"`
 	Notes         *string           `json:"notes,omitempty"`
 	ResumeVersion *string           `json:"resume_version,omitempty"`
+	ResumeID      *uuid.UUID        `json:"resume_id,omitempty"` // version record resume_version names, if any
 	CoverLetter   *string           `json:"cover_letter,omitempty"`
 	ReminderDate  *time.Time        `json:"reminder_date,omitempty"`
 	LastUpdated   time.Time         `json:"last_updated"`
 	Timeline      []TimelineEntry   `json:"timeline"`
 	CreatedAt     time.Time         `json:"created_at"`
+	DeletedAt     *time.Time        `json:"deleted_at,omitempty"`
+
+	// Changes to the job's posting since the application was created
+	UpdatedSinceSaved bool          `json:"updated_since_saved"`
+	JobRevisions      []JobRevision `json:"job_revisions,omitempty"` // only on single-application reads
 }
 
 // TimelineEntry represents a status change in application history
@@ -56,10 +62,11 @@ type ApplicationCreate struct {
 
 // ApplicationUpdate represents the request to update an application
 type ApplicationUpdate struct {
-	Status       *ApplicationStatus `json:"status,omitempty"`
-	Notes        *string            `json:"notes,omitempty"`
-	CoverLetter  *string            `json:"cover_letter,omitempty"`
-	ReminderDate *time.Time         `json:"reminder_date,omitempty"`
+	Status        *ApplicationStatus `json:"status,omitempty"`
+	Notes         *string            `json:"notes,omitempty"`
+	ResumeVersion *string            `json:"resume_version,omitempty"`
+	CoverLetter   *string            `json:"cover_letter,omitempty"`
+	ReminderDate  *time.Time         `json:"reminder_date,omitempty"`
 }
 
 // ApplicationListResponse represents the response for listing applications
@@ -74,9 +81,26 @@ type ApplicationStats struct {
 	TotalApplications     int            `json:"total_applications"`
 	ByStatus              map[string]int `json:"by_status"`
 	ResponseRate          *float64       `json:"response_rate,omitempty"`
-	AverageTimeToResponse *int           `json:"average_time_to_response,omitempty"`
+	AverageTimeToResponse *int           `json:"average_time_to_response,omitempty"` // days
 	TopMatchedSkills      []string       `json:"top_matched_skills,omitempty"`
 	TopMissingSkills      []string       `json:"top_missing_skills,omitempty"`
+
+	// ByResumeVersion compares outcomes per resume version, best first.
+	// BestResumeVersion is set once at least two versions have enough
+	// applications for the difference to mean something.
+	ByResumeVersion   []ResumeVersionStats `json:"by_resume_version,omitempty"`
+	BestResumeVersion *string              `json:"best_resume_version,omitempty"`
+}
+
+// ResumeVersionStats is how applications sent with one resume version fared
+type ResumeVersionStats struct {
+	Version               string   `json:"version"`
+	Submitted             int      `json:"submitted"`
+	Responses             int      `json:"responses"`
+	Interviews            int      `json:"interviews"`
+	ResponseRate          *float64 `json:"response_rate,omitempty"`
+	InterviewRate         *float64 `json:"interview_rate,omitempty"`
+	AverageTimeToResponse *int     `json:"average_time_to_response,omitempty"` // days
 }
 
 // SavedSearch represents a saved search preset
@@ -115,6 +139,17 @@ type CoverLetterResponse struct {
 	HighlightsUsed []string  `json:"highlights_used"`
 }
 
+// ApplicationEmailRequest is the body of an application email draft. When
+// JobID is set the description and recipient are filled in from the job.
+type ApplicationEmailRequest struct {
+	JobID          *uuid.UUID `json:"job_id,omitempty"`
+	JobDescription string     `json:"job_description"`
+	RecipientName  string     `json:"recipient_name,omitempty"`
+	RecipientEmail string     `json:"recipient_email,omitempty"`
+	Tone           string     `json:"tone"`
+	Length         string     `json:"length"`
+}
+
 // JobRecommendation represents an AI-recommended job
 type JobRecommendation struct {
 	Job                  JobBrief `json:"job"`
@@ -124,9 +159,45 @@ type JobRecommendation struct {
 
 // JobSearchStats represents job database statistics
 type JobSearchStats struct {
-	TotalJobsIndexed    int            `json:"total_jobs_indexed"`
-	JobsBySource        map[string]int `json:"jobs_by_source"`
-	JobsByLocationType  map[string]int `json:"jobs_by_location_type"`
-	AverageSalary       *int           `json:"average_salary,omitempty"`
-	LastScrapeAt        *time.Time     `json:"last_scrape_at,omitempty"`
+	TotalJobsIndexed     int            `json:"total_jobs_indexed"`
+	JobsBySource         map[string]int `json:"jobs_by_source"`
+	JobsByLocationType   map[string]int `json:"jobs_by_location_type"`
+	JobsByEmploymentType map[string]int `json:"jobs_by_employment_type"`
+	AverageSalary        *int           `json:"average_salary,omitempty"`
+	SalaryDistribution   []SalaryBucket `json:"salary_distribution,omitempty"`
+	LastScrapeAt         *time.Time     `json:"last_scrape_at,omitempty"`
+}
+
+// SalaryBucket counts jobs whose salary midpoint falls in [Min, Max)
+type SalaryBucket struct {
+	Min   int `json:"min"`
+	Max   int `json:"max"`
+	Count int `json:"count"`
+}
+
+// TrashRetention is how long soft-deleted items stay restorable before being purged
+const TrashRetention = 30 * 24 * time.Hour
+
+// TrashItemType identifies the kind of soft-deleted item
+type TrashItemType string
+
+const (
+	TrashItemJob         TrashItemType = "job"
+	TrashItemApplication TrashItemType = "application"
+)
+
+// TrashItem represents a soft-deleted job or application
+type TrashItem struct {
+	ID        uuid.UUID     `json:"id"`
+	Type      TrashItemType `json:"type"`
+	Title     string        `json:"title"`
+	Company   string        `json:"company,omitempty"`
+	DeletedAt time.Time     `json:"deleted_at"`
+	PurgeAt   time.Time     `json:"purge_at"`
+}
+
+// TrashResponse represents the trash listing
+type TrashResponse struct {
+	Items []TrashItem `json:"items"`
+	Total int         `json:"total"`
 }
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntry records a single mutating operation
+type AuditEntry struct {
+	ID         uuid.UUID              `json:"id"`
+	Actor      string                 `json:"actor"`
+	Action     string                 `json:"action"` // create, update, delete, or admin action
+	Resource   string                 `json:"resource"`
+	ResourceID *string                `json:"resource_id,omitempty"`
+	Method     string                 `json:"method"`
+	Path       string                 `json:"path"`
+	Status     int                    `json:"status"`
+	RequestID  string                 `json:"request_id,omitempty"`
+	IP         string                 `json:"ip,omitempty"`
+	Details    map[string]interface{} `json:"details,omitempty"`
+	CreatedAt  time.Time              `json:"created_at"`
+}
+
+// AuditQuery filters audit log entries
+type AuditQuery struct {
+	Actor      string     `json:"actor,omitempty"`
+	Action     string     `json:"action,omitempty"`
+	Resource   string     `json:"resource,omitempty"`
+	ResourceID string     `json:"resource_id,omitempty"`
+	Since      *time.Time `json:"since,omitempty"`
+	Until      *time.Time `json:"until,omitempty"`
+	Limit      int        `json:"limit"`
+	Offset     int        `json:"offset"`
+}
+
+// AuditLogResponse represents a page of audit entries
+type AuditLogResponse struct {
+	Entries []AuditEntry `json:"entries"`
+	Total   int          `json:"total"`
+}
@@ -10,10 +10,13 @@ import (
 type ChatMode string
 
 const (
-	ChatModeChat      ChatMode = "chat"
-	ChatModeEmail     ChatMode = "email"
-	ChatModeTailor    ChatMode = "tailor"
-	ChatModeInterview ChatMode = "interview"
+	ChatModeChat        ChatMode = "chat"
+	ChatModeEmail       ChatMode = "email"
+	ChatModeTailor      ChatMode = "tailor"
+	ChatModeInterview   ChatMode = "interview"
+	ChatModeCoverLetter ChatMode = "cover_letter"
+	ChatModeNegotiation ChatMode = "negotiation"
+	ChatModeRewrite     ChatMode = "rewrite"
 )
 
 // ChatRequest represents an incoming chat request
@@ -23,6 +26,10 @@ type ChatRequest struct {
 	JobDescription  *string  `json:"job_description,omitempty"`
 	UseVerification bool     `json:"use_verification"`
 	SessionID       *string  `json:"session_id,omitempty"`
+
+	// Stories are vetted STAR stories from the story bank for the model to
+	// reuse, attached by the API in interview and chat modes
+	Stories []StoryMatch `json:"stories,omitempty"`
 }
 
 // ChatResponse represents the response to a chat request
@@ -34,6 +41,27 @@ type ChatResponse struct {
 	SearchMode       string     `json:"search_mode"` // hybrid, vector
 	ProcessingTimeMs int64      `json:"processing_time_ms"`
 	SessionID        string     `json:"session_id"`
+
+	// Rewrites is populated in rewrite mode
+	Rewrites []BulletRewrite `json:"rewrites,omitempty"`
+}
+
+// BulletRewrite is a proposed before/after change to a single resume bullet
+type BulletRewrite struct {
+	ID        string              `json:"id"`
+	Section   string              `json:"section"`
+	Before    string              `json:"before"`
+	After     string              `json:"after"`
+	Keywords  []KeywordAnnotation `json:"keywords,omitempty"`
+	Rationale string              `json:"rationale,omitempty"`
+}
+
+// KeywordAnnotation marks where a target keyword appears in a rewritten bullet
+type KeywordAnnotation struct {
+	Keyword string `json:"keyword"`
+	Start   int    `json:"start"`
+	End     int    `json:"end"`
+	IsNew   bool   `json:"is_new"`
 }
 
 // Citation represents a citation from the resume
@@ -69,6 +97,22 @@ type ChatHistoryResponse struct {
 	Total    int           `json:"total"`
 }
 
+// ChatSearchResult represents a message matching a history search
+type ChatSearchResult struct {
+	Message     ChatMessage `json:"message"`
+	SessionID   uuid.UUID   `json:"session_id"`
+	SessionMode ChatMode    `json:"session_mode"`
+	Snippet     string      `json:"snippet"`
+	Rank        float64     `json:"rank"`
+}
+
+// ChatSearchResponse represents chat history search results
+type ChatSearchResponse struct {
+	Query   string             `json:"query"`
+	Results []ChatSearchResult `json:"results"`
+	Total   int                `json:"total"`
+}
+
 // SuggestedPrompt represents a suggested prompt for a mode
 type SuggestedPrompt struct {
 	Text     string   `json:"text"`
@@ -110,7 +154,104 @@ func GetDefaultSuggestions(mode ChatMode) []SuggestedPrompt {
 			{Text: "How should I explain my career transitions?", Category: "story", Mode: mode},
 			{Text: "What technical questions should I prepare for?", Category: "technical", Mode: mode},
 		}
+	case ChatModeCoverLetter:
+		return []SuggestedPrompt{
+			{Text: "Write a cover letter for this job", Category: "general", Mode: mode},
+			{Text: "Which of my projects best fit this role?", Category: "highlights", Mode: mode},
+			{Text: "Write a short cover letter for a startup", Category: "short", Mode: mode},
+		}
+	case ChatModeRewrite:
+		return []SuggestedPrompt{
+			{Text: "Rewrite my experience bullets for this job", Category: "experience", Mode: mode},
+			{Text: "Add missing keywords to my bullets", Category: "keywords", Mode: mode},
+			{Text: "Quantify the impact in my bullets", Category: "impact", Mode: mode},
+		}
+	case ChatModeNegotiation:
+		return []SuggestedPrompt{
+			{Text: "How should I respond to this offer?", Category: "offer", Mode: mode},
+			{Text: "What leverage do I have in this negotiation?", Category: "leverage", Mode: mode},
+			{Text: "Draft a counter-offer email", Category: "counter", Mode: mode},
+		}
 	default:
 		return []SuggestedPrompt{}
 	}
 }
+
+// ChatModeProfile describes the prompt template and retrieval behavior for a mode
+type ChatModeProfile struct {
+	Mode              ChatMode `json:"mode"`
+	SystemPrompt      string   `json:"-"`
+	RequiresJob       bool     `json:"requires_job"`
+	TopK              int      `json:"top_k"`
+	PreferredSections []string `json:"preferred_sections,omitempty"`
+}
+
+// IsValid reports whether the mode is a known chat mode
+func (m ChatMode) IsValid() bool {
+	switch m {
+	case ChatModeChat, ChatModeEmail, ChatModeTailor, ChatModeInterview,
+		ChatModeCoverLetter, ChatModeNegotiation, ChatModeRewrite:
+		return true
+	}
+	return false
+}
+
+// GetModeProfile returns the prompt template and retrieval settings for a mode
+func GetModeProfile(mode ChatMode) ChatModeProfile {
+	switch mode {
+	case ChatModeEmail:
+		return ChatModeProfile{
+			Mode:              mode,
+			SystemPrompt:      "You write concise, professional emails grounded in the candidate's resume. Never invent experience.",
+			RequiresJob:       false,
+			TopK:              5,
+			PreferredSections: []string{"experience", "summary"},
+		}
+	case ChatModeTailor:
+		return ChatModeProfile{
+			Mode:              mode,
+			SystemPrompt:      "You suggest concrete resume changes that align the candidate's real experience with the job description.",
+			RequiresJob:       false,
+			TopK:              10,
+			PreferredSections: []string{"experience", "skills", "projects"},
+		}
+	case ChatModeInterview:
+		return ChatModeProfile{
+			Mode:              mode,
+			SystemPrompt:      "You are an interview coach. Use the candidate's resume to anticipate questions and structure STAR answers. When stories from the candidate's story bank are provided, build answers from them instead of inventing new ones.",
+			RequiresJob:       false,
+			TopK:              8,
+			PreferredSections: []string{"experience", "projects"},
+		}
+	case ChatModeCoverLetter:
+		return ChatModeProfile{
+			Mode:              mode,
+			SystemPrompt:      "You write tailored cover letters. Pick the two or three resume achievements most relevant to the job and cite them.",
+			RequiresJob:       true,
+			TopK:              6,
+			PreferredSections: []string{"experience", "projects", "summary"},
+		}
+	case ChatModeNegotiation:
+		return ChatModeProfile{
+			Mode:              mode,
+			SystemPrompt:      "You are a salary negotiation advisor. Ground leverage points in the candidate's experience and keep the tone collaborative.",
+			RequiresJob:       false,
+			TopK:              4,
+			PreferredSections: []string{"experience", "skills", "achievements"},
+		}
+	case ChatModeRewrite:
+		return ChatModeProfile{
+			Mode:              mode,
+			SystemPrompt:      "You rewrite individual resume bullets for the target job. Return each change as a before/after pair and mark target keywords. Never invent experience.",
+			RequiresJob:       true,
+			TopK:              12,
+			PreferredSections: []string{"experience", "projects"},
+		}
+	default:
+		return ChatModeProfile{
+			Mode:         ChatModeChat,
+			SystemPrompt: "You answer questions about the candidate's resume using only the retrieved context.",
+			TopK:         5,
+		}
+	}
+}
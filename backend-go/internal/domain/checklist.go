@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChecklistItem is one task on an application's checklist. Items from a
+// stage template have an ID of the form "<stage>:<slug>".
+type ChecklistItem struct {
+	ID        string            `json:"id"`
+	Title     string            `json:"title"`
+	Stage     ApplicationStatus `json:"stage,omitempty"` // status whose template added the item
+	Custom    bool              `json:"custom"`
+	Done      bool              `json:"done"`
+	DoneAt    *time.Time        `json:"done_at,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
+
+// Checklist is the task list for one application
+type Checklist struct {
+	ApplicationID uuid.UUID       `json:"application_id"`
+	Items         []ChecklistItem `json:"items"`
+	Dismissed     []string        `json:"dismissed,omitempty"` // template items the user removed
+	Completed     int             `json:"completed"`
+	Total         int             `json:"total"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// NextItem returns the first open item, or nil when everything is done
+func (c *Checklist) NextItem() *ChecklistItem {
+	for i := range c.Items {
+		if !c.Items[i].Done {
+			return &c.Items[i]
+		}
+	}
+	return nil
+}
+
+// ChecklistItemInput adds a custom item
+type ChecklistItemInput struct {
+	Title string `json:"title"`
+}
+
+// ChecklistItemUpdate renames an item or marks it done or open
+type ChecklistItemUpdate struct {
+	Title *string `json:"title,omitempty"`
+	Done  *bool   `json:"done,omitempty"`
+}
+
+// NextAction is the next open checklist item on an active application
+type NextAction struct {
+	ApplicationID uuid.UUID         `json:"application_id"`
+	Job           JobBrief          `json:"job"`
+	Status        ApplicationStatus `json:"status"`
+	ItemID        string            `json:"item_id"`
+	Title         string            `json:"title"`
+	Remaining     int               `json:"remaining"` // open items on the checklist
+}
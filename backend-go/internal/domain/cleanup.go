@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobCleanupCriteria selects jobs to move to the trash in bulk. A job must
+// match every criterion that is set; jobs with an application or a favorite
+// are always kept.
+type JobCleanupCriteria struct {
+	InactiveDays    *int        `json:"inactive_days,omitempty"`     // not seen by a scrape for at least this many days
+	Sources         []JobSource `json:"sources,omitempty"`           // scraped from one of these sources
+	BelowMatchScore *float64    `json:"below_match_score,omitempty"` // scored below this; unscored jobs are kept
+}
+
+// IsEmpty reports whether no criterion is set, which would select every job
+func (c JobCleanupCriteria) IsEmpty() bool {
+	return c.InactiveDays == nil && len(c.Sources) == 0 && c.BelowMatchScore == nil
+}
+
+// JobCleanupRequest asks for a bulk cleanup, or with DryRun for the number of
+// jobs it would remove
+type JobCleanupRequest struct {
+	JobCleanupCriteria
+	DryRun bool `json:"dry_run"`
+}
+
+// JobCleanupStatus is the state of a bulk cleanup
+type JobCleanupStatus string
+
+const (
+	JobCleanupRunning   JobCleanupStatus = "running"
+	JobCleanupCompleted JobCleanupStatus = "completed"
+	JobCleanupFailed    JobCleanupStatus = "failed"
+	JobCleanupCancelled JobCleanupStatus = "cancelled"
+)
+
+// JobCleanup is a bulk cleanup running in the background. Matched is the
+// count when it started; Trashed grows as batches are moved to the trash.
+type JobCleanup struct {
+	ID         uuid.UUID          `json:"id"`
+	Criteria   JobCleanupCriteria `json:"criteria"`
+	Status     JobCleanupStatus   `json:"status"`
+	Matched    int                `json:"matched"`
+	Trashed    int                `json:"trashed"`
+	Error      *string            `json:"error,omitempty"`
+	StartedAt  time.Time          `json:"started_at"`
+	FinishedAt *time.Time         `json:"finished_at,omitempty"`
+}
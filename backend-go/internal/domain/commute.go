@@ -0,0 +1,46 @@
+package domain
+
+import "time"
+
+// CommuteMode is how the user travels to an onsite or hybrid job
+type CommuteMode string
+
+const (
+	CommuteDriving CommuteMode = "driving"
+	CommuteTransit CommuteMode = "transit"
+	CommuteCycling CommuteMode = "cycling"
+	CommuteWalking CommuteMode = "walking"
+)
+
+// Valid reports whether m is a known commute mode
+func (m CommuteMode) Valid() bool {
+	switch m {
+	case CommuteDriving, CommuteTransit, CommuteCycling, CommuteWalking:
+		return true
+	}
+	return false
+}
+
+// CommuteHome is the user's saved home location used for commute estimates
+type CommuteHome struct {
+	Address   string      `json:"address"`
+	Location  GeoLocation `json:"location"`
+	Mode      CommuteMode `json:"mode"` // default mode for estimates and filters
+	UpdatedAt time.Time   `json:"updated_at"`
+}
+
+// CommuteHomeUpdate sets the home location, by address or by coordinates
+type CommuteHomeUpdate struct {
+	Address   string      `json:"address"`
+	Latitude  *float64    `json:"latitude,omitempty"`
+	Longitude *float64    `json:"longitude,omitempty"`
+	Mode      CommuteMode `json:"mode,omitempty"`
+}
+
+// CommuteTime is the estimated travel time from home to a job
+type CommuteTime struct {
+	Mode       CommuteMode `json:"mode"`
+	Minutes    int         `json:"minutes"`
+	DistanceKm float64     `json:"distance_km"`
+	Estimated  bool        `json:"estimated"` // approximated from straight-line distance rather than routed
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "github.com/google/uuid"
+
+// CoverageLevel grades how well the resume addresses a job requirement
+type CoverageLevel string
+
+const (
+	CoverageStrong  CoverageLevel = "strong"
+	CoveragePartial CoverageLevel = "partial"
+	CoverageNone    CoverageLevel = "none"
+)
+
+// SectionCoverage is how much of one requirement a resume section addresses
+type SectionCoverage struct {
+	Section string   `json:"section"`
+	Score   float64  `json:"score"` // 0-1, weighted share of the requirement's keywords
+	Matched []string `json:"matched,omitempty"`
+}
+
+// RequirementCoverage is one row of the coverage matrix
+type RequirementCoverage struct {
+	Requirement string            `json:"requirement"`
+	Keywords    []string          `json:"keywords"`
+	Cells       []SectionCoverage `json:"cells"` // one per section, in CoverageMatrix.Sections order
+	Best        string            `json:"best_section,omitempty"`
+	Score       float64           `json:"score"` // best section score
+	Level       CoverageLevel     `json:"level"`
+}
+
+// CoverageMatrix maps each job requirement to the resume sections that
+// address it, for rendering as a heatmap
+type CoverageMatrix struct {
+	JobID        uuid.UUID             `json:"job_id"`
+	Sections     []string              `json:"sections"`
+	Requirements []RequirementCoverage `json:"requirements"`
+	Overall      float64               `json:"overall"`   // mean requirement score
+	Uncovered    []string              `json:"uncovered"` // requirements no section addresses
+}
+
+// CoverageRequest optionally supplies the resume text to check coverage
+// against; otherwise the active resume is used
+type CoverageRequest struct {
+	ResumeText string `json:"resume_text"`
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// CredentialKind is what a stored credential is for
+type CredentialKind string
+
+const (
+	CredentialLLMAPIKey  CredentialKind = "llm_api_key"
+	CredentialOAuthToken CredentialKind = "oauth_token"
+	CredentialSMTP       CredentialKind = "smtp"
+)
+
+// Credential describes a stored secret. The value itself is never returned;
+// Hint shows its last characters so users can tell keys apart.
+type Credential struct {
+	Name      string         `json:"name"`
+	Kind      CredentialKind `json:"kind"`
+	Hint      string         `json:"hint"`
+	KeyID     string         `json:"key_id"` // encryption key the value is stored under
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// CredentialInput stores a credential's value
+type CredentialInput struct {
+	Kind  CredentialKind `json:"kind"`
+	Value string         `json:"value"`
+}
+
+// KeyRotationTarget is the result of re-encrypting one kind of stored field
+type KeyRotationTarget struct {
+	Name    string `json:"name"`
+	Scanned int    `json:"scanned"`
+	Rotated int    `json:"rotated"`
+	Error   string `json:"error,omitempty"`
+}
+
+// KeyRotationReport is the result of re-encrypting stored fields under the
+// primary key
+type KeyRotationReport struct {
+	PrimaryKey string              `json:"primary_key"`
+	Targets    []KeyRotationTarget `json:"targets"`
+	StartedAt  time.Time           `json:"started_at"`
+	FinishedAt time.Time           `json:"finished_at"`
+}
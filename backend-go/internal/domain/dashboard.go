@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// Dashboard is the home-screen summary returned in a single payload
+type Dashboard struct {
+	ActiveApplications   int            `json:"active_applications"`
+	ApplicationsByStatus map[string]int `json:"applications_by_status"`
+	InterviewsThisWeek   int            `json:"interviews_this_week"`
+	DueReminders         int            `json:"due_reminders"`
+	NewMatches           int            `json:"new_matches"`
+	TopMatches           []JobBrief     `json:"top_matches"`
+	TotalJobs            int            `json:"total_jobs"`
+	LastScrapeAt         *time.Time     `json:"last_scrape_at,omitempty"`
+	ScrapeStale          bool           `json:"scrape_stale"`
+	Goals                *GoalSummary   `json:"goals,omitempty"` // progress, streaks and nudges
+	NextActions          []NextAction   `json:"next_actions,omitempty"`
+	GeneratedAt          time.Time      `json:"generated_at"`
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Digest is a periodic summary of job search activity
+type Digest struct {
+	PeriodStart       time.Time       `json:"period_start"`
+	PeriodEnd         time.Time       `json:"period_end"`
+	NewMatches        []JobBrief      `json:"new_matches"`
+	Activity          DigestActivity  `json:"activity"`
+	UpcomingReminders []Application   `json:"upcoming_reminders"`
+	SkillGaps         []SkillGapTrend `json:"skill_gaps"`
+	ResolvedSkillGaps []string        `json:"resolved_skill_gaps,omitempty"`
+	GeneratedAt       time.Time       `json:"generated_at"`
+	TaskID            *uuid.UUID      `json:"task_id,omitempty"` // timeline of the run that delivered it
+}
+
+// DigestActivity counts application activity within the digest period
+type DigestActivity struct {
+	Applied       int `json:"applied"`
+	StatusChanges int `json:"status_changes"`
+	Interviews    int `json:"interviews"`
+	Offers        int `json:"offers"`
+	Rejections    int `json:"rejections"`
+}
+
+// SkillGapTrend describes a commonly missing skill and whether it is new since the last digest
+type SkillGapTrend struct {
+	Skill string `json:"skill"`
+	New   bool   `json:"new"`
+}
@@ -0,0 +1,103 @@
+package domain
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// EmailRequest asks for an email drafted for a job
+type EmailRequest struct {
+	EmailType      string `json:"email_type,omitempty"` // application (default), followup, thankyou
+	JobDescription string `json:"job_description"`
+	Tone           string `json:"tone,omitempty"`   // professional, conversational, enthusiastic
+	Length         string `json:"length,omitempty"` // brief, standard, detailed
+}
+
+// GeneratedEmail is a drafted email with optional alternative versions
+type GeneratedEmail struct {
+	Subject    string   `json:"subject"`
+	Body       string   `json:"body"`
+	EmailType  string   `json:"email_type"`
+	Variations []string `json:"variations,omitempty"`
+}
+
+// Validate checks the email has a subject and body
+func (e *GeneratedEmail) Validate() error {
+	if strings.TrimSpace(e.Subject) == "" || strings.TrimSpace(e.Body) == "" {
+		return errors.New("generated email has no subject or body")
+	}
+	return nil
+}
+
+// OutgoingEmail is an email ready to be sent over SMTP or Gmail
+type OutgoingEmail struct {
+	To          []string          `json:"to"`
+	Cc          []string          `json:"cc,omitempty"`
+	Subject     string            `json:"subject"`
+	Body        string            `json:"body"`
+	Attachments []EmailAttachment `json:"attachments,omitempty"`
+}
+
+// EmailAttachment is a stored file attached to an outgoing email
+type EmailAttachment struct {
+	Filename   string `json:"filename"`
+	StorageKey string `json:"storage_key"`
+}
+
+// DeliverabilityIssue is a problem that blocks an email from being sent
+type DeliverabilityIssue struct {
+	Field   string `json:"field"` // to, cc, subject, body, attachments
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// MailMergeRequest drafts one personalized email per contact from a template.
+// Templates use {{name}}, {{first_name}}, {{email}}, {{company}}, {{role}},
+// {{research}} and any key in a contact's fields.
+type MailMergeRequest struct {
+	Template MailMergeTemplate  `json:"template"`
+	Contacts []MailMergeContact `json:"contacts"`
+}
+
+// MailMergeTemplate is the subject and body merged for each contact
+type MailMergeTemplate struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// MailMergeContact is one recipient of a batch; only the company is required
+type MailMergeContact struct {
+	Name    string            `json:"name,omitempty"`
+	Email   string            `json:"email,omitempty"`
+	Company string            `json:"company"`
+	Role    string            `json:"role,omitempty"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+// CompanyResearch is what is known about a company, injected into drafts
+type CompanyResearch struct {
+	Company    string         `json:"company"`
+	Rating     *float64       `json:"rating,omitempty"`
+	H1BFilings *int           `json:"h1b_filings,omitempty"`
+	Highlights []string       `json:"highlights"`
+	Details    *CompanyReport `json:"details,omitempty"` // from the research service, when configured
+}
+
+// MailMergeDraft is one personalized draft, with the issues that would block
+// it from being sent
+type MailMergeDraft struct {
+	Contact  MailMergeContact      `json:"contact"`
+	Email    OutgoingEmail         `json:"email"`
+	Research *CompanyResearch      `json:"research,omitempty"`
+	Issues   []DeliverabilityIssue `json:"issues"`
+	Ready    bool                  `json:"ready"`
+}
+
+// MailMergeBatch is a batch of drafts for review; nothing is sent
+type MailMergeBatch struct {
+	Drafts      []MailMergeDraft `json:"drafts"`
+	Total       int              `json:"total"`
+	Ready       int              `json:"ready"`
+	GeneratedAt time.Time        `json:"generated_at"`
+}
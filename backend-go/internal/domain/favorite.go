@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Favorite is a starred job, kept apart from applications so that bookmarking
+// an interesting job doesn't add it to the application board
+type Favorite struct {
+	JobID     uuid.UUID `json:"job_id"`
+	Note      string    `json:"note,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FavoriteInput stars a job, optionally with a note
+type FavoriteInput struct {
+	Note string `json:"note"`
+}
+
+// FavoriteJob is a favorite with its job, when the job still exists
+type FavoriteJob struct {
+	Favorite
+	Job *Job `json:"job,omitempty"`
+}
+
+// FavoriteList is a page of favorites, most recently starred first
+type FavoriteList struct {
+	Favorites []FavoriteJob `json:"favorites"`
+	Total     int           `json:"total"`
+}
+
+// FavoritePromotion turns a favorite into an application. The favorite's
+// note is carried over into the application notes.
+type FavoritePromotion struct {
+	Status       *ApplicationStatus `json:"status,omitempty"` // defaults to saved
+	Notes        *string            `json:"notes,omitempty"`  // appended after the favorite's note
+	ReminderDate *time.Time         `json:"reminder_date,omitempty"`
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// GoalMetric is the activity a goal counts
+type GoalMetric string
+
+const (
+	GoalApplications     GoalMetric = "applications"      // applications submitted
+	GoalSavedJobs        GoalMetric = "saved_jobs"        // jobs added to the tracker
+	GoalPracticeSessions GoalMetric = "practice_sessions" // days with at least one practice answer
+	GoalPracticeAnswers  GoalMetric = "practice_answers"  // practice answers scored
+)
+
+// GoalMetrics lists the supported metrics
+var GoalMetrics = []GoalMetric{GoalApplications, GoalSavedJobs, GoalPracticeSessions, GoalPracticeAnswers}
+
+// Valid reports whether m is a supported metric
+func (m GoalMetric) Valid() bool {
+	for _, known := range GoalMetrics {
+		if m == known {
+			return true
+		}
+	}
+	return false
+}
+
+// GoalPeriod is how often a goal's target resets
+type GoalPeriod string
+
+const (
+	GoalDaily  GoalPeriod = "day"
+	GoalWeekly GoalPeriod = "week"
+)
+
+// Goal is a job search target such as "10 applications a week"
+type Goal struct {
+	ID        uuid.UUID  `json:"id"`
+	Metric    GoalMetric `json:"metric"`
+	Target    int        `json:"target"`
+	Period    GoalPeriod `json:"period"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// GoalInput creates a goal; period defaults to week
+type GoalInput struct {
+	Metric GoalMetric `json:"metric"`
+	Target int        `json:"target"`
+	Period GoalPeriod `json:"period"`
+}
+
+// GoalPeriodCount is a goal's progress in one past period
+type GoalPeriodCount struct {
+	Start time.Time `json:"start"`
+	Count int       `json:"count"`
+	Met   bool      `json:"met"`
+}
+
+// GoalProgress is a goal's progress in the current period and its streak of
+// periods in which it was met
+type GoalProgress struct {
+	Goal
+	Current    int               `json:"current"`
+	Remaining  int               `json:"remaining"`
+	Percent    float64           `json:"percent"`
+	Met        bool              `json:"met"`
+	PeriodEnd  time.Time         `json:"period_end"`
+	Streak     int               `json:"streak"`
+	BestStreak int               `json:"best_streak"`
+	History    []GoalPeriodCount `json:"history"` // oldest first, ending with the current period
+}
+
+// GoalSummary is the progress on every goal plus the daily activity streak
+type GoalSummary struct {
+	Goals          []GoalProgress `json:"goals"`
+	ActivityStreak int            `json:"activity_streak"` // consecutive days with any activity
+	ActiveToday    bool           `json:"active_today"`
+	Nudges         []string       `json:"nudges"`
+	GeneratedAt    time.Time      `json:"generated_at"`
+}
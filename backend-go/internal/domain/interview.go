@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PracticeRequest is an answer submitted for evaluation in practice mode
+type PracticeRequest struct {
+	QuestionID string `json:"question_id,omitempty"` // derived from the question text when empty
+	Question   string `json:"question"`
+	Answer     string `json:"answer"`
+	Category   string `json:"category,omitempty"`
+	Difficulty int    `json:"difficulty,omitempty"` // listed difficulty, 1-5
+}
+
+// STARRequest asks for a STAR-structured answer to a behavioral question
+type STARRequest struct {
+	Prompt string `json:"prompt"`
+}
+
+// InterviewQuestion is a question from the question bank
+type InterviewQuestion struct {
+	ID         string   `json:"id"`
+	Question   string   `json:"question"`
+	Category   string   `json:"category"`
+	RoleTypes  []string `json:"role_types"`
+	Difficulty int      `json:"difficulty"` // 1-5
+	Tips       *string  `json:"tips,omitempty"`
+}
+
+// QuestionSet is the questions matching a category, role and difficulty
+type QuestionSet struct {
+	Questions []InterviewQuestion `json:"questions"`
+	Total     int                 `json:"total"`
+}
+
+// STARAnswer is an answer in situation, task, action, result form
+type STARAnswer struct {
+	Situation   string   `json:"situation"`
+	Task        string   `json:"task"`
+	Action      string   `json:"action"`
+	Result      string   `json:"result"`
+	QuestionFit []string `json:"question_fit,omitempty"` // questions the answer suits
+}
+
+// Validate checks every part of the answer is present
+func (a *STARAnswer) Validate() error {
+	if a.Situation == "" || a.Task == "" || a.Action == "" || a.Result == "" {
+		return errors.New("STAR answer is missing a situation, task, action or result")
+	}
+	return nil
+}
+
+// PracticeEvaluation is the feedback on a practice answer
+type PracticeEvaluation struct {
+	Score               float64  `json:"score"` // 0-100
+	RelevanceFeedback   string   `json:"relevance_feedback"`
+	StructureFeedback   string   `json:"structure_feedback"`
+	SpecificityFeedback string   `json:"specificity_feedback"`
+	Improvements        []string `json:"improvements"`
+	Strengths           []string `json:"strengths"`
+}
+
+// Validate checks the score is in range
+func (e *PracticeEvaluation) Validate() error {
+	if e.Score < 0 || e.Score > 100 {
+		return fmt.Errorf("score %.1f is outside 0-100", e.Score)
+	}
+	return nil
+}
+
+// CompanyReport is background on a company for interview preparation
+type CompanyReport struct {
+	Company          string    `json:"company"`
+	Summary          string    `json:"summary"`
+	Values           []string  `json:"values,omitempty"`
+	InterviewProcess string    `json:"interview_process,omitempty"`
+	RecentNews       []string  `json:"recent_news,omitempty"`
+	Tips             []string  `json:"tips,omitempty"`
+	GeneratedAt      time.Time `json:"generated_at"`
+}
+
+// ReviewCard tracks how well the user answers one practice question and
+// when it should be asked again
+type ReviewCard struct {
+	QuestionID   string    `json:"question_id"`
+	Question     string    `json:"question"`
+	Category     string    `json:"category,omitempty"`
+	Difficulty   int       `json:"difficulty,omitempty"`
+	Calibrated   float64   `json:"calibrated_difficulty"` // 1-5, from the user's own scores
+	Attempts     int       `json:"attempts"`
+	LastScore    float64   `json:"last_score"` // 0-1
+	AverageScore float64   `json:"average_score"`
+	Ease         float64   `json:"ease"`
+	IntervalDays int       `json:"interval_days"`
+	Streak       int       `json:"streak"` // consecutive passing answers
+	ReviewedAt   time.Time `json:"reviewed_at"`
+	DueAt        time.Time `json:"due_at"`
+}
+
+// Weak reports whether the user has been struggling with the question
+func (c *ReviewCard) Weak(passScore float64) bool {
+	return c.LastScore < passScore || c.AverageScore < passScore
+}
+
+// ReviewQueue is the set of practice questions due for review
+type ReviewQueue struct {
+	Due       []ReviewCard `json:"due"`
+	DueCount  int          `json:"due_count"`
+	Tracked   int          `json:"tracked"`
+	NextDueAt *time.Time   `json:"next_due_at,omitempty"` // earliest upcoming review when nothing is due
+}
+
+// SpokenPracticeRequest is a spoken practice answer, sent as an audio upload
+// in the "audio" form field or as a transcript the client produced itself
+type SpokenPracticeRequest struct {
+	QuestionID      string  `json:"question_id,omitempty" form:"question_id"`
+	Question        string  `json:"question" form:"question"`
+	Category        string  `json:"category,omitempty" form:"category"`
+	Difficulty      int     `json:"difficulty,omitempty" form:"difficulty"`
+	Transcript      string  `json:"transcript,omitempty" form:"transcript"`
+	DurationSeconds float64 `json:"duration_seconds,omitempty" form:"duration_seconds"` // answer length, for pace
+}
+
+// Practice returns the practice request for the transcribed answer
+func (r SpokenPracticeRequest) Practice(answer string) PracticeRequest {
+	return PracticeRequest{
+		QuestionID: r.QuestionID,
+		Question:   r.Question,
+		Answer:     answer,
+		Category:   r.Category,
+		Difficulty: r.Difficulty,
+	}
+}
+
+// Transcript is the text of a spoken answer
+type Transcript struct {
+	Text            string  `json:"text"`
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+	Language        string  `json:"language,omitempty"`
+}
+
+// DeliveryMetrics describes how an answer was spoken, apart from its content
+type DeliveryMetrics struct {
+	WordCount      int            `json:"word_count"`
+	FillerWords    int            `json:"filler_words"`
+	Fillers        map[string]int `json:"fillers"`
+	FillerRate     float64        `json:"filler_rate"`                // filler words per 100 words
+	WordsPerMinute float64        `json:"words_per_minute,omitempty"` // needs the answer duration
+	Pace           string         `json:"pace,omitempty"`             // slow, good, fast
+}
+
+// QuestionSource is where a company interview question came from
+type QuestionSource string
+
+const (
+	QuestionSourceSeed QuestionSource = "seed" // bundled or imported dataset
+	QuestionSourceUser QuestionSource = "user" // submitted by the user
+)
+
+// CompanyQuestion is an interview question reported for a company. Reports
+// of the same question are merged and counted.
+type CompanyQuestion struct {
+	ID             uuid.UUID      `json:"id"`
+	Company        string         `json:"company"`
+	Question       string         `json:"question"`
+	Category       string         `json:"category,omitempty"` // behavioral, technical, system_design, ...
+	Round          string         `json:"round,omitempty"`    // phone_screen, onsite, panel, ...
+	Role           string         `json:"role,omitempty"`
+	Source         QuestionSource `json:"source"`
+	Reports        int            `json:"reports"`
+	LastReportedAt time.Time      `json:"last_reported_at"`
+}
+
+// CompanyQuestionReport submits a question the user was asked at a company
+type CompanyQuestionReport struct {
+	Question string `json:"question"`
+	Category string `json:"category,omitempty"`
+	Round    string `json:"round,omitempty"`
+	Role     string `json:"role,omitempty"`
+}
+
+// CompanyQuestionFilter narrows a company's reported questions
+type CompanyQuestionFilter struct {
+	Category string
+	Round    string
+	Role     string
+	Limit    int
+}
+
+// PrepPlan gathers interview preparation for one application: the questions
+// reported at the company and story bank stories for the behavioral ones
+type PrepPlan struct {
+	ApplicationID uuid.UUID      `json:"application_id"`
+	Company       string         `json:"company"`
+	Role          string         `json:"role"`
+	Questions     []PrepQuestion `json:"questions"`
+	Categories    map[string]int `json:"categories"`
+	Competencies  []Competency   `json:"competencies"` // themes to have stories ready for
+	Uncovered     []Competency   `json:"uncovered"`    // themes with no story in the bank
+	GeneratedAt   time.Time      `json:"generated_at"`
+}
+
+// PrepQuestion is a company question in a prep plan with the stories that
+// could answer it
+type PrepQuestion struct {
+	CompanyQuestion
+	Competencies []Competency `json:"competencies,omitempty"`
+	Stories      []StoryMatch `json:"stories,omitempty"`
+}
@@ -1,7 +1,12 @@
 package domain
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/google/uuid"
 )
@@ -15,6 +20,27 @@ const (
 	LocationTypeOnsite LocationType = "onsite"
 )
 
+// EmploymentType represents the normalized employment arrangement
+type EmploymentType string
+
+const (
+	EmploymentTypeFullTime   EmploymentType = "full-time"
+	EmploymentTypePartTime   EmploymentType = "part-time"
+	EmploymentTypeContract   EmploymentType = "contract"
+	EmploymentTypeInternship EmploymentType = "internship"
+	EmploymentTypeTemp       EmploymentType = "temp"
+)
+
+// QualityFlag explains why a posting's quality score was lowered
+type QualityFlag string
+
+const (
+	QualityFlagStaffingAgency    QualityFlag = "staffing_agency"
+	QualityFlagGhostJob          QualityFlag = "ghost_job"
+	QualityFlagRepeatRepost      QualityFlag = "repeat_repost"
+	QualityFlagVagueRequirements QualityFlag = "vague_requirements"
+)
+
 // CompanySize represents company size categories
 type CompanySize string
 
@@ -36,8 +62,32 @@ const (
 	JobSourceYCombinator JobSource = "ycombinator"
 	JobSourceBuiltIn     JobSource = "builtin"
 	JobSourceLinkedIn    JobSource = "linkedin"
+	JobSourceAdzuna      JobSource = "adzuna"
+	JobSourceUSAJobs     JobSource = "usajobs"
+	JobSourceJooble      JobSource = "jooble"
+	JobSourceRemotive    JobSource = "remotive"
+	JobSourceSimplify    JobSource = "simplify"
+	JobSourceCareerPage  JobSource = "career_page"
+	JobSourceAshby       JobSource = "ashby"
+	JobSourceWorkable    JobSource = "workable"
 )
 
+// StoredJobSources are the sources the job_source enum accepts, by name
+var StoredJobSources = []JobSource{
+	JobSourceAdzuna, JobSourceAshby, JobSourceCareerPage, JobSourceDice, JobSourceIndeed, JobSourceJooble,
+	JobSourceLinkedIn, JobSourceRemotive, JobSourceSimplify, JobSourceUSAJobs, JobSourceWellfound, JobSourceWorkable,
+}
+
+// Stored reports whether jobs can be stored under the source
+func (s JobSource) Stored() bool {
+	for _, stored := range StoredJobSources {
+		if s == stored {
+			return true
+		}
+	}
+	return false
+}
+
 // MatchQuality represents the quality of resume-job match
 type MatchQuality string
 
@@ -50,75 +100,195 @@ const (
 
 // Company represents a company entity
 type Company struct {
-	ID             uuid.UUID   `json:"id"`
-	Name           string      `json:"name"`
-	NormalizedName string      `json:"-"`
-	LogoURL        *string     `json:"logo_url,omitempty"`
-	Website        *string     `json:"website,omitempty"`
-	Industry       *string     `json:"industry,omitempty"`
-	Size           *CompanySize `json:"size,omitempty"`
-	Rating         *float64    `json:"rating,omitempty"`
-	CreatedAt      time.Time   `json:"created_at"`
+	ID                 uuid.UUID    `json:"id"`
+	Name               string       `json:"name"`
+	NormalizedName     string       `json:"-"`
+	LogoURL            *string      `json:"logo_url,omitempty"`
+	Website            *string      `json:"website,omitempty"`
+	Industry           *string      `json:"industry,omitempty"`
+	Size               *CompanySize `json:"size,omitempty"`
+	Rating             *float64     `json:"rating,omitempty"`
+	H1BFilings         *int         `json:"h1b_filings,omitempty"` // certified H1B filings in the imported dataset
+	LikelySponsorsVisa bool         `json:"likely_sponsors_visa"`
+	CreatedAt          time.Time    `json:"created_at"`
 }
 
 // Job represents a job listing
 type Job struct {
-	ID             uuid.UUID     `json:"id"`
-	URL            string        `json:"url"`
-	Title          string        `json:"title"`
-	Company        Company       `json:"company"`
-	Location       *string       `json:"location,omitempty"`
-	LocationType   *LocationType `json:"location_type,omitempty"`
-	SalaryMin      *int          `json:"salary_min,omitempty"`
-	SalaryMax      *int          `json:"salary_max,omitempty"`
-	SalaryCurrency string        `json:"salary_currency"`
-	SalaryText     *string       `json:"salary_text,omitempty"`
-	Description    string        `json:"description"`
-	Requirements   []string      `json:"requirements"`
-	PostedDate     *time.Time    `json:"posted_date,omitempty"`
-	ScrapedAt      time.Time     `json:"scraped_at"`
-	Source         JobSource     `json:"source"`
-	IsActive       bool          `json:"is_active"`
-	EmbeddingID    *uuid.UUID    `json:"-"`
-	ContentHash    *string       `json:"-"`
-	CreatedAt      time.Time     `json:"created_at"`
-	UpdatedAt      time.Time     `json:"updated_at"`
-
-	// Computed fields (from match scoring)
-	MatchScore     *float64      `json:"match_score,omitempty"`
-	MatchQuality   *MatchQuality `json:"match_quality,omitempty"`
-	MatchedSkills  []string      `json:"matched_skills,omitempty"`
-	MissingSkills  []string      `json:"missing_skills,omitempty"`
-}
-
-// JobBrief is a compact representation for list views
-type JobBrief struct {
 	ID                uuid.UUID         `json:"id"`
+	URL               string            `json:"url"`
 	Title             string            `json:"title"`
-	CompanyName       string            `json:"company_name"`
-	CompanyLogo       *string           `json:"company_logo,omitempty"`
+	Company           Company           `json:"company"`
 	Location          *string           `json:"location,omitempty"`
 	LocationType      *LocationType     `json:"location_type,omitempty"`
+	EmploymentType    EmploymentType    `json:"employment_type,omitempty"`
+	Geo               *GeoLocation      `json:"geo,omitempty"`
+	SalaryMin         *int              `json:"salary_min,omitempty"`
+	SalaryMax         *int              `json:"salary_max,omitempty"`
+	SalaryCurrency    string            `json:"salary_currency"`
 	SalaryText        *string           `json:"salary_text,omitempty"`
+	Description       string            `json:"description"`
+	Requirements      []string          `json:"requirements"`
+	RequiredSkills    []string          `json:"required_skills,omitempty"`
 	PostedDate        *time.Time        `json:"posted_date,omitempty"`
+	ScrapedAt         time.Time         `json:"scraped_at"`
 	Source            JobSource         `json:"source"`
-	MatchScore        *float64          `json:"match_score,omitempty"`
-	MatchQuality      *MatchQuality     `json:"match_quality,omitempty"`
-	ApplicationStatus *ApplicationStatus `json:"application_status,omitempty"`
+	IsActive          bool              `json:"is_active"`
+	EmbeddingID       *uuid.UUID        `json:"-"`
+	ContentHash       *string           `json:"-"`
+	Sources           []JobSourceRef    `json:"sources,omitempty"`
+	FirstSeenAt       *time.Time        `json:"first_seen_at,omitempty"`
+	LastSeenAt        *time.Time        `json:"last_seen_at,omitempty"`
+	RepostedAt        *time.Time        `json:"reposted_at,omitempty"`
+	RepostCount       int               `json:"repost_count"`
+	QualityScore      *int              `json:"quality_score,omitempty"` // 0-100, higher is a more genuine posting
+	QualityFlags      []QualityFlag     `json:"quality_flags,omitempty"`
+	PayGrade          *string           `json:"pay_grade,omitempty"`          // federal pay grade, e.g. GS-12/13
+	Clearance         *string           `json:"security_clearance,omitempty"` // required security clearance
+	NewGrad           bool              `json:"new_grad"`                     // new-grad / early-career program
+	Seasons           []string          `json:"seasons,omitempty"`            // program terms, e.g. "summer 2025"
+	Deadline          *time.Time        `json:"application_deadline,omitempty"`
+	Contacts          []JobContact      `json:"contacts,omitempty"` // recruiters named in the posting
+	TechStack         *TechStack        `json:"tech_stack,omitempty"`
+	Commute           *CommuteTime      `json:"commute,omitempty"`          // from the saved home location, not stored
+	SalaryBenchmark   *SalaryComparison `json:"salary_benchmark,omitempty"` // against market salaries, not stored
+	Revisions         []JobRevision     `json:"revisions,omitempty"`        // changes seen on re-scrapes, newest first
+	UpdatedSinceSaved bool              `json:"updated_since_saved"`        // changed since it was applied to or starred
+	CreatedAt         time.Time         `json:"created_at"`
+	UpdatedAt         time.Time         `json:"updated_at"`
+	DeletedAt         *time.Time        `json:"deleted_at,omitempty"`
+
+	// Computed fields (from match scoring)
+	MatchScore    *float64      `json:"match_score,omitempty"`
+	MatchQuality  *MatchQuality `json:"match_quality,omitempty"`
+	MatchedSkills []string      `json:"matched_skills,omitempty"`
+	MissingSkills []string      `json:"missing_skills,omitempty"`
+}
+
+// GeoLocation is a geocoded job location
+type GeoLocation struct {
+	City      string  `json:"city,omitempty"`
+	State     string  `json:"state,omitempty"`
+	Country   string  `json:"country,omitempty"` // ISO 3166-1 alpha-2
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// JobSourceRef records one board a (possibly cross-posted) job was seen on
+type JobSourceRef struct {
+	Source JobSource `json:"source"`
+	URL    string    `json:"url"`
+	SeenAt time.Time `json:"seen_at"`
+}
+
+// JobContact is a recruiter or hiring manager named in a job posting
+type JobContact struct {
+	Name  string `json:"name,omitempty"`
+	Email string `json:"email,omitempty"`
+}
+
+// TechStack is the concrete technology stack detected in a posting, with
+// each technology under its canonical lowercase name
+type TechStack struct {
+	Languages  []string `json:"languages,omitempty"`
+	Frameworks []string `json:"frameworks,omitempty"`
+	Clouds     []string `json:"clouds,omitempty"`
+	Databases  []string `json:"databases,omitempty"`
+}
+
+// All returns every technology in the stack
+func (s *TechStack) All() []string {
+	if s == nil {
+		return nil
+	}
+	all := make([]string, 0, len(s.Languages)+len(s.Frameworks)+len(s.Clouds)+len(s.Databases))
+	all = append(all, s.Languages...)
+	all = append(all, s.Frameworks...)
+	all = append(all, s.Clouds...)
+	return append(all, s.Databases...)
+}
+
+// Matches reports whether the stack uses every required technology and none
+// of the excluded ones
+func (s *TechStack) Matches(require, exclude []string) bool {
+	has := make(map[string]bool)
+	for _, tech := range s.All() {
+		has[tech] = true
+	}
+	for _, tech := range require {
+		if !has[tech] {
+			return false
+		}
+	}
+	for _, tech := range exclude {
+		if has[tech] {
+			return false
+		}
+	}
+	return true
+}
+
+// JobBrief is a compact representation for list views
+type JobBrief struct {
+	ID                 uuid.UUID          `json:"id"`
+	Title              string             `json:"title"`
+	CompanyName        string             `json:"company_name"`
+	CompanyLogo        *string            `json:"company_logo,omitempty"`
+	CompanyRating      *float64           `json:"company_rating,omitempty"`
+	Location           *string            `json:"location,omitempty"`
+	LocationType       *LocationType      `json:"location_type,omitempty"`
+	EmploymentType     EmploymentType     `json:"employment_type,omitempty"`
+	LikelySponsorsVisa bool               `json:"likely_sponsors_visa"`
+	SalaryText         *string            `json:"salary_text,omitempty"`
+	Clearance          *string            `json:"security_clearance,omitempty"`
+	NewGrad            bool               `json:"new_grad"`
+	Seasons            []string           `json:"seasons,omitempty"`
+	Deadline           *time.Time         `json:"application_deadline,omitempty"`
+	TechStack          *TechStack         `json:"tech_stack,omitempty"`
+	Commute            *CommuteTime       `json:"commute,omitempty"`
+	PostedDate         *time.Time         `json:"posted_date,omitempty"`
+	Source             JobSource          `json:"source"`
+	FirstSeenAt        *time.Time         `json:"first_seen_at,omitempty"`
+	RepostCount        int                `json:"repost_count"`
+	QualityScore       *int               `json:"quality_score,omitempty"`
+	MatchScore         *float64           `json:"match_score,omitempty"`
+	MatchQuality       *MatchQuality      `json:"match_quality,omitempty"`
+	ApplicationStatus  *ApplicationStatus `json:"application_status,omitempty"`
+	Favorited          bool               `json:"favorited"`
 }
 
 // JobFilters represents search filters
 type JobFilters struct {
-	Keywords         []string       `json:"keywords,omitempty"`
-	Location         *string        `json:"location,omitempty"`
-	LocationTypes    []LocationType `json:"location_type,omitempty"`
-	SalaryMin        *int           `json:"salary_min,omitempty"`
-	SalaryMax        *int           `json:"salary_max,omitempty"`
-	CompanySizes     []CompanySize  `json:"company_size,omitempty"`
-	Sources          []JobSource    `json:"sources,omitempty"`
-	PostedWithinDays *int           `json:"posted_within_days,omitempty"`
-	ExperienceLevel  *string        `json:"experience_level,omitempty"`
-	Industry         *string        `json:"industry,omitempty"`
+	Query            *string          `json:"q,omitempty"` // full-text query: "phrase", prefix*, -exclude
+	Keywords         []string         `json:"keywords,omitempty"`
+	Location         *string          `json:"location,omitempty"`
+	Near             *string          `json:"near,omitempty"`      // center for radius filtering, e.g. "Austin, TX"
+	RadiusKm         *float64         `json:"radius_km,omitempty"` // defaults to geo.default_radius_km
+	Countries        []string         `json:"countries,omitempty"`
+	States           []string         `json:"states,omitempty"`
+	LocationTypes    []LocationType   `json:"location_type,omitempty"`
+	EmploymentTypes  []EmploymentType `json:"employment_type,omitempty"`
+	SalaryMin        *int             `json:"salary_min,omitempty"`
+	SalaryMax        *int             `json:"salary_max,omitempty"`
+	CompanySizes     []CompanySize    `json:"company_size,omitempty"`
+	Sources          []JobSource      `json:"sources,omitempty"`
+	PostedWithinDays *int             `json:"posted_within_days,omitempty"`
+	ExperienceLevel  *string          `json:"experience_level,omitempty"`
+	Industry         *string          `json:"industry,omitempty"`
+	GenuinelyNew     bool             `json:"genuinely_new,omitempty"`       // exclude reposts of already-seen jobs
+	SponsorsVisa     bool             `json:"sponsors_visa,omitempty"`       // only companies likely to sponsor visas
+	MinRating        *float64         `json:"min_rating,omitempty"`          // minimum company rating (0-5)
+	HideLowQuality   bool             `json:"hide_low_quality,omitempty"`    // hide spam, ghost and low-effort postings
+	NewGrad          bool             `json:"new_grad,omitempty"`            // only new-grad / early-career programs
+	Seasons          []string         `json:"seasons,omitempty"`             // program terms, e.g. "summer 2025"
+	ClosingWithin    *int             `json:"closing_within_days,omitempty"` // application deadline within this many days
+	Stack            []string         `json:"stack,omitempty"`               // must use all of these technologies
+	ExcludeStack     []string         `json:"exclude_stack,omitempty"`       // must use none of these technologies
+	MaxCommute       *int             `json:"max_commute_minutes,omitempty"` // onsite/hybrid jobs within this commute from home
+	CommuteMode      CommuteMode      `json:"commute_mode,omitempty"`        // defaults to the home location's mode
+	Favorites        bool             `json:"favorites,omitempty"`           // only starred jobs
+	SeenAfter        *time.Time       `json:"seen_after,omitempty"`          // first seen strictly after this time
+	JobIDs           []uuid.UUID      `json:"job_ids,omitempty"`             // only these jobs
 }
 
 // JobSearchRequest represents a job search request
@@ -128,7 +298,7 @@ type JobSearchRequest struct {
 	IncludeMatchScores bool        `json:"include_match_scores"`
 	Page               int         `json:"page"`
 	Limit              int         `json:"limit"`
-	SortBy             string      `json:"sort_by"`  // match_score, posted_date, salary
+	SortBy             string      `json:"sort_by"`  // match_score, posted_date, salary, relevance, deadline, first_seen
 	SortOrder          string      `json:"sort_order"` // asc, desc
 }
 
@@ -143,6 +313,20 @@ type JobSearchResponse struct {
 	Cached        bool         `json:"cached"`
 	ScrapeStatus  ScrapeStatus `json:"scrape_status"`
 	FiltersApplied *JobFilters `json:"filters_applied,omitempty"`
+	Facets         *JobFacets  `json:"facets,omitempty"`
+}
+
+// FacetCount is the number of matching jobs for one facet value
+type FacetCount struct {
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+// JobFacets holds facet counts for the current result set
+type JobFacets struct {
+	Countries       []FacetCount `json:"countries,omitempty"`
+	States          []FacetCount `json:"states,omitempty"`
+	EmploymentTypes []FacetCount `json:"employment_types,omitempty"`
 }
 
 // ScrapeStatus represents the status of a scraping task
@@ -153,22 +337,58 @@ const (
 	ScrapeStatusInProgress ScrapeStatus = "in_progress"
 	ScrapeStatusCompleted  ScrapeStatus = "completed"
 	ScrapeStatusFailed     ScrapeStatus = "failed"
+	ScrapeStatusCancelled  ScrapeStatus = "cancelled"
 )
 
 // ScrapeTask represents a background scraping task
 type ScrapeTask struct {
-	ID         uuid.UUID    `json:"id"`
-	Keywords   []string     `json:"keywords"`
-	Location   *string      `json:"location,omitempty"`
-	Sources    []JobSource  `json:"sources"`
-	Status     ScrapeStatus `json:"status"`
-	JobsFound  int          `json:"jobs_found"`
-	Error      *string      `json:"error,omitempty"`
-	StartedAt  *time.Time   `json:"started_at,omitempty"`
-	FinishedAt *time.Time   `json:"finished_at,omitempty"`
-	CreatedAt  time.Time    `json:"created_at"`
+	ID          uuid.UUID                   `json:"id"`
+	Keywords    []string                    `json:"keywords"`
+	Location    *string                     `json:"location,omitempty"`
+	Sources     []JobSource                 `json:"sources"`
+	MaxJobs     int                         `json:"max_jobs,omitempty"`   // per source; 0 uses the scraper default
+	ProfileID   *uuid.UUID                  `json:"profile_id,omitempty"` // scrape profile the task was queued from
+	Status      ScrapeStatus                `json:"status"`
+	JobsFound   int                         `json:"jobs_found"`
+	Error       *string                     `json:"error,omitempty"`
+	Errors      []ScrapeErrorSummary        `json:"errors,omitempty"`
+	ErrorCounts map[ScrapeErrorCategory]int `json:"error_counts,omitempty"`
+	Rejected    map[ScrapeRejectReason]int  `json:"rejected,omitempty"` // parsed jobs dropped by validation
+	StartedAt   *time.Time                  `json:"started_at,omitempty"`
+	FinishedAt  *time.Time                  `json:"finished_at,omitempty"`
+	CreatedAt   time.Time                   `json:"created_at"`
 }
 
+// ScrapeErrorCategory classifies why part of a scrape failed
+type ScrapeErrorCategory string
+
+const (
+	ScrapeErrorSelectorMissing ScrapeErrorCategory = "selector_missing"
+	ScrapeErrorBlocked         ScrapeErrorCategory = "blocked"
+	ScrapeErrorTimeout         ScrapeErrorCategory = "timeout"
+	ScrapeErrorParse           ScrapeErrorCategory = "parse_error"
+	ScrapeErrorNetwork         ScrapeErrorCategory = "network"
+	ScrapeErrorOther           ScrapeErrorCategory = "other"
+)
+
+// ScrapeErrorSummary counts one category of error from one source during a scrape run
+type ScrapeErrorSummary struct {
+	Source   JobSource           `json:"source,omitempty"`
+	Category ScrapeErrorCategory `json:"category"`
+	Count    int                 `json:"count"`
+	Sample   string              `json:"sample"` // first error message seen
+}
+
+// ScrapeRejectReason is why a scraped job failed validation and was not stored
+type ScrapeRejectReason string
+
+const (
+	ScrapeRejectEmptyTitle       ScrapeRejectReason = "empty_title"
+	ScrapeRejectMissingURL       ScrapeRejectReason = "missing_url"
+	ScrapeRejectShortDescription ScrapeRejectReason = "short_description"
+	ScrapeRejectSalaryRange      ScrapeRejectReason = "invalid_salary_range"
+)
+
 // JobMatchScore represents pre-calculated match scores
 type JobMatchScore struct {
 	ID              uuid.UUID `json:"id"`
@@ -183,6 +403,56 @@ type JobMatchScore struct {
 	CalculatedAt    time.Time `json:"calculated_at"`
 }
 
+// NormalizeEmploymentType maps the free-text employment type scraped from a job
+// board onto one of the canonical values. Unrecognized text returns "".
+func NormalizeEmploymentType(raw string) EmploymentType {
+	s := strings.ToLower(strings.TrimSpace(raw))
+	s = strings.NewReplacer("_", " ", "-", " ").Replace(s)
+
+	switch {
+	case s == "":
+		return ""
+	case strings.Contains(s, "intern") || strings.Contains(s, "co op") || strings.Contains(s, "coop"):
+		return EmploymentTypeInternship
+	case strings.Contains(s, "contract") || strings.Contains(s, "freelance") ||
+		strings.Contains(s, "c2c") || strings.Contains(s, "corp to corp") || strings.Contains(s, "1099"):
+		return EmploymentTypeContract
+	case strings.Contains(s, "temp") || strings.Contains(s, "seasonal"):
+		return EmploymentTypeTemp
+	case strings.Contains(s, "part time") || strings.Contains(s, "parttime"):
+		return EmploymentTypePartTime
+	case strings.Contains(s, "full time") || strings.Contains(s, "fulltime") ||
+		strings.Contains(s, "permanent") || s == "w2":
+		return EmploymentTypeFullTime
+	default:
+		return ""
+	}
+}
+
+// seasonPattern matches a program term such as "Summer 2025", "Fall '25" or "2026 Spring"
+var seasonPattern = regexp.MustCompile(`(?i)\b(?:(spring|summer|fall|autumn|winter)\s*(?:of\s*)?(20\d\d|'\d\d)|(20\d\d)\s*(spring|summer|fall|autumn|winter))\b`)
+
+// NormalizeSeason converts a program term to "<season> <year>", e.g.
+// "Summer '25" to "summer 2025". Unrecognized text returns "".
+func NormalizeSeason(raw string) string {
+	m := seasonPattern.FindStringSubmatch(raw)
+	if m == nil {
+		return ""
+	}
+	season, year := m[1], m[2]
+	if season == "" {
+		season, year = m[4], m[3]
+	}
+	season = strings.ToLower(season)
+	if season == "autumn" {
+		season = "fall"
+	}
+	if strings.HasPrefix(year, "'") {
+		year = "20" + year[1:]
+	}
+	return season + " " + year
+}
+
 // GetMatchQuality returns the quality category for a score
 func GetMatchQuality(score float64) MatchQuality {
 	switch {
@@ -196,3 +466,141 @@ func GetMatchQuality(score float64) MatchQuality {
 		return MatchQualityPoor
 	}
 }
+
+// RepostMinGap is the minimum gap between posted dates for a re-listing to count as a repost
+const RepostMinGap = 24 * time.Hour
+
+// repostPenalty is the match score deducted per detected repost, capped at maxRepostPenalty
+const (
+	repostPenalty    = 2.0
+	maxRepostPenalty = 10.0
+)
+
+// RecordSighting updates first/last-seen dates for a job seen again at seenAt with
+// the given posted date, and reports whether the sighting is a repost
+func (j *Job) RecordSighting(posted *time.Time, seenAt time.Time) bool {
+	if j.FirstSeenAt == nil || seenAt.Before(*j.FirstSeenAt) {
+		j.FirstSeenAt = &seenAt
+	}
+	if j.LastSeenAt == nil || seenAt.After(*j.LastSeenAt) {
+		j.LastSeenAt = &seenAt
+	}
+
+	latest := j.RepostedAt
+	if latest == nil {
+		latest = j.PostedDate
+	}
+	if posted == nil || latest == nil || posted.Sub(*latest) < RepostMinGap {
+		return false
+	}
+
+	j.RepostCount++
+	j.RepostedAt = posted
+	return true
+}
+
+// IsGenuinelyNew reports whether the job was first seen within the window and never reposted
+func (j *Job) IsGenuinelyNew(within time.Duration, now time.Time) bool {
+	if j.RepostCount > 0 {
+		return false
+	}
+	if j.FirstSeenAt == nil {
+		return true
+	}
+	return now.Sub(*j.FirstSeenAt) <= within
+}
+
+// RankingScore demotes a match score for jobs that keep getting re-posted
+func RankingScore(matchScore float64, repostCount int) float64 {
+	penalty := float64(repostCount) * repostPenalty
+	if penalty > maxRepostPenalty {
+		penalty = maxRepostPenalty
+	}
+	if matchScore < penalty {
+		return 0
+	}
+	return matchScore - penalty
+}
+
+// ComputeContentHash hashes the normalized title, company and description so
+// the same role cross-posted on several boards maps to one canonical record
+func ComputeContentHash(title, company, description string) string {
+	h := sha256.New()
+	for _, part := range []string{title, company, description} {
+		h.Write([]byte(normalizeForHash(part)))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// EnsureContentHash computes and stores the job's content hash if missing
+func (j *Job) EnsureContentHash() string {
+	if j.ContentHash == nil || *j.ContentHash == "" {
+		hash := ComputeContentHash(j.Title, j.Company.Name, j.Description)
+		j.ContentHash = &hash
+	}
+	return *j.ContentHash
+}
+
+// HasSource reports whether the job was already seen at the given source URL
+func (j *Job) HasSource(source JobSource, url string) bool {
+	for _, ref := range j.Sources {
+		if ref.Source == source && ref.URL == url {
+			return true
+		}
+	}
+	return false
+}
+
+// companySuffixes are legal-entity suffixes dropped when matching company names
+var companySuffixes = map[string]bool{
+	"inc": true, "incorporated": true, "llc": true, "llp": true, "lp": true,
+	"corp": true, "corporation": true, "co": true, "company": true,
+	"ltd": true, "limited": true, "plc": true, "gmbh": true, "ag": true,
+}
+
+// NormalizeCompanyName reduces a company name to a matching key, e.g.
+// "Google, LLC" and "GOOGLE LLC." both become "google"
+func NormalizeCompanyName(name string) string {
+	words := strings.Fields(normalizeForHash(name))
+	for len(words) > 1 && companySuffixes[words[len(words)-1]] {
+		words = words[:len(words)-1]
+	}
+	return strings.Join(words, " ")
+}
+
+// normalizeForHash lowercases, drops punctuation and collapses whitespace
+func normalizeForHash(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if space && b.Len() > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteRune(r)
+			space = false
+		case unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r):
+			space = true
+		}
+	}
+	return b.String()
+}
+
+// JobFeed is a page of jobs first seen after Since, best matches first. Pass
+// NextSince as the next request's since to continue without gaps or repeats.
+type JobFeed struct {
+	Jobs        []JobBrief `json:"jobs"`
+	Since       time.Time  `json:"since"`
+	NextSince   time.Time  `json:"next_since"`
+	HasMore     bool       `json:"has_more"` // more jobs are waiting after NextSince
+	GeneratedAt time.Time  `json:"generated_at"`
+}
+
+// SourceWeight is the ranking weight applied to one source's jobs
+type SourceWeight struct {
+	Source     JobSource `json:"source"`
+	Weight     float64   `json:"weight"`
+	Overridden bool      `json:"overridden"` // set at runtime rather than from config
+}
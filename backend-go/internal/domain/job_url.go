@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// trackingParams are query parameters that identify a campaign, click or
+// referrer rather than the posting; trackingPrefixes match families of them.
+// referralParams are generic names that only mark a referral on the
+// trackingHosts, job boards and applicant tracking systems; on other sites
+// they may select the page, so they are kept.
+var (
+	trackingParams = map[string]bool{
+		"gclid": true, "gbraid": true, "wbraid": true, "dclid": true, "fbclid": true,
+		"msclkid": true, "yclid": true, "igshid": true, "mc_cid": true, "mc_eid": true,
+		"_hsenc": true, "_hsmi": true, "ref_src": true, "trk": true, "trkinfo": true,
+		"trackingid": true, "refid": true, "gh_src": true, "lever-source": true,
+		"lever-origin": true,
+	}
+	trackingPrefixes = []string{"utm_", "hsa_"}
+	referralParams   = map[string]bool{"ref": true, "src": true, "referer": true, "referrer": true}
+	trackingHosts    = []string{
+		"linkedin.com", "indeed.com", "dice.com", "wellfound.com", "glassdoor.com",
+		"ziprecruiter.com", "greenhouse.io", "lever.co", "ashbyhq.com", "workable.com",
+		"smartrecruiters.com", "myworkdayjobs.com",
+	}
+)
+
+// linkedInJobID is the numeric posting ID ending a LinkedIn job path
+// (/jobs/view/123 or /jobs/view/senior-engineer-at-acme-123)
+var linkedInJobID = regexp.MustCompile(`^/jobs/view/(?:[^/]*-)?(\d+)/?$`)
+
+// CanonicalJobURL normalizes a posting URL so differently tracked links to
+// the same posting compare equal: the scheme and host are lowercased, and a
+// www. prefix, default ports, fragments and tracking parameters dropped; the
+// remaining parameters are sorted. LinkedIn and Indeed links are reduced to
+// the posting ID. URLs that don't parse are returned trimmed.
+func CanonicalJobURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return raw
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	if strings.Contains(host, ":") { // IPv6 literal
+		host = "[" + host + "]"
+	}
+	if port := u.Port(); port != "" && !(u.Scheme == "https" && port == "443") && !(u.Scheme == "http" && port == "80") {
+		host += ":" + port
+	}
+	u.Host = host
+	u.Fragment, u.RawFragment, u.User = "", "", nil
+
+	query := u.Query()
+	hostname := u.Hostname()
+	switch {
+	case hostname == "linkedin.com" || strings.HasSuffix(hostname, ".linkedin.com"):
+		if m := linkedInJobID.FindStringSubmatch(u.Path); m != nil {
+			u.Path, query = "/jobs/view/"+m[1], nil
+		}
+	case strings.HasPrefix(hostname, "indeed.") || strings.Contains(hostname, ".indeed."):
+		// Click-tracking paths redirect to the posting's viewjob page
+		if jk := query.Get("jk"); jk != "" {
+			u.Path, query = "/viewjob", url.Values{"jk": {jk}}
+		}
+	}
+
+	referrals := isTrackingHost(hostname)
+	for key := range query {
+		if isTrackingParam(key, referrals) {
+			query.Del(key)
+		}
+	}
+	u.RawQuery = query.Encode()
+	u.ForceQuery = false
+
+	if len(u.Path) > 1 {
+		u.Path = strings.TrimRight(u.Path, "/")
+		u.RawPath = ""
+	}
+	return u.String()
+}
+
+func isTrackingParam(key string, referrals bool) bool {
+	key = strings.ToLower(key)
+	if trackingParams[key] || (referrals && referralParams[key]) {
+		return true
+	}
+	for _, prefix := range trackingPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTrackingHost reports whether host is one of the trackingHosts or a
+// subdomain of one
+func isTrackingHost(host string) bool {
+	for _, h := range trackingHosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+// CanonicalizeURLs replaces the job's URL and its source URLs with their
+// canonical form
+func (j *Job) CanonicalizeURLs() {
+	j.URL = CanonicalJobURL(j.URL)
+	for i := range j.Sources {
+		j.Sources[i].URL = CanonicalJobURL(j.Sources[i].URL)
+	}
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// NotificationEvent is something the user can be notified about
+type NotificationEvent string
+
+const (
+	NotificationReminder    NotificationEvent = "reminder"     // application reminder or nearing deadline
+	NotificationSavedSearch NotificationEvent = "saved_search" // new jobs matching a saved search
+	NotificationDigest      NotificationEvent = "digest"       // periodic activity digest
+)
+
+// NotificationEvents lists every notification event
+var NotificationEvents = []NotificationEvent{NotificationReminder, NotificationSavedSearch, NotificationDigest}
+
+// NotificationChannel is a way of delivering notifications
+type NotificationChannel string
+
+const (
+	NotificationEmail NotificationChannel = "email"
+	NotificationPush  NotificationChannel = "push"
+)
+
+// NotificationChannels lists every notification channel
+var NotificationChannels = []NotificationChannel{NotificationEmail, NotificationPush}
+
+// QuietHours is a daily window in which notifications are held until it
+// ends. Start and End are HH:MM in Timezone; a window may span midnight.
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone"` // IANA name, e.g. Europe/Berlin; empty = UTC
+}
+
+// NotificationPreferences say which channels each event is sent on, and
+// when. In digest-only mode reminders and saved-search alerts are left to
+// the digest.
+type NotificationPreferences struct {
+	Channels   map[NotificationEvent][]NotificationChannel `json:"channels"`
+	QuietHours *QuietHours                                 `json:"quiet_hours,omitempty"`
+	DigestOnly bool                                        `json:"digest_only"`
+	UpdatedAt  *time.Time                                  `json:"updated_at,omitempty"`
+}
+
+// NotificationPreferencesUpdate changes the set fields of the preferences.
+// Channels replaces the channels of the events it names; clear_quiet_hours
+// turns quiet hours off.
+type NotificationPreferencesUpdate struct {
+	Channels        map[NotificationEvent][]NotificationChannel `json:"channels,omitempty"`
+	QuietHours      *QuietHours                                 `json:"quiet_hours,omitempty"`
+	ClearQuietHours bool                                        `json:"clear_quiet_hours,omitempty"`
+	DigestOnly      *bool                                       `json:"digest_only,omitempty"`
+}
+
+// Notification is a message to deliver on the channels the preferences allow
+type Notification struct {
+	Event     NotificationEvent `json:"event"`
+	Title     string            `json:"title"`
+	Body      string            `json:"body"`
+	URL       string            `json:"url,omitempty"` // app path the notification opens
+	CreatedAt time.Time         `json:"created_at"`
+}
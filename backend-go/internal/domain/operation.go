@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// Operation is a running long-running request that can be cancelled
+type Operation struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	StartedAt time.Time `json:"started_at"`
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutreachStatus is the state of an outreach sequence
+type OutreachStatus string
+
+const (
+	OutreachActive    OutreachStatus = "active"
+	OutreachPaused    OutreachStatus = "paused"
+	OutreachCompleted OutreachStatus = "completed"
+	OutreachCancelled OutreachStatus = "cancelled"
+)
+
+// OutreachStepStatus is the state of one step in a sequence
+type OutreachStepStatus string
+
+const (
+	OutreachStepPending OutreachStepStatus = "pending" // not yet due
+	OutreachStepDrafted OutreachStepStatus = "drafted" // due; draft ready to review and send
+	OutreachStepSent    OutreachStepStatus = "sent"
+	OutreachStepSkipped OutreachStepStatus = "skipped"
+)
+
+// OutreachStep is one email in an outreach sequence
+type OutreachStep struct {
+	Name        string             `json:"name"`
+	EmailType   string             `json:"email_type"` // application, followup, thankyou
+	DueAt       time.Time          `json:"due_at"`
+	Status      OutreachStepStatus `json:"status"`
+	Draft       *GeneratedEmail    `json:"draft,omitempty"`
+	DraftError  string             `json:"draft_error,omitempty"`
+	CompletedAt *time.Time         `json:"completed_at,omitempty"`
+}
+
+// Done reports whether the step has been sent or skipped
+func (s *OutreachStep) Done() bool {
+	return s.Status == OutreachStepSent || s.Status == OutreachStepSkipped
+}
+
+// OutreachSequence is a multi-step outreach plan for one application. It
+// pauses itself when the application moves on, e.g. to an interview.
+type OutreachSequence struct {
+	ID            uuid.UUID         `json:"id"`
+	ApplicationID uuid.UUID         `json:"application_id"`
+	Status        OutreachStatus    `json:"status"`
+	PausedReason  string            `json:"paused_reason,omitempty"`
+	AppStatus     ApplicationStatus `json:"application_status"` // status the sequence runs under
+	Steps         []OutreachStep    `json:"steps"`
+	StartedAt     time.Time         `json:"started_at"`
+	PausedAt      *time.Time        `json:"paused_at,omitempty"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// NextStep returns the first step not yet sent or skipped, or nil
+func (s *OutreachSequence) NextStep() *OutreachStep {
+	for i := range s.Steps {
+		if !s.Steps[i].Done() {
+			return &s.Steps[i]
+		}
+	}
+	return nil
+}
+
+// OutreachStepInput customises a step when starting a sequence
+type OutreachStepInput struct {
+	Name      string `json:"name"`
+	EmailType string `json:"email_type"`
+	AfterDays int    `json:"after_days"` // days after the sequence starts
+}
+
+// OutreachStart starts a sequence; empty steps use the configured default
+type OutreachStart struct {
+	Steps []OutreachStepInput `json:"steps,omitempty"`
+}
+
+// OutreachStepUpdate marks a step as sent or skipped
+type OutreachStepUpdate struct {
+	Status OutreachStepStatus `json:"status"`
+}
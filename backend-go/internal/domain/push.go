@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PushSubscription is a browser's Web Push subscription, as returned by
+// PushManager.subscribe, with its delivery record
+type PushSubscription struct {
+	ID        uuid.UUID        `json:"id"`
+	Endpoint  string           `json:"endpoint"`
+	Keys      PushKeys         `json:"keys"`
+	UserAgent string           `json:"user_agent,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+	Delivery  PushDeliveryInfo `json:"delivery"`
+}
+
+// PushKeys are the subscription's keys for encrypting payloads (base64url)
+type PushKeys struct {
+	P256dh string `json:"p256dh"`
+	Auth   string `json:"auth"`
+}
+
+// PushDeliveryInfo tracks deliveries to one subscription. Failures count
+// consecutive failed deliveries and reset on success.
+type PushDeliveryInfo struct {
+	Delivered     int        `json:"delivered"`
+	Failures      int        `json:"failures"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	LastFailureAt *time.Time `json:"last_failure_at,omitempty"`
+	LastError     string     `json:"last_error,omitempty"`
+}
+
+// PushSubscriptionCreate is the subscription JSON sent by the browser
+type PushSubscriptionCreate struct {
+	Endpoint string   `json:"endpoint"`
+	Keys     PushKeys `json:"keys"`
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// Capability is an external dependency that some features need
+type Capability string
+
+const (
+	CapabilityDB      Capability = "db"      // PostgreSQL
+	CapabilityVectors Capability = "vectors" // Qdrant vector store
+	CapabilityML      Capability = "ml"      // ML service (embeddings, scoring)
+	CapabilityLLM     Capability = "llm"     // language model backend
+	CapabilityBrowser Capability = "browser" // headless Chrome for scraping
+)
+
+// ReadinessStatus summarizes the capabilities as a whole
+type ReadinessStatus string
+
+const (
+	Ready    ReadinessStatus = "ready"     // every capability is available
+	Degraded ReadinessStatus = "degraded"  // core capabilities are up, some features are unavailable
+	NotReady ReadinessStatus = "not_ready" // a core capability is down
+)
+
+// CapabilityStatus is the result of one capability check
+type CapabilityStatus struct {
+	Name      Capability `json:"name"`
+	Ready     bool       `json:"ready"`
+	Core      bool       `json:"core"` // the API is not ready without it
+	Reason    string     `json:"reason,omitempty"`
+	CheckedAt time.Time  `json:"checked_at"`
+}
+
+// ReadinessReport is the state of every registered capability
+type ReadinessReport struct {
+	Status       ReadinessStatus    `json:"status"`
+	Capabilities []CapabilityStatus `json:"capabilities"`
+	Unavailable  []Capability       `json:"unavailable,omitempty"`
+}
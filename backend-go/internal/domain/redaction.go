@@ -0,0 +1,43 @@
+package domain
+
+// RedactionField is a kind of personal detail that can be stripped from a
+// resume before it is sent to an LLM backend
+type RedactionField string
+
+const (
+	RedactionName       RedactionField = "name"
+	RedactionEmail      RedactionField = "email"
+	RedactionPhone      RedactionField = "phone"
+	RedactionAddress    RedactionField = "address"
+	RedactionReferences RedactionField = "references"
+)
+
+// RedactionProfile is what is stripped for one LLM backend
+type RedactionProfile struct {
+	Backend string           `json:"backend"`
+	Trust   string           `json:"trust"`
+	Fields  []RedactionField `json:"fields"`
+}
+
+// RedactionSettings lists the redaction profile of every known backend
+type RedactionSettings struct {
+	Enabled        bool               `json:"enabled"`
+	DefaultBackend string             `json:"default_backend"`
+	Profiles       []RedactionProfile `json:"profiles"`
+}
+
+// RedactionPreviewRequest asks what a backend would receive. The active
+// resume is used when no text is given, and the current backend when no
+// backend is named.
+type RedactionPreviewRequest struct {
+	ResumeText string `json:"resume_text"`
+	Backend    string `json:"backend"`
+}
+
+// RedactionPreview is resume text as a backend would receive it, with the
+// number of details stripped per field
+type RedactionPreview struct {
+	RedactionProfile
+	Text     string                 `json:"text"`
+	Redacted map[RedactionField]int `json:"redacted"`
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ResumeSection is one headed section of a resume, e.g. "Experience"
+type ResumeSection struct {
+	Name string `json:"name"`
+	Text string `json:"text"`
+}
+
+// ResumeChangeKind is the kind of edit made when tailoring a resume
+type ResumeChangeKind string
+
+const (
+	ResumeChangeReordered ResumeChangeKind = "reordered"     // bullets moved to lead with relevant work
+	ResumeChangeSummary   ResumeChangeKind = "summary"       // summary adjusted for the role
+	ResumeChangeKeyword   ResumeChangeKind = "keyword_added" // skill shown elsewhere added to the skills list
+)
+
+// ResumeChange is one entry in a variant's change log against the base resume
+type ResumeChange struct {
+	Kind    ResumeChangeKind `json:"kind"`
+	Section string           `json:"section"`
+	Before  string           `json:"before,omitempty"`
+	After   string           `json:"after,omitempty"`
+}
+
+// ResumeVariant is a resume tailored for one application
+type ResumeVariant struct {
+	ID            uuid.UUID       `json:"id"`
+	ApplicationID uuid.UUID       `json:"application_id"`
+	JobID         uuid.UUID       `json:"job_id"`
+	Version       string          `json:"version"`   // label recorded on the application
+	BaseHash      string          `json:"base_hash"` // SHA-256 of the base resume text
+	Sections      []ResumeSection `json:"sections"`
+	Changes       []ResumeChange  `json:"changes"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+// ResumeVariantCreate optionally supplies the base resume text; otherwise the
+// active resume is used
+type ResumeVariantCreate struct {
+	ResumeText string `json:"resume_text"`
+}
+
+// ResumeVersion is a named resume kept by the user, e.g. "Backend" or
+// "Data engineering". The active version is the one chat, coverage and
+// matching read; applications record which version they were sent with.
+type ResumeVersion struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	Content   string    `json:"content"`
+	Active    bool      `json:"active"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ResumeVersionInput creates or changes a resume version; nil fields are left unchanged
+type ResumeVersionInput struct {
+	Name    *string `json:"name,omitempty"`
+	Content *string `json:"content,omitempty"`
+}
+
+// ResumeTailorRequest asks for tailoring suggestions against a stored job
+// or a pasted job description. ResumeText defaults to the active resume.
+type ResumeTailorRequest struct {
+	JobID          *uuid.UUID `json:"job_id,omitempty"`
+	JobDescription string     `json:"job_description,omitempty"`
+	ResumeText     string     `json:"resume_text,omitempty"`
+	IncludeDraft   bool       `json:"include_draft"`
+}
+
+// KeywordInsertion is a job keyword the resume leaves out, with where and
+// how the candidate's experience supports adding it
+type KeywordInsertion struct {
+	Keyword    string `json:"keyword"`
+	Section    string `json:"section"`
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// ResumeTailoring is the LLM's advice for fitting a resume to a job
+type ResumeTailoring struct {
+	JobID    *uuid.UUID         `json:"job_id,omitempty"`
+	Summary  string             `json:"summary"`
+	Rewrites []BulletRewrite    `json:"rewrites"`
+	Keywords []KeywordInsertion `json:"keywords"`
+	Draft    string             `json:"draft,omitempty"` // full tailored resume, when requested
+	Backend  string             `json:"backend"`
+	Model    string             `json:"model"`
+}
@@ -0,0 +1,114 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// JobRevision is a change to a posting noticed when the job was re-scraped
+// at the same URL, e.g. new requirements or a different salary range
+type JobRevision struct {
+	ID                uuid.UUID `json:"id"`
+	JobID             uuid.UUID `json:"job_id"`
+	ChangedAt         time.Time `json:"changed_at"`
+	PreviousTitle     *string   `json:"previous_title,omitempty"` // set when the title changed
+	SalaryChanged     bool      `json:"salary_changed"`
+	PreviousSalaryMin *int      `json:"previous_salary_min,omitempty"`
+	PreviousSalaryMax *int      `json:"previous_salary_max,omitempty"`
+	Removed           []string  `json:"removed"` // description lines no longer in the posting
+	Added             []string  `json:"added"`   // description lines new to the posting
+}
+
+// NewJobRevision compares a stored job with a fresh scrape of the same
+// posting. It returns nil when nothing a user would notice changed, such as
+// whitespace or punctuation.
+func NewJobRevision(stored, scraped *Job) *JobRevision {
+	rev := &JobRevision{JobID: stored.ID}
+	rev.Removed, rev.Added = DiffLines(stored.Description, scraped.Description)
+
+	if normalizeForHash(stored.Title) != normalizeForHash(scraped.Title) {
+		title := stored.Title
+		rev.PreviousTitle = &title
+	}
+	if !sameInt(stored.SalaryMin, scraped.SalaryMin) || !sameInt(stored.SalaryMax, scraped.SalaryMax) {
+		rev.SalaryChanged = true
+		rev.PreviousSalaryMin, rev.PreviousSalaryMax = stored.SalaryMin, stored.SalaryMax
+	}
+
+	if len(rev.Removed) == 0 && len(rev.Added) == 0 && rev.PreviousTitle == nil && !rev.SalaryChanged {
+		return nil
+	}
+	return rev
+}
+
+// DiffLines returns the lines of before missing from after, and the lines of
+// after missing from before, each in order. Lines are compared ignoring case
+// and punctuation, and long paragraphs are split into sentences so a one-word
+// edit does not report the whole paragraph.
+func DiffLines(before, after string) (removed, added []string) {
+	beforeLines, afterLines := descriptionLines(before), descriptionLines(after)
+
+	remaining := make(map[string]int, len(afterLines))
+	for _, line := range afterLines {
+		remaining[normalizeForHash(line)]++
+	}
+	removed = []string{}
+	for _, line := range beforeLines {
+		key := normalizeForHash(line)
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+		removed = append(removed, line)
+	}
+
+	remaining = make(map[string]int, len(beforeLines))
+	for _, line := range beforeLines {
+		remaining[normalizeForHash(line)]++
+	}
+	added = []string{}
+	for _, line := range afterLines {
+		key := normalizeForHash(line)
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+		added = append(added, line)
+	}
+	return removed, added
+}
+
+// descriptionLines splits a description into non-empty lines, and lines into
+// sentences
+func descriptionLines(description string) []string {
+	var lines []string
+	for _, line := range strings.Split(description, "\n") {
+		start := 0
+		for i := 0; i < len(line); i++ {
+			end := line[i] == '.' || line[i] == '!' || line[i] == '?'
+			if end && (i+1 == len(line) || line[i+1] == ' ') {
+				lines = appendLine(lines, line[start:i+1])
+				start = i + 1
+			}
+		}
+		lines = appendLine(lines, line[start:])
+	}
+	return lines
+}
+
+func appendLine(lines []string, line string) []string {
+	line = strings.TrimSpace(strings.TrimLeft(line, " \t•*-–·"))
+	if normalizeForHash(line) == "" {
+		return lines
+	}
+	return append(lines, line)
+}
+
+func sameInt(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
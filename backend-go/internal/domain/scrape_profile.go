@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScrapeProfile is a named scrape preset, e.g. "daily-remote-golang". Scrapes
+// can be queued by profile name, and a profile with a schedule is queued
+// automatically every Schedule.
+type ScrapeProfile struct {
+	ID         uuid.UUID   `json:"id"`
+	Name       string      `json:"name"`
+	Keywords   []string    `json:"keywords"`
+	Location   *string     `json:"location,omitempty"`
+	Sources    []JobSource `json:"sources"`            // empty scrapes every source
+	MaxJobs    int         `json:"max_jobs,omitempty"` // per source; 0 uses the scraper default
+	Schedule   string      `json:"schedule,omitempty"` // interval such as "24h"; empty runs only on request
+	LastRunAt  *time.Time  `json:"last_run_at,omitempty"`
+	LastTaskID *uuid.UUID  `json:"last_task_id,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+	UpdatedAt  time.Time   `json:"updated_at"`
+}
+
+// ScrapeProfileInput creates or changes a scrape profile; nil fields are left unchanged
+type ScrapeProfileInput struct {
+	Name     *string     `json:"name,omitempty"`
+	Keywords []string    `json:"keywords,omitempty"`
+	Location *string     `json:"location,omitempty"` // empty string clears it
+	Sources  []JobSource `json:"sources,omitempty"`
+	MaxJobs  *int        `json:"max_jobs,omitempty"`
+	Schedule *string     `json:"schedule,omitempty"` // empty string removes the schedule
+}
+
+// ScrapeRequest queues a scrape task
+type ScrapeRequest struct {
+	Keywords  []string
+	Location  *string
+	Sources   []string
+	MaxJobs   int
+	ProfileID *uuid.UUID
+}
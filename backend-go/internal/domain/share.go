@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ShareScope is what a share link exposes
+type ShareScope string
+
+const (
+	ShareBoard       ShareScope = "board"       // every tracked application
+	ShareApplication ShareScope = "application" // a single application
+)
+
+// ShareField is an application field that can be hidden from a share link
+type ShareField string
+
+const (
+	ShareSalary        ShareField = "salary"
+	ShareNotes         ShareField = "notes" // application and timeline notes
+	ShareCoverLetter   ShareField = "cover_letter"
+	ShareResumeVersion ShareField = "resume_version"
+)
+
+// ShareFields lists the fields that can be redacted
+var ShareFields = []ShareField{ShareSalary, ShareNotes, ShareCoverLetter, ShareResumeVersion}
+
+// Valid reports whether f is a redactable field
+func (f ShareField) Valid() bool {
+	for _, known := range ShareFields {
+		if f == known {
+			return true
+		}
+	}
+	return false
+}
+
+// ShareLink is a read-only link to the application board or one application.
+// Only a hash of the token is kept; the token itself is returned once, when
+// the link is created.
+type ShareLink struct {
+	ID            uuid.UUID    `json:"id"`
+	TokenHash     string       `json:"-"`
+	Scope         ShareScope   `json:"scope"`
+	ApplicationID *uuid.UUID   `json:"application_id,omitempty"`
+	Label         string       `json:"label,omitempty"`
+	Redact        []ShareField `json:"redact"`
+	ExpiresAt     *time.Time   `json:"expires_at,omitempty"`
+	RevokedAt     *time.Time   `json:"revoked_at,omitempty"`
+	Views         int          `json:"views"`
+	LastViewedAt  *time.Time   `json:"last_viewed_at,omitempty"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+// Active reports whether the link can still be viewed
+func (l *ShareLink) Active(now time.Time) bool {
+	return l.RevokedAt == nil && (l.ExpiresAt == nil || now.Before(*l.ExpiresAt))
+}
+
+// ShareLinkCreate creates a share link. ApplicationID is required for the
+// application scope; ExpiresIn is in hours and zero means no expiry.
+type ShareLinkCreate struct {
+	Scope         ShareScope   `json:"scope"`
+	ApplicationID *uuid.UUID   `json:"application_id,omitempty"`
+	Label         string       `json:"label,omitempty"`
+	Redact        []ShareField `json:"redact,omitempty"`
+	ExpiresIn     int          `json:"expires_in_hours,omitempty"`
+}
+
+// CreatedShareLink is a new link with its token and path
+type CreatedShareLink struct {
+	ShareLink
+	Token string `json:"token"`
+	Path  string `json:"path"`
+}
+
+// SharedView is what a share link shows: the board grouped by status, or a
+// single application, with redacted fields removed
+type SharedView struct {
+	Scope       ShareScope                          `json:"scope"`
+	Label       string                              `json:"label,omitempty"`
+	Redacted    []ShareField                        `json:"redacted"`
+	Application *Application                        `json:"application,omitempty"`
+	Board       map[ApplicationStatus][]Application `json:"board,omitempty"`
+	Total       int                                 `json:"total"`
+	ExpiresAt   *time.Time                          `json:"expires_at,omitempty"`
+	GeneratedAt time.Time                           `json:"generated_at"`
+}
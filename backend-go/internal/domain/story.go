@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Competency is a behavioral theme a STAR story demonstrates
+type Competency string
+
+const (
+	CompetencyLeadership    Competency = "leadership"
+	CompetencyConflict      Competency = "conflict"
+	CompetencyFailure       Competency = "failure"
+	CompetencyTeamwork      Competency = "teamwork"
+	CompetencyOwnership     Competency = "ownership"
+	CompetencyInfluence     Competency = "influence"
+	CompetencyAmbiguity     Competency = "ambiguity"
+	CompetencyCustomerFocus Competency = "customer_focus"
+	CompetencyDelivery      Competency = "delivery"
+	CompetencyInnovation    Competency = "innovation"
+	CompetencyGrowth        Competency = "growth"
+)
+
+// Competencies lists the known competencies
+var Competencies = []Competency{
+	CompetencyLeadership, CompetencyConflict, CompetencyFailure, CompetencyTeamwork,
+	CompetencyOwnership, CompetencyInfluence, CompetencyAmbiguity, CompetencyCustomerFocus,
+	CompetencyDelivery, CompetencyInnovation, CompetencyGrowth,
+}
+
+// Valid reports whether c is a known competency
+func (c Competency) Valid() bool {
+	for _, known := range Competencies {
+		if c == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Story is a vetted STAR story from the user's story bank
+type Story struct {
+	ID           uuid.UUID    `json:"id"`
+	Title        string       `json:"title"`
+	Competencies []Competency `json:"competencies"`
+	Situation    string       `json:"situation"`
+	Task         string       `json:"task"`
+	Action       string       `json:"action"`
+	Result       string       `json:"result"`
+	Tags         []string     `json:"tags,omitempty"` // free-form, e.g. company or project
+	CreatedAt    time.Time    `json:"created_at"`
+	UpdatedAt    time.Time    `json:"updated_at"`
+}
+
+// Text returns the story as one STAR-formatted block
+func (s *Story) Text() string {
+	parts := []string{s.Title}
+	for _, p := range []struct{ label, text string }{
+		{"Situation", s.Situation}, {"Task", s.Task}, {"Action", s.Action}, {"Result", s.Result},
+	} {
+		if strings.TrimSpace(p.text) != "" {
+			parts = append(parts, p.label+": "+strings.TrimSpace(p.text))
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// StoryInput creates or replaces a story
+type StoryInput struct {
+	Title        string       `json:"title"`
+	Competencies []Competency `json:"competencies"`
+	Situation    string       `json:"situation"`
+	Task         string       `json:"task"`
+	Action       string       `json:"action"`
+	Result       string       `json:"result"`
+	Tags         []string     `json:"tags,omitempty"`
+}
+
+// StoryMatch is a story retrieved for an interview question
+type StoryMatch struct {
+	Story   Story        `json:"story"`
+	Score   float64      `json:"score"`
+	Matched []Competency `json:"matched_competencies,omitempty"`
+}
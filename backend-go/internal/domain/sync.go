@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ChangeEntity is the kind of record a sync change refers to
+type ChangeEntity string
+
+const (
+	ChangeEntityJob         ChangeEntity = "job"
+	ChangeEntityApplication ChangeEntity = "application"
+)
+
+// Change is the latest state of a job or application changed after a sync
+// cursor. Deleted changes are tombstones and carry no record.
+type Change struct {
+	Entity      ChangeEntity `json:"entity"`
+	ID          uuid.UUID    `json:"id"`
+	Version     int64        `json:"version"`
+	Deleted     bool         `json:"deleted"`
+	ChangedAt   time.Time    `json:"changed_at"`
+	Job         *Job         `json:"job,omitempty"`
+	Application *Application `json:"application,omitempty"`
+}
+
+// ChangeSet is a page of changes in version order. Clients pass Cursor back
+// to get the next page; when Reset is set the cursor is too old to continue
+// from and the client should discard its cache and sync from scratch.
+type ChangeSet struct {
+	Changes []Change `json:"changes"`
+	Cursor  string   `json:"cursor"`
+	HasMore bool     `json:"has_more"`
+	Reset   bool     `json:"reset"`
+}
@@ -0,0 +1,87 @@
+package domain
+
+import "time"
+
+// SystemStats is an operator's one-call overview of the deployment. Sections
+// that could not be computed, e.g. database figures while no database is
+// connected, are left out and named in Unavailable.
+type SystemStats struct {
+	Database     *DatabaseSize         `json:"database,omitempty"`
+	TotalJobs    int                   `json:"total_jobs"`
+	JobsBySource map[string]int        `json:"jobs_by_source"`
+	Queues       map[string]QueueDepth `json:"queues"` // scrape, llm:<backend>
+	ScraperRuns  []ScraperErrorRate    `json:"scraper_runs,omitempty"`
+	LLMSpend     *LLMSpend             `json:"llm_spend,omitempty"`
+	ActiveUsers  *ActiveUsers          `json:"active_users,omitempty"`
+	Unavailable  map[string]string     `json:"unavailable,omitempty"` // section -> reason
+	GeneratedAt  time.Time             `json:"generated_at"`
+}
+
+// DatabaseSize is the on-disk size of the database and its largest tables
+type DatabaseSize struct {
+	TotalBytes int64       `json:"total_bytes"`
+	Tables     []TableSize `json:"tables"`
+}
+
+// TableSize is one table's size, including indexes
+type TableSize struct {
+	Table string `json:"table"`
+	Rows  int64  `json:"rows"` // planner estimate
+	Bytes int64  `json:"bytes"`
+}
+
+// QueueDepth is how much work a queue is doing and holding
+type QueueDepth struct {
+	Active int `json:"active"`
+	Queued int `json:"queued"`
+}
+
+// ScraperErrorRate is how often one source's scrape runs failed or hit errors
+type ScraperErrorRate struct {
+	Source     JobSource `json:"source"`
+	Runs       int       `json:"runs"`
+	FailedRuns int       `json:"failed_runs"`
+	Errors     int       `json:"errors"` // categorized errors across all runs
+	ErrorRate  float64   `json:"error_rate"`
+}
+
+// LLMSpend is LLM usage and cost since the start of the month
+type LLMSpend struct {
+	Month        string             `json:"month"` // YYYY-MM
+	Requests     int                `json:"requests"`
+	InputTokens  int64              `json:"input_tokens"`
+	OutputTokens int64              `json:"output_tokens"`
+	CostUSD      float64            `json:"cost_usd"`
+	ByBackend    map[string]float64 `json:"by_backend"` // backend -> cost_usd
+}
+
+// ActiveUsers counts distinct users making changes over recent windows
+type ActiveUsers struct {
+	Day   int `json:"day"`
+	Week  int `json:"week"`
+	Month int `json:"month"`
+}
+
+// LLMBackendState is the state of an LLM backend's circuit breaker
+type LLMBackendState string
+
+const (
+	LLMBackendHealthy  LLMBackendState = "healthy"   // breaker closed
+	LLMBackendOpen     LLMBackendState = "open"      // skipped until the cooldown ends
+	LLMBackendHalfOpen LLMBackendState = "half_open" // cooldown over; the next request is a trial
+	LLMBackendDisabled LLMBackendState = "disabled"  // no API key configured
+)
+
+// LLMBackendHealth is the request record of one LLM backend since startup
+type LLMBackendHealth struct {
+	Backend             string          `json:"backend"`
+	State               LLMBackendState `json:"state"`
+	Requests            int64           `json:"requests"`
+	Failures            int64           `json:"failures"`
+	Fallbacks           int64           `json:"fallbacks"` // requests it served for a failed backend
+	ConsecutiveFailures int             `json:"consecutive_failures"`
+	LastError           string          `json:"last_error,omitempty"`
+	LastSuccessAt       *time.Time      `json:"last_success_at,omitempty"`
+	LastFailureAt       *time.Time      `json:"last_failure_at,omitempty"`
+	OpenUntil           *time.Time      `json:"open_until,omitempty"`
+}
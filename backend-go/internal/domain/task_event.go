@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskKind names the background subsystem a task belongs to
+type TaskKind string
+
+const (
+	TaskKindScrape  TaskKind = "scrape"
+	TaskKindMatch   TaskKind = "match"
+	TaskKindEmbed   TaskKind = "embed"
+	TaskKindDigest  TaskKind = "digest"
+	TaskKindCleanup TaskKind = "cleanup"
+)
+
+// TaskEventType is the step of a task an event records
+type TaskEventType string
+
+const (
+	TaskEventQueued    TaskEventType = "queued"
+	TaskEventStarted   TaskEventType = "started"
+	TaskEventProgress  TaskEventType = "progress"
+	TaskEventCompleted TaskEventType = "completed"
+	TaskEventFailed    TaskEventType = "failed"
+	TaskEventCancelled TaskEventType = "cancelled"
+)
+
+// TaskEvent is one entry in a background task's timeline. Scrape tasks also
+// collect the embed and match events of the jobs they found.
+type TaskEvent struct {
+	ID        uuid.UUID              `json:"id"`
+	TaskID    uuid.UUID              `json:"task_id"`
+	Kind      TaskKind               `json:"kind"`
+	Type      TaskEventType          `json:"type"`
+	Message   string                 `json:"message,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+}
@@ -0,0 +1,194 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// H1BRecord aggregates public H1B (LCA) filings for one employer
+type H1BRecord struct {
+	Employer      string `json:"employer"`
+	Filings       int    `json:"filings"`
+	Certified     int    `json:"certified"`
+	LastFiledYear int    `json:"last_filed_year,omitempty"`
+}
+
+// H1BStats describes the currently loaded dataset
+type H1BStats struct {
+	Employers  int        `json:"employers"`
+	Rows       int        `json:"rows"`
+	ImportedAt *time.Time `json:"imported_at,omitempty"`
+	MinFilings int        `json:"min_filings"`
+}
+
+// H1BIndex maps normalized employer names to their H1B filing history
+type H1BIndex struct {
+	cfg config.H1BConfig
+
+	mu         sync.RWMutex
+	employers  map[string]*H1BRecord
+	rows       int
+	importedAt *time.Time
+}
+
+// NewH1BIndex creates an empty index
+func NewH1BIndex(cfg config.H1BConfig) *H1BIndex {
+	return &H1BIndex{
+		cfg:       cfg,
+		employers: make(map[string]*H1BRecord),
+	}
+}
+
+// ImportFile loads the dataset at path, replacing the current index
+func (idx *H1BIndex) ImportFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open H1B dataset: %w", err)
+	}
+	defer f.Close()
+	return idx.Import(f)
+}
+
+// Import loads a CSV dataset (e.g. the DOL LCA disclosure export), replacing
+// the current index. Column names come from configuration.
+func (idx *H1BIndex) Import(r io.Reader) (int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read H1B dataset header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[strings.ToUpper(strings.TrimSpace(name))] = i
+	}
+	employerCol, ok := cols[strings.ToUpper(idx.cfg.EmployerColumn)]
+	if !ok {
+		return 0, fmt.Errorf("H1B dataset is missing column %q", idx.cfg.EmployerColumn)
+	}
+	statusCol, hasStatus := cols[strings.ToUpper(idx.cfg.StatusColumn)]
+	yearCol, hasYear := cols[strings.ToUpper(idx.cfg.YearColumn)]
+
+	employers := make(map[string]*H1BRecord)
+	rows := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse H1B dataset at row %d: %w", rows+2, err)
+		}
+		if employerCol >= len(record) {
+			continue
+		}
+
+		name := strings.TrimSpace(record[employerCol])
+		key := domain.NormalizeCompanyName(name)
+		if key == "" {
+			continue
+		}
+		rows++
+
+		rec, ok := employers[key]
+		if !ok {
+			rec = &H1BRecord{Employer: name}
+			employers[key] = rec
+		}
+		rec.Filings++
+		if hasStatus && statusCol < len(record) && strings.HasPrefix(strings.ToUpper(record[statusCol]), "CERTIFIED") {
+			rec.Certified++
+		}
+		if hasYear && yearCol < len(record) {
+			if year := parseYear(record[yearCol]); year > rec.LastFiledYear {
+				rec.LastFiledYear = year
+			}
+		}
+	}
+
+	now := time.Now()
+	idx.mu.Lock()
+	idx.employers = employers
+	idx.rows = rows
+	idx.importedAt = &now
+	idx.mu.Unlock()
+
+	return len(employers), nil
+}
+
+// Lookup returns the filing history for a company name
+func (idx *H1BIndex) Lookup(company string) (*H1BRecord, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	rec, ok := idx.employers[domain.NormalizeCompanyName(company)]
+	if !ok {
+		return nil, false
+	}
+	copied := *rec
+	return &copied, true
+}
+
+// LikelySponsor reports whether a record shows enough certified filings to
+// suggest the employer sponsors visas
+func (idx *H1BIndex) LikelySponsor(rec *H1BRecord) bool {
+	return rec != nil && rec.Certified >= idx.cfg.MinFilings
+}
+
+// EnrichCompany sets the H1B fields on a company
+func (idx *H1BIndex) EnrichCompany(c *domain.Company) {
+	rec, ok := idx.Lookup(c.Name)
+	if !ok {
+		return
+	}
+	filings := rec.Certified
+	c.H1BFilings = &filings
+	c.LikelySponsorsVisa = idx.LikelySponsor(rec)
+}
+
+// Enrich sets H1B fields on the companies of freshly ingested jobs
+func (idx *H1BIndex) Enrich(ctx context.Context, jobs []*domain.Job) {
+	for _, job := range jobs {
+		idx.EnrichCompany(&job.Company)
+	}
+}
+
+// Stats describes the loaded dataset
+func (idx *H1BIndex) Stats() H1BStats {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	return H1BStats{
+		Employers:  len(idx.employers),
+		Rows:       idx.rows,
+		ImportedAt: idx.importedAt,
+		MinFilings: idx.cfg.MinFilings,
+	}
+}
+
+// parseYear extracts a year from a fiscal year column or a date like 2023-10-01 / 10/01/2023
+func parseYear(s string) int {
+	s = strings.TrimSpace(s)
+	if year, err := strconv.Atoi(s); err == nil {
+		return year
+	}
+	for _, layout := range []string{"2006-01-02", "01/02/2006", time.RFC3339} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Year()
+		}
+	}
+	return 0
+}
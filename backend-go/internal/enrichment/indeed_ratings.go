@@ -0,0 +1,86 @@
+package enrichment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// indeedCompanySlug strips characters Indeed drops from /cmp/ URLs
+var indeedCompanySlug = regexp.MustCompile(`[^a-z0-9]+`)
+
+// IndeedRatingSource reads ratings from public Indeed company pages
+type IndeedRatingSource struct {
+	baseURL   string
+	userAgent string
+	client    *http.Client
+}
+
+// NewIndeedRatingSource creates an Indeed rating source
+func NewIndeedRatingSource(baseURL, userAgent string, timeout time.Duration) *IndeedRatingSource {
+	return &IndeedRatingSource{
+		baseURL:   strings.TrimRight(baseURL, "/"),
+		userAgent: userAgent,
+		client:    &http.Client{Timeout: timeout},
+	}
+}
+
+// Name returns the source name
+func (s *IndeedRatingSource) Name() string {
+	return "indeed"
+}
+
+// FetchRating fetches the overall rating from the company's Indeed page
+func (s *IndeedRatingSource) FetchRating(ctx context.Context, company string) (*CompanyRating, error) {
+	slug := strings.Trim(indeedCompanySlug.ReplaceAllString(strings.ToLower(company), "-"), "-")
+	if slug == "" {
+		return nil, ErrRatingNotFound
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+"/cmp/"+slug, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", s.userAgent)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("rating request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrRatingNotFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rating request failed: status %d", resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse company page: %w", err)
+	}
+
+	ratingText, _ := doc.Find("[itemprop='ratingValue']").First().Attr("content")
+	rating, err := strconv.ParseFloat(strings.TrimSpace(ratingText), 64)
+	if err != nil {
+		return nil, ErrRatingNotFound
+	}
+
+	cr := &CompanyRating{
+		Company:   company,
+		Rating:    rating,
+		Source:    s.Name(),
+		FetchedAt: time.Now(),
+	}
+	if reviews, ok := doc.Find("[itemprop='reviewCount']").First().Attr("content"); ok {
+		cr.ReviewCount, _ = strconv.Atoi(strings.TrimSpace(reviews))
+	}
+	return cr, nil
+}
@@ -0,0 +1,269 @@
+package enrichment
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// ErrRatingNotFound is returned when a source has no rating for a company
+var ErrRatingNotFound = errors.New("company rating not found")
+
+// CompanyRating is an employee-review rating for a company
+type CompanyRating struct {
+	Company     string    `json:"company"`
+	Rating      float64   `json:"rating"`
+	ReviewCount int       `json:"review_count,omitempty"`
+	Source      string    `json:"source"`
+	FetchedAt   time.Time `json:"fetched_at"`
+}
+
+// RatingSource fetches a company's rating from a review site
+type RatingSource interface {
+	Name() string
+	FetchRating(ctx context.Context, company string) (*CompanyRating, error)
+}
+
+// RatingStats describes the ratings store
+type RatingStats struct {
+	Companies   int        `json:"companies"`
+	Rated       int        `json:"rated"`
+	Source      string     `json:"source,omitempty"`
+	LastRefresh *time.Time `json:"last_refresh,omitempty"`
+}
+
+// RatingStore keeps company ratings, filled by bulk import and periodic refresh
+type RatingStore struct {
+	cfg    config.RatingsConfig
+	source RatingSource
+
+	mu          sync.RWMutex
+	ratings     map[string]*CompanyRating
+	tracked     map[string]string // normalized name -> display name, for refresh
+	lastRefresh *time.Time
+}
+
+// NewRatingStore creates a ratings store; source may be nil to rely on imports only
+func NewRatingStore(cfg config.RatingsConfig, source RatingSource) *RatingStore {
+	return &RatingStore{
+		cfg:     cfg,
+		source:  source,
+		ratings: make(map[string]*CompanyRating),
+		tracked: make(map[string]string),
+	}
+}
+
+// ImportFile loads ratings from a CSV file
+func (s *RatingStore) ImportFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open ratings file: %w", err)
+	}
+	defer f.Close()
+	return s.Import(f)
+}
+
+// Import merges ratings from a CSV with columns company,rating[,review_count[,source]]
+func (s *RatingStore) Import(r io.Reader) (int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read ratings header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	companyCol, ok := cols["company"]
+	if !ok {
+		return 0, errors.New(`ratings file is missing column "company"`)
+	}
+	ratingCol, ok := cols["rating"]
+	if !ok {
+		return 0, errors.New(`ratings file is missing column "rating"`)
+	}
+	reviewsCol, hasReviews := cols["review_count"]
+	sourceCol, hasSource := cols["source"]
+
+	now := time.Now()
+	imported := make([]*CompanyRating, 0)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse ratings at line %d: %w", line, err)
+		}
+		if companyCol >= len(record) || ratingCol >= len(record) {
+			continue
+		}
+
+		rating, err := strconv.ParseFloat(strings.TrimSpace(record[ratingCol]), 64)
+		if err != nil || rating < 0 || rating > 5 {
+			return 0, fmt.Errorf("invalid rating at line %d: %q", line, record[ratingCol])
+		}
+		cr := &CompanyRating{
+			Company:   strings.TrimSpace(record[companyCol]),
+			Rating:    rating,
+			Source:    "import",
+			FetchedAt: now,
+		}
+		if hasReviews && reviewsCol < len(record) {
+			cr.ReviewCount, _ = strconv.Atoi(strings.TrimSpace(record[reviewsCol]))
+		}
+		if hasSource && sourceCol < len(record) && strings.TrimSpace(record[sourceCol]) != "" {
+			cr.Source = strings.TrimSpace(record[sourceCol])
+		}
+		imported = append(imported, cr)
+	}
+
+	s.mu.Lock()
+	for _, cr := range imported {
+		s.ratings[domain.NormalizeCompanyName(cr.Company)] = cr
+	}
+	s.mu.Unlock()
+
+	return len(imported), nil
+}
+
+// Lookup returns the stored rating for a company
+func (s *RatingStore) Lookup(company string) (*CompanyRating, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	cr, ok := s.ratings[domain.NormalizeCompanyName(company)]
+	if !ok {
+		return nil, false
+	}
+	copied := *cr
+	return &copied, true
+}
+
+// EnrichCompany sets Company.Rating and tracks the company for refresh
+func (s *RatingStore) EnrichCompany(c *domain.Company) {
+	key := domain.NormalizeCompanyName(c.Name)
+	if key == "" {
+		return
+	}
+
+	s.mu.Lock()
+	if _, ok := s.tracked[key]; !ok {
+		s.tracked[key] = c.Name
+	}
+	cr := s.ratings[key]
+	s.mu.Unlock()
+
+	if cr != nil {
+		rating := cr.Rating
+		c.Rating = &rating
+	}
+}
+
+// Enrich sets ratings on the companies of freshly ingested jobs
+func (s *RatingStore) Enrich(ctx context.Context, jobs []*domain.Job) {
+	for _, job := range jobs {
+		s.EnrichCompany(&job.Company)
+	}
+}
+
+// Refresh fetches ratings for tracked companies that are missing or older than
+// MaxAge, and returns how many were updated
+func (s *RatingStore) Refresh(ctx context.Context) (int, error) {
+	if s.source == nil {
+		return 0, errors.New("no rating source configured")
+	}
+
+	cutoff := time.Now().Add(-s.cfg.MaxAge)
+	s.mu.RLock()
+	stale := make([]string, 0)
+	for key, name := range s.tracked {
+		if cr, ok := s.ratings[key]; !ok || cr.FetchedAt.Before(cutoff) {
+			stale = append(stale, name)
+		}
+	}
+	s.mu.RUnlock()
+
+	updated := 0
+	for _, name := range stale {
+		if ctx.Err() != nil {
+			return updated, ctx.Err()
+		}
+		cr, err := s.source.FetchRating(ctx, name)
+		if err != nil {
+			if !errors.Is(err, ErrRatingNotFound) {
+				logger.Debug("Failed to fetch company rating", zap.String("company", name), zap.Error(err))
+			}
+			continue
+		}
+
+		s.mu.Lock()
+		s.ratings[domain.NormalizeCompanyName(name)] = cr
+		s.mu.Unlock()
+		updated++
+	}
+
+	now := time.Now()
+	s.mu.Lock()
+	s.lastRefresh = &now
+	s.mu.Unlock()
+
+	return updated, nil
+}
+
+// Start refreshes ratings on the configured interval until ctx is cancelled
+func (s *RatingStore) Start(ctx context.Context) {
+	if s.source == nil || s.cfg.RefreshInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.cfg.RefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				updated, err := s.Refresh(ctx)
+				if err != nil {
+					logger.Warn("Company rating refresh failed", zap.Error(err))
+					continue
+				}
+				logger.Info("Company ratings refreshed", zap.Int("updated", updated))
+			}
+		}
+	}()
+}
+
+// Stats describes the store
+func (s *RatingStore) Stats() RatingStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats := RatingStats{
+		Companies:   len(s.tracked),
+		Rated:       len(s.ratings),
+		LastRefresh: s.lastRefresh,
+	}
+	if s.source != nil {
+		stats.Source = s.source.Name()
+	}
+	return stats
+}
@@ -0,0 +1,386 @@
+package enrichment
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Survey salaries outside this range are typos or monthly figures and are dropped
+const (
+	minSurveySalary = 1_000
+	maxSurveySalary = 2_000_000
+)
+
+// titleStopWords carry no meaning when matching job titles to survey roles
+var titleStopWords = map[string]bool{"or": true, "and": true, "of": true, "the": true, "a": true}
+
+// genericTitleWords appear in most roles, so they only break ties
+var genericTitleWords = map[string]bool{
+	"developer": true, "engineer": true, "engineering": true, "software": true, "specialist": true,
+}
+
+// countryAliases maps common location spellings to the survey's country names
+var countryAliases = map[string]string{
+	"us":              "united states of america",
+	"usa":             "united states of america",
+	"united states":   "united states of america",
+	"uk":              "united kingdom of great britain and northern ireland",
+	"united kingdom":  "united kingdom of great britain and northern ireland",
+	"great britain":   "united kingdom of great britain and northern ireland",
+	"england":         "united kingdom of great britain and northern ireland",
+	"russia":          "russian federation",
+	"south korea":     "republic of korea",
+	"vietnam":         "viet nam",
+	"the netherlands": "netherlands",
+	"uae":             "united arab emirates",
+}
+
+// SalaryBenchmarkStats describes the currently loaded survey
+type SalaryBenchmarkStats struct {
+	Source     string     `json:"source"`
+	Roles      int        `json:"roles"`
+	Locations  int        `json:"locations"`
+	Rows       int        `json:"rows"`
+	ImportedAt *time.Time `json:"imported_at,omitempty"`
+	MinSamples int        `json:"min_samples"`
+}
+
+// salaryRole is one survey role with its salaries overall and per location,
+// each sorted ascending
+type salaryRole struct {
+	name       string
+	words      []string
+	all        []int
+	byLocation map[string][]int
+}
+
+// SalaryBenchmarks holds market salary distributions from a public survey
+// (e.g. the Stack Overflow Developer Survey), by role and location
+type SalaryBenchmarks struct {
+	cfg config.SalaryBenchmarkConfig
+
+	mu         sync.RWMutex
+	roles      []*salaryRole
+	locations  map[string]string // normalized -> survey spelling
+	rows       int
+	importedAt *time.Time
+}
+
+// NewSalaryBenchmarks creates an empty benchmark set
+func NewSalaryBenchmarks(cfg config.SalaryBenchmarkConfig) *SalaryBenchmarks {
+	return &SalaryBenchmarks{
+		cfg:       cfg,
+		locations: make(map[string]string),
+	}
+}
+
+// ImportFile loads the survey at path, replacing the current benchmarks
+func (b *SalaryBenchmarks) ImportFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open salary survey: %w", err)
+	}
+	defer f.Close()
+	return b.Import(f)
+}
+
+// Import loads a survey CSV, replacing the current benchmarks. Column names
+// come from configuration; a respondent listing several roles (separated by
+// ";") counts toward each of them. Returns the number of roles.
+func (b *SalaryBenchmarks) Import(r io.Reader) (int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+	reader.LazyQuotes = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read salary survey header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[strings.ToUpper(strings.TrimSpace(name))] = i
+	}
+	titleCol, ok := cols[strings.ToUpper(b.cfg.TitleColumn)]
+	if !ok {
+		return 0, fmt.Errorf("salary survey is missing column %q", b.cfg.TitleColumn)
+	}
+	salaryCol, ok := cols[strings.ToUpper(b.cfg.SalaryColumn)]
+	if !ok {
+		return 0, fmt.Errorf("salary survey is missing column %q", b.cfg.SalaryColumn)
+	}
+	locationCol, hasLocation := cols[strings.ToUpper(b.cfg.LocationColumn)]
+
+	roles := make(map[string]*salaryRole)
+	locations := make(map[string]string)
+	rows := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse salary survey at row %d: %w", rows+2, err)
+		}
+		if titleCol >= len(record) || salaryCol >= len(record) {
+			continue
+		}
+
+		salary, err := strconv.ParseFloat(strings.TrimSpace(record[salaryCol]), 64)
+		if err != nil || salary < minSurveySalary || salary > maxSurveySalary {
+			continue
+		}
+		var location string
+		if hasLocation && locationCol < len(record) {
+			if name := strings.TrimSpace(record[locationCol]); name != "" {
+				location = normalizeLocation(name)
+				locations[location] = name
+			}
+		}
+
+		counted := false
+		for _, title := range strings.Split(record[titleCol], ";") {
+			title = strings.TrimSpace(title)
+			key := strings.ToLower(title)
+			if key == "" || key == "na" {
+				continue
+			}
+			role, ok := roles[key]
+			if !ok {
+				role = &salaryRole{name: title, words: titleWords(title), byLocation: make(map[string][]int)}
+				roles[key] = role
+			}
+			role.all = append(role.all, int(salary))
+			if location != "" {
+				role.byLocation[location] = append(role.byLocation[location], int(salary))
+			}
+			counted = true
+		}
+		if counted {
+			rows++
+		}
+	}
+
+	sorted := make([]*salaryRole, 0, len(roles))
+	for _, role := range roles {
+		sort.Ints(role.all)
+		for _, salaries := range role.byLocation {
+			sort.Ints(salaries)
+		}
+		sorted = append(sorted, role)
+	}
+	// Most answered roles first, so they win ties when matching titles
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i].all) > len(sorted[j].all) })
+
+	now := time.Now()
+	b.mu.Lock()
+	b.roles = sorted
+	b.locations = locations
+	b.rows = rows
+	b.importedAt = &now
+	b.mu.Unlock()
+
+	return len(sorted), nil
+}
+
+// Benchmark returns the market salaries for the survey role closest to a job
+// title, in the given location when it has enough samples and across all
+// locations otherwise
+func (b *SalaryBenchmarks) Benchmark(title, location string) (*domain.SalaryBenchmark, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	bench, _ := b.lookup(title, location)
+	return bench, bench != nil
+}
+
+// Compare places a posted salary range against the benchmark for a title and
+// location. Without a posted range only the benchmark is returned.
+func (b *SalaryBenchmarks) Compare(title, location string, salaryMin, salaryMax *int) (*domain.SalaryComparison, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	bench, salaries := b.lookup(title, location)
+	if bench == nil {
+		return nil, false
+	}
+	cmp := &domain.SalaryComparison{Benchmark: *bench, PostedMin: salaryMin, PostedMax: salaryMax}
+
+	var midpoint int
+	switch {
+	case salaryMin != nil && salaryMax != nil:
+		midpoint = (*salaryMin + *salaryMax) / 2
+	case salaryMin != nil:
+		midpoint = *salaryMin
+	case salaryMax != nil:
+		midpoint = *salaryMax
+	default:
+		return cmp, true
+	}
+
+	percentile := sort.SearchInts(salaries, midpoint) * 100 / len(salaries)
+	cmp.Percentile = &percentile
+	switch {
+	case midpoint < bench.P25:
+		cmp.Position = domain.SalaryBelowMarket
+	case midpoint > bench.P75:
+		cmp.Position = domain.SalaryAboveMarket
+	default:
+		cmp.Position = domain.SalaryAtMarket
+	}
+	return cmp, true
+}
+
+// CompareJob benchmarks a job's posted salary. Jobs paid in another currency
+// than the survey are not compared.
+func (b *SalaryBenchmarks) CompareJob(job *domain.Job) (*domain.SalaryComparison, bool) {
+	if job.SalaryCurrency != "" && !strings.EqualFold(job.SalaryCurrency, b.cfg.Currency) {
+		return nil, false
+	}
+	var location string
+	if job.Location != nil {
+		location = *job.Location
+	}
+	return b.Compare(job.Title, location, job.SalaryMin, job.SalaryMax)
+}
+
+// Stats describes the loaded survey
+func (b *SalaryBenchmarks) Stats() SalaryBenchmarkStats {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return SalaryBenchmarkStats{
+		Source:     b.cfg.Source,
+		Roles:      len(b.roles),
+		Locations:  len(b.locations),
+		Rows:       b.rows,
+		ImportedAt: b.importedAt,
+		MinSamples: b.cfg.MinSamples,
+	}
+}
+
+// matchRole returns the role sharing the most words with title; generic words
+// like "engineer" count for less. Callers hold the read lock.
+func (b *SalaryBenchmarks) matchRole(title string) *salaryRole {
+	words := make(map[string]bool)
+	for _, w := range titleWords(title) {
+		words[w] = true
+	}
+
+	var best *salaryRole
+	bestScore := 0.0
+	for _, role := range b.roles {
+		score := 0.0
+		for _, w := range role.words {
+			if !words[w] {
+				continue
+			}
+			if genericTitleWords[w] {
+				score += 0.5
+			} else {
+				score++
+			}
+		}
+		if score > bestScore {
+			best, bestScore = role, score
+		}
+	}
+	return best
+}
+
+// matchLocation finds the survey location named in a free-text location such
+// as "Berlin, Germany", trying the most general part first. Callers hold the
+// read lock.
+func (b *SalaryBenchmarks) matchLocation(location string) (string, bool) {
+	parts := strings.Split(location, ",")
+	for i := len(parts) - 1; i >= 0; i-- {
+		loc := normalizeLocation(parts[i])
+		if _, ok := b.locations[loc]; ok {
+			return loc, true
+		}
+	}
+	return "", false
+}
+
+// lookup returns the benchmark for a title and location with the sorted
+// salaries behind it, or nil when too few salaries were reported. Callers
+// hold the read lock.
+func (b *SalaryBenchmarks) lookup(title, location string) (*domain.SalaryBenchmark, []int) {
+	role := b.matchRole(title)
+	if role == nil {
+		return nil, nil
+	}
+	var name string
+	salaries := role.all
+	if loc, ok := b.matchLocation(location); ok && len(role.byLocation[loc]) >= b.cfg.MinSamples {
+		name, salaries = b.locations[loc], role.byLocation[loc]
+	}
+	if len(salaries) == 0 || len(salaries) < b.cfg.MinSamples {
+		return nil, nil
+	}
+
+	return &domain.SalaryBenchmark{
+		Title:    role.name,
+		Location: name,
+		Source:   b.cfg.Source,
+		Currency: b.cfg.Currency,
+		Samples:  len(salaries),
+		P10:      quantile(salaries, 0.10),
+		P25:      quantile(salaries, 0.25),
+		Median:   quantile(salaries, 0.50),
+		P75:      quantile(salaries, 0.75),
+		P90:      quantile(salaries, 0.90),
+	}, salaries
+}
+
+// quantile returns the q-th quantile of sorted values by linear interpolation
+func quantile(sorted []int, q float64) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := q * float64(len(sorted)-1)
+	lo := int(pos)
+	if lo+1 >= len(sorted) {
+		return sorted[lo]
+	}
+	frac := pos - float64(lo)
+	return sorted[lo] + int(frac*float64(sorted[lo+1]-sorted[lo]))
+}
+
+// titleWords splits a title into lowercase words, joining hyphenated words so
+// "back-end" matches "backend"
+func titleWords(title string) []string {
+	title = strings.ReplaceAll(strings.ToLower(title), "-", "")
+	fields := strings.FieldsFunc(title, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	words := fields[:0]
+	for _, w := range fields {
+		if !titleStopWords[w] {
+			words = append(words, w)
+		}
+	}
+	return words
+}
+
+// normalizeLocation lowercases a location and maps common aliases to the
+// survey's country names
+func normalizeLocation(location string) string {
+	loc := strings.ToLower(strings.TrimSpace(location))
+	if alias, ok := countryAliases[loc]; ok {
+		return alias
+	}
+	return loc
+}
@@ -0,0 +1,77 @@
+package favorites
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists favorites in the job_favorites table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed favorites store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Get returns a job's favorite, or nil
+func (p *PostgresStore) Get(ctx context.Context, jobID uuid.UUID) (*domain.Favorite, error) {
+	var f domain.Favorite
+	err := p.db.QueryRow(ctx, `SELECT job_id, note, created_at FROM job_favorites WHERE job_id = $1`, jobID).
+		Scan(&f.JobID, &f.Note, &f.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get favorite: %w", err)
+	}
+	return &f, nil
+}
+
+// Save inserts or replaces a favorite
+func (p *PostgresStore) Save(ctx context.Context, f *domain.Favorite) error {
+	_, err := p.db.Exec(ctx, `
+		INSERT INTO job_favorites (job_id, note, created_at) VALUES ($1, $2, $3)
+		ON CONFLICT (tenant_id, job_id) DO UPDATE SET note = EXCLUDED.note`,
+		f.JobID, f.Note, f.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save favorite: %w", err)
+	}
+	return nil
+}
+
+// Delete unstars a job
+func (p *PostgresStore) Delete(ctx context.Context, jobID uuid.UUID) (bool, error) {
+	tag, err := p.db.Exec(ctx, `DELETE FROM job_favorites WHERE job_id = $1`, jobID)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete favorite: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// List returns every favorite, most recently starred first
+func (p *PostgresStore) List(ctx context.Context) ([]domain.Favorite, error) {
+	rows, err := p.db.Query(ctx, `SELECT job_id, note, created_at FROM job_favorites ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list favorites: %w", err)
+	}
+	defer rows.Close()
+
+	favorites := []domain.Favorite{}
+	for rows.Next() {
+		var f domain.Favorite
+		if err := rows.Scan(&f.JobID, &f.Note, &f.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan favorite: %w", err)
+		}
+		favorites = append(favorites, f)
+	}
+	return favorites, rows.Err()
+}
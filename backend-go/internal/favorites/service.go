@@ -0,0 +1,161 @@
+package favorites
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// maxNoteLength bounds a favorite's note
+const maxNoteLength = 2000
+
+var (
+	// ErrJobNotFound is returned when starring a job that does not exist
+	ErrJobNotFound = errors.New("job not found")
+	// ErrNotFound is returned when the job is not starred
+	ErrNotFound = errors.New("favorite not found")
+	// ErrInvalid is returned for a malformed favorite
+	ErrInvalid = errors.New("invalid favorite")
+)
+
+// JobService reads jobs and creates applications
+type JobService interface {
+	GetJobDetails(ctx context.Context, jobID uuid.UUID) (*domain.Job, error)
+	CreateApplication(ctx context.Context, req domain.ApplicationCreate) (*domain.Application, error)
+}
+
+// Service stars jobs and promotes starred jobs to applications
+type Service struct {
+	store Store
+	jobs  JobService
+}
+
+// NewService creates a favorites service
+func NewService(store Store, jobs JobService) *Service {
+	return &Service{store: store, jobs: jobs}
+}
+
+// Star favorites a job. Starring an already starred job replaces its note.
+func (s *Service) Star(ctx context.Context, jobID uuid.UUID, in domain.FavoriteInput) (*domain.Favorite, error) {
+	note := strings.TrimSpace(in.Note)
+	if len(note) > maxNoteLength {
+		return nil, fmt.Errorf("%w: note is longer than %d characters", ErrInvalid, maxNoteLength)
+	}
+	if _, err := s.jobs.GetJobDetails(ctx, jobID); err != nil {
+		return nil, ErrJobNotFound
+	}
+
+	f, err := s.store.Get(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		f = &domain.Favorite{JobID: jobID, CreatedAt: time.Now()}
+	}
+	f.Note = note
+	if err := s.store.Save(ctx, f); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// Unstar removes a job from the favorites
+func (s *Service) Unstar(ctx context.Context, jobID uuid.UUID) error {
+	removed, err := s.store.Delete(ctx, jobID)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// List returns a page of favorites with their jobs
+func (s *Service) List(ctx context.Context, limit, offset int) (*domain.FavoriteList, error) {
+	all, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &domain.FavoriteList{Favorites: []domain.FavoriteJob{}, Total: len(all)}
+	if offset >= len(all) {
+		return list, nil
+	}
+	page := all[offset:min(offset+limit, len(all))]
+	for _, f := range page {
+		entry := domain.FavoriteJob{Favorite: f}
+		// A job removed since it was starred is listed without details
+		if job, err := s.jobs.GetJobDetails(ctx, f.JobID); err == nil {
+			entry.Job = job
+		}
+		list.Favorites = append(list.Favorites, entry)
+	}
+	return list, nil
+}
+
+// IDs returns the IDs of every starred job
+func (s *Service) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	all, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uuid.UUID, len(all))
+	for i, f := range all {
+		ids[i] = f.JobID
+	}
+	return ids, nil
+}
+
+// Promote creates an application for a starred job, carrying the favorite's
+// note over, and removes the star
+func (s *Service) Promote(ctx context.Context, jobID uuid.UUID, req domain.FavoritePromotion) (*domain.Application, error) {
+	f, err := s.store.Get(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return nil, ErrNotFound
+	}
+
+	status := domain.ApplicationStatusSaved
+	if req.Status != nil {
+		status = *req.Status
+	}
+	create := domain.ApplicationCreate{JobID: jobID, Status: &status, ReminderDate: req.ReminderDate}
+	if notes := joinNotes(f.Note, req.Notes); notes != "" {
+		create.Notes = &notes
+	}
+
+	app, err := s.jobs.CreateApplication(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	// The application exists either way; a leftover star is harmless
+	if _, err := s.store.Delete(ctx, jobID); err != nil {
+		logger.Warn("Failed to remove promoted favorite", zap.String("job_id", jobID.String()), zap.Error(err))
+	}
+	return app, nil
+}
+
+// joinNotes puts the favorite's note ahead of any notes given on promotion
+func joinNotes(note string, extra *string) string {
+	parts := make([]string, 0, 2)
+	if note != "" {
+		parts = append(parts, note)
+	}
+	if extra != nil {
+		if e := strings.TrimSpace(*extra); e != "" {
+			parts = append(parts, e)
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
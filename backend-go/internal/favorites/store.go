@@ -0,0 +1,74 @@
+package favorites
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Store persists favorites
+type Store interface {
+	// Get returns a job's favorite, or nil when the job is not starred
+	Get(ctx context.Context, jobID uuid.UUID) (*domain.Favorite, error)
+	Save(ctx context.Context, f *domain.Favorite) error
+	// Delete unstars a job, reporting whether it was starred
+	Delete(ctx context.Context, jobID uuid.UUID) (bool, error)
+	// List returns every favorite, most recently starred first
+	List(ctx context.Context) ([]domain.Favorite, error)
+}
+
+// MemoryStore keeps favorites in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	favorites map[uuid.UUID]domain.Favorite
+}
+
+// NewMemoryStore creates an in-memory favorites store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{favorites: make(map[uuid.UUID]domain.Favorite)}
+}
+
+// Get returns a job's favorite, or nil
+func (m *MemoryStore) Get(ctx context.Context, jobID uuid.UUID) (*domain.Favorite, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	f, ok := m.favorites[jobID]
+	if !ok {
+		return nil, nil
+	}
+	return &f, nil
+}
+
+// Save inserts or replaces a favorite
+func (m *MemoryStore) Save(ctx context.Context, f *domain.Favorite) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.favorites[f.JobID] = *f
+	return nil
+}
+
+// Delete unstars a job
+func (m *MemoryStore) Delete(ctx context.Context, jobID uuid.UUID) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.favorites[jobID]
+	delete(m.favorites, jobID)
+	return ok, nil
+}
+
+// List returns every favorite, most recently starred first
+func (m *MemoryStore) List(ctx context.Context) ([]domain.Favorite, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make([]domain.Favorite, 0, len(m.favorites))
+	for _, f := range m.favorites {
+		out = append(out, f)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].CreatedAt.After(out[j].CreatedAt) })
+	return out, nil
+}
@@ -0,0 +1,108 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+const (
+	// DefaultWindow is how far back a feed reaches when no since is given
+	DefaultWindow = 24 * time.Hour
+	// MaxLimit caps the jobs in one feed page
+	MaxLimit = 200
+)
+
+// Source searches the job corpus; JobListService satisfies it
+type Source interface {
+	Search(ctx context.Context, req domain.JobSearchRequest) (*domain.JobSearchResponse, error)
+}
+
+// Builder compiles incremental feeds of newly seen jobs
+type Builder struct {
+	source Source
+}
+
+// NewBuilder creates a feed builder
+func NewBuilder(source Source) *Builder {
+	return &Builder{source: source}
+}
+
+// Build returns up to limit jobs first seen after since. Jobs are taken in
+// the order they were first seen, so a truncated page can be continued from
+// NextSince, then ordered by match score for display.
+func (b *Builder) Build(ctx context.Context, since time.Time, limit int) (*domain.JobFeed, error) {
+	// One extra job tells whether more are waiting
+	resp, err := b.source.Search(ctx, domain.JobSearchRequest{
+		Filters:            &domain.JobFilters{SeenAfter: &since},
+		IncludeMatchScores: true,
+		Page:               1,
+		Limit:              limit + 1,
+		SortBy:             "first_seen",
+		SortOrder:          "asc",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load new jobs: %w", err)
+	}
+
+	jobs := make([]domain.JobBrief, 0, len(resp.Jobs))
+	for _, job := range resp.Jobs {
+		if seen := firstSeen(job); seen != nil && seen.After(since) {
+			jobs = append(jobs, job)
+		}
+	}
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return firstSeen(jobs[i]).Before(*firstSeen(jobs[j]))
+	})
+
+	feed := &domain.JobFeed{Since: since, NextSince: since, GeneratedAt: time.Now()}
+	if len(jobs) > limit {
+		feed.HasMore = true
+		jobs = jobs[:cutoff(jobs, limit)]
+	}
+	if len(jobs) > 0 {
+		feed.NextSince = *firstSeen(jobs[len(jobs)-1])
+	}
+
+	sort.SliceStable(jobs, func(i, j int) bool {
+		return score(jobs[i]) > score(jobs[j])
+	})
+	feed.Jobs = jobs
+	return feed, nil
+}
+
+// cutoff returns how many of the oldest jobs fit a page of limit without
+// splitting jobs first seen at the same instant, which NextSince could not
+// tell apart. When every job fetched shares that instant there is no clean
+// cut, and they are all returned.
+func cutoff(jobs []domain.JobBrief, limit int) int {
+	boundary := *firstSeen(jobs[limit])
+	n := limit
+	for n > 0 && firstSeen(jobs[n-1]).Equal(boundary) {
+		n--
+	}
+	if n == 0 {
+		for n < len(jobs) && firstSeen(jobs[n]).Equal(boundary) {
+			n++
+		}
+	}
+	return n
+}
+
+// firstSeen is when the job entered the corpus, falling back to its posting date
+func firstSeen(job domain.JobBrief) *time.Time {
+	if job.FirstSeenAt != nil {
+		return job.FirstSeenAt
+	}
+	return job.PostedDate
+}
+
+func score(job domain.JobBrief) float64 {
+	if job.MatchScore == nil {
+		return -1
+	}
+	return *job.MatchScore
+}
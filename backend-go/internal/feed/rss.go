@@ -0,0 +1,187 @@
+package feed
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Channel is a list of jobs to publish as a feed
+type Channel struct {
+	Title       string
+	Description string
+	Link        string // the feed's own URL
+	Updated     time.Time
+	Jobs        []domain.JobBrief
+}
+
+// NewJobsChannel is the channel for a new-jobs feed
+func NewJobsChannel(f *domain.JobFeed, baseURL string) Channel {
+	return Channel{
+		Title:       "New jobs",
+		Description: "Jobs first seen since " + f.Since.UTC().Format(time.RFC3339) + ", best matches first",
+		Link:        strings.TrimSuffix(baseURL, "/") + "/api/job-list/feed?format=rss",
+		Updated:     f.GeneratedAt,
+		Jobs:        f.Jobs,
+	}
+}
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate,omitempty"`
+	Category    string  `xml:"category,omitempty"`
+	Description string  `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+// RenderRSS renders a channel as RSS 2.0. Items link to the job's API
+// resource under baseURL and use the job ID as their GUID, so readers
+// deduplicate jobs across fetches.
+func RenderRSS(ch Channel, baseURL string) ([]byte, error) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	doc := rss{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         ch.Title,
+			Link:          ch.Link,
+			Description:   ch.Description,
+			LastBuildDate: ch.Updated.UTC().Format(time.RFC1123Z),
+			Items:         make([]rssItem, 0, len(ch.Jobs)),
+		},
+	}
+
+	for _, job := range ch.Jobs {
+		item := rssItem{
+			Title:       job.Title + " at " + job.CompanyName,
+			Link:        jobLink(baseURL, job),
+			GUID:        rssGUID{Value: job.ID.String()},
+			Category:    string(job.Source),
+			Description: describe(job),
+		}
+		if seen := firstSeen(job); seen != nil {
+			item.PubDate = seen.UTC().Format(time.RFC1123Z)
+		}
+		doc.Channel.Items = append(doc.Channel.Items, item)
+	}
+	return marshal(doc)
+}
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Link     atomLink    `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID       string     `xml:"id"`
+	Title    string     `xml:"title"`
+	Updated  string     `xml:"updated"`
+	Link     atomLink   `xml:"link"`
+	Category *atomTerm  `xml:"category,omitempty"`
+	Summary  string     `xml:"summary"`
+	Author   atomAuthor `xml:"author"`
+}
+
+type atomTerm struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// RenderAtom renders a channel as Atom 1.0, with the same links and stable
+// job IDs as RenderRSS
+func RenderAtom(ch Channel, baseURL string) ([]byte, error) {
+	baseURL = strings.TrimSuffix(baseURL, "/")
+	doc := atomFeed{
+		ID:       ch.Link,
+		Title:    ch.Title,
+		Subtitle: ch.Description,
+		Updated:  ch.Updated.UTC().Format(time.RFC3339),
+		Link:     atomLink{Rel: "self", Href: ch.Link},
+		Entries:  make([]atomEntry, 0, len(ch.Jobs)),
+	}
+
+	for _, job := range ch.Jobs {
+		updated := ch.Updated
+		if seen := firstSeen(job); seen != nil {
+			updated = *seen
+		}
+		entry := atomEntry{
+			ID:      "urn:uuid:" + job.ID.String(),
+			Title:   job.Title + " at " + job.CompanyName,
+			Updated: updated.UTC().Format(time.RFC3339),
+			Link:    atomLink{Rel: "alternate", Href: jobLink(baseURL, job)},
+			Summary: describe(job),
+			Author:  atomAuthor{Name: job.CompanyName},
+		}
+		if job.Source != "" {
+			entry.Category = &atomTerm{Term: string(job.Source)}
+		}
+		doc.Entries = append(doc.Entries, entry)
+	}
+	return marshal(doc)
+}
+
+func marshal(doc interface{}) ([]byte, error) {
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(out, '\n')...), nil
+}
+
+func jobLink(baseURL string, job domain.JobBrief) string {
+	return baseURL + "/api/job-list/jobs/" + job.ID.String()
+}
+
+// describe summarizes a job in one line
+func describe(job domain.JobBrief) string {
+	parts := []string{job.CompanyName}
+	if job.Location != nil && *job.Location != "" {
+		parts = append(parts, *job.Location)
+	}
+	if job.LocationType != nil {
+		parts = append(parts, string(*job.LocationType))
+	}
+	if job.SalaryText != nil && *job.SalaryText != "" {
+		parts = append(parts, *job.SalaryText)
+	}
+	if job.MatchScore != nil {
+		parts = append(parts, fmt.Sprintf("%.0f%% match", *job.MatchScore))
+	}
+	return strings.Join(parts, " · ")
+}
@@ -0,0 +1,45 @@
+package feed
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// Signer issues and checks the tokens that let feed readers fetch a saved
+// search's feed without logging in. Tokens are derived from the search ID and
+// tenant, so they need no storage and stop working when the search is deleted.
+type Signer struct {
+	key []byte
+}
+
+// NewSigner creates a token signer. An empty key uses a random per-process
+// key, so feed URLs stop working after a restart.
+func NewSigner(key string) (*Signer, error) {
+	k := []byte(key)
+	if len(k) == 0 {
+		k = make([]byte, 32)
+		if _, err := rand.Read(k); err != nil {
+			return nil, err
+		}
+	}
+	return &Signer{key: k}, nil
+}
+
+// Token returns the feed token for a saved search
+func (s *Signer) Token(ctx context.Context, searchID uuid.UUID) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte("saved-search\n" + tenant.ID(ctx) + "\n" + searchID.String()))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil)[:24])
+}
+
+// Verify checks a saved search's feed token in constant time
+func (s *Signer) Verify(ctx context.Context, searchID uuid.UUID, token string) bool {
+	return hmac.Equal([]byte(token), []byte(s.Token(ctx, searchID)))
+}
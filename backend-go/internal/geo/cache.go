@@ -0,0 +1,47 @@
+package geo
+
+import (
+	"context"
+	"errors"
+
+	"github.com/resume-rag/backend/internal/cache"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// CachedGeocoder memoizes lookups, including misses, to stay within provider rate limits
+type CachedGeocoder struct {
+	next  Geocoder
+	cache *cache.LRU
+}
+
+// NewCachedGeocoder wraps a geocoder with a cache
+func NewCachedGeocoder(next Geocoder, c *cache.LRU) *CachedGeocoder {
+	return &CachedGeocoder{next: next, cache: c}
+}
+
+// Geocode returns the cached result or resolves and caches it
+func (g *CachedGeocoder) Geocode(ctx context.Context, location string) (*domain.GeoLocation, error) {
+	key := "geo:" + normalizeQuery(location)
+
+	if v, ok := g.cache.Get(key); ok {
+		loc, _ := v.(*domain.GeoLocation)
+		if loc == nil {
+			return nil, ErrNotFound
+		}
+		copied := *loc
+		return &copied, nil
+	}
+
+	loc, err := g.next.Geocode(ctx, location)
+	if err != nil {
+		// Only remember definite misses; transient failures should be retried
+		if errors.Is(err, ErrNotFound) {
+			g.cache.Set(key, (*domain.GeoLocation)(nil))
+		}
+		return nil, err
+	}
+
+	g.cache.Set(key, loc)
+	copied := *loc
+	return &copied, nil
+}
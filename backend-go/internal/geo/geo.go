@@ -0,0 +1,117 @@
+package geo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/cache"
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+var (
+	// ErrNotFound is returned when a location cannot be geocoded
+	ErrNotFound = errors.New("location not found")
+	// ErrDisabled is returned for lookups that need geocoding while it is disabled
+	ErrDisabled = errors.New("geocoding is not enabled")
+)
+
+// EarthRadiusKm is the mean Earth radius used for distance calculations
+const EarthRadiusKm = 6371.0
+
+// kmPerDegreeLat is the length of one degree of latitude
+const kmPerDegreeLat = EarthRadiusKm * math.Pi / 180
+
+// Geocoder resolves free-text locations to coordinates
+type Geocoder interface {
+	Geocode(ctx context.Context, location string) (*domain.GeoLocation, error)
+}
+
+// New creates the geocoder selected by configuration, wrapped in a cache.
+// Returns nil when geocoding is disabled.
+func New(cfg config.GeoConfig) (Geocoder, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	var g Geocoder
+	switch cfg.Provider {
+	case "", "nominatim":
+		g = NewNominatimGeocoder(cfg)
+	default:
+		return nil, fmt.Errorf("unknown geocoding provider: %s", cfg.Provider)
+	}
+
+	return NewCachedGeocoder(g, cache.New(config.CacheConfig{
+		Enabled: true,
+		TTL:     cfg.CacheTTL,
+		MaxSize: cfg.CacheSize,
+	})), nil
+}
+
+// DistanceKm returns the great-circle distance between two points
+func DistanceKm(a, b domain.GeoLocation) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// BoundingBox returns the latitude and longitude ranges enclosing the circle
+// of radiusKm around center, for narrowing candidates before DistanceKm.
+// When the circle crosses the antimeridian or takes in a pole, the longitude
+// range spans the globe.
+func BoundingBox(center domain.GeoLocation, radiusKm float64) (minLat, maxLat, minLon, maxLon float64) {
+	dLat := radiusKm / kmPerDegreeLat
+	minLat, maxLat = center.Latitude-dLat, center.Latitude+dLat
+	if minLat <= -90 || maxLat >= 90 {
+		return math.Max(minLat, -90), math.Min(maxLat, 90), -180, 180
+	}
+	dLon := dLat / math.Cos(center.Latitude*math.Pi/180)
+	minLon, maxLon = center.Longitude-dLon, center.Longitude+dLon
+	if minLon < -180 || maxLon > 180 {
+		return minLat, maxLat, -180, 180
+	}
+	return minLat, maxLat, minLon, maxLon
+}
+
+// GeocodeJobs fills in Job.Geo for freshly ingested jobs. Jobs without a
+// location, remote-only jobs and lookup failures are left untouched.
+func GeocodeJobs(ctx context.Context, g Geocoder, jobs []*domain.Job) {
+	if g == nil {
+		return
+	}
+	for _, job := range jobs {
+		if job.Geo != nil || job.Location == nil || !Geocodable(*job.Location) {
+			continue
+		}
+		loc, err := g.Geocode(ctx, *job.Location)
+		if err != nil {
+			continue
+		}
+		job.Geo = loc
+	}
+}
+
+// Geocodable reports whether a free-text location names a real place
+func Geocodable(location string) bool {
+	switch normalizeQuery(location) {
+	case "", "remote", "anywhere", "worldwide", "remote us", "remote usa", "united states remote":
+		return false
+	}
+	return true
+}
+
+// normalizeQuery lowercases and trims noise so equivalent locations share a cache entry
+func normalizeQuery(location string) string {
+	s := strings.ToLower(strings.TrimSpace(location))
+	s = strings.NewReplacer("(", " ", ")", " ", "-", " ", ",", " ").Replace(s)
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,130 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// nominatimMinInterval is the public Nominatim usage-policy limit of one request per second
+const nominatimMinInterval = time.Second
+
+// NominatimGeocoder geocodes via an OpenStreetMap Nominatim server
+type NominatimGeocoder struct {
+	baseURL   string
+	userAgent string
+	client    *http.Client
+
+	mu       sync.Mutex
+	lastCall time.Time
+}
+
+type nominatimResult struct {
+	Lat     string `json:"lat"`
+	Lon     string `json:"lon"`
+	Address struct {
+		City        string `json:"city"`
+		Town        string `json:"town"`
+		Village     string `json:"village"`
+		State       string `json:"state"`
+		Country     string `json:"country"`
+		CountryCode string `json:"country_code"`
+	} `json:"address"`
+}
+
+// NewNominatimGeocoder creates a Nominatim geocoder
+func NewNominatimGeocoder(cfg config.GeoConfig) *NominatimGeocoder {
+	return &NominatimGeocoder{
+		baseURL:   strings.TrimRight(cfg.BaseURL, "/"),
+		userAgent: cfg.UserAgent,
+		client:    &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Geocode resolves a location to its best match
+func (g *NominatimGeocoder) Geocode(ctx context.Context, location string) (*domain.GeoLocation, error) {
+	if err := g.throttle(ctx); err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("q", location)
+	params.Set("format", "jsonv2")
+	params.Set("addressdetails", "1")
+	params.Set("limit", "1")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, g.baseURL+"/search?"+params.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", g.userAgent)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("geocode request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("geocode request failed: status %d", resp.StatusCode)
+	}
+
+	var results []nominatimResult
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, fmt.Errorf("failed to decode geocode response: %w", err)
+	}
+	if len(results) == 0 {
+		return nil, ErrNotFound
+	}
+
+	r := results[0]
+	lat, err := strconv.ParseFloat(r.Lat, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid latitude: %w", err)
+	}
+	lon, err := strconv.ParseFloat(r.Lon, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid longitude: %w", err)
+	}
+
+	city := r.Address.City
+	if city == "" {
+		city = r.Address.Town
+	}
+	if city == "" {
+		city = r.Address.Village
+	}
+
+	return &domain.GeoLocation{
+		City:      city,
+		State:     r.Address.State,
+		Country:   strings.ToUpper(r.Address.CountryCode),
+		Latitude:  lat,
+		Longitude: lon,
+	}, nil
+}
+
+// throttle spaces out requests to respect the provider's rate limit
+func (g *NominatimGeocoder) throttle(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if wait := nominatimMinInterval - time.Since(g.lastCall); wait > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+	g.lastCall = time.Now()
+	return nil
+}
@@ -0,0 +1,69 @@
+package goals
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists goals in the goals table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed goal store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// List returns every goal, oldest first
+func (p *PostgresStore) List(ctx context.Context) ([]domain.Goal, error) {
+	rows, err := p.db.Query(ctx, `SELECT id, metric, target, period, created_at FROM goals ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list goals: %w", err)
+	}
+	defer rows.Close()
+
+	list := []domain.Goal{}
+	for rows.Next() {
+		var g domain.Goal
+		if err := rows.Scan(&g.ID, &g.Metric, &g.Target, &g.Period, &g.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan goal: %w", err)
+		}
+		list = append(list, g)
+	}
+	return list, rows.Err()
+}
+
+// Create adds a goal
+func (p *PostgresStore) Create(ctx context.Context, g *domain.Goal) error {
+	tag, err := p.db.Exec(ctx, `
+		INSERT INTO goals (id, metric, target, period, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (tenant_id, metric, period) DO NOTHING`,
+		g.ID, string(g.Metric), g.Target, string(g.Period), g.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save goal: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return errExists
+	}
+	return nil
+}
+
+// Delete removes a goal
+func (p *PostgresStore) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := p.db.Exec(ctx, `DELETE FROM goals WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete goal: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
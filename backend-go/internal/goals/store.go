@@ -0,0 +1,69 @@
+package goals
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// errExists is returned by a store for a second goal on the same metric and period
+var errExists = errors.New("goal already exists")
+
+// Store persists goals
+type Store interface {
+	// List returns every goal, oldest first
+	List(ctx context.Context) ([]domain.Goal, error)
+	// Create adds a goal, failing with errExists when one already covers its
+	// metric and period
+	Create(ctx context.Context, g *domain.Goal) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// MemoryStore keeps goals in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	goals []domain.Goal
+}
+
+// NewMemoryStore creates an in-memory goal store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// List returns every goal, oldest first
+func (m *MemoryStore) List(ctx context.Context) ([]domain.Goal, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return append([]domain.Goal{}, m.goals...), nil
+}
+
+// Create adds a goal
+func (m *MemoryStore) Create(ctx context.Context, g *domain.Goal) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.goals {
+		if existing.Metric == g.Metric && existing.Period == g.Period {
+			return errExists
+		}
+	}
+	m.goals = append(m.goals, *g)
+	return nil
+}
+
+// Delete removes a goal
+func (m *MemoryStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for i, g := range m.goals {
+		if g.ID == id {
+			m.goals = append(m.goals[:i:i], m.goals[i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
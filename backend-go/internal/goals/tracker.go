@@ -0,0 +1,351 @@
+package goals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/practice"
+)
+
+const (
+	// historyPeriods is how many periods of history are returned per goal
+	historyPeriods = 8
+	// applicationPageSize is how many applications are loaded per request
+	applicationPageSize = 100
+	// maxTarget caps a goal's target
+	maxTarget = 1000
+)
+
+var (
+	// ErrNotFound is returned when a goal does not exist
+	ErrNotFound = errors.New("goal not found")
+	// ErrInvalid is returned for a malformed goal
+	ErrInvalid = errors.New("invalid goal")
+)
+
+// ApplicationService lists tracked applications
+type ApplicationService interface {
+	GetApplications(ctx context.Context, status *domain.ApplicationStatus, limit, offset int) (*domain.ApplicationListResponse, error)
+}
+
+// PracticeActivity reports practice answers recorded per day
+type PracticeActivity interface {
+	PracticeDays(ctx context.Context, since time.Time) (map[string]int, error)
+}
+
+// Tracker keeps job search goals and measures progress against them from
+// application and practice activity
+type Tracker struct {
+	apps     ApplicationService
+	practice PracticeActivity
+	store    Store
+}
+
+// NewTracker creates a goal tracker. practice may be nil, in which case
+// practice goals never progress.
+func NewTracker(apps ApplicationService, practice PracticeActivity, store Store) *Tracker {
+	return &Tracker{apps: apps, practice: practice, store: store}
+}
+
+// List returns every goal, oldest first
+func (t *Tracker) List(ctx context.Context) ([]domain.Goal, error) {
+	return t.store.List(ctx)
+}
+
+// Create adds a goal. There is at most one goal per metric and period.
+func (t *Tracker) Create(ctx context.Context, in domain.GoalInput) (*domain.Goal, error) {
+	if in.Period == "" {
+		in.Period = domain.GoalWeekly
+	}
+	switch {
+	case !in.Metric.Valid():
+		return nil, fmt.Errorf("%w: unknown metric %q", ErrInvalid, in.Metric)
+	case in.Period != domain.GoalDaily && in.Period != domain.GoalWeekly:
+		return nil, fmt.Errorf("%w: period must be day or week", ErrInvalid)
+	case in.Target < 1 || in.Target > maxTarget:
+		return nil, fmt.Errorf("%w: target must be between 1 and %d", ErrInvalid, maxTarget)
+	}
+
+	goal := domain.Goal{
+		ID:        uuid.New(),
+		Metric:    in.Metric,
+		Target:    in.Target,
+		Period:    in.Period,
+		CreatedAt: time.Now(),
+	}
+	err := t.store.Create(ctx, &goal)
+	if errors.Is(err, errExists) {
+		return nil, fmt.Errorf("%w: a %s %s goal already exists", ErrInvalid, periodAdjective(goal.Period), goal.Metric)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &goal, nil
+}
+
+// Delete removes a goal
+func (t *Tracker) Delete(ctx context.Context, id uuid.UUID) error {
+	return t.store.Delete(ctx, id)
+}
+
+// Progress measures every goal as of now, computes streaks over the past
+// year of activity and suggests nudges
+func (t *Tracker) Progress(ctx context.Context, now time.Time) (*domain.GoalSummary, error) {
+	since := periodStart(now, domain.GoalWeekly).AddDate(-1, 0, 0)
+	act, err := t.activity(ctx, since, now.Location())
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &domain.GoalSummary{
+		Goals:       []domain.GoalProgress{},
+		GeneratedAt: now,
+	}
+	goals, err := t.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, g := range goals {
+		summary.Goals = append(summary.Goals, progress(g, act, since, now))
+	}
+	summary.ActivityStreak, summary.ActiveToday = act.streak(now)
+	summary.Nudges = nudges(summary, now)
+	return summary, nil
+}
+
+// activity counts each metric per day (practice.DayLayout) since since
+type activity map[domain.GoalMetric]map[string]int
+
+func (a activity) add(metric domain.GoalMetric, day string, n int) {
+	if a[metric] == nil {
+		a[metric] = make(map[string]int)
+	}
+	a[metric][day] += n
+}
+
+// count sums a metric over [start, end)
+func (a activity) count(metric domain.GoalMetric, start, end time.Time) int {
+	total := 0
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		total += a[metric][d.Format(practice.DayLayout)]
+	}
+	return total
+}
+
+// streak returns the consecutive days with any activity, ending today or,
+// when nothing has happened yet today, yesterday
+func (a activity) streak(now time.Time) (days int, activeToday bool) {
+	active := func(d time.Time) bool {
+		key := d.Format(practice.DayLayout)
+		for _, days := range a {
+			if days[key] > 0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	day := periodStart(now, domain.GoalDaily)
+	activeToday = active(day)
+	if !activeToday {
+		day = day.AddDate(0, 0, -1)
+	}
+	for days < 366 && active(day) {
+		days++
+		day = day.AddDate(0, 0, -1)
+	}
+	return days, activeToday
+}
+
+// activity gathers application and practice activity since since
+func (t *Tracker) activity(ctx context.Context, since time.Time, loc *time.Location) (activity, error) {
+	act := make(activity)
+	day := func(ts time.Time) string { return ts.In(loc).Format(practice.DayLayout) }
+
+	for offset := 0; ; offset += applicationPageSize {
+		page, err := t.apps.GetApplications(ctx, nil, applicationPageSize, offset)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range page.Applications {
+			if !app.CreatedAt.Before(since) {
+				act.add(domain.GoalSavedJobs, day(app.CreatedAt), 1)
+			}
+			if applied := appliedAt(app); applied != nil && !applied.Before(since) {
+				act.add(domain.GoalApplications, day(*applied), 1)
+			}
+		}
+		if len(page.Applications) < applicationPageSize {
+			break
+		}
+	}
+
+	if t.practice != nil {
+		days, err := t.practice.PracticeDays(ctx, since)
+		if err != nil {
+			return nil, err
+		}
+		for d, n := range days {
+			if n > 0 {
+				act.add(domain.GoalPracticeAnswers, d, n)
+				act.add(domain.GoalPracticeSessions, d, 1)
+			}
+		}
+	}
+	return act, nil
+}
+
+// appliedAt returns when an application was submitted, from its applied
+// date or else the first move to applied in its timeline
+func appliedAt(app domain.Application) *time.Time {
+	if app.AppliedDate != nil {
+		return app.AppliedDate
+	}
+	for _, entry := range app.Timeline {
+		if entry.NewStatus == domain.ApplicationStatusApplied {
+			at := entry.ChangedAt
+			return &at
+		}
+	}
+	return nil
+}
+
+// progress measures one goal. A period still in progress does not break
+// the streak until it ends unmet.
+func progress(g domain.Goal, act activity, since, now time.Time) domain.GoalProgress {
+	start := periodStart(now, g.Period)
+	end := nextPeriod(start, g.Period)
+
+	p := domain.GoalProgress{
+		Goal:      g,
+		Current:   act.count(g.Metric, start, end),
+		PeriodEnd: end,
+		History:   []domain.GoalPeriodCount{},
+	}
+	p.Met = p.Current >= g.Target
+	p.Remaining = max(g.Target-p.Current, 0)
+	p.Percent = math.Min(100, math.Round(float64(p.Current)/float64(g.Target)*1000)/10)
+
+	// Walk back from the current period to the start of the window
+	run, counting := 0, true
+	for s, i := start, 0; !s.Before(since); s, i = prevPeriod(s, g.Period), i+1 {
+		n := act.count(g.Metric, s, nextPeriod(s, g.Period))
+		met := n >= g.Target
+		if i < historyPeriods {
+			p.History = append([]domain.GoalPeriodCount{{Start: s, Count: n, Met: met}}, p.History...)
+		}
+
+		switch {
+		case met:
+			run++
+		case i == 0:
+			// The current period can still be met
+			continue
+		default:
+			if counting {
+				p.Streak = run
+				counting = false
+			}
+			run = 0
+		}
+		p.BestStreak = max(p.BestStreak, run)
+	}
+	if counting {
+		p.Streak = run
+	}
+	return p
+}
+
+// nudges suggests what to do next
+func nudges(s *domain.GoalSummary, now time.Time) []string {
+	list := []string{}
+	if len(s.Goals) == 0 {
+		list = append(list, "Set a goal, like 10 applications a week, to track your progress.")
+	}
+
+	for _, g := range s.Goals {
+		when := "this week"
+		if g.Period == domain.GoalDaily {
+			when = "today"
+		}
+		if g.Met {
+			list = append(list, fmt.Sprintf("You hit your goal of %s %s. Nice work!", quantity(g.Target, g.Metric), when))
+			continue
+		}
+
+		nouns := metricNouns[g.Metric]
+		msg := fmt.Sprintf("%d more %s to reach your goal of %s %s", g.Remaining, plural(g.Remaining, nouns[0], nouns[1]), quantity(g.Target, g.Metric), when)
+		if g.Period == domain.GoalWeekly {
+			days := int(math.Ceil(g.PeriodEnd.Sub(now).Hours() / 24))
+			msg += fmt.Sprintf(" (%d %s left)", days, plural(days, "day", "days"))
+		}
+		if g.Streak >= 2 {
+			msg += fmt.Sprintf(" and keep your %d-%s streak going", g.Streak, g.Period)
+		}
+		list = append(list, msg+".")
+	}
+
+	switch {
+	case s.ActivityStreak > 0 && !s.ActiveToday:
+		list = append(list, fmt.Sprintf("Apply to a job or practice a question today to keep your %d-day streak alive.", s.ActivityStreak))
+	case s.ActivityStreak >= 3:
+		list = append(list, fmt.Sprintf("%d-day activity streak. Keep it up!", s.ActivityStreak))
+	}
+	return list
+}
+
+// periodStart returns midnight at the start of t's day or week (Monday)
+func periodStart(t time.Time, period domain.GoalPeriod) time.Time {
+	if period == domain.GoalWeekly {
+		t = t.AddDate(0, 0, -((int(t.Weekday()) + 6) % 7))
+	}
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
+
+func nextPeriod(start time.Time, period domain.GoalPeriod) time.Time {
+	if period == domain.GoalWeekly {
+		return start.AddDate(0, 0, 7)
+	}
+	return start.AddDate(0, 0, 1)
+}
+
+func prevPeriod(start time.Time, period domain.GoalPeriod) time.Time {
+	if period == domain.GoalWeekly {
+		return start.AddDate(0, 0, -7)
+	}
+	return start.AddDate(0, 0, -1)
+}
+
+func periodAdjective(period domain.GoalPeriod) string {
+	if period == domain.GoalDaily {
+		return "daily"
+	}
+	return "weekly"
+}
+
+// metricNouns are the singular and plural names of each metric
+var metricNouns = map[domain.GoalMetric][2]string{
+	domain.GoalApplications:     {"application", "applications"},
+	domain.GoalSavedJobs:        {"saved job", "saved jobs"},
+	domain.GoalPracticeSessions: {"practice session", "practice sessions"},
+	domain.GoalPracticeAnswers:  {"practice answer", "practice answers"},
+}
+
+func quantity(n int, metric domain.GoalMetric) string {
+	nouns := metricNouns[metric]
+	return fmt.Sprintf("%d %s", n, plural(n, nouns[0], nouns[1]))
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
@@ -0,0 +1,106 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// anthropicProvider calls the Anthropic messages endpoint
+type anthropicProvider struct {
+	key   string
+	model string
+	http  *http.Client
+}
+
+// Complete sends req, streaming the reply to onText when it is set
+func (p *anthropicProvider) Complete(ctx context.Context, req Request, onText func(string) error) (*Completion, error) {
+	key, model := p.key, p.model
+	body := map[string]interface{}{
+		"model":       model,
+		"messages":    req.Messages,
+		"max_tokens":  req.MaxTokens,
+		"temperature": *req.Temperature,
+	}
+	if req.System != "" {
+		body["system"] = req.System
+	}
+	headers := map[string]string{"x-api-key": key, "anthropic-version": anthropicVersion}
+	type usage struct {
+		InputTokens  int `json:"input_tokens"`
+		OutputTokens int `json:"output_tokens"`
+	}
+
+	if onText != nil {
+		body["stream"] = true
+		completion := &Completion{Model: model}
+		var text strings.Builder
+		err := stream(ctx, p.http, anthropicURL, headers, body, func(data []byte) error {
+			var event struct {
+				Type    string `json:"type"`
+				Message struct {
+					Model string `json:"model"`
+					Usage usage  `json:"usage"`
+				} `json:"message"`
+				Delta struct {
+					Type string `json:"type"`
+					Text string `json:"text"`
+				} `json:"delta"`
+				Usage usage `json:"usage"`
+				Error struct {
+					Message string `json:"message"`
+				} `json:"error"`
+			}
+			if err := json.Unmarshal(data, &event); err != nil {
+				return err
+			}
+			switch event.Type {
+			case "message_start":
+				completion.Model = event.Message.Model
+				completion.InputTokens = event.Message.Usage.InputTokens
+			case "content_block_delta":
+				if event.Delta.Type == "text_delta" && event.Delta.Text != "" {
+					text.WriteString(event.Delta.Text)
+					return onText(event.Delta.Text)
+				}
+			case "message_delta":
+				completion.OutputTokens = event.Usage.OutputTokens
+			case "error":
+				return errors.New(event.Error.Message)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		completion.Text = text.String()
+		return completion, nil
+	}
+
+	var resp struct {
+		Model   string `json:"model"`
+		Content []struct {
+			Type string `json:"type"`
+			Text string `json:"text"`
+		} `json:"content"`
+		Usage usage `json:"usage"`
+	}
+	if err := post(ctx, p.http, anthropicURL, headers, body, &resp); err != nil {
+		return nil, err
+	}
+
+	var text strings.Builder
+	for _, block := range resp.Content {
+		if block.Type == "text" {
+			text.WriteString(block.Text)
+		}
+	}
+	return &Completion{
+		Text:         text.String(),
+		Model:        resp.Model,
+		InputTokens:  resp.Usage.InputTokens,
+		OutputTokens: resp.Usage.OutputTokens,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package llm
+
+import (
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// breaker tracks one backend's requests and skips it after repeated
+// failures. After the cooldown one trial request is let through; it closes
+// the breaker on success and reopens it on failure.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	health    domain.LLMBackendHealth
+	openUntil time.Time
+	trial     bool // a half-open trial request is in flight
+}
+
+func newBreaker(backend string, cfg config.LLMBreakerConfig) *breaker {
+	return &breaker{
+		threshold: cfg.FailureThreshold,
+		cooldown:  cfg.Cooldown,
+		health:    domain.LLMBackendHealth{Backend: backend, State: domain.LLMBackendHealthy},
+	}
+}
+
+// allow reports whether a request may be sent to the backend now
+func (b *breaker) allow(now time.Time) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openUntil.IsZero() {
+		return true
+	}
+	if now.Before(b.openUntil) || b.trial {
+		return false
+	}
+	b.trial = true
+	return true
+}
+
+// record records the outcome of a request; fallback is true when the
+// backend served it for another that failed
+func (b *breaker) record(now time.Time, err error, fallback bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	h := &b.health
+	h.Requests++
+	b.trial = false
+	if err == nil {
+		if fallback {
+			h.Fallbacks++
+		}
+		h.ConsecutiveFailures = 0
+		h.LastSuccessAt = &now
+		b.openUntil = time.Time{}
+		return
+	}
+
+	h.Failures++
+	h.ConsecutiveFailures++
+	h.LastError = err.Error()
+	h.LastFailureAt = &now
+	if b.threshold > 0 && h.ConsecutiveFailures >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+// release ends a trial request that was neither a success nor a backend
+// failure, e.g. because the caller went away
+func (b *breaker) release() {
+	b.mu.Lock()
+	b.trial = false
+	b.mu.Unlock()
+}
+
+// snapshot returns the backend's health at now
+func (b *breaker) snapshot(now time.Time) domain.LLMBackendHealth {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	h := b.health
+	switch {
+	case b.openUntil.IsZero():
+		h.State = domain.LLMBackendHealthy
+	case now.Before(b.openUntil):
+		h.State = domain.LLMBackendOpen
+		until := b.openUntil
+		h.OpenUntil = &until
+	default:
+		h.State = domain.LLMBackendHalfOpen
+	}
+	return h
+}
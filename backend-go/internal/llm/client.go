@@ -0,0 +1,299 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+const (
+	groqURL      = "https://api.groq.com/openai/v1/chat/completions"
+	openAIURL    = "https://api.openai.com/v1/chat/completions"
+	anthropicURL = "https://api.anthropic.com/v1/messages"
+
+	anthropicVersion = "2023-06-01"
+
+	// defaultMaxTokens caps replies when a request sets no limit
+	defaultMaxTokens = 1024
+)
+
+var (
+	// ErrUnknownBackend is returned for a backend other than groq, openai or claude
+	ErrUnknownBackend = errors.New("unknown llm backend")
+	// ErrNotConfigured is returned for a backend without an API key
+	ErrNotConfigured = errors.New("llm backend has no API key configured")
+	// ErrUnavailable is returned for a backend skipped while its breaker is open
+	ErrUnavailable = errors.New("llm backend is unavailable after repeated failures")
+)
+
+// Message is one turn of a conversation
+type Message struct {
+	Role    string `json:"role"` // user, assistant
+	Content string `json:"content"`
+}
+
+// Request is a chat completion request
+type Request struct {
+	System      string
+	Messages    []Message
+	MaxTokens   int
+	Temperature *float64 // nil uses the configured temperature
+}
+
+// Completion is a backend's reply with its token usage
+type Completion struct {
+	Text         string
+	Backend      string
+	Model        string
+	InputTokens  int
+	OutputTokens int
+}
+
+// UsageRecorder stores the token usage and cost of completed requests
+// (stats.PostgresSystemStats)
+type UsageRecorder interface {
+	RecordLLMUsage(ctx context.Context, backend, model string, inputTokens, outputTokens int, costUSD float64) error
+}
+
+// Provider sends chat completions to one LLM backend
+type Provider interface {
+	// Complete sends req, calling onText with each piece of the reply as the
+	// backend produces it. A nil onText waits for the whole reply.
+	Complete(ctx context.Context, req Request, onText func(string) error) (*Completion, error)
+}
+
+// Backends are the LLM backends a Client can send to
+var Backends = []string{"groq", "openai", "claude"}
+
+// NewProvider creates the provider for backend from cfg, with the backend's
+// timeout. Groq and OpenAI are called through the OpenAI chat API, Claude
+// through the Anthropic messages API.
+func NewProvider(cfg config.LLMConfig, backend string) (Provider, error) {
+	key := cfg.APIKey(backend)
+	if key == "" {
+		return nil, fmt.Errorf("%w: %s", ErrNotConfigured, backend)
+	}
+	client := &http.Client{Timeout: cfg.BackendTimeout(backend)}
+	switch backend {
+	case "groq":
+		return &openAIProvider{url: groqURL, key: key, model: cfg.Groq.Model, http: client}, nil
+	case "openai":
+		return &openAIProvider{url: openAIURL, key: key, model: cfg.OpenAI.Model, http: client}, nil
+	case "claude":
+		return &anthropicProvider{key: key, model: cfg.Claude.Model, http: client}, nil
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnknownBackend, backend)
+}
+
+// Client sends chat completions to the configured backends, selecting the
+// default backend when a request names none. When a backend fails, the
+// request is retried on the next backend of the fallback order; a backend
+// failing repeatedly is skipped until its breaker's cooldown ends.
+// Concurrency is bounded separately by the Limiter.
+type Client struct {
+	cfg       config.LLMConfig
+	providers map[string]Provider
+	breakers  map[string]*breaker
+	guard     func(requested, fallback string) bool
+	usage     UsageRecorder
+}
+
+// NewClient creates a client for the backends in cfg that have an API key
+func NewClient(cfg config.LLMConfig) *Client {
+	c := &Client{cfg: cfg, providers: make(map[string]Provider), breakers: make(map[string]*breaker)}
+	for _, backend := range Backends {
+		if p, err := NewProvider(cfg, backend); err == nil {
+			c.providers[backend] = p
+		}
+		c.breakers[backend] = newBreaker(backend, cfg.Breaker)
+	}
+	return c
+}
+
+// SetFallbackGuard limits fallbacks to the backends guard accepts for the
+// requested one, e.g. those resume text redacted for it may be sent to.
+// Call before requests are sent.
+func (c *Client) SetFallbackGuard(guard func(requested, fallback string) bool) {
+	c.guard = guard
+}
+
+// Complete sends req to backend, or to the default backend when empty
+func (c *Client) Complete(ctx context.Context, backend string, req Request) (*Completion, error) {
+	return c.Stream(ctx, backend, req, nil)
+}
+
+// Stream sends req to backend like Complete, calling onText with each piece of
+// the reply as the backend produces it. An error from onText, e.g. because the
+// caller has gone away, stops the request. A nil onText waits for the whole reply.
+// A reply that failed after text was streamed is not retried on a fallback.
+func (c *Client) Stream(ctx context.Context, backend string, req Request, onText func(string) error) (*Completion, error) {
+	if backend == "" {
+		backend = c.cfg.DefaultBackend
+	}
+	if !known(backend) {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownBackend, backend)
+	}
+	if req.MaxTokens <= 0 {
+		req.MaxTokens = c.cfg.MaxTokens
+	}
+	if req.MaxTokens <= 0 {
+		req.MaxTokens = defaultMaxTokens
+	}
+	if req.Temperature == nil {
+		req.Temperature = &c.cfg.Temperature
+	}
+
+	var errs []error
+	for _, b := range c.chain(backend) {
+		provider, ok := c.providers[b]
+		if !ok {
+			if b == backend {
+				errs = append(errs, fmt.Errorf("%w: %s", ErrNotConfigured, b))
+			}
+			continue
+		}
+		br := c.breakers[b]
+		if !br.allow(time.Now()) {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrUnavailable, b))
+			continue
+		}
+		if len(errs) > 0 {
+			logger.Warn("LLM backend failed, falling back", zap.String("backend", backend), zap.String("fallback", b))
+		}
+
+		streamed, callerErr := false, error(nil)
+		send := onText
+		if onText != nil {
+			send = func(text string) error {
+				streamed = true
+				if err := onText(text); err != nil {
+					callerErr = err
+					return err
+				}
+				return nil
+			}
+		}
+
+		completion, err := provider.Complete(ctx, req, send)
+		if err == nil {
+			br.record(time.Now(), nil, b != backend)
+			completion.Backend = b
+			c.recordUsage(ctx, completion)
+			return completion, nil
+		}
+		err = fmt.Errorf("%s completion failed: %w", b, err)
+		if callerErr != nil || ctx.Err() != nil || badRequest(err) {
+			br.release()
+			return nil, err
+		}
+		br.record(time.Now(), err, false)
+		errs = append(errs, err)
+		if streamed {
+			break
+		}
+	}
+	return nil, errors.Join(errs...)
+}
+
+// chain is backend followed by the fallback order, without repeats and
+// without backends the guard rejects
+func (c *Client) chain(backend string) []string {
+	chain := []string{backend}
+	for _, b := range c.cfg.Fallback {
+		b = strings.ToLower(strings.TrimSpace(b))
+		if !known(b) || slices.Contains(chain, b) || (c.guard != nil && !c.guard(backend, b)) {
+			continue
+		}
+		chain = append(chain, b)
+	}
+	return chain
+}
+
+// SetUsageRecorder records every completed request's token usage and cost.
+// Call before requests are sent.
+func (c *Client) SetUsageRecorder(usage UsageRecorder) {
+	c.usage = usage
+}
+
+// recordUsage stores a completion's usage. It runs after the reply has been
+// delivered, so it outlives the caller's cancellation and only logs failures.
+func (c *Client) recordUsage(ctx context.Context, completion *Completion) {
+	if c.usage == nil {
+		return
+	}
+	cost := c.cfg.Cost(completion.Backend, completion.InputTokens, completion.OutputTokens)
+	err := c.usage.RecordLLMUsage(context.WithoutCancel(ctx), completion.Backend, completion.Model,
+		completion.InputTokens, completion.OutputTokens, cost)
+	if err != nil {
+		logger.Warn("Failed to record LLM usage", zap.String("backend", completion.Backend), zap.Error(err))
+	}
+}
+
+// Health returns each backend's breaker state and request record
+func (c *Client) Health() []domain.LLMBackendHealth {
+	now := time.Now()
+	health := make([]domain.LLMBackendHealth, 0, len(Backends))
+	for _, b := range Backends {
+		h := c.breakers[b].snapshot(now)
+		if _, ok := c.providers[b]; !ok {
+			h.State = domain.LLMBackendDisabled
+		}
+		health = append(health, h)
+	}
+	return health
+}
+
+// Ready reports whether requests to the default backend can be served by it
+// or a fallback
+func (c *Client) Ready(ctx context.Context) error {
+	now := time.Now()
+	var down []string
+	for _, b := range c.chain(c.cfg.DefaultBackend) {
+		if _, ok := c.providers[b]; !ok {
+			continue
+		}
+		if c.breakers[b].snapshot(now).State != domain.LLMBackendOpen {
+			return nil
+		}
+		down = append(down, b)
+	}
+	if len(down) == 0 {
+		return fmt.Errorf("no API key configured for %s", c.cfg.DefaultBackend)
+	}
+	return fmt.Errorf("LLM backends unavailable after repeated failures: %s", strings.Join(down, ", "))
+}
+
+// badRequest reports whether err is the backend rejecting the request
+// itself, which another backend would likely reject too and which says
+// nothing about the backend's health
+func badRequest(err error) bool {
+	var status *StatusError
+	if !errors.As(err, &status) {
+		return false
+	}
+	switch status.Status {
+	case http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnprocessableEntity:
+		return true
+	}
+	return false
+}
+
+// known reports whether backend is one of Backends
+func known(backend string) bool {
+	for _, b := range Backends {
+		if b == backend {
+			return true
+		}
+	}
+	return false
+}
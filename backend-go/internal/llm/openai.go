@@ -0,0 +1,104 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// openAIProvider calls an OpenAI-compatible chat completions endpoint. Groq
+// and OpenAI both serve this API.
+type openAIProvider struct {
+	url   string
+	key   string
+	model string
+	http  *http.Client
+}
+
+// Complete sends req, streaming the reply to onText when it is set
+func (p *openAIProvider) Complete(ctx context.Context, req Request, onText func(string) error) (*Completion, error) {
+	url, key, model := p.url, p.key, p.model
+	messages := make([]Message, 0, len(req.Messages)+1)
+	if req.System != "" {
+		messages = append(messages, Message{Role: "system", Content: req.System})
+	}
+	messages = append(messages, req.Messages...)
+
+	body := map[string]interface{}{
+		"model":       model,
+		"messages":    messages,
+		"max_tokens":  req.MaxTokens,
+		"temperature": *req.Temperature,
+	}
+	headers := map[string]string{"Authorization": "Bearer " + key}
+	type usage struct {
+		PromptTokens     int `json:"prompt_tokens"`
+		CompletionTokens int `json:"completion_tokens"`
+	}
+
+	if onText != nil {
+		body["stream"] = true
+		if url == openAIURL {
+			body["stream_options"] = map[string]bool{"include_usage": true}
+		}
+		completion := &Completion{Model: model}
+		var text strings.Builder
+		err := stream(ctx, p.http, url, headers, body, func(data []byte) error {
+			var chunk struct {
+				Model   string `json:"model"`
+				Choices []struct {
+					Delta Message `json:"delta"`
+				} `json:"choices"`
+				Usage *usage `json:"usage"`
+				Groq  struct {
+					Usage *usage `json:"usage"`
+				} `json:"x_groq"`
+			}
+			if err := json.Unmarshal(data, &chunk); err != nil {
+				return err
+			}
+			if chunk.Model != "" {
+				completion.Model = chunk.Model
+			}
+			u := chunk.Usage
+			if u == nil {
+				u = chunk.Groq.Usage // Groq reports usage in its own field
+			}
+			if u != nil {
+				completion.InputTokens, completion.OutputTokens = u.PromptTokens, u.CompletionTokens
+			}
+			if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+				return nil
+			}
+			text.WriteString(chunk.Choices[0].Delta.Content)
+			return onText(chunk.Choices[0].Delta.Content)
+		})
+		if err != nil {
+			return nil, err
+		}
+		completion.Text = text.String()
+		return completion, nil
+	}
+
+	var resp struct {
+		Model   string `json:"model"`
+		Choices []struct {
+			Message Message `json:"message"`
+		} `json:"choices"`
+		Usage usage `json:"usage"`
+	}
+	if err := post(ctx, p.http, url, headers, body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Choices) == 0 {
+		return nil, errors.New("response has no choices")
+	}
+	return &Completion{
+		Text:         resp.Choices[0].Message.Content,
+		Model:        resp.Model,
+		InputTokens:  resp.Usage.PromptTokens,
+		OutputTokens: resp.Usage.CompletionTokens,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+)
+
+var (
+	// ErrQueueFull is returned when a backend already has MaxQueue requests waiting
+	ErrQueueFull = errors.New("llm request queue is full")
+
+	// ErrQueueTimeout is returned when a request waited longer than MaxWait for a slot
+	ErrQueueTimeout = errors.New("timed out waiting for llm capacity")
+)
+
+// QueueStats is a snapshot of one backend's concurrency limiter
+type QueueStats struct {
+	MaxConcurrent int           `json:"max_concurrent"`
+	MaxQueue      int           `json:"max_queue"`
+	Active        int           `json:"active"`
+	Queued        int           `json:"queued"`
+	Admitted      uint64        `json:"admitted"`
+	Rejected      uint64        `json:"rejected"`
+	TimedOut      uint64        `json:"timed_out"`
+	AvgWait       time.Duration `json:"avg_wait_ns"`
+	AvgDuration   time.Duration `json:"avg_duration_ns"`
+}
+
+// Limiter bounds concurrent LLM requests per backend. Requests beyond the
+// concurrency limit wait in a bounded queue for at most MaxWait.
+type Limiter struct {
+	cfg config.LLMQueueConfig
+
+	mu       sync.Mutex
+	backends map[string]*backendQueue
+}
+
+type backendQueue struct {
+	slots chan struct{}
+
+	// guarded by Limiter.mu
+	queued    int
+	admitted  uint64
+	rejected  uint64
+	timedOut  uint64
+	waitSum   time.Duration
+	completed uint64
+	busySum   time.Duration
+}
+
+// NewLimiter creates a per-backend concurrency limiter
+func NewLimiter(cfg config.LLMQueueConfig) *Limiter {
+	if cfg.MaxConcurrent <= 0 {
+		cfg.MaxConcurrent = 4
+	}
+	if cfg.MaxQueue < 0 {
+		cfg.MaxQueue = 0
+	}
+	if cfg.MaxWait <= 0 {
+		cfg.MaxWait = 15 * time.Second
+	}
+	return &Limiter{cfg: cfg, backends: make(map[string]*backendQueue)}
+}
+
+// Acquire waits for a free slot on backend. The returned release function
+// must be called once the LLM request has finished.
+func (l *Limiter) Acquire(ctx context.Context, backend string) (func(), error) {
+	l.mu.Lock()
+	q := l.backend(backend)
+
+	// Fast path: a slot is free
+	select {
+	case q.slots <- struct{}{}:
+		q.admitted++
+		l.mu.Unlock()
+		return l.releaser(q, time.Now()), nil
+	default:
+	}
+
+	if q.queued >= l.cfg.MaxQueue {
+		q.rejected++
+		l.mu.Unlock()
+		return nil, ErrQueueFull
+	}
+	q.queued++
+	l.mu.Unlock()
+
+	start := time.Now()
+	timer := time.NewTimer(l.cfg.MaxWait)
+	defer timer.Stop()
+
+	select {
+	case q.slots <- struct{}{}:
+		l.mu.Lock()
+		q.queued--
+		q.admitted++
+		q.waitSum += time.Since(start)
+		l.mu.Unlock()
+		return l.releaser(q, time.Now()), nil
+	case <-timer.C:
+		l.mu.Lock()
+		q.queued--
+		q.timedOut++
+		l.mu.Unlock()
+		return nil, ErrQueueTimeout
+	case <-ctx.Done():
+		l.mu.Lock()
+		q.queued--
+		l.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// RetryAfter estimates how long a rejected client should wait before retrying,
+// from the backend's backlog and average request duration
+func (l *Limiter) RetryAfter(backend string) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	q := l.backend(backend)
+	avg := l.cfg.MaxWait
+	if q.completed > 0 {
+		avg = q.busySum / time.Duration(q.completed)
+	}
+
+	wait := avg * time.Duration(q.queued+1) / time.Duration(l.cfg.MaxConcurrent)
+	if wait < time.Second {
+		wait = time.Second
+	}
+	return wait.Round(time.Second)
+}
+
+// Stats returns a snapshot of every backend's queue
+func (l *Limiter) Stats() map[string]QueueStats {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	stats := make(map[string]QueueStats, len(l.backends))
+	for name, q := range l.backends {
+		s := QueueStats{
+			MaxConcurrent: l.cfg.MaxConcurrent,
+			MaxQueue:      l.cfg.MaxQueue,
+			Active:        len(q.slots),
+			Queued:        q.queued,
+			Admitted:      q.admitted,
+			Rejected:      q.rejected,
+			TimedOut:      q.timedOut,
+		}
+		if q.admitted > 0 {
+			s.AvgWait = q.waitSum / time.Duration(q.admitted)
+		}
+		if q.completed > 0 {
+			s.AvgDuration = q.busySum / time.Duration(q.completed)
+		}
+		stats[name] = s
+	}
+	return stats
+}
+
+// backend returns the queue for name, creating it on first use. Caller holds l.mu.
+func (l *Limiter) backend(name string) *backendQueue {
+	q, ok := l.backends[name]
+	if !ok {
+		q = &backendQueue{slots: make(chan struct{}, l.cfg.MaxConcurrent)}
+		l.backends[name] = q
+	}
+	return q
+}
+
+func (l *Limiter) releaser(q *backendQueue, start time.Time) func() {
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			l.mu.Lock()
+			q.completed++
+			q.busySum += time.Since(start)
+			l.mu.Unlock()
+			<-q.slots
+		})
+	}
+}
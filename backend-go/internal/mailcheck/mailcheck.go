@@ -0,0 +1,136 @@
+package mailcheck
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/storage"
+)
+
+// Issue codes
+const (
+	CodeNoRecipient         = "no_recipient"
+	CodeInvalidRecipient    = "invalid_recipient"
+	CodeMissingSubject      = "missing_subject"
+	CodeEmptyBody           = "empty_body"
+	CodeUnfilledPlaceholder = "unfilled_placeholder"
+	CodeMissingAttachment   = "missing_attachment"
+	CodeAttachmentNotFound  = "attachment_not_found"
+)
+
+// placeholderPatterns match template variables left unfilled: {{company}},
+// [Company Name], <hiring manager>
+var placeholderPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`\{\{\s*[^{}]+?\s*\}\}`),
+	regexp.MustCompile(`(?i)\[(?:your |the |hiring )?(?:name|company(?: name)?|position|role|job title|title|manager|hiring manager|recruiter(?: name)?|date|team|phone(?: number)?|email|link|insert[^\]]*)\]`),
+	regexp.MustCompile(`(?i)<(?:your |the |hiring )?(?:name|company(?: name)?|position|role|job title|manager|hiring manager|recruiter)>`),
+}
+
+// attachmentCue matches a body that says something is attached
+var attachmentCue = regexp.MustCompile(`(?i)\b(attached|attachment|enclosed|i(?:'ve| have) included my (?:resume|cv|cover letter|portfolio))\b`)
+
+// Checker runs deliverability checks on outgoing email
+type Checker struct {
+	store storage.Storage
+}
+
+// NewChecker creates a checker; store, when set, is used to confirm that
+// attachments exist
+func NewChecker(store storage.Storage) *Checker {
+	return &Checker{store: store}
+}
+
+// Check returns the problems that should block msg from being sent
+func (c *Checker) Check(ctx context.Context, msg domain.OutgoingEmail) []domain.DeliverabilityIssue {
+	issues := []domain.DeliverabilityIssue{}
+	add := func(field, code, format string, args ...interface{}) {
+		issues = append(issues, domain.DeliverabilityIssue{Field: field, Code: code, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if len(msg.To) == 0 {
+		add("to", CodeNoRecipient, "Add at least one recipient")
+	}
+	for _, addr := range msg.To {
+		if !ValidAddress(addr) {
+			add("to", CodeInvalidRecipient, "%q is not a valid email address", addr)
+		}
+	}
+	for _, addr := range msg.Cc {
+		if !ValidAddress(addr) {
+			add("cc", CodeInvalidRecipient, "%q is not a valid email address", addr)
+		}
+	}
+
+	if strings.TrimSpace(msg.Subject) == "" {
+		add("subject", CodeMissingSubject, "Add a subject line; emails without one are often filtered as spam")
+	}
+	for _, placeholder := range Placeholders(msg.Subject) {
+		add("subject", CodeUnfilledPlaceholder, "Replace the template placeholder %s", placeholder)
+	}
+
+	if strings.TrimSpace(msg.Body) == "" {
+		add("body", CodeEmptyBody, "The email body is empty")
+	}
+	for _, placeholder := range Placeholders(msg.Body) {
+		add("body", CodeUnfilledPlaceholder, "Replace the template placeholder %s", placeholder)
+	}
+
+	if len(msg.Attachments) == 0 {
+		if m := attachmentCue.FindString(msg.Body); m != "" {
+			add("attachments", CodeMissingAttachment, "The email mentions %q but has no attachment", m)
+		}
+	}
+	for _, a := range msg.Attachments {
+		if err := c.exists(ctx, a.StorageKey); err != nil {
+			add("attachments", CodeAttachmentNotFound, "Attachment %s could not be found", a.Filename)
+		}
+	}
+	return issues
+}
+
+// exists confirms a stored attachment can be read
+func (c *Checker) exists(ctx context.Context, key string) error {
+	if key == "" {
+		return storage.ErrNotFound
+	}
+	if c.store == nil {
+		return nil
+	}
+	r, err := c.store.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	return r.Close()
+}
+
+// ValidAddress reports whether addr is a single, plain email address with a
+// dotted domain
+func ValidAddress(addr string) bool {
+	parsed, err := mail.ParseAddress(strings.TrimSpace(addr))
+	if err != nil {
+		return false
+	}
+	at := strings.LastIndex(parsed.Address, "@")
+	domainPart := parsed.Address[at+1:]
+	return at > 0 && strings.Contains(domainPart, ".") &&
+		!strings.HasPrefix(domainPart, ".") && !strings.HasSuffix(domainPart, ".")
+}
+
+// Placeholders returns the unfilled template placeholders in text
+func Placeholders(text string) []string {
+	var found []string
+	seen := make(map[string]bool)
+	for _, p := range placeholderPatterns {
+		for _, m := range p.FindAllString(text, -1) {
+			if !seen[m] {
+				seen[m] = true
+				found = append(found, m)
+			}
+		}
+	}
+	return found
+}
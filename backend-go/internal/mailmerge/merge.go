@@ -0,0 +1,192 @@
+package mailmerge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// MaxContacts caps the contacts merged in one batch
+const MaxContacts = 200
+
+// ErrInvalid is returned for a malformed merge request
+var ErrInvalid = errors.New("invalid mail merge")
+
+// field matches a {{placeholder}} in a template
+var field = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// CompanyEnricher adds known facts, such as ratings or H1B filings, to a company
+type CompanyEnricher interface {
+	EnrichCompany(c *domain.Company)
+}
+
+// Researcher looks up free-form research on a company
+type Researcher interface {
+	GetCompanyResearch(ctx context.Context, companyName string) (*domain.CompanyReport, error)
+}
+
+// Checker finds problems that should block an email from being sent
+type Checker interface {
+	Check(ctx context.Context, msg domain.OutgoingEmail) []domain.DeliverabilityIssue
+}
+
+// Merger drafts personalized emails for a batch of contacts
+type Merger struct {
+	checker    Checker
+	researcher Researcher
+	enrichers  []CompanyEnricher
+}
+
+// NewMerger creates a mail merger. researcher may be nil; enrichers supply
+// the company facts injected as {{research}}.
+func NewMerger(checker Checker, researcher Researcher, enrichers ...CompanyEnricher) *Merger {
+	return &Merger{checker: checker, researcher: researcher, enrichers: enrichers}
+}
+
+// Merge drafts one email per contact and checks each for deliverability.
+// Placeholders with no value are left in place so the check flags them.
+func (m *Merger) Merge(ctx context.Context, req domain.MailMergeRequest) (*domain.MailMergeBatch, error) {
+	if strings.TrimSpace(req.Template.Body) == "" {
+		return nil, fmt.Errorf("%w: template body is required", ErrInvalid)
+	}
+	if len(req.Contacts) == 0 {
+		return nil, fmt.Errorf("%w: at least one contact is required", ErrInvalid)
+	}
+	if len(req.Contacts) > MaxContacts {
+		return nil, fmt.Errorf("%w: at most %d contacts per batch", ErrInvalid, MaxContacts)
+	}
+	for i, contact := range req.Contacts {
+		if strings.TrimSpace(contact.Company) == "" {
+			return nil, fmt.Errorf("%w: contact %d has no company", ErrInvalid, i)
+		}
+	}
+
+	batch := &domain.MailMergeBatch{
+		Drafts:      make([]domain.MailMergeDraft, 0, len(req.Contacts)),
+		GeneratedAt: time.Now(),
+	}
+	research := make(map[string]*domain.CompanyResearch)
+	for _, contact := range req.Contacts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		key := domain.NormalizeCompanyName(contact.Company)
+		r, ok := research[key]
+		if !ok {
+			r = m.research(ctx, contact.Company)
+			research[key] = r
+		}
+
+		values := fields(contact, r)
+		msg := domain.OutgoingEmail{
+			Subject: Fill(req.Template.Subject, values),
+			Body:    Fill(req.Template.Body, values),
+		}
+		if contact.Email != "" {
+			msg.To = []string{contact.Email}
+		}
+
+		issues := []domain.DeliverabilityIssue{}
+		if m.checker != nil {
+			issues = m.checker.Check(ctx, msg)
+		}
+		draft := domain.MailMergeDraft{
+			Contact:  contact,
+			Email:    msg,
+			Research: r,
+			Issues:   issues,
+			Ready:    len(issues) == 0,
+		}
+		if draft.Ready {
+			batch.Ready++
+		}
+		batch.Drafts = append(batch.Drafts, draft)
+	}
+	batch.Total = len(batch.Drafts)
+	return batch, nil
+}
+
+// research gathers what is known about a company
+func (m *Merger) research(ctx context.Context, name string) *domain.CompanyResearch {
+	company := domain.Company{Name: name}
+	for _, e := range m.enrichers {
+		e.EnrichCompany(&company)
+	}
+
+	r := &domain.CompanyResearch{
+		Company:    name,
+		Rating:     company.Rating,
+		H1BFilings: company.H1BFilings,
+		Highlights: []string{},
+	}
+	if company.Rating != nil {
+		r.Highlights = append(r.Highlights, fmt.Sprintf("%s is rated %.1f/5 by its employees", name, *company.Rating))
+	}
+	if company.LikelySponsorsVisa && company.H1BFilings != nil {
+		r.Highlights = append(r.Highlights, fmt.Sprintf("%s has sponsored %d H1B visas", name, *company.H1BFilings))
+	}
+
+	if m.researcher != nil {
+		details, err := m.researcher.GetCompanyResearch(ctx, name)
+		if err != nil {
+			logger.Warn("Company research failed", zap.String("company", name), zap.Error(err))
+		} else {
+			r.Details = details
+			if summary := strings.TrimSpace(details.Summary); summary != "" {
+				r.Highlights = append(r.Highlights, summary)
+			}
+		}
+	}
+	return r
+}
+
+// fields returns the placeholder values for a contact
+func fields(contact domain.MailMergeContact, research *domain.CompanyResearch) map[string]string {
+	values := map[string]string{
+		"name":       strings.TrimSpace(contact.Name),
+		"first_name": firstName(contact.Name),
+		"email":      strings.TrimSpace(contact.Email),
+		"company":    strings.TrimSpace(contact.Company),
+		"role":       strings.TrimSpace(contact.Role),
+	}
+	if research != nil && len(research.Highlights) > 0 {
+		sentences := make([]string, len(research.Highlights))
+		for i, h := range research.Highlights {
+			sentences[i] = strings.TrimRight(h, ". ")
+		}
+		values["research"] = strings.Join(sentences, ". ") + "."
+	}
+	for k, v := range contact.Fields {
+		if key := strings.ToLower(k); values[key] == "" {
+			values[key] = strings.TrimSpace(v)
+		}
+	}
+	return values
+}
+
+// Fill replaces {{placeholders}} in text with values, matched
+// case-insensitively. Placeholders without a value are left as they are.
+func Fill(text string, values map[string]string) string {
+	return field.ReplaceAllStringFunc(text, func(m string) string {
+		name := strings.ToLower(field.FindStringSubmatch(m)[1])
+		if v := values[name]; v != "" {
+			return v
+		}
+		return m
+	})
+}
+
+func firstName(name string) string {
+	if parts := strings.Fields(name); len(parts) > 0 {
+		return parts[0]
+	}
+	return ""
+}
@@ -0,0 +1,194 @@
+// Package mlclient is the gRPC client for the ML service (ml-service/), which
+// computes embeddings and reranks documents with a cross-encoder.
+package mlclient
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/resume-rag/backend/internal/config"
+)
+
+// servicePath prefixes the full method names of the ML service
+const servicePath = "/ml.MLService/"
+
+// Client calls the ML service. Each attempt gets the configured deadline, and
+// calls that fail because the service is unavailable, overloaded or slow are
+// retried with backoff. A Client is safe for concurrent use.
+type Client struct {
+	conn    *grpc.ClientConn
+	timeout time.Duration
+	retry   config.MLRetryConfig
+}
+
+// New creates a client for the configured ML service. The connection is made
+// lazily, so New succeeds while the service is still starting.
+func New(cfg config.MLServiceConfig) (*Client, error) {
+	creds := insecure.NewCredentials()
+	if cfg.TLS {
+		creds = credentials.NewTLS(&tls.Config{MinVersion: tls.VersionTLS12})
+	}
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(codec{})),
+	}
+	if cfg.MaxMessageSize > 0 {
+		opts = append(opts, grpc.WithDefaultCallOptions(
+			grpc.MaxCallRecvMsgSize(cfg.MaxMessageSize),
+			grpc.MaxCallSendMsgSize(cfg.MaxMessageSize),
+		))
+	}
+
+	conn, err := grpc.Dial(cfg.Address(), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ML service client: %w", err)
+	}
+	return &Client{conn: conn, timeout: cfg.Timeout, retry: cfg.Retry}, nil
+}
+
+// Close closes the connection to the ML service
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// Embed returns the embedding of text
+func (c *Client) Embed(ctx context.Context, text string) ([]float32, error) {
+	var resp embedResponse
+	if err := c.invoke(ctx, "Embed", &embedRequest{text: text}, &resp); err != nil {
+		return nil, err
+	}
+	return resp.embedding, nil
+}
+
+// EmbedBatch returns the embeddings of texts, in the order given
+func (c *Client) EmbedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return nil, nil
+	}
+	var resp embedBatchResponse
+	if err := c.invoke(ctx, "EmbedBatch", &embedBatchRequest{texts: texts}, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.embeddings) != len(texts) {
+		return nil, fmt.Errorf("ML service returned %d embeddings for %d texts", len(resp.embeddings), len(texts))
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, e := range resp.embeddings {
+		if e.index < 0 || e.index >= len(texts) {
+			return nil, fmt.Errorf("ML service returned embedding for unknown index %d", e.index)
+		}
+		vectors[e.index] = e.vector
+	}
+	return vectors, nil
+}
+
+// Rerank scores documents against query with the cross-encoder and returns
+// the best topK (all when topK is 0), best first
+func (c *Client) Rerank(ctx context.Context, query string, documents []Document, topK int) ([]RankedDocument, error) {
+	if len(documents) == 0 {
+		return []RankedDocument{}, nil
+	}
+	var resp rerankResponse
+	if err := c.invoke(ctx, "Rerank", &rerankRequest{query: query, documents: documents, topK: topK}, &resp); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(resp.documents, func(i, j int) bool { return resp.documents[i].Score > resp.documents[j].Score })
+	return resp.documents, nil
+}
+
+// HealthCheck returns the ML service's health report
+func (c *Client) HealthCheck(ctx context.Context) (*Health, error) {
+	var resp healthCheckResponse
+	if err := c.invoke(ctx, "HealthCheck", &healthCheckRequest{}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp.Health, nil
+}
+
+// Ping checks that the ML service answers and is not unhealthy, for readiness
+// probes. A degraded service still serves requests.
+func (c *Client) Ping(ctx context.Context) error {
+	health, err := c.HealthCheck(ctx)
+	if err != nil {
+		return err
+	}
+	if health.Status == "unhealthy" {
+		return errors.New("ML service reports unhealthy")
+	}
+	return nil
+}
+
+// invoke calls a method, retrying transient failures until the attempts run
+// out or ctx is done
+func (c *Client) invoke(ctx context.Context, method string, req request, resp response) error {
+	attempts := max(c.retry.MaxAttempts, 1)
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			timer := time.NewTimer(c.backoff(attempt - 1))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+		if err = c.call(ctx, method, req, resp); err == nil || !retryable(err) || ctx.Err() != nil {
+			break
+		}
+	}
+	if err != nil {
+		return fmt.Errorf("ML service %s failed: %w", method, err)
+	}
+	return nil
+}
+
+// call makes one attempt with the per-call deadline
+func (c *Client) call(ctx context.Context, method string, req request, resp response) error {
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+	return c.conn.Invoke(ctx, servicePath+method, req, resp)
+}
+
+// backoff returns the delay before the given retry (1-based): the base delay
+// doubled per retry, capped at MaxDelay, with jitter over its upper half
+func (c *Client) backoff(retry int) time.Duration {
+	d := c.retry.BaseDelay
+	for i := 1; i < retry && (c.retry.MaxDelay <= 0 || d < c.retry.MaxDelay); i++ {
+		d *= 2
+	}
+	if c.retry.MaxDelay > 0 && d > c.retry.MaxDelay {
+		d = c.retry.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// retryable reports whether a failed call may succeed when repeated
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
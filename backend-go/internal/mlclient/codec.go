@@ -0,0 +1,26 @@
+package mlclient
+
+import "fmt"
+
+// codec encodes the hand-written messages in messages.go. It is named
+// "proto" since the wire format is protobuf, so requests carry the content
+// type the ML service expects.
+type codec struct{}
+
+func (codec) Name() string { return "proto" }
+
+func (codec) Marshal(v interface{}) ([]byte, error) {
+	req, ok := v.(request)
+	if !ok {
+		return nil, fmt.Errorf("mlclient: cannot marshal %T", v)
+	}
+	return req.marshal(), nil
+}
+
+func (codec) Unmarshal(data []byte, v interface{}) error {
+	resp, ok := v.(response)
+	if !ok {
+		return fmt.Errorf("mlclient: cannot unmarshal into %T", v)
+	}
+	return resp.unmarshal(data)
+}
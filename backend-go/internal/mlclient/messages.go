@@ -0,0 +1,320 @@
+package mlclient
+
+import (
+	"fmt"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The messages below mirror ml-service/proto/ml.proto field for field. They
+// are encoded with protowire so the client needs no protoc-generated code;
+// keep field numbers in sync with the proto file.
+
+// request is a message sent to the ML service
+type request interface {
+	marshal() []byte
+}
+
+// response is a message received from the ML service
+type response interface {
+	unmarshal(b []byte) error
+}
+
+// Document is a candidate passed to Rerank
+type Document struct {
+	ID       string
+	Content  string
+	Score    float32 // optional initial score, e.g. from vector search
+	Metadata map[string]string
+}
+
+// RankedDocument is a reranked document with its cross-encoder score
+type RankedDocument struct {
+	ID           string
+	Content      string
+	Score        float32
+	OriginalRank int
+	NewRank      int
+	Metadata     map[string]string
+}
+
+// Health is the ML service's own health report
+type Health struct {
+	Status     string            // healthy, degraded, unhealthy
+	Components map[string]string // per-component status
+	Version    string
+}
+
+type embedRequest struct {
+	text  string
+	model string
+}
+
+func (m *embedRequest) marshal() []byte {
+	var b []byte
+	b = appendString(b, 1, m.text)
+	return appendString(b, 2, m.model)
+}
+
+type embedResponse struct {
+	embedding  []float32
+	dimensions int
+	model      string
+}
+
+func (m *embedResponse) unmarshal(b []byte) error {
+	return decode(b, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case 1:
+			return appendFloats(&m.embedding, typ, v, n)
+		case 2:
+			m.dimensions = int(int32(n))
+		case 3:
+			m.model = string(v)
+		}
+		return nil
+	})
+}
+
+type embedBatchRequest struct {
+	texts []string
+	model string
+}
+
+func (m *embedBatchRequest) marshal() []byte {
+	var b []byte
+	for _, text := range m.texts {
+		b = protowire.AppendTag(b, 1, protowire.BytesType)
+		b = protowire.AppendString(b, text)
+	}
+	return appendString(b, 2, m.model)
+}
+
+type embedBatchResponse struct {
+	embeddings []indexedEmbedding
+	model      string
+}
+
+// indexedEmbedding is one vector of a batch with its position in the request
+type indexedEmbedding struct {
+	vector []float32
+	index  int
+}
+
+func (m *embedBatchResponse) unmarshal(b []byte) error {
+	return decode(b, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case 1:
+			var e indexedEmbedding
+			err := decode(v, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+				switch num {
+				case 1:
+					return appendFloats(&e.vector, typ, v, n)
+				case 2:
+					e.index = int(int32(n))
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			m.embeddings = append(m.embeddings, e)
+		case 2:
+			m.model = string(v)
+		}
+		return nil
+	})
+}
+
+type rerankRequest struct {
+	query     string
+	documents []Document
+	topK      int
+	model     string
+}
+
+func (m *rerankRequest) marshal() []byte {
+	var b []byte
+	b = appendString(b, 1, m.query)
+	for _, doc := range m.documents {
+		var d []byte
+		d = appendString(d, 1, doc.ID)
+		d = appendString(d, 2, doc.Content)
+		if doc.Score != 0 {
+			d = protowire.AppendTag(d, 3, protowire.Fixed32Type)
+			d = protowire.AppendFixed32(d, math.Float32bits(doc.Score))
+		}
+		d = appendMap(d, 4, doc.Metadata)
+		b = protowire.AppendTag(b, 2, protowire.BytesType)
+		b = protowire.AppendBytes(b, d)
+	}
+	if m.topK != 0 {
+		b = protowire.AppendTag(b, 3, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(int64(m.topK)))
+	}
+	return appendString(b, 4, m.model)
+}
+
+type rerankResponse struct {
+	documents []RankedDocument
+	model     string
+}
+
+func (m *rerankResponse) unmarshal(b []byte) error {
+	return decode(b, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case 1:
+			var doc RankedDocument
+			err := decode(v, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+				switch num {
+				case 1:
+					doc.ID = string(v)
+				case 2:
+					doc.Content = string(v)
+				case 3:
+					doc.Score = math.Float32frombits(uint32(n))
+				case 4:
+					doc.OriginalRank = int(int32(n))
+				case 5:
+					doc.NewRank = int(int32(n))
+				case 6:
+					return decodeMapEntry(&doc.Metadata, v)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+			m.documents = append(m.documents, doc)
+		case 2:
+			m.model = string(v)
+		}
+		return nil
+	})
+}
+
+type healthCheckRequest struct{}
+
+func (m *healthCheckRequest) marshal() []byte { return nil }
+
+type healthCheckResponse struct {
+	Health
+}
+
+func (m *healthCheckResponse) unmarshal(b []byte) error {
+	return decode(b, func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error {
+		switch num {
+		case 1:
+			m.Status = string(v)
+		case 2:
+			return decodeMapEntry(&m.Components, v)
+		case 3:
+			m.Version = string(v)
+		}
+		return nil
+	})
+}
+
+// decode calls field for every field in b. Length-delimited values are passed
+// in v, varint and fixed-size values in n. Unknown fields are skipped by the
+// callers' switch statements.
+func decode(b []byte, field func(num protowire.Number, typ protowire.Type, v []byte, n uint64) error) error {
+	for len(b) > 0 {
+		num, typ, tagLen := protowire.ConsumeTag(b)
+		if tagLen < 0 {
+			return protowire.ParseError(tagLen)
+		}
+		b = b[tagLen:]
+
+		var v []byte
+		var n uint64
+		var valueLen int
+		switch typ {
+		case protowire.VarintType:
+			n, valueLen = protowire.ConsumeVarint(b)
+		case protowire.Fixed32Type:
+			var x uint32
+			x, valueLen = protowire.ConsumeFixed32(b)
+			n = uint64(x)
+		case protowire.Fixed64Type:
+			n, valueLen = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			v, valueLen = protowire.ConsumeBytes(b)
+		default:
+			valueLen = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		if valueLen < 0 {
+			return protowire.ParseError(valueLen)
+		}
+		b = b[valueLen:]
+
+		if err := field(num, typ, v, n); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// appendFloats decodes a repeated float field, packed or not
+func appendFloats(dst *[]float32, typ protowire.Type, v []byte, n uint64) error {
+	if typ == protowire.Fixed32Type {
+		*dst = append(*dst, math.Float32frombits(uint32(n)))
+		return nil
+	}
+	if len(v)%4 != 0 {
+		return fmt.Errorf("packed float field has %d bytes", len(v))
+	}
+	if *dst == nil {
+		*dst = make([]float32, 0, len(v)/4)
+	}
+	for len(v) > 0 {
+		x, _ := protowire.ConsumeFixed32(v)
+		*dst = append(*dst, math.Float32frombits(x))
+		v = v[4:]
+	}
+	return nil
+}
+
+// decodeMapEntry adds one map<string, string> entry to m
+func decodeMapEntry(m *map[string]string, v []byte) error {
+	var key, value string
+	err := decode(v, func(num protowire.Number, _ protowire.Type, v []byte, _ uint64) error {
+		switch num {
+		case 1:
+			key = string(v)
+		case 2:
+			value = string(v)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if *m == nil {
+		*m = make(map[string]string)
+	}
+	(*m)[key] = value
+	return nil
+}
+
+// appendString encodes a string field, omitting the proto3 default ""
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+// appendMap encodes a map<string, string> field as repeated entries
+func appendMap(b []byte, num protowire.Number, m map[string]string) []byte {
+	for key, value := range m {
+		var entry []byte
+		entry = appendString(entry, 1, key)
+		entry = appendString(entry, 2, value)
+		b = protowire.AppendTag(b, num, protowire.BytesType)
+		b = protowire.AppendBytes(b, entry)
+	}
+	return b
+}
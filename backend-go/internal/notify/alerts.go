@@ -0,0 +1,103 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// alertLimit caps the new jobs looked at per saved search
+const alertLimit = 50
+
+// SavedSearches runs the saved searches alerts are sent for
+type SavedSearches interface {
+	GetSavedSearches(ctx context.Context) ([]domain.SavedSearch, error)
+	Search(ctx context.Context, req domain.JobSearchRequest) (*domain.JobSearchResponse, error)
+}
+
+// AlertSavedSearches sends a saved search notification for each saved search
+// with notifications enabled that found jobs first seen since it was last
+// checked, and returns how many were sent
+func (s *Service) AlertSavedSearches(ctx context.Context, searches SavedSearches, now time.Time) (int, error) {
+	list, err := searches.GetSavedSearches(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, search := range list {
+		if !search.NotificationEnabled {
+			continue
+		}
+
+		key := tenant.ID(ctx) + "/" + search.ID.String()
+		s.mu.Lock()
+		since, ok := s.checked[key]
+		if !ok {
+			since = s.started
+		}
+		s.checked[key] = now
+		s.mu.Unlock()
+
+		result, err := searches.Search(ctx, domain.JobSearchRequest{
+			Query:     search.Query,
+			Filters:   search.Filters,
+			Page:      1,
+			Limit:     alertLimit,
+			SortBy:    "first_seen",
+			SortOrder: "desc",
+		})
+		if err != nil {
+			logger.Warn("Saved search alert failed", zap.String("search", search.Name), zap.Error(err))
+			continue
+		}
+
+		var fresh []domain.JobBrief
+		for _, job := range result.Jobs {
+			if job.FirstSeenAt != nil && job.FirstSeenAt.After(since) {
+				fresh = append(fresh, job)
+			}
+		}
+		if len(fresh) == 0 {
+			continue
+		}
+
+		if err := s.Notify(ctx, savedSearchNotification(search, fresh, now)); err != nil {
+			logger.Warn("Failed to send saved search alert", zap.String("search", search.Name), zap.Error(err))
+			continue
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// savedSearchNotification lists the first few new jobs of a saved search
+func savedSearchNotification(search domain.SavedSearch, jobs []domain.JobBrief, now time.Time) domain.Notification {
+	var b strings.Builder
+	for i, job := range jobs {
+		if i == 5 {
+			fmt.Fprintf(&b, "and %d more\n", len(jobs)-i)
+			break
+		}
+		fmt.Fprintf(&b, "%s at %s\n", job.Title, job.CompanyName)
+	}
+
+	noun := "jobs"
+	if len(jobs) == 1 {
+		noun = "job"
+	}
+	return domain.Notification{
+		Event:     domain.NotificationSavedSearch,
+		Title:     fmt.Sprintf("%d new %s for %q", len(jobs), noun, search.Name),
+		Body:      strings.TrimSpace(b.String()),
+		URL:       "/job-list",
+		CreatedAt: now,
+	}
+}
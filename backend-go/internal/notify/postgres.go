@@ -0,0 +1,66 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists preferences in the notification_preferences table,
+// one row per tenant
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed preference store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Get returns the tenant's preferences, or nil when none were saved
+func (s *PostgresStore) Get(ctx context.Context) (*domain.NotificationPreferences, error) {
+	var p domain.NotificationPreferences
+	var start, end, timezone *string
+	err := s.db.QueryRow(ctx, `
+		SELECT channels, quiet_start, quiet_end, quiet_timezone, digest_only, updated_at
+		FROM notification_preferences`,
+	).Scan(&p.Channels, &start, &end, &timezone, &p.DigestOnly, &p.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get notification preferences: %w", err)
+	}
+	if start != nil && end != nil {
+		p.QuietHours = &domain.QuietHours{Start: *start, End: *end}
+		if timezone != nil {
+			p.QuietHours.Timezone = *timezone
+		}
+	}
+	return &p, nil
+}
+
+// Save replaces the tenant's preferences
+func (s *PostgresStore) Save(ctx context.Context, p *domain.NotificationPreferences) error {
+	var start, end, timezone *string
+	if q := p.QuietHours; q != nil {
+		start, end, timezone = &q.Start, &q.End, &q.Timezone
+	}
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO notification_preferences (channels, quiet_start, quiet_end, quiet_timezone, digest_only, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id) DO UPDATE SET
+			channels = EXCLUDED.channels, quiet_start = EXCLUDED.quiet_start, quiet_end = EXCLUDED.quiet_end,
+			quiet_timezone = EXCLUDED.quiet_timezone, digest_only = EXCLUDED.digest_only, updated_at = EXCLUDED.updated_at`,
+		p.Channels, start, end, timezone, p.DigestOnly, p.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save notification preferences: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+// Package notify delivers notifications according to the user's
+// preferences: which channels each event goes to, a daily quiet window in
+// which notifications are held, and a digest-only mode.
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// ErrInvalid is returned for malformed preferences
+var ErrInvalid = errors.New("invalid notification preferences")
+
+// Sender delivers notifications on one channel
+type Sender interface {
+	Send(ctx context.Context, n domain.Notification) error
+}
+
+// held is a notification waiting for quiet hours to end
+type held struct {
+	tenant   string
+	channels []domain.NotificationChannel
+	n        domain.Notification
+	until    time.Time
+}
+
+// Service keeps notification preferences and delivers notifications on the
+// channels they allow
+type Service struct {
+	store   Store
+	senders map[domain.NotificationChannel]Sender
+
+	mu      sync.Mutex
+	held    []held
+	checked map[string]time.Time // saved search alerts, see alerts.go
+	started time.Time
+}
+
+// NewService creates a notification service with no senders
+func NewService(store Store) *Service {
+	return &Service{
+		store:   store,
+		senders: make(map[domain.NotificationChannel]Sender),
+		checked: make(map[string]time.Time),
+		started: time.Now(),
+	}
+}
+
+// AddSender registers the sender of a channel. Notifications for channels
+// without a sender are skipped.
+func (s *Service) AddSender(channel domain.NotificationChannel, sender Sender) {
+	s.senders[channel] = sender
+}
+
+// DefaultPreferences sends every event by email and reminders and new
+// matches to subscribed browsers too, at any time
+func DefaultPreferences() *domain.NotificationPreferences {
+	both := []domain.NotificationChannel{domain.NotificationEmail, domain.NotificationPush}
+	return &domain.NotificationPreferences{Channels: map[domain.NotificationEvent][]domain.NotificationChannel{
+		domain.NotificationReminder:    both,
+		domain.NotificationSavedSearch: both,
+		domain.NotificationDigest:      {domain.NotificationEmail},
+	}}
+}
+
+// Preferences returns the user's preferences, or the defaults when none were saved
+func (s *Service) Preferences(ctx context.Context) (*domain.NotificationPreferences, error) {
+	p, err := s.store.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if p == nil {
+		return DefaultPreferences(), nil
+	}
+	return p, nil
+}
+
+// Update changes the set fields of the preferences
+func (s *Service) Update(ctx context.Context, in domain.NotificationPreferencesUpdate) (*domain.NotificationPreferences, error) {
+	p, err := s.Preferences(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Work on a copy so a rejected update leaves the saved channels alone
+	current := p.Channels
+	p.Channels = make(map[domain.NotificationEvent][]domain.NotificationChannel, len(current))
+	for event, channels := range current {
+		p.Channels[event] = channels
+	}
+	for event, channels := range in.Channels {
+		if !knownEvent(event) {
+			return nil, fmt.Errorf("%w: unknown event %q", ErrInvalid, event)
+		}
+		seen := make(map[domain.NotificationChannel]bool)
+		list := []domain.NotificationChannel{}
+		for _, channel := range channels {
+			if !knownChannel(channel) {
+				return nil, fmt.Errorf("%w: unknown channel %q", ErrInvalid, channel)
+			}
+			if !seen[channel] {
+				seen[channel] = true
+				list = append(list, channel)
+			}
+		}
+		p.Channels[event] = list
+	}
+	if in.ClearQuietHours {
+		p.QuietHours = nil
+	}
+	if in.QuietHours != nil {
+		q := *in.QuietHours
+		q.Start, q.End, q.Timezone = strings.TrimSpace(q.Start), strings.TrimSpace(q.End), strings.TrimSpace(q.Timezone)
+		if _, _, _, err := parseQuietHours(&q); err != nil {
+			return nil, err
+		}
+		p.QuietHours = &q
+	}
+	if in.DigestOnly != nil {
+		p.DigestOnly = *in.DigestOnly
+	}
+
+	now := time.Now()
+	p.UpdatedAt = &now
+	if err := s.store.Save(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Notify delivers n on the channels the preferences allow for its event.
+// In digest-only mode only the digest is delivered; during quiet hours the
+// notification is held until they end.
+func (s *Service) Notify(ctx context.Context, n domain.Notification) error {
+	p, err := s.Preferences(ctx)
+	if err != nil {
+		return err
+	}
+	if p.DigestOnly && n.Event != domain.NotificationDigest {
+		return nil
+	}
+	channels := p.Channels[n.Event]
+	if len(channels) == 0 {
+		return nil
+	}
+	if n.CreatedAt.IsZero() {
+		n.CreatedAt = time.Now()
+	}
+
+	if until, quiet := quietUntil(p.QuietHours, time.Now()); quiet {
+		s.mu.Lock()
+		s.held = append(s.held, held{tenant: tenant.ID(ctx), channels: channels, n: n, until: until})
+		s.mu.Unlock()
+		return nil
+	}
+	return s.send(ctx, channels, n)
+}
+
+// send delivers n on each channel that has a sender
+func (s *Service) send(ctx context.Context, channels []domain.NotificationChannel, n domain.Notification) error {
+	var errs []error
+	for _, channel := range channels {
+		sender, ok := s.senders[channel]
+		if !ok {
+			continue
+		}
+		if err := sender.Send(ctx, n); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", channel, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Start delivers held notifications on the interval until ctx is cancelled
+func (s *Service) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.Flush(ctx, time.Now())
+			}
+		}
+	}()
+}
+
+// Flush delivers the held notifications whose quiet hours have ended and
+// returns how many were delivered
+func (s *Service) Flush(ctx context.Context, now time.Time) int {
+	s.mu.Lock()
+	var due, waiting []held
+	for _, h := range s.held {
+		if now.Before(h.until) {
+			waiting = append(waiting, h)
+		} else {
+			due = append(due, h)
+		}
+	}
+	s.held = waiting
+	s.mu.Unlock()
+
+	for _, h := range due {
+		tctx := tenant.WithTenant(ctx, config.TenantConfig{ID: h.tenant})
+		if err := s.send(tctx, h.channels, h.n); err != nil {
+			logger.Warn("Failed to deliver held notification",
+				zap.String("tenant", h.tenant), zap.String("event", string(h.n.Event)), zap.Error(err))
+		}
+	}
+	return len(due)
+}
+
+// quietUntil reports whether now is within the quiet hours, and when they end
+func quietUntil(q *domain.QuietHours, now time.Time) (time.Time, bool) {
+	if q == nil {
+		return time.Time{}, false
+	}
+	start, end, loc, err := parseQuietHours(q)
+	if err != nil || start == end {
+		return time.Time{}, false
+	}
+
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	quiet := minute >= start && minute < end
+	if start > end { // spans midnight
+		quiet = minute >= start || minute < end
+	}
+	if !quiet {
+		return time.Time{}, false
+	}
+
+	until := time.Date(local.Year(), local.Month(), local.Day(), end/60, end%60, 0, 0, loc)
+	if !until.After(local) {
+		until = until.AddDate(0, 0, 1)
+	}
+	return until, true
+}
+
+// parseQuietHours returns the window's start and end as minutes of the day,
+// and its time zone
+func parseQuietHours(q *domain.QuietHours) (start, end int, loc *time.Location, err error) {
+	if start, err = minuteOfDay(q.Start); err != nil {
+		return 0, 0, nil, fmt.Errorf("%w: quiet_hours.start must be HH:MM", ErrInvalid)
+	}
+	if end, err = minuteOfDay(q.End); err != nil {
+		return 0, 0, nil, fmt.Errorf("%w: quiet_hours.end must be HH:MM", ErrInvalid)
+	}
+	loc = time.UTC
+	if q.Timezone != "" {
+		if loc, err = time.LoadLocation(q.Timezone); err != nil {
+			return 0, 0, nil, fmt.Errorf("%w: unknown time zone %q", ErrInvalid, q.Timezone)
+		}
+	}
+	return start, end, loc, nil
+}
+
+func minuteOfDay(hhmm string) (int, error) {
+	t, err := time.Parse("15:04", hhmm)
+	if err != nil {
+		return 0, err
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+func knownEvent(event domain.NotificationEvent) bool {
+	for _, e := range domain.NotificationEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+func knownChannel(channel domain.NotificationChannel) bool {
+	for _, c := range domain.NotificationChannels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
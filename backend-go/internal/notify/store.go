@@ -0,0 +1,47 @@
+package notify
+
+import (
+	"context"
+	"sync"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// Store persists the notification preferences of each tenant's user
+type Store interface {
+	// Get returns the saved preferences, or nil when none were saved
+	Get(ctx context.Context) (*domain.NotificationPreferences, error)
+	Save(ctx context.Context, p *domain.NotificationPreferences) error
+}
+
+// MemoryStore keeps notification preferences in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	prefs map[string]domain.NotificationPreferences
+}
+
+// NewMemoryStore creates an in-memory preference store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{prefs: make(map[string]domain.NotificationPreferences)}
+}
+
+// Get returns the tenant's preferences, or nil when none were saved
+func (m *MemoryStore) Get(ctx context.Context) (*domain.NotificationPreferences, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	p, ok := m.prefs[tenant.ID(ctx)]
+	if !ok {
+		return nil, nil
+	}
+	return &p, nil
+}
+
+// Save replaces the tenant's preferences
+func (m *MemoryStore) Save(ctx context.Context, p *domain.NotificationPreferences) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.prefs[tenant.ID(ctx)] = *p
+	return nil
+}
@@ -0,0 +1,99 @@
+package operations
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+var (
+	// ErrCancelled is the cause of a context cancelled through the registry
+	ErrCancelled = errors.New("operation cancelled")
+	// ErrDuplicate is returned when an operation ID is already running
+	ErrDuplicate = errors.New("operation already running")
+)
+
+type operation struct {
+	info   domain.Operation
+	owner  string
+	cancel context.CancelCauseFunc
+}
+
+// Registry tracks running long-running operations so their callers can
+// cancel them. Operations are keyed by ID and only visible to their owner.
+type Registry struct {
+	mu  sync.Mutex
+	ops map[string]*operation
+}
+
+// NewRegistry creates an empty operation registry
+func NewRegistry() *Registry {
+	return &Registry{ops: make(map[string]*operation)}
+}
+
+// Start registers an operation and returns a context that is cancelled with
+// ErrCancelled when the operation is cancelled, plus a done func that must be
+// called when the operation finishes.
+func (r *Registry) Start(parent context.Context, owner, id, kind string) (context.Context, func(), error) {
+	ctx, cancel := context.WithCancelCause(parent)
+
+	r.mu.Lock()
+	if _, ok := r.ops[id]; ok {
+		r.mu.Unlock()
+		cancel(nil)
+		return nil, nil, ErrDuplicate
+	}
+	op := &operation{
+		info:   domain.Operation{ID: id, Kind: kind, StartedAt: time.Now()},
+		owner:  owner,
+		cancel: cancel,
+	}
+	r.ops[id] = op
+	r.mu.Unlock()
+
+	done := func() {
+		r.mu.Lock()
+		if r.ops[id] == op {
+			delete(r.ops, id)
+		}
+		r.mu.Unlock()
+		cancel(nil)
+	}
+	return ctx, done, nil
+}
+
+// List returns the owner's running operations, oldest first
+func (r *Registry) List(owner string) []domain.Operation {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	list := make([]domain.Operation, 0)
+	for _, op := range r.ops {
+		if op.owner == owner {
+			list = append(list, op.info)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].StartedAt.Before(list[j].StartedAt) })
+	return list
+}
+
+// Cancel cancels one of the owner's running operations. It reports whether
+// the operation was found.
+func (r *Registry) Cancel(owner, id string) bool {
+	r.mu.Lock()
+	op, ok := r.ops[id]
+	if ok && op.owner == owner {
+		delete(r.ops, id)
+	}
+	r.mu.Unlock()
+
+	if !ok || op.owner != owner {
+		return false
+	}
+	op.cancel(ErrCancelled)
+	return true
+}
@@ -0,0 +1,109 @@
+package outreach
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists outreach sequences in the outreach_sequences table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed sequence store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const sequenceColumns = `id, application_id, status, paused_reason, application_status, steps, started_at, paused_at, updated_at`
+
+// Save inserts or replaces a sequence
+func (p *PostgresStore) Save(ctx context.Context, seq *domain.OutreachSequence) error {
+	steps, err := json.Marshal(seq.Steps)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.db.Exec(ctx, `
+		INSERT INTO outreach_sequences (`+sequenceColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			status = EXCLUDED.status, paused_reason = EXCLUDED.paused_reason,
+			application_status = EXCLUDED.application_status, steps = EXCLUDED.steps,
+			paused_at = EXCLUDED.paused_at, updated_at = EXCLUDED.updated_at`,
+		seq.ID, seq.ApplicationID, string(seq.Status), seq.PausedReason, string(seq.AppStatus), steps, seq.StartedAt, seq.PausedAt, seq.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save outreach sequence: %w", err)
+	}
+	return nil
+}
+
+// Get returns a sequence by ID
+func (p *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*domain.OutreachSequence, error) {
+	seq, err := scanSequence(p.db.QueryRow(ctx, `SELECT `+sequenceColumns+` FROM outreach_sequences WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get outreach sequence: %w", err)
+	}
+	return seq, nil
+}
+
+// ForApplication returns an application's sequences, newest first
+func (p *PostgresStore) ForApplication(ctx context.Context, appID uuid.UUID) ([]domain.OutreachSequence, error) {
+	return p.list(ctx, `
+		SELECT `+sequenceColumns+` FROM outreach_sequences
+		WHERE application_id = $1 ORDER BY started_at DESC`, appID)
+}
+
+// Active returns every active sequence
+func (p *PostgresStore) Active(ctx context.Context) ([]domain.OutreachSequence, error) {
+	return p.list(ctx, `
+		SELECT `+sequenceColumns+` FROM outreach_sequences
+		WHERE status = $1 ORDER BY started_at DESC`, string(domain.OutreachActive))
+}
+
+func (p *PostgresStore) list(ctx context.Context, query string, args ...interface{}) ([]domain.OutreachSequence, error) {
+	rows, err := p.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list outreach sequences: %w", err)
+	}
+	defer rows.Close()
+
+	list := []domain.OutreachSequence{}
+	for rows.Next() {
+		seq, err := scanSequence(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan outreach sequence: %w", err)
+		}
+		list = append(list, *seq)
+	}
+	return list, rows.Err()
+}
+
+func scanSequence(row pgx.Row) (*domain.OutreachSequence, error) {
+	var (
+		seq               domain.OutreachSequence
+		status, appStatus string
+		steps             []byte
+	)
+	if err := row.Scan(&seq.ID, &seq.ApplicationID, &status, &seq.PausedReason, &appStatus, &steps, &seq.StartedAt, &seq.PausedAt, &seq.UpdatedAt); err != nil {
+		return nil, err
+	}
+	seq.Status = domain.OutreachStatus(status)
+	seq.AppStatus = domain.ApplicationStatus(appStatus)
+	if err := json.Unmarshal(steps, &seq.Steps); err != nil {
+		return nil, fmt.Errorf("invalid outreach steps: %w", err)
+	}
+	return &seq, nil
+}
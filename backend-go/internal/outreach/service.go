@@ -0,0 +1,433 @@
+package outreach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+var (
+	// ErrApplicationNotFound is returned when the sequence's application does not exist
+	ErrApplicationNotFound = errors.New("application not found")
+	// ErrInvalid is returned for a malformed sequence or step update
+	ErrInvalid = errors.New("invalid outreach sequence")
+	// ErrRunning is returned when an application already has a sequence that
+	// is active or paused
+	ErrRunning = errors.New("application already has an outreach sequence")
+)
+
+// emailTypes are the drafts a step can generate
+var emailTypes = map[string]bool{"application": true, "followup": true, "thankyou": true}
+
+// ApplicationService reads and updates tracked applications
+type ApplicationService interface {
+	GetApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error)
+	UpdateApplication(ctx context.Context, appID uuid.UUID, req domain.ApplicationUpdate) (*domain.Application, error)
+	GetJobDetails(ctx context.Context, jobID uuid.UUID) (*domain.Job, error)
+}
+
+// Drafter generates email drafts
+type Drafter interface {
+	Generate(ctx context.Context, emailType, jobDescription string, tone, length string) (*domain.GeneratedEmail, error)
+}
+
+// Service runs outreach sequences: each step drafts an email when it comes
+// due and sets the application's reminder to the next step
+type Service struct {
+	store   Store
+	apps    ApplicationService
+	drafter Drafter
+	cfg     config.OutreachConfig
+	tenants []string
+}
+
+// NewService creates an outreach service whose worker advances the sequences
+// of the given tenants. drafter may be nil, in which case steps only set
+// reminders.
+func NewService(store Store, apps ApplicationService, drafter Drafter, cfg config.OutreachConfig, tenants []string) *Service {
+	return &Service{store: store, apps: apps, drafter: drafter, cfg: cfg, tenants: tenants}
+}
+
+// Create starts a sequence for an application. Step offsets count from the
+// applied date, or from now for applications not yet applied to.
+func (s *Service) Create(ctx context.Context, appID uuid.UUID, req domain.OutreachStart) (*domain.OutreachSequence, error) {
+	app, err := s.apps.GetApplication(ctx, appID)
+	if err != nil {
+		return nil, ErrApplicationNotFound
+	}
+
+	existing, err := s.store.ForApplication(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	for _, seq := range existing {
+		if seq.Status == domain.OutreachActive || seq.Status == domain.OutreachPaused {
+			return nil, ErrRunning
+		}
+	}
+
+	now := time.Now()
+	base := now
+	if app.AppliedDate != nil {
+		base = *app.AppliedDate
+	}
+	steps, err := s.steps(req.Steps, base)
+	if err != nil {
+		return nil, err
+	}
+
+	seq := &domain.OutreachSequence{
+		ID:            uuid.New(),
+		ApplicationID: appID,
+		Status:        domain.OutreachActive,
+		AppStatus:     app.Status,
+		Steps:         steps,
+		StartedAt:     now,
+		UpdatedAt:     now,
+	}
+	s.advance(ctx, seq, app, now)
+	if err := s.store.Save(ctx, seq); err != nil {
+		return nil, err
+	}
+	return seq, nil
+}
+
+// steps builds a sequence's steps from the request, or the configured default
+func (s *Service) steps(inputs []domain.OutreachStepInput, base time.Time) ([]domain.OutreachStep, error) {
+	if len(inputs) == 0 {
+		for _, step := range s.cfg.Steps {
+			inputs = append(inputs, domain.OutreachStepInput{
+				Name:      step.Name,
+				EmailType: step.EmailType,
+				AfterDays: int(step.After / (24 * time.Hour)),
+			})
+		}
+	}
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("%w: at least one step is required", ErrInvalid)
+	}
+
+	steps := make([]domain.OutreachStep, 0, len(inputs))
+	for i, in := range inputs {
+		emailType := strings.ToLower(strings.TrimSpace(in.EmailType))
+		if !emailTypes[emailType] {
+			return nil, fmt.Errorf("%w: step %d has unknown email_type %q", ErrInvalid, i, in.EmailType)
+		}
+		if in.AfterDays < 0 {
+			return nil, fmt.Errorf("%w: step %d has negative after_days", ErrInvalid, i)
+		}
+		name := strings.TrimSpace(in.Name)
+		if name == "" {
+			name = emailType
+		}
+		steps = append(steps, domain.OutreachStep{
+			Name:      name,
+			EmailType: emailType,
+			DueAt:     base.AddDate(0, 0, in.AfterDays),
+			Status:    domain.OutreachStepPending,
+		})
+	}
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].DueAt.Before(steps[j].DueAt) })
+	return steps, nil
+}
+
+// ForApplication returns an application's sequences, newest first, pausing
+// any whose application has changed status since the last run
+func (s *Service) ForApplication(ctx context.Context, appID uuid.UUID) ([]domain.OutreachSequence, error) {
+	list, err := s.store.ForApplication(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	var app *domain.Application
+	for i := range list {
+		if list[i].Status != domain.OutreachActive {
+			continue
+		}
+		if app == nil {
+			if app, err = s.apps.GetApplication(ctx, appID); err != nil {
+				return nil, ErrApplicationNotFound
+			}
+		}
+		if s.sync(&list[i], app, time.Now()) {
+			if err := s.store.Save(ctx, &list[i]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return list, nil
+}
+
+// Pause stops a sequence from drafting further steps
+func (s *Service) Pause(ctx context.Context, id uuid.UUID) (*domain.OutreachSequence, error) {
+	seq, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if seq.Status != domain.OutreachActive {
+		return nil, fmt.Errorf("%w: only active sequences can be paused", ErrInvalid)
+	}
+
+	s.pause(seq, "paused by user", time.Now())
+	if err := s.store.Save(ctx, seq); err != nil {
+		return nil, err
+	}
+	return seq, nil
+}
+
+// Resume restarts a paused sequence under the application's current status.
+// Steps not yet due are pushed back by the time the sequence was paused.
+func (s *Service) Resume(ctx context.Context, id uuid.UUID) (*domain.OutreachSequence, error) {
+	seq, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if seq.Status != domain.OutreachPaused {
+		return nil, fmt.Errorf("%w: only paused sequences can be resumed", ErrInvalid)
+	}
+	app, err := s.apps.GetApplication(ctx, seq.ApplicationID)
+	if err != nil {
+		return nil, ErrApplicationNotFound
+	}
+
+	now := time.Now()
+	if seq.PausedAt != nil {
+		shift := now.Sub(*seq.PausedAt)
+		for i := range seq.Steps {
+			if step := &seq.Steps[i]; step.Status == domain.OutreachStepPending && step.DueAt.After(*seq.PausedAt) {
+				step.DueAt = step.DueAt.Add(shift)
+			}
+		}
+	}
+	seq.Status = domain.OutreachActive
+	seq.AppStatus = app.Status
+	seq.PausedReason = ""
+	seq.PausedAt = nil
+	seq.UpdatedAt = now
+	s.advance(ctx, seq, app, now)
+
+	if err := s.store.Save(ctx, seq); err != nil {
+		return nil, err
+	}
+	return seq, nil
+}
+
+// Cancel ends a sequence for good
+func (s *Service) Cancel(ctx context.Context, id uuid.UUID) (*domain.OutreachSequence, error) {
+	seq, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if seq.Status == domain.OutreachCompleted || seq.Status == domain.OutreachCancelled {
+		return seq, nil
+	}
+
+	seq.Status = domain.OutreachCancelled
+	seq.UpdatedAt = time.Now()
+	if err := s.store.Save(ctx, seq); err != nil {
+		return nil, err
+	}
+	return seq, nil
+}
+
+// UpdateStep marks a step as sent or skipped and moves the application
+// reminder on to the next step
+func (s *Service) UpdateStep(ctx context.Context, id uuid.UUID, index int, req domain.OutreachStepUpdate) (*domain.OutreachSequence, error) {
+	if req.Status != domain.OutreachStepSent && req.Status != domain.OutreachStepSkipped {
+		return nil, fmt.Errorf("%w: status must be sent or skipped", ErrInvalid)
+	}
+	seq, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(seq.Steps) {
+		return nil, fmt.Errorf("%w: no step %d", ErrInvalid, index)
+	}
+	if seq.Status == domain.OutreachCancelled {
+		return nil, fmt.Errorf("%w: sequence was cancelled", ErrInvalid)
+	}
+
+	now := time.Now()
+	step := &seq.Steps[index]
+	step.Status = req.Status
+	step.CompletedAt = &now
+	seq.UpdatedAt = now
+
+	if seq.Status == domain.OutreachActive {
+		app, err := s.apps.GetApplication(ctx, seq.ApplicationID)
+		if err != nil {
+			return nil, ErrApplicationNotFound
+		}
+		s.advance(ctx, seq, app, now)
+	}
+	if err := s.store.Save(ctx, seq); err != nil {
+		return nil, err
+	}
+	return seq, nil
+}
+
+// Start runs the worker on the configured interval until ctx is cancelled
+func (s *Service) Start(ctx context.Context) {
+	if s.cfg.Interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				for _, id := range s.tenants {
+					tctx := tenant.WithTenant(ctx, config.TenantConfig{ID: id})
+					if n, err := s.Run(tctx, time.Now()); err != nil {
+						logger.Warn("Outreach run failed", zap.String("tenant", id), zap.Error(err))
+					} else if n > 0 {
+						logger.Info("Drafted outreach steps", zap.String("tenant", id), zap.Int("count", n))
+					}
+				}
+			}
+		}
+	}()
+}
+
+// Run advances every active sequence of the tenant of ctx, pausing those whose application has
+// changed status, and returns how many steps were drafted
+func (s *Service) Run(ctx context.Context, now time.Time) (int, error) {
+	active, err := s.store.Active(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	drafted := 0
+	for i := range active {
+		seq := &active[i]
+		app, err := s.apps.GetApplication(ctx, seq.ApplicationID)
+		if err != nil {
+			logger.Warn("Failed to load outreach application", zap.String("sequence_id", seq.ID.String()), zap.Error(err))
+			continue
+		}
+
+		changed := s.sync(seq, app, now)
+		if seq.Status == domain.OutreachActive {
+			n := s.advance(ctx, seq, app, now)
+			drafted += n
+			changed = changed || n > 0
+		}
+		if !changed {
+			continue
+		}
+		if err := s.store.Save(ctx, seq); err != nil {
+			logger.Warn("Failed to save outreach sequence", zap.String("sequence_id", seq.ID.String()), zap.Error(err))
+		}
+	}
+	return drafted, nil
+}
+
+// sync pauses seq when its application has moved on, and reports whether
+// seq changed. Moving from saved to applied is the sequence's own first step
+// and does not pause it.
+func (s *Service) sync(seq *domain.OutreachSequence, app *domain.Application, now time.Time) bool {
+	if seq.Status != domain.OutreachActive || app.Status == seq.AppStatus {
+		return false
+	}
+	if seq.AppStatus == domain.ApplicationStatusSaved && app.Status == domain.ApplicationStatusApplied {
+		seq.AppStatus = app.Status
+		seq.UpdatedAt = now
+		return true
+	}
+	s.pause(seq, fmt.Sprintf("application status changed to %s", app.Status), now)
+	return true
+}
+
+func (s *Service) pause(seq *domain.OutreachSequence, reason string, now time.Time) {
+	seq.Status = domain.OutreachPaused
+	seq.PausedReason = reason
+	seq.PausedAt = &now
+	seq.UpdatedAt = now
+}
+
+// advance drafts every pending step that is due, sets the application
+// reminder to the next open step, and completes the sequence once every step
+// is done. Returns how many steps were drafted.
+func (s *Service) advance(ctx context.Context, seq *domain.OutreachSequence, app *domain.Application, now time.Time) int {
+	drafted := 0
+	var description *string
+	for i := range seq.Steps {
+		step := &seq.Steps[i]
+		if step.Status != domain.OutreachStepPending || step.DueAt.After(now) {
+			continue
+		}
+		if description == nil {
+			d := s.jobDescription(ctx, app)
+			description = &d
+		}
+		if err := s.draft(ctx, step, *description); err != nil {
+			step.DraftError = err.Error()
+			logger.Warn("Failed to draft outreach step", zap.String("sequence_id", seq.ID.String()), zap.String("step", step.Name), zap.Error(err))
+			continue
+		}
+		step.Status = domain.OutreachStepDrafted
+		step.DraftError = ""
+		drafted++
+	}
+
+	next := seq.NextStep()
+	if next == nil {
+		seq.Status = domain.OutreachCompleted
+		seq.UpdatedAt = now
+		return drafted
+	}
+	if drafted > 0 {
+		seq.UpdatedAt = now
+	}
+
+	if app.ReminderDate == nil || !app.ReminderDate.Equal(next.DueAt) {
+		remindAt := next.DueAt
+		if _, err := s.apps.UpdateApplication(ctx, app.ID, domain.ApplicationUpdate{ReminderDate: &remindAt}); err != nil {
+			logger.Warn("Failed to set outreach reminder", zap.String("application_id", app.ID.String()), zap.Error(err))
+		} else {
+			app.ReminderDate = &remindAt
+		}
+	}
+	return drafted
+}
+
+// draft generates the step's email, leaving it empty without a drafter
+func (s *Service) draft(ctx context.Context, step *domain.OutreachStep, jobDescription string) error {
+	if s.drafter == nil {
+		return nil
+	}
+	email, err := s.drafter.Generate(ctx, step.EmailType, jobDescription, s.cfg.Tone, s.cfg.Length)
+	if err != nil {
+		return err
+	}
+	if err := email.Validate(); err != nil {
+		return err
+	}
+	step.Draft = email
+	return nil
+}
+
+// jobDescription returns the application's job description, falling back to
+// its title and company when the job cannot be loaded
+func (s *Service) jobDescription(ctx context.Context, app *domain.Application) string {
+	if job, err := s.apps.GetJobDetails(ctx, app.Job.ID); err == nil && job.Description != "" {
+		return job.Description
+	}
+	return fmt.Sprintf("%s at %s", app.Job.Title, app.Job.CompanyName)
+}
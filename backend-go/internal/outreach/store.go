@@ -0,0 +1,86 @@
+package outreach
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ErrNotFound is returned when a sequence does not exist
+var ErrNotFound = errors.New("outreach sequence not found")
+
+// Store persists outreach sequences
+type Store interface {
+	Save(ctx context.Context, seq *domain.OutreachSequence) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.OutreachSequence, error)
+	// ForApplication returns an application's sequences, newest first
+	ForApplication(ctx context.Context, appID uuid.UUID) ([]domain.OutreachSequence, error)
+	// Active returns every sequence the worker should advance
+	Active(ctx context.Context) ([]domain.OutreachSequence, error)
+}
+
+// MemoryStore keeps outreach sequences in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	sequences map[uuid.UUID]domain.OutreachSequence
+}
+
+// NewMemoryStore creates an in-memory sequence store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sequences: make(map[uuid.UUID]domain.OutreachSequence)}
+}
+
+// Save inserts or replaces a sequence
+func (m *MemoryStore) Save(ctx context.Context, seq *domain.OutreachSequence) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sequences[seq.ID] = clone(*seq)
+	return nil
+}
+
+// Get returns a sequence by ID
+func (m *MemoryStore) Get(ctx context.Context, id uuid.UUID) (*domain.OutreachSequence, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	seq, ok := m.sequences[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	seq = clone(seq)
+	return &seq, nil
+}
+
+// ForApplication returns an application's sequences, newest first
+func (m *MemoryStore) ForApplication(ctx context.Context, appID uuid.UUID) ([]domain.OutreachSequence, error) {
+	return m.list(func(seq *domain.OutreachSequence) bool { return seq.ApplicationID == appID }), nil
+}
+
+// Active returns every active sequence
+func (m *MemoryStore) Active(ctx context.Context) ([]domain.OutreachSequence, error) {
+	return m.list(func(seq *domain.OutreachSequence) bool { return seq.Status == domain.OutreachActive }), nil
+}
+
+func (m *MemoryStore) list(keep func(*domain.OutreachSequence) bool) []domain.OutreachSequence {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	list := []domain.OutreachSequence{}
+	for _, seq := range m.sequences {
+		if keep(&seq) {
+			list = append(list, clone(seq))
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].StartedAt.After(list[j].StartedAt) })
+	return list
+}
+
+// clone copies seq so stored steps are not shared with callers
+func clone(seq domain.OutreachSequence) domain.OutreachSequence {
+	seq.Steps = append([]domain.OutreachStep(nil), seq.Steps...)
+	return seq
+}
@@ -0,0 +1,133 @@
+package pii
+
+import (
+	"regexp"
+	"strings"
+)
+
+// redacted replaces credentials and the values of sensitive keys
+const redacted = "[REDACTED]"
+
+// sensitiveKeys are JSON keys whose values are always replaced, whatever
+// they hold
+var sensitiveKeys = map[string]bool{
+	"api_key":        true,
+	"apikey":         true,
+	"password":       true,
+	"token":          true,
+	"access_token":   true,
+	"refresh_token":  true,
+	"secret":         true,
+	"authorization":  true,
+	"resume":         true,
+	"resume_text":    true,
+	"resume_content": true,
+}
+
+// SensitiveKey reports whether values stored under key are always redacted
+func SensitiveKey(key string) bool {
+	return sensitiveKeys[strings.ToLower(key)]
+}
+
+// rule replaces one kind of sensitive value. When valid is set, only
+// matches it accepts are replaced.
+type rule struct {
+	pattern *regexp.Regexp
+	with    string
+	valid   func(match string) bool
+}
+
+// rules run in order. Credentials go first so a key is never half-matched as
+// a phone number, and key=value pairs keep their key.
+var rules = []rule{
+	{pattern: regexp.MustCompile(`(?i)\b(api[_-]?key|access[_-]?token|token|secret|password|passwd)=[^&\s"'<>]+`), with: "${1}=" + redacted},
+	{pattern: regexp.MustCompile(`\bBearer\s+[A-Za-z0-9._~+/-]+=*`), with: "Bearer " + redacted},
+	{pattern: regexp.MustCompile(`\b(?:sk-(?:ant-|proj-)?[A-Za-z0-9_-]{10,}|gsk_[A-Za-z0-9]{10,}|AKIA[0-9A-Z]{16}|gh[pousr]_[A-Za-z0-9]{20,}|xox[abpr]-[A-Za-z0-9-]{10,})`), with: redacted},
+	{pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`), with: "[EMAIL]"},
+	// Card numbers in their printed groupings, or unbroken with a known
+	// issuer prefix, and only when the check digit is right, so order
+	// numbers and timestamps are left alone
+	{
+		pattern: regexp.MustCompile(`\b(?:\d{4}-\d{4}-\d{4}-\d{4}|\d{4} \d{4} \d{4} \d{4}|3[47]\d{2}[ -]\d{6}[ -]\d{5}|3[47]\d{13}|(?:4\d|5[1-5]|6[05])\d{14})\b`),
+		with:    "[CARD]",
+		valid:   luhn,
+	},
+	{pattern: regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`), with: "[SSN]"},
+	// Separators are required so IDs, timestamps and counts aren't mistaken
+	// for phone numbers
+	{pattern: regexp.MustCompile(`(?:\+\d{1,3}[\s.-]?)?(?:\(\d{3}\)\s?|\b\d{3}[\s.-])\d{3}[\s.-]\d{4}\b`), with: "[PHONE]"},
+	{pattern: regexp.MustCompile(`\+\d{10,14}\b`), with: "[PHONE]"},
+}
+
+// Scrub replaces emails, phone numbers, SSNs, card numbers and credentials
+// in s. It is applied to text that is kept around: logs, audit entries,
+// logged payloads and recorded scrape pages.
+func Scrub(s string) string {
+	for _, r := range rules {
+		if r.valid == nil {
+			s = r.pattern.ReplaceAllString(s, r.with)
+			continue
+		}
+		s = r.pattern.ReplaceAllStringFunc(s, func(match string) string {
+			if r.valid(match) {
+				return r.with
+			}
+			return match
+		})
+	}
+	return s
+}
+
+// ScrubValue scrubs every string in a decoded JSON-like value and redacts
+// the values of sensitive keys, returning a copy so the original is left
+// untouched
+func ScrubValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case string:
+		return Scrub(val)
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(val))
+		for k, child := range val {
+			if SensitiveKey(k) {
+				out[k] = redacted
+			} else {
+				out[k] = ScrubValue(child)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(val))
+		for i, child := range val {
+			out[i] = ScrubValue(child)
+		}
+		return out
+	case []string:
+		out := make([]string, len(val))
+		for i, s := range val {
+			out[i] = Scrub(s)
+		}
+		return out
+	default:
+		return val
+	}
+}
+
+// luhn reports whether the digits in s pass the card number checksum
+func luhn(s string) bool {
+	sum, double := 0, false
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
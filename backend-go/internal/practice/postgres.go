@@ -0,0 +1,122 @@
+package practice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists review cards in the review_cards table and answers
+// per day in practice_activity
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed review store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const cardColumns = `question_id, question, category, difficulty, calibrated_difficulty, attempts,
+	last_score, average_score, ease, interval_days, streak, reviewed_at, due_at`
+
+// Card returns the review card of a question
+func (p *PostgresStore) Card(ctx context.Context, questionID string) (*domain.ReviewCard, error) {
+	card, err := scanCard(p.db.QueryRow(ctx, `SELECT `+cardColumns+` FROM review_cards WHERE question_id = $1`, questionID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, errNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get review card: %w", err)
+	}
+	return card, nil
+}
+
+// SaveCard inserts or replaces a review card
+func (p *PostgresStore) SaveCard(ctx context.Context, c *domain.ReviewCard) error {
+	_, err := p.db.Exec(ctx, `
+		INSERT INTO review_cards (`+cardColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		ON CONFLICT (tenant_id, question_id) DO UPDATE SET
+			question = EXCLUDED.question, category = EXCLUDED.category, difficulty = EXCLUDED.difficulty,
+			calibrated_difficulty = EXCLUDED.calibrated_difficulty, attempts = EXCLUDED.attempts,
+			last_score = EXCLUDED.last_score, average_score = EXCLUDED.average_score, ease = EXCLUDED.ease,
+			interval_days = EXCLUDED.interval_days, streak = EXCLUDED.streak,
+			reviewed_at = EXCLUDED.reviewed_at, due_at = EXCLUDED.due_at`,
+		c.QuestionID, c.Question, c.Category, c.Difficulty, c.Calibrated, c.Attempts,
+		c.LastScore, c.AverageScore, c.Ease, c.IntervalDays, c.Streak, c.ReviewedAt, c.DueAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save review card: %w", err)
+	}
+	return nil
+}
+
+// Cards returns every review card
+func (p *PostgresStore) Cards(ctx context.Context) ([]domain.ReviewCard, error) {
+	rows, err := p.db.Query(ctx, `SELECT `+cardColumns+` FROM review_cards`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list review cards: %w", err)
+	}
+	defer rows.Close()
+
+	cards := []domain.ReviewCard{}
+	for rows.Next() {
+		card, err := scanCard(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan review card: %w", err)
+		}
+		cards = append(cards, *card)
+	}
+	return cards, rows.Err()
+}
+
+// AddActivity counts n more answers on day
+func (p *PostgresStore) AddActivity(ctx context.Context, day string, n int) error {
+	_, err := p.db.Exec(ctx, `
+		INSERT INTO practice_activity (day, answers) VALUES ($1::date, $2)
+		ON CONFLICT (tenant_id, day) DO UPDATE SET answers = practice_activity.answers + EXCLUDED.answers`,
+		day, n,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save practice activity: %w", err)
+	}
+	return nil
+}
+
+// Activity returns the answers per day since since
+func (p *PostgresStore) Activity(ctx context.Context, since string) (map[string]int, error) {
+	rows, err := p.db.Query(ctx, `
+		SELECT to_char(day, 'YYYY-MM-DD'), answers FROM practice_activity
+		WHERE day >= $1::date`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load practice activity: %w", err)
+	}
+	defer rows.Close()
+
+	days := make(map[string]int)
+	for rows.Next() {
+		var day string
+		var n int
+		if err := rows.Scan(&day, &n); err != nil {
+			return nil, fmt.Errorf("failed to scan practice activity: %w", err)
+		}
+		days[day] = n
+	}
+	return days, rows.Err()
+}
+
+func scanCard(row pgx.Row) (*domain.ReviewCard, error) {
+	var c domain.ReviewCard
+	err := row.Scan(&c.QuestionID, &c.Question, &c.Category, &c.Difficulty, &c.Calibrated, &c.Attempts,
+		&c.LastScore, &c.AverageScore, &c.Ease, &c.IntervalDays, &c.Streak, &c.ReviewedAt, &c.DueAt)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
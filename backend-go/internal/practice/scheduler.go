@@ -0,0 +1,190 @@
+package practice
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Spaced-repetition parameters, following SM-2
+const (
+	initialEase = 2.5
+	minEase     = 1.3
+	// PassScore is the score, 0-1, at which an answer counts as recalled
+	PassScore = 0.6
+	// maxIntervalDays keeps even well-known questions in rotation
+	maxIntervalDays = 180
+)
+
+// DayLayout formats the days practice activity is counted under
+const DayLayout = "2006-01-02"
+
+// Scheduler tracks practice scores per question and schedules weak questions
+// for review on a spaced-repetition curve
+type Scheduler struct {
+	store Store
+
+	// mu serializes updates to a card, which are read, scored and saved
+	mu sync.Mutex
+}
+
+// NewScheduler creates a review scheduler
+func NewScheduler(store Store) *Scheduler {
+	return &Scheduler{store: store}
+}
+
+// QuestionID returns the ID a question is tracked under: its own ID, else a
+// hash of its normalized text
+func QuestionID(req domain.PracticeRequest) string {
+	if id := strings.TrimSpace(req.QuestionID); id != "" {
+		return id
+	}
+	text := strings.Join(strings.Fields(strings.ToLower(req.Question)), " ")
+	sum := sha256.Sum256([]byte(text))
+	return "q-" + hex.EncodeToString(sum[:6])
+}
+
+// Record schedules the next review of a question from the score, 0-1, the
+// user's answer received
+func (s *Scheduler) Record(ctx context.Context, req domain.PracticeRequest, score float64) (*domain.ReviewCard, error) {
+	score = math.Max(0, math.Min(1, score))
+	now := time.Now()
+	id := QuestionID(req)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	card, err := s.store.Card(ctx, id)
+	if errors.Is(err, errNotFound) {
+		card = &domain.ReviewCard{
+			QuestionID: id,
+			Question:   strings.TrimSpace(req.Question),
+			Category:   req.Category,
+			Difficulty: req.Difficulty,
+			Ease:       initialEase,
+		}
+	} else if err != nil {
+		return nil, err
+	}
+	if req.Difficulty > 0 {
+		card.Difficulty = req.Difficulty
+	}
+	review(card, score, now)
+
+	if err := s.store.SaveCard(ctx, card); err != nil {
+		return nil, err
+	}
+	if err := s.store.AddActivity(ctx, now.Format(DayLayout), 1); err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// PracticeDays returns how many answers were recorded on each day since
+// since, keyed by DayLayout
+func (s *Scheduler) PracticeDays(ctx context.Context, since time.Time) (map[string]int, error) {
+	return s.store.Activity(ctx, since.Format(DayLayout))
+}
+
+// review applies one scored answer to a card. Failed answers restart the
+// card at a one-day interval; passing ones stretch the interval by its ease.
+func review(card *domain.ReviewCard, score float64, now time.Time) {
+	card.AverageScore = round2((card.AverageScore*float64(card.Attempts) + score) / float64(card.Attempts+1))
+	card.Attempts++
+	card.LastScore = round2(score)
+	card.Calibrated = calibrate(card)
+
+	quality := score * 5
+	if score < PassScore {
+		card.Streak = 0
+		card.IntervalDays = 1
+	} else {
+		card.Streak++
+		switch card.Streak {
+		case 1:
+			card.IntervalDays = 1
+		case 2:
+			card.IntervalDays = 6
+		default:
+			card.IntervalDays = int(math.Round(float64(card.IntervalDays) * card.Ease))
+		}
+	}
+	card.Ease = math.Max(minEase, round2(card.Ease+0.1-(5-quality)*(0.08+(5-quality)*0.02)))
+	if card.IntervalDays > maxIntervalDays {
+		card.IntervalDays = maxIntervalDays
+	}
+
+	card.ReviewedAt = now
+	card.DueAt = now.AddDate(0, 0, card.IntervalDays)
+}
+
+// calibrate estimates a question's difficulty, 1-5, for this user. The
+// listed difficulty counts as one prior attempt so a single score does not
+// swing it to an extreme.
+func calibrate(card *domain.ReviewCard) float64 {
+	observed := 1 + 4*(1-card.AverageScore)
+	if card.Difficulty <= 0 {
+		return round2(observed)
+	}
+	n := float64(card.Attempts)
+	return round2((float64(card.Difficulty) + observed*n) / (n + 1))
+}
+
+// Queue returns the questions due for review, weakest and most overdue
+// first. category filters when set; limit caps the result.
+func (s *Scheduler) Queue(ctx context.Context, category string, limit int) (domain.ReviewQueue, error) {
+	now := time.Now()
+	cards, err := s.store.Cards(ctx)
+	if err != nil {
+		return domain.ReviewQueue{}, err
+	}
+
+	queue := domain.ReviewQueue{Due: []domain.ReviewCard{}}
+	var next *time.Time
+	for _, card := range cards {
+		if category != "" && !strings.EqualFold(card.Category, category) {
+			continue
+		}
+		queue.Tracked++
+		if card.DueAt.After(now) {
+			if next == nil || card.DueAt.Before(*next) {
+				due := card.DueAt
+				next = &due
+			}
+			continue
+		}
+		queue.Due = append(queue.Due, card)
+	}
+
+	sort.Slice(queue.Due, func(i, j int) bool {
+		a, b := queue.Due[i], queue.Due[j]
+		if a.LastScore != b.LastScore {
+			return a.LastScore < b.LastScore
+		}
+		return a.DueAt.Before(b.DueAt)
+	})
+	queue.DueCount = len(queue.Due)
+	if limit > 0 && len(queue.Due) > limit {
+		queue.Due = queue.Due[:limit]
+	}
+	if queue.DueCount == 0 {
+		queue.NextDueAt = next
+	}
+	return queue, nil
+}
+
+// Score returns an evaluation's 0-100 score on the 0-1 scale cards use
+func Score(e *domain.PracticeEvaluation) float64 {
+	return math.Max(0, math.Min(e.Score/100, 1))
+}
+
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
@@ -0,0 +1,90 @@
+package practice
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// errNotFound is returned by a store for a question that has no review card yet
+var errNotFound = errors.New("review card not found")
+
+// Store persists review cards and the answers recorded per day
+type Store interface {
+	Card(ctx context.Context, questionID string) (*domain.ReviewCard, error)
+	SaveCard(ctx context.Context, card *domain.ReviewCard) error
+	Cards(ctx context.Context) ([]domain.ReviewCard, error)
+	// AddActivity counts n more answers on day (DayLayout)
+	AddActivity(ctx context.Context, day string, n int) error
+	// Activity returns the answers per day from day since (DayLayout) on
+	Activity(ctx context.Context, since string) (map[string]int, error)
+}
+
+// MemoryStore keeps review cards and activity in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	cards    map[string]domain.ReviewCard
+	activity map[string]int
+}
+
+// NewMemoryStore creates an in-memory review store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		cards:    make(map[string]domain.ReviewCard),
+		activity: make(map[string]int),
+	}
+}
+
+// Card returns the review card of a question
+func (m *MemoryStore) Card(ctx context.Context, questionID string) (*domain.ReviewCard, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	card, ok := m.cards[questionID]
+	if !ok {
+		return nil, errNotFound
+	}
+	return &card, nil
+}
+
+// SaveCard inserts or replaces a review card
+func (m *MemoryStore) SaveCard(ctx context.Context, card *domain.ReviewCard) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.cards[card.QuestionID] = *card
+	return nil
+}
+
+// Cards returns every review card
+func (m *MemoryStore) Cards(ctx context.Context) ([]domain.ReviewCard, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	cards := make([]domain.ReviewCard, 0, len(m.cards))
+	for _, card := range m.cards {
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+// AddActivity counts n more answers on day
+func (m *MemoryStore) AddActivity(ctx context.Context, day string, n int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.activity[day] += n
+	return nil
+}
+
+// Activity returns the answers per day since since
+func (m *MemoryStore) Activity(ctx context.Context, since string) (map[string]int, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	days := make(map[string]int)
+	for day, n := range m.activity {
+		if day >= since {
+			days[day] = n
+		}
+	}
+	return days, nil
+}
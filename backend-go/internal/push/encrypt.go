@@ -0,0 +1,104 @@
+package push
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// recordSize is the aes128gcm record size; payloads fit in one record
+const recordSize = 4096
+
+// maxPayload is the largest plaintext that fits a single record, leaving
+// room for the padding delimiter and the GCM tag
+const maxPayload = recordSize - 17
+
+// encrypt encrypts payload for a subscription as a single aes128gcm record
+// (RFC 8291)
+func encrypt(keys domain.PushKeys, payload []byte) ([]byte, error) {
+	if len(payload) > maxPayload {
+		return nil, fmt.Errorf("push payload of %d bytes exceeds %d", len(payload), maxPayload)
+	}
+
+	uaPublicBytes, err := decodeKey(keys.P256dh)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	uaPublic, err := ecdh.P256().NewPublicKey(uaPublicBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+	auth, err := decodeKey(keys.Auth)
+	if err != nil || len(auth) != 16 {
+		return nil, fmt.Errorf("invalid auth secret")
+	}
+
+	asPrivate, err := ecdh.P256().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := asPrivate.ECDH(uaPublic)
+	if err != nil {
+		return nil, err
+	}
+	asPublic := asPrivate.PublicKey().Bytes()
+
+	// IKM = HKDF(auth, ecdh_secret, "WebPush: info" || 0x00 || ua_public || as_public)
+	info := append([]byte("WebPush: info\x00"), uaPublicBytes...)
+	info = append(info, asPublic...)
+	ikm, err := expand(hkdf.Extract(sha256.New, shared, auth), info, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	cek, err := expand(prk, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := expand(prk, []byte("Content-Encoding: nonce\x00"), 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// Header: salt || record size || key ID length || key ID (as_public)
+	body := make([]byte, 0, 21+len(asPublic)+len(payload)+17)
+	body = append(body, salt...)
+	body = binary.BigEndian.AppendUint32(body, recordSize)
+	body = append(body, byte(len(asPublic)))
+	body = append(body, asPublic...)
+
+	// The last (and only) record ends with the 0x02 padding delimiter
+	plaintext := append(append([]byte{}, payload...), 0x02)
+	return gcm.Seal(body, nonce, plaintext, nil), nil
+}
+
+func expand(prk, info []byte, n int) ([]byte, error) {
+	out := make([]byte, n)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,113 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+const subscriptionColumns = `id, endpoint, p256dh, auth, user_agent, created_at,
+	delivered, failures, last_success_at, last_failure_at, last_error`
+
+// PostgresStore persists subscriptions in the push_subscriptions table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed subscription store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func scanSubscription(row pgx.Row) (*domain.PushSubscription, error) {
+	var sub domain.PushSubscription
+	d := &sub.Delivery
+	if err := row.Scan(&sub.ID, &sub.Endpoint, &sub.Keys.P256dh, &sub.Keys.Auth, &sub.UserAgent, &sub.CreatedAt,
+		&d.Delivered, &d.Failures, &d.LastSuccessAt, &d.LastFailureAt, &d.LastError); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// List returns the tenant's subscriptions, oldest first
+func (s *PostgresStore) List(ctx context.Context) ([]domain.PushSubscription, error) {
+	rows, err := s.db.Query(ctx, `SELECT `+subscriptionColumns+` FROM push_subscriptions ORDER BY created_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list push subscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subs := []domain.PushSubscription{}
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan push subscription: %w", err)
+		}
+		subs = append(subs, *sub)
+	}
+	return subs, rows.Err()
+}
+
+// Get returns a subscription
+func (s *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*domain.PushSubscription, error) {
+	sub, err := scanSubscription(s.db.QueryRow(ctx, `SELECT `+subscriptionColumns+` FROM push_subscriptions WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get push subscription: %w", err)
+	}
+	return sub, nil
+}
+
+// Save adds a subscription or updates the keys of the one with the same endpoint
+func (s *PostgresStore) Save(ctx context.Context, sub *domain.PushSubscription) error {
+	stored, err := scanSubscription(s.db.QueryRow(ctx, `
+		INSERT INTO push_subscriptions (id, endpoint, p256dh, auth, user_agent, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (tenant_id, endpoint) DO UPDATE SET
+			p256dh = EXCLUDED.p256dh, auth = EXCLUDED.auth, user_agent = EXCLUDED.user_agent
+		RETURNING `+subscriptionColumns,
+		sub.ID, sub.Endpoint, sub.Keys.P256dh, sub.Keys.Auth, sub.UserAgent, sub.CreatedAt,
+	))
+	if err != nil {
+		return fmt.Errorf("failed to save push subscription: %w", err)
+	}
+	*sub = *stored
+	return nil
+}
+
+// Delete removes a subscription
+func (s *PostgresStore) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := s.db.Exec(ctx, `DELETE FROM push_subscriptions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete push subscription: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// RecordDelivery records a delivery attempt
+func (s *PostgresStore) RecordDelivery(ctx context.Context, id uuid.UUID, at time.Time, err error) error {
+	var query string
+	args := []interface{}{id, at}
+	if err == nil {
+		query = `UPDATE push_subscriptions SET delivered = delivered + 1, failures = 0, last_success_at = $2 WHERE id = $1`
+	} else {
+		query = `UPDATE push_subscriptions SET failures = failures + 1, last_failure_at = $2, last_error = $3 WHERE id = $1`
+		args = append(args, err.Error())
+	}
+	if _, err := s.db.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("failed to record push delivery: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,218 @@
+// Package push sends notifications to subscribed browsers with Web Push,
+// so they arrive while the app's tab is closed. Payloads are encrypted for
+// each subscription (RFC 8291) and the server identifies itself with VAPID
+// (RFC 8292).
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// maxBody caps the notification body in the payload; browsers show a few
+// lines and the digest body links to the full digest
+const maxBody = 1000
+
+// ErrInvalid is returned for a malformed subscription
+var ErrInvalid = errors.New("invalid push subscription")
+
+// errGone is a push service's report that the subscription has expired or
+// was unsubscribed
+var errGone = errors.New("subscription is gone")
+
+// Service keeps the browsers' push subscriptions and delivers notifications
+// to them
+type Service struct {
+	store  Store
+	vapid  *VAPID
+	client *http.Client
+	ttl    time.Duration
+}
+
+// NewService creates a push service. Without a configured VAPID key a key
+// is generated for this process.
+func NewService(store Store, cfg config.PushConfig) (*Service, error) {
+	vapid, err := NewVAPID(cfg.VAPIDPrivateKey, cfg.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.VAPIDPrivateKey == "" {
+		logger.Warn("No VAPID key configured; push subscriptions last until restart (set PUSH_VAPID_PRIVATE_KEY)")
+	}
+	return &Service{
+		store:  store,
+		vapid:  vapid,
+		client: &http.Client{Timeout: cfg.Timeout},
+		ttl:    cfg.TTL,
+	}, nil
+}
+
+// PublicKey is the applicationServerKey browsers subscribe with
+func (s *Service) PublicKey() string {
+	return s.vapid.PublicKey()
+}
+
+// Subscribe saves a browser's subscription. Subscribing the same endpoint
+// again updates its keys.
+func (s *Service) Subscribe(ctx context.Context, in domain.PushSubscriptionCreate, userAgent string) (*domain.PushSubscription, error) {
+	endpoint := strings.TrimSpace(in.Endpoint)
+	if u, err := url.Parse(endpoint); err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("%w: endpoint must be an https URL", ErrInvalid)
+	}
+	if key, err := decodeKey(in.Keys.P256dh); err != nil || len(key) != 65 || key[0] != 0x04 {
+		return nil, fmt.Errorf("%w: keys.p256dh must be an uncompressed P-256 key", ErrInvalid)
+	}
+	if auth, err := decodeKey(in.Keys.Auth); err != nil || len(auth) != 16 {
+		return nil, fmt.Errorf("%w: keys.auth must be 16 bytes", ErrInvalid)
+	}
+
+	sub := &domain.PushSubscription{
+		ID:        uuid.New(),
+		Endpoint:  endpoint,
+		Keys:      in.Keys,
+		UserAgent: userAgent,
+		CreatedAt: time.Now(),
+	}
+	if err := s.store.Save(ctx, sub); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// Subscriptions lists the subscriptions with their delivery records
+func (s *Service) Subscriptions(ctx context.Context) ([]domain.PushSubscription, error) {
+	return s.store.List(ctx)
+}
+
+// Unsubscribe removes a subscription
+func (s *Service) Unsubscribe(ctx context.Context, id uuid.UUID) error {
+	return s.store.Delete(ctx, id)
+}
+
+// Send delivers n to every subscription, dropping the ones the push service
+// reports gone. It implements notify.Sender for the push channel.
+func (s *Service) Send(ctx context.Context, n domain.Notification) error {
+	subs, err := s.store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range subs {
+		if err := s.deliver(ctx, &subs[i], n); err != nil && !errors.Is(err, errGone) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SendTest delivers a test notification to one subscription and returns it
+// with its updated delivery record
+func (s *Service) SendTest(ctx context.Context, id uuid.UUID) (*domain.PushSubscription, error) {
+	sub, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.deliver(ctx, sub, domain.Notification{
+		Title:     "Notifications are on",
+		Body:      "New matches and reminders will appear here.",
+		URL:       "/settings",
+		CreatedAt: time.Now(),
+	})
+	if errors.Is(err, errGone) {
+		return nil, ErrNotFound
+	}
+	if sub, err = s.store.Get(ctx, id); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// deliver pushes n to one subscription and records the attempt
+func (s *Service) deliver(ctx context.Context, sub *domain.PushSubscription, n domain.Notification) error {
+	err := s.post(ctx, sub, n)
+	if errors.Is(err, errGone) {
+		if err := s.store.Delete(ctx, sub.ID); err != nil && !errors.Is(err, ErrNotFound) {
+			logger.Warn("Failed to remove expired push subscription", zap.String("subscription_id", sub.ID.String()), zap.Error(err))
+		}
+		return err
+	}
+	if rerr := s.store.RecordDelivery(ctx, sub.ID, time.Now(), err); rerr != nil {
+		logger.Warn("Failed to record push delivery", zap.String("subscription_id", sub.ID.String()), zap.Error(rerr))
+	}
+	if err != nil {
+		return fmt.Errorf("push to subscription %s: %w", sub.ID, err)
+	}
+	return nil
+}
+
+// post sends the encrypted notification to the subscription's push service
+func (s *Service) post(ctx context.Context, sub *domain.PushSubscription, n domain.Notification) error {
+	body := n.Body
+	if len(body) > maxBody {
+		body = strings.ToValidUTF8(body[:maxBody], "") + "…"
+	}
+	payload, err := json.Marshal(map[string]interface{}{
+		"event":      n.Event,
+		"title":      n.Title,
+		"body":       body,
+		"url":        n.URL,
+		"created_at": n.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+	encrypted, err := encrypt(sub.Keys, payload)
+	if err != nil {
+		return err
+	}
+	auth, err := s.vapid.Authorization(sub.Endpoint, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.Endpoint, bytes.NewReader(encrypted))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", auth)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(int(s.ttl.Seconds())))
+	urgency := "normal"
+	if n.Event == domain.NotificationReminder {
+		urgency = "high"
+	}
+	req.Header.Set("Urgency", urgency)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return errGone
+	case resp.StatusCode >= 300:
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("push service returned %d: %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+	}
+	return nil
+}
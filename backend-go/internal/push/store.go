@@ -0,0 +1,119 @@
+package push
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// ErrNotFound is returned for an unknown subscription
+var ErrNotFound = errors.New("push subscription not found")
+
+// Store persists the push subscriptions of each tenant
+type Store interface {
+	List(ctx context.Context) ([]domain.PushSubscription, error)
+	Get(ctx context.Context, id uuid.UUID) (*domain.PushSubscription, error)
+	// Save adds a subscription, or updates the keys of the one with the same
+	// endpoint, and sets sub's ID and creation time to the stored ones
+	Save(ctx context.Context, sub *domain.PushSubscription) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// RecordDelivery records a delivery attempt; err is nil on success
+	RecordDelivery(ctx context.Context, id uuid.UUID, at time.Time, err error) error
+}
+
+// MemoryStore keeps push subscriptions in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu   sync.Mutex
+	subs map[string][]domain.PushSubscription
+}
+
+// NewMemoryStore creates an in-memory subscription store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{subs: make(map[string][]domain.PushSubscription)}
+}
+
+// List returns the tenant's subscriptions, oldest first
+func (m *MemoryStore) List(ctx context.Context) ([]domain.PushSubscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]domain.PushSubscription{}, m.subs[tenant.ID(ctx)]...), nil
+}
+
+// Get returns a subscription
+func (m *MemoryStore) Get(ctx context.Context, id uuid.UUID) (*domain.PushSubscription, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, sub := range m.subs[tenant.ID(ctx)] {
+		if sub.ID == id {
+			return &sub, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// Save adds a subscription or updates the one with the same endpoint
+func (m *MemoryStore) Save(ctx context.Context, sub *domain.PushSubscription) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := tenant.ID(ctx)
+	for i, existing := range m.subs[key] {
+		if existing.Endpoint == sub.Endpoint {
+			sub.ID, sub.CreatedAt, sub.Delivery = existing.ID, existing.CreatedAt, existing.Delivery
+			m.subs[key][i] = *sub
+			return nil
+		}
+	}
+	m.subs[key] = append(m.subs[key], *sub)
+	return nil
+}
+
+// Delete removes a subscription
+func (m *MemoryStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := tenant.ID(ctx)
+	for i, sub := range m.subs[key] {
+		if sub.ID == id {
+			m.subs[key] = append(m.subs[key][:i], m.subs[key][i+1:]...)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// RecordDelivery records a delivery attempt
+func (m *MemoryStore) RecordDelivery(ctx context.Context, id uuid.UUID, at time.Time, err error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	subs := m.subs[tenant.ID(ctx)]
+	for i := range subs {
+		if subs[i].ID == id {
+			record(&subs[i].Delivery, at, err)
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// record applies a delivery attempt to the delivery info
+func record(d *domain.PushDeliveryInfo, at time.Time, err error) {
+	if err == nil {
+		d.Delivered++
+		d.Failures = 0
+		d.LastSuccessAt = &at
+		return
+	}
+	d.Failures++
+	d.LastFailureAt = &at
+	d.LastError = err.Error()
+}
@@ -0,0 +1,109 @@
+package push
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// vapidExpiry is how long a VAPID token is valid; push services reject
+// tokens valid for more than 24 hours
+const vapidExpiry = 12 * time.Hour
+
+// VAPID identifies this server to push services (RFC 8292)
+type VAPID struct {
+	key     *ecdsa.PrivateKey
+	public  string // uncompressed P-256 point, base64url
+	subject string
+}
+
+// NewVAPID loads the base64url VAPID private key, a raw P-256 scalar as
+// printed by common web-push tooling. An empty key generates a key pair for
+// this process only; browsers must subscribe again after a restart.
+func NewVAPID(privateKey, subject string) (*VAPID, error) {
+	var key *ecdsa.PrivateKey
+	if privateKey == "" {
+		var err error
+		if key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader); err != nil {
+			return nil, fmt.Errorf("failed to generate VAPID key: %w", err)
+		}
+	} else {
+		d, err := decodeKey(privateKey)
+		if err != nil || len(d) != 32 {
+			return nil, fmt.Errorf("invalid VAPID private key")
+		}
+		key = &ecdsa.PrivateKey{D: new(big.Int).SetBytes(d)}
+		key.Curve = elliptic.P256()
+		key.X, key.Y = key.Curve.ScalarBaseMult(d)
+	}
+
+	public, err := key.PublicKey.ECDH()
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+	return &VAPID{
+		key:     key,
+		public:  base64.RawURLEncoding.EncodeToString(public.Bytes()),
+		subject: subject,
+	}, nil
+}
+
+// PublicKey is the applicationServerKey browsers subscribe with
+func (v *VAPID) PublicKey() string {
+	return v.public
+}
+
+// PrivateKey is the base64url private key, for saving a generated key
+func (v *VAPID) PrivateKey() string {
+	return base64.RawURLEncoding.EncodeToString(v.key.D.FillBytes(make([]byte, 32)))
+}
+
+// Authorization returns the Authorization header for a push to endpoint
+func (v *VAPID) Authorization(endpoint string, now time.Time) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	claims := map[string]interface{}{
+		"aud": u.Scheme + "://" + u.Host,
+		"exp": now.Add(vapidExpiry).Unix(),
+	}
+	if v.subject != "" {
+		claims["sub"] = v.subject
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString([]byte(`{"typ":"JWT","alg":"ES256"}`)) + "." +
+		base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, v.key, digest[:])
+	if err != nil {
+		return "", err
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	token := unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+	return "vapid t=" + token + ", k=" + v.public, nil
+}
+
+// decodeKey decodes base64url with or without padding, as browsers and
+// tooling disagree on it
+func decodeKey(s string) ([]byte, error) {
+	s = strings.TrimRight(strings.TrimSpace(s), "=")
+	s = strings.NewReplacer("+", "-", "/", "_").Replace(s)
+	return base64.RawURLEncoding.DecodeString(s)
+}
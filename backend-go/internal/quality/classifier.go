@@ -0,0 +1,162 @@
+package quality
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// Score deductions for each heuristic
+const (
+	staffingAgencyPenalty    = 30
+	ghostJobPenalty          = 35
+	vagueRequirementsPenalty = 25
+	repeatRepostPenalty      = 15
+)
+
+// minDescriptionLength is the shortest description treated as a real posting
+const minDescriptionLength = 300
+
+// repeatRepostThreshold is the repost count at which a job looks perpetually open
+const repeatRepostThreshold = 3
+
+// knownAgencies are staffing firms whose postings are usually reposts of client roles
+var knownAgencies = []string{
+	"robert half", "teksystems", "insight global", "randstad", "kforce",
+	"apex systems", "aerotek", "cybercoders", "adecco", "manpower",
+	"kelly services", "motion recruitment", "jobot", "collabera", "infosys bpm",
+}
+
+// agencyPattern matches staffing-agency phrasing in company names and descriptions
+var agencyPattern = regexp.MustCompile(`(?i)\b(staffing|recruiting|recruitment|talent solutions)\b|` +
+	`\b(our client|on behalf of (a|our) client|c2c|corp[ -]to[ -]corp|w2 only|immediate (need|opening)s?)\b`)
+
+// requirementPattern matches phrasing that signals concrete requirements
+var requirementPattern = regexp.MustCompile(`(?i)\b(\d+\+?\s*(years|yrs)|experience (with|in)|proficien(t|cy)|` +
+	`degree in|knowledge of|familiarity with|must have|required skills|qualifications)\b`)
+
+// LLMReviewer optionally scores postings the heuristics are unsure about.
+// Score is 0-100 where higher is a more genuine posting.
+type LLMReviewer interface {
+	ReviewJobQuality(ctx context.Context, job *domain.Job) (score int, flags []domain.QualityFlag, err error)
+}
+
+// Classifier flags recruiter spam, ghost jobs and low-effort postings
+type Classifier struct {
+	cfg config.QualityConfig
+	llm LLMReviewer
+}
+
+// NewClassifier creates a classifier; llm may be nil to use heuristics only
+func NewClassifier(cfg config.QualityConfig, llm LLMReviewer) *Classifier {
+	return &Classifier{cfg: cfg, llm: llm}
+}
+
+// Classify scores a job and returns the flags that lowered its score
+func (c *Classifier) Classify(ctx context.Context, job *domain.Job, now time.Time) (int, []domain.QualityFlag) {
+	score := 100
+	flags := make([]domain.QualityFlag, 0)
+
+	if isStaffingAgency(job) {
+		score -= staffingAgencyPenalty
+		flags = append(flags, domain.QualityFlagStaffingAgency)
+	}
+	if c.isGhostJob(job, now) {
+		score -= ghostJobPenalty
+		flags = append(flags, domain.QualityFlagGhostJob)
+	} else if job.RepostCount >= repeatRepostThreshold {
+		score -= repeatRepostPenalty
+		flags = append(flags, domain.QualityFlagRepeatRepost)
+	}
+	if lacksRequirements(job) {
+		score -= vagueRequirementsPenalty
+		flags = append(flags, domain.QualityFlagVagueRequirements)
+	}
+	if score < 0 {
+		score = 0
+	}
+
+	// Only spend LLM calls on borderline postings
+	if c.llm != nil && score >= c.cfg.MinScore && score < c.cfg.LLMReviewBelow {
+		llmScore, llmFlags, err := c.llm.ReviewJobQuality(ctx, job)
+		if err != nil {
+			logger.Debug("LLM quality review failed", zap.String("job_id", job.ID.String()), zap.Error(err))
+		} else {
+			score = (score + llmScore) / 2
+			flags = mergeFlags(flags, llmFlags)
+		}
+	}
+
+	return score, flags
+}
+
+// Enrich scores freshly ingested jobs
+func (c *Classifier) Enrich(ctx context.Context, jobs []*domain.Job) {
+	now := time.Now()
+	for _, job := range jobs {
+		score, flags := c.Classify(ctx, job, now)
+		job.QualityScore = &score
+		job.QualityFlags = flags
+	}
+}
+
+// IsLowQuality reports whether a score falls below the configured threshold
+func (c *Classifier) IsLowQuality(score int) bool {
+	return score < c.cfg.MinScore
+}
+
+// isGhostJob reports whether the job has stayed open longer than GhostJobAge
+func (c *Classifier) isGhostJob(job *domain.Job, now time.Time) bool {
+	if c.cfg.GhostJobAge <= 0 || !job.IsActive {
+		return false
+	}
+	opened := job.FirstSeenAt
+	if job.PostedDate != nil && (opened == nil || job.PostedDate.Before(*opened)) {
+		opened = job.PostedDate
+	}
+	return opened != nil && now.Sub(*opened) >= c.cfg.GhostJobAge
+}
+
+// isStaffingAgency reports whether the posting comes from or reads like a staffing agency
+func isStaffingAgency(job *domain.Job) bool {
+	name := strings.ToLower(job.Company.Name)
+	for _, agency := range knownAgencies {
+		if strings.Contains(name, agency) {
+			return true
+		}
+	}
+	return agencyPattern.MatchString(job.Company.Name) || agencyPattern.MatchString(job.Description)
+}
+
+// lacksRequirements reports whether the posting has no real requirements
+func lacksRequirements(job *domain.Job) bool {
+	if len(job.Requirements) > 0 {
+		return false
+	}
+	desc := strings.TrimSpace(job.Description)
+	return len(desc) < minDescriptionLength || !requirementPattern.MatchString(desc)
+}
+
+// mergeFlags appends flags not already present
+func mergeFlags(flags, extra []domain.QualityFlag) []domain.QualityFlag {
+	for _, f := range extra {
+		found := false
+		for _, existing := range flags {
+			if existing == f {
+				found = true
+				break
+			}
+		}
+		if !found {
+			flags = append(flags, f)
+		}
+	}
+	return flags
+}
@@ -0,0 +1,89 @@
+package quality
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// backfillBatchSize is how many unscored jobs Backfill classifies per round trip
+const backfillBatchSize = 500
+
+// PostgresStore scores stored jobs in the jobs table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a quality store backed by the jobs table
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Backfill classifies stored jobs that have no quality score yet, such as
+// those ingested before scoring was enabled, and returns how many it scored
+func (s *PostgresStore) Backfill(ctx context.Context, c *Classifier) (int, error) {
+	total := 0
+	for {
+		jobs, err := s.unscored(ctx)
+		if err != nil {
+			return total, err
+		}
+		if len(jobs) == 0 {
+			return total, nil
+		}
+
+		now := time.Now()
+		batch := &pgx.Batch{}
+		for _, job := range jobs {
+			score, flags := c.Classify(ctx, job, now)
+			batch.Queue(`UPDATE jobs SET quality_score = $2, quality_flags = $3 WHERE id = $1`,
+				job.ID, score, flagStrings(flags))
+		}
+		if err := s.db.SendBatch(ctx, batch).Close(); err != nil {
+			return total, fmt.Errorf("failed to save quality scores: %w", err)
+		}
+		total += len(jobs)
+	}
+}
+
+// unscored loads the next batch of jobs without a quality score, with the
+// fields the classifier reads
+func (s *PostgresStore) unscored(ctx context.Context) ([]*domain.Job, error) {
+	rows, err := s.db.Query(ctx, `
+		SELECT j.id, coalesce(c.name, ''), coalesce(j.description, ''), j.posted_at,
+			j.first_seen_at, coalesce(j.repost_count, 0), coalesce(j.is_active, TRUE)
+		FROM jobs j
+		LEFT JOIN companies c ON c.id = j.company_id
+		WHERE j.quality_score IS NULL AND j.deleted_at IS NULL
+		ORDER BY j.id
+		LIMIT $1`, backfillBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load unscored jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*domain.Job
+	for rows.Next() {
+		job := &domain.Job{}
+		if err := rows.Scan(&job.ID, &job.Company.Name, &job.Description, &job.PostedDate,
+			&job.FirstSeenAt, &job.RepostCount, &job.IsActive); err != nil {
+			return nil, fmt.Errorf("failed to scan unscored job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, rows.Err()
+}
+
+// flagStrings converts flags for a text[] argument
+func flagStrings(flags []domain.QualityFlag) []string {
+	out := make([]string, len(flags))
+	for i, f := range flags {
+		out[i] = string(f)
+	}
+	return out
+}
@@ -0,0 +1,77 @@
+package questions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists company questions in the company_questions table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed question store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const questionColumns = `id, company, question, category, round, role, source, reports, last_reported_at`
+
+// Add records a report of q, merging it into an existing row for the same question
+func (p *PostgresStore) Add(ctx context.Context, q *domain.CompanyQuestion) (*domain.CompanyQuestion, error) {
+	var out domain.CompanyQuestion
+	err := p.db.QueryRow(ctx, `
+		INSERT INTO company_questions (`+questionColumns+`, company_key, question_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (company_key, question_key) DO UPDATE SET
+			reports = company_questions.reports + EXCLUDED.reports,
+			last_reported_at = GREATEST(company_questions.last_reported_at, EXCLUDED.last_reported_at),
+			category = COALESCE(NULLIF(company_questions.category, ''), EXCLUDED.category),
+			round = COALESCE(NULLIF(company_questions.round, ''), EXCLUDED.round),
+			role = COALESCE(NULLIF(company_questions.role, ''), EXCLUDED.role),
+			source = CASE WHEN EXCLUDED.source = 'user' THEN 'user' ELSE company_questions.source END
+		RETURNING `+questionColumns,
+		q.ID, q.Company, q.Question, q.Category, q.Round, q.Role, q.Source, q.Reports, q.LastReportedAt,
+		domain.NormalizeCompanyName(q.Company), questionKey(q.Question),
+	).Scan(&out.ID, &out.Company, &out.Question, &out.Category, &out.Round, &out.Role, &out.Source, &out.Reports, &out.LastReportedAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record company question: %w", err)
+	}
+	return &out, nil
+}
+
+// List returns a company's questions matching filter, most reported first
+func (p *PostgresStore) List(ctx context.Context, company string, filter domain.CompanyQuestionFilter) ([]domain.CompanyQuestion, error) {
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = 1000
+	}
+	rows, err := p.db.Query(ctx, `
+		SELECT `+questionColumns+` FROM company_questions
+		WHERE company_key = $1
+		  AND ($2 = '' OR category = $2)
+		  AND ($3 = '' OR round = $3)
+		  AND ($4 = '' OR role = '' OR LOWER(role) = LOWER($4))
+		ORDER BY reports DESC, last_reported_at DESC
+		LIMIT $5`,
+		domain.NormalizeCompanyName(company), filter.Category, filter.Round, filter.Role, limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list company questions: %w", err)
+	}
+	defer rows.Close()
+
+	list := []domain.CompanyQuestion{}
+	for rows.Next() {
+		var q domain.CompanyQuestion
+		if err := rows.Scan(&q.ID, &q.Company, &q.Question, &q.Category, &q.Round, &q.Role, &q.Source, &q.Reports, &q.LastReportedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan company question: %w", err)
+		}
+		list = append(list, q)
+	}
+	return list, rows.Err()
+}
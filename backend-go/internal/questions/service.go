@@ -0,0 +1,195 @@
+package questions
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/stories"
+)
+
+// ErrInvalid is returned for a question report that cannot be recorded
+var ErrInvalid = errors.New("invalid question report")
+
+// Prep plan limits
+const (
+	planQuestions      = 15
+	storiesPerQuestion = 2
+)
+
+// StoryRetriever finds story bank stories relevant to a question
+type StoryRetriever interface {
+	Relevant(ctx context.Context, question string, limit int) ([]domain.StoryMatch, error)
+}
+
+// Service aggregates interview questions reported per company from a seed
+// dataset and user submissions
+type Service struct {
+	store Store
+}
+
+// NewService creates a company question service
+func NewService(store Store) *Service {
+	return &Service{store: store}
+}
+
+// Report records a question the user was asked at company
+func (s *Service) Report(ctx context.Context, company string, report domain.CompanyQuestionReport) (*domain.CompanyQuestion, error) {
+	if strings.TrimSpace(company) == "" || strings.TrimSpace(report.Question) == "" {
+		return nil, fmt.Errorf("%w: company and question are required", ErrInvalid)
+	}
+	q := newQuestion(company, report, domain.QuestionSourceUser)
+	q.LastReportedAt = time.Now()
+	return s.store.Add(ctx, q)
+}
+
+// ForCompany returns the questions reported at company, most reported first
+func (s *Service) ForCompany(ctx context.Context, company string, filter domain.CompanyQuestionFilter) ([]domain.CompanyQuestion, error) {
+	filter.Category = strings.ToLower(filter.Category)
+	filter.Round = strings.ToLower(filter.Round)
+	return s.store.List(ctx, company, filter)
+}
+
+// ImportFile loads seed questions from a CSV file
+func (s *Service) ImportFile(path string) (int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open questions file: %w", err)
+	}
+	defer f.Close()
+	return s.Import(f)
+}
+
+// Import merges seed questions from a CSV with columns
+// company,question[,category[,round[,role[,reports]]]]
+func (s *Service) Import(r io.Reader) (int, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return 0, fmt.Errorf("failed to read questions header: %w", err)
+	}
+	cols := make(map[string]int, len(header))
+	for i, name := range header {
+		cols[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	companyCol, ok := cols["company"]
+	if !ok {
+		return 0, errors.New(`questions file is missing column "company"`)
+	}
+	questionCol, ok := cols["question"]
+	if !ok {
+		return 0, errors.New(`questions file is missing column "question"`)
+	}
+	field := func(record []string, name string) string {
+		if i, ok := cols[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	now := time.Now()
+	var imported []*domain.CompanyQuestion
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to parse questions at line %d: %w", line, err)
+		}
+		if companyCol >= len(record) || questionCol >= len(record) ||
+			strings.TrimSpace(record[companyCol]) == "" || strings.TrimSpace(record[questionCol]) == "" {
+			continue
+		}
+
+		q := newQuestion(record[companyCol], domain.CompanyQuestionReport{
+			Question: record[questionCol],
+			Category: field(record, "category"),
+			Round:    field(record, "round"),
+			Role:     field(record, "role"),
+		}, domain.QuestionSourceSeed)
+		q.LastReportedAt = now
+		if reports := field(record, "reports"); reports != "" {
+			n, err := strconv.Atoi(reports)
+			if err != nil || n < 1 {
+				return 0, fmt.Errorf("invalid reports at line %d: %q", line, reports)
+			}
+			q.Reports = n
+		}
+		imported = append(imported, q)
+	}
+
+	ctx := context.Background()
+	for _, q := range imported {
+		if _, err := s.store.Add(ctx, q); err != nil {
+			return 0, err
+		}
+	}
+	return len(imported), nil
+}
+
+// PrepPlan builds interview prep for an application from the questions
+// reported at its company, pairing behavioral questions with story bank
+// stories when retriever is set
+func (s *Service) PrepPlan(ctx context.Context, app *domain.Application, retriever StoryRetriever) (*domain.PrepPlan, error) {
+	list, err := s.store.List(ctx, app.Job.CompanyName, domain.CompanyQuestionFilter{Role: app.Job.Title, Limit: planQuestions})
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &domain.PrepPlan{
+		ApplicationID: app.ID,
+		Company:       app.Job.CompanyName,
+		Role:          app.Job.Title,
+		Questions:     make([]domain.PrepQuestion, 0, len(list)),
+		Categories:    make(map[string]int),
+		Competencies:  []domain.Competency{},
+		Uncovered:     []domain.Competency{},
+		GeneratedAt:   time.Now(),
+	}
+
+	wanted := make(map[domain.Competency]bool)
+	covered := make(map[domain.Competency]bool)
+	for _, q := range list {
+		pq := domain.PrepQuestion{CompanyQuestion: q, Competencies: stories.Classify(q.Question)}
+		for _, c := range pq.Competencies {
+			wanted[c] = true
+		}
+		if retriever != nil && len(pq.Competencies) > 0 {
+			if matches, err := retriever.Relevant(ctx, q.Question, storiesPerQuestion); err == nil {
+				pq.Stories = matches
+				for _, m := range matches {
+					for _, c := range m.Matched {
+						covered[c] = true
+					}
+				}
+			}
+		}
+		category := q.Category
+		if category == "" {
+			category = "other"
+		}
+		plan.Categories[category]++
+		plan.Questions = append(plan.Questions, pq)
+	}
+
+	// Keep competencies in their canonical order
+	for _, c := range domain.Competencies {
+		if wanted[c] {
+			plan.Competencies = append(plan.Competencies, c)
+			if !covered[c] {
+				plan.Uncovered = append(plan.Uncovered, c)
+			}
+		}
+	}
+	return plan, nil
+}
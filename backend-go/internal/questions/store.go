@@ -0,0 +1,128 @@
+package questions
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Store persists company interview questions, merging repeat reports
+type Store interface {
+	// Add records a report of q, or counts it against an existing report of
+	// the same question at the same company
+	Add(ctx context.Context, q *domain.CompanyQuestion) (*domain.CompanyQuestion, error)
+	List(ctx context.Context, company string, filter domain.CompanyQuestionFilter) ([]domain.CompanyQuestion, error)
+}
+
+// questionKey reduces a question to a matching key, so rewordings that only
+// differ in case or punctuation merge
+func questionKey(question string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(question), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
+
+// MemoryStore keeps company questions in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu        sync.RWMutex
+	questions map[string]map[string]*domain.CompanyQuestion // company key -> question key -> question
+}
+
+// NewMemoryStore creates an in-memory question store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{questions: make(map[string]map[string]*domain.CompanyQuestion)}
+}
+
+// Add records a report of q
+func (m *MemoryStore) Add(ctx context.Context, q *domain.CompanyQuestion) (*domain.CompanyQuestion, error) {
+	company := domain.NormalizeCompanyName(q.Company)
+	key := questionKey(q.Question)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	byQuestion, ok := m.questions[company]
+	if !ok {
+		byQuestion = make(map[string]*domain.CompanyQuestion)
+		m.questions[company] = byQuestion
+	}
+	existing, ok := byQuestion[key]
+	if !ok {
+		stored := *q
+		byQuestion[key] = &stored
+		return &stored, nil
+	}
+
+	merge(existing, q)
+	result := *existing
+	return &result, nil
+}
+
+// List returns a company's questions matching filter, most reported first
+func (m *MemoryStore) List(ctx context.Context, company string, filter domain.CompanyQuestionFilter) ([]domain.CompanyQuestion, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	list := []domain.CompanyQuestion{}
+	for _, q := range m.questions[domain.NormalizeCompanyName(company)] {
+		if matches(q, filter) {
+			list = append(list, *q)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].Reports != list[j].Reports {
+			return list[i].Reports > list[j].Reports
+		}
+		return list[i].LastReportedAt.After(list[j].LastReportedAt)
+	})
+	if filter.Limit > 0 && len(list) > filter.Limit {
+		list = list[:filter.Limit]
+	}
+	return list, nil
+}
+
+// merge counts a repeat report against an existing question, filling in
+// details the first report lacked. A user report outranks a seed entry.
+func merge(existing, report *domain.CompanyQuestion) {
+	existing.Reports += report.Reports
+	if report.LastReportedAt.After(existing.LastReportedAt) {
+		existing.LastReportedAt = report.LastReportedAt
+	}
+	if existing.Category == "" {
+		existing.Category = report.Category
+	}
+	if existing.Round == "" {
+		existing.Round = report.Round
+	}
+	if existing.Role == "" {
+		existing.Role = report.Role
+	}
+	if report.Source == domain.QuestionSourceUser {
+		existing.Source = domain.QuestionSourceUser
+	}
+}
+
+func matches(q *domain.CompanyQuestion, f domain.CompanyQuestionFilter) bool {
+	return (f.Category == "" || strings.EqualFold(q.Category, f.Category)) &&
+		(f.Round == "" || strings.EqualFold(q.Round, f.Round)) &&
+		(f.Role == "" || q.Role == "" || strings.EqualFold(q.Role, f.Role))
+}
+
+// newQuestion builds a single report of a question
+func newQuestion(company string, report domain.CompanyQuestionReport, source domain.QuestionSource) *domain.CompanyQuestion {
+	return &domain.CompanyQuestion{
+		ID:       uuid.New(),
+		Company:  strings.TrimSpace(company),
+		Question: strings.TrimSpace(report.Question),
+		Category: strings.ToLower(strings.TrimSpace(report.Category)),
+		Round:    strings.ToLower(strings.TrimSpace(report.Round)),
+		Role:     strings.TrimSpace(report.Role),
+		Source:   source,
+		Reports:  1,
+	}
+}
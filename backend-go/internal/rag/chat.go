@@ -0,0 +1,241 @@
+package rag
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/llm"
+	"github.com/resume-rag/backend/internal/mlclient"
+	"github.com/resume-rag/backend/internal/vectorstore"
+)
+
+// preferredBoost raises the vector score of chunks from a mode's preferred
+// sections when there is no reranker to order them
+const preferredBoost = 1.15
+
+// History search result limits
+const (
+	defaultSearchResults = 20
+	maxSearchResults     = 100
+)
+
+// Completer sends prompts to an LLM backend, streaming the reply to onText
+// when it is set
+type Completer interface {
+	Stream(ctx context.Context, backend string, req llm.Request, onText func(string) error) (*llm.Completion, error)
+}
+
+// Reranker scores passages against a query with a cross-encoder
+type Reranker interface {
+	Rerank(ctx context.Context, query string, documents []mlclient.Document, topK int) ([]mlclient.RankedDocument, error)
+}
+
+// Redactor strips personal details from resume excerpts bound for an LLM backend
+type Redactor interface {
+	Backend(ctx context.Context) string
+	Excerpts(ctx context.Context, resume string, excerpts []string) []string
+}
+
+// ChatService answers chat messages from the candidate's resume
+type ChatService struct {
+	index    *ResumeIndex
+	reranker Reranker
+	llm      Completer
+	redactor Redactor
+	history  HistoryStore
+	cfg      config.ChatConfig
+}
+
+// NewChatService creates a RAG chat service. reranker and redactor may be nil,
+// in which case chunks keep their vector order and excerpts are sent as is.
+func NewChatService(index *ResumeIndex, reranker Reranker, completer Completer, redactor Redactor, history HistoryStore, cfg config.ChatConfig) *ChatService {
+	return &ChatService{
+		index:    index,
+		reranker: reranker,
+		llm:      completer,
+		redactor: redactor,
+		history:  history,
+		cfg:      cfg,
+	}
+}
+
+// Chat retrieves the resume chunks relevant to the message, asks the LLM
+// with the mode's prompt, and reports which chunks the answer cites and how
+// much of it they support
+func (s *ChatService) Chat(ctx context.Context, req domain.ChatRequest) (*domain.ChatResponse, error) {
+	return s.answer(ctx, req, nil)
+}
+
+// ChatStream answers like Chat, passing the answer to onText as the LLM
+// produces it. In rewrite mode that is the raw JSON reply; the returned
+// response carries the parsed rewrites.
+func (s *ChatService) ChatStream(ctx context.Context, req domain.ChatRequest, onText func(string) error) (*domain.ChatResponse, error) {
+	return s.answer(ctx, req, onText)
+}
+
+func (s *ChatService) answer(ctx context.Context, req domain.ChatRequest, onText func(string) error) (*domain.ChatResponse, error) {
+	start := time.Now()
+	profile := domain.GetModeProfile(req.Mode)
+
+	sessionID := uuid.New()
+	if req.SessionID != nil {
+		if id, err := uuid.Parse(*req.SessionID); err == nil {
+			sessionID = id
+		}
+	}
+	var history []domain.ChatMessage
+	session, err := s.history.Session(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chat session: %w", err)
+	}
+	if session != nil {
+		history = session.Messages[max(len(session.Messages)-s.cfg.HistoryTurns, 0):]
+	}
+
+	excerpts, err := s.retrieve(ctx, retrievalQuery(req), profile)
+	if err != nil {
+		return nil, err
+	}
+	texts, sections := make([]string, len(excerpts)), make([]string, len(excerpts))
+	for i, e := range excerpts {
+		texts[i], sections[i] = e.Text, e.Section
+	}
+	backend := ""
+	if s.redactor != nil {
+		resume, err := s.index.ResumeText(ctx)
+		if err != nil {
+			return nil, err
+		}
+		texts = s.redactor.Excerpts(ctx, resume, texts)
+		backend = s.redactor.Backend(ctx)
+	}
+
+	completion, err := s.llm.Stream(ctx, backend, prompt(req, profile, texts, sections, history), onText)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &domain.ChatResponse{
+		Response:   completion.Text,
+		Mode:       profile.Mode,
+		SearchMode: "vector",
+		SessionID:  sessionID.String(),
+	}
+	if req.Mode == domain.ChatModeRewrite {
+		resp.Response, resp.Rewrites = parseRewrites(completion.Text)
+	}
+	resp.Citations = citations(resp.Response, excerpts)
+	score := groundingScore(resp.Response, excerpts)
+	resp.GroundingScore = &score
+
+	now := time.Now()
+	err = s.history.Append(ctx, sessionID, profile.Mode,
+		domain.ChatMessage{ID: uuid.New(), Role: "user", Content: req.Message, CreatedAt: start},
+		domain.ChatMessage{ID: uuid.New(), Role: "assistant", Content: resp.Response, Citations: resp.Citations, GroundingScore: resp.GroundingScore, CreatedAt: now},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save chat session: %w", err)
+	}
+
+	resp.ProcessingTimeMs = time.Since(start).Milliseconds()
+	return resp, nil
+}
+
+// retrieve returns the profile's TopK chunks for query. Three times as many
+// candidates are fetched so the reranker, or the preferred-section boost, can
+// reorder them.
+func (s *ChatService) retrieve(ctx context.Context, query string, profile domain.ChatModeProfile) ([]vectorstore.ResumeChunkMatch, error) {
+	candidates, err := s.index.Search(ctx, query, profile.TopK*3)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.reranker != nil {
+		docs := make([]mlclient.Document, len(candidates))
+		for i, c := range candidates {
+			docs[i] = mlclient.Document{ID: strconv.Itoa(i), Content: c.Text, Score: float32(c.Score)}
+		}
+		ranked, err := s.reranker.Rerank(ctx, query, docs, profile.TopK)
+		if err == nil {
+			excerpts := make([]vectorstore.ResumeChunkMatch, 0, len(ranked))
+			for _, doc := range ranked {
+				if i, err := strconv.Atoi(doc.ID); err == nil && i >= 0 && i < len(candidates) {
+					excerpt := candidates[i]
+					excerpt.Score = float64(doc.Score)
+					excerpts = append(excerpts, excerpt)
+				}
+			}
+			return excerpts, nil
+		}
+		// Fall back to vector order when the cross-encoder is unavailable
+	}
+
+	preferred := make(map[string]bool, len(profile.PreferredSections))
+	for _, section := range profile.PreferredSections {
+		preferred[section] = true
+	}
+	boosted := func(c vectorstore.ResumeChunkMatch) float64 {
+		if preferred[strings.ToLower(c.Section)] {
+			return c.Score * preferredBoost
+		}
+		return c.Score
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return boosted(candidates[i]) > boosted(candidates[j]) })
+	return candidates[:min(profile.TopK, len(candidates))], nil
+}
+
+// GetSuggestions returns the suggested prompts for a mode
+func (s *ChatService) GetSuggestions(ctx context.Context, mode domain.ChatMode) (*domain.ChatSuggestionsResponse, error) {
+	return &domain.ChatSuggestionsResponse{
+		Suggestions: domain.GetDefaultSuggestions(mode),
+		Mode:        mode,
+	}, nil
+}
+
+// GetHistory returns one session, or the most recently active sessions
+func (s *ChatService) GetHistory(ctx context.Context, sessionID *uuid.UUID, limit int) (*domain.ChatHistoryResponse, error) {
+	sessions := []domain.ChatSession{}
+	if sessionID != nil {
+		session, err := s.history.Session(ctx, *sessionID)
+		if err != nil {
+			return nil, err
+		}
+		if session != nil {
+			sessions = append(sessions, *session)
+		}
+	} else {
+		list, err := s.history.Sessions(ctx, limit)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, list...)
+	}
+	return &domain.ChatHistoryResponse{Sessions: sessions, Total: len(sessions)}, nil
+}
+
+// ClearHistory deletes one session, or all of them
+func (s *ChatService) ClearHistory(ctx context.Context, sessionID *uuid.UUID) error {
+	return s.history.Clear(ctx, sessionID)
+}
+
+// SearchHistory finds messages matching query, returning at most
+// maxSearchResults of them (defaultSearchResults when limit is not positive)
+func (s *ChatService) SearchHistory(ctx context.Context, query string, limit int) (*domain.ChatSearchResponse, error) {
+	if limit <= 0 {
+		limit = defaultSearchResults
+	}
+	limit = min(limit, maxSearchResults)
+	results, err := s.history.Search(ctx, query, limit)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ChatSearchResponse{Query: query, Results: results, Total: len(results)}, nil
+}
@@ -0,0 +1,129 @@
+package rag
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/vectorstore"
+)
+
+const (
+	// supportedOverlap is the share of a sentence's terms an excerpt must
+	// contain for the sentence to count as grounded
+	supportedOverlap = 0.5
+	// minClaimTerms skips sentences too short to make a claim, e.g. "Sure!"
+	minClaimTerms = 3
+)
+
+// citationMarker matches [1] or [1, 3] in an answer
+var citationMarker = regexp.MustCompile(`\[(\d+(?:\s*,\s*\d+)*)\]`)
+
+// stopTerms are left out when comparing an answer with the resume
+var stopTerms = map[string]bool{
+	"a": true, "an": true, "and": true, "or": true, "the": true, "of": true, "in": true, "on": true,
+	"to": true, "for": true, "with": true, "as": true, "at": true, "by": true, "from": true, "is": true,
+	"are": true, "was": true, "were": true, "be": true, "been": true, "it": true, "its": true, "this": true,
+	"that": true, "these": true, "those": true, "you": true, "your": true, "i": true, "my": true, "me": true,
+	"we": true, "our": true, "they": true, "their": true, "he": true, "she": true, "his": true, "her": true,
+	"has": true, "have": true, "had": true, "will": true, "would": true, "can": true, "could": true,
+	"should": true, "which": true, "who": true, "also": true, "such": true, "into": true, "about": true,
+}
+
+// citations returns the excerpts the answer cites by number, or every
+// excerpt when it cites none
+func citations(answer string, excerpts []vectorstore.ResumeChunkMatch) []domain.Citation {
+	cited := make(map[int]bool)
+	for _, m := range citationMarker.FindAllStringSubmatch(answer, -1) {
+		for _, part := range strings.Split(m[1], ",") {
+			if n, err := strconv.Atoi(strings.TrimSpace(part)); err == nil && n >= 1 && n <= len(excerpts) {
+				cited[n-1] = true
+			}
+		}
+	}
+
+	list := make([]domain.Citation, 0, len(excerpts))
+	for i, e := range excerpts {
+		if len(cited) > 0 && !cited[i] {
+			continue
+		}
+		list = append(list, domain.Citation{
+			Section:        e.Section,
+			Text:           e.Text,
+			RelevanceScore: math.Round(e.Score*1000) / 1000,
+		})
+	}
+	return list
+}
+
+// groundingScore is the share of the answer's sentences whose terms mostly
+// appear in one of the excerpts. Answers without any claim score 1.
+func groundingScore(answer string, excerpts []vectorstore.ResumeChunkMatch) float64 {
+	sources := make([]map[string]bool, len(excerpts))
+	for i, e := range excerpts {
+		sources[i] = termSet(e.Text)
+	}
+
+	claims, grounded := 0, 0
+	for _, sentence := range sentences(citationMarker.ReplaceAllString(answer, "")) {
+		terms := termSet(sentence)
+		if len(terms) < minClaimTerms {
+			continue
+		}
+		claims++
+		for _, source := range sources {
+			if overlap(terms, source) >= supportedOverlap {
+				grounded++
+				break
+			}
+		}
+	}
+	if claims == 0 {
+		return 1
+	}
+	return math.Round(float64(grounded)/float64(claims)*100) / 100
+}
+
+// overlap is the share of terms found in source
+func overlap(terms, source map[string]bool) float64 {
+	found := 0
+	for t := range terms {
+		if source[t] {
+			found++
+		}
+	}
+	return float64(found) / float64(len(terms))
+}
+
+// sentences splits text at sentence ends and line breaks
+func sentences(text string) []string {
+	var out []string
+	for _, line := range strings.Split(text, "\n") {
+		start := 0
+		for i := 0; i < len(line); i++ {
+			end := line[i] == '.' || line[i] == '!' || line[i] == '?'
+			if end && (i+1 == len(line) || line[i+1] == ' ') {
+				out = append(out, line[start:i+1])
+				start = i + 1
+			}
+		}
+		out = append(out, line[start:])
+	}
+	return out
+}
+
+// termSet returns the lowercased content words of text
+func termSet(text string) map[string]bool {
+	terms := make(map[string]bool)
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '+' && r != '#'
+	}) {
+		if len(word) > 1 && !stopTerms[word] {
+			terms[word] = true
+		}
+	}
+	return terms
+}
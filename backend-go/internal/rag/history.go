@@ -0,0 +1,160 @@
+package rag
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// HistoryStore persists chat sessions and their messages
+type HistoryStore interface {
+	// Session returns a session with its messages, or nil when unknown
+	Session(ctx context.Context, id uuid.UUID) (*domain.ChatSession, error)
+	// Append adds messages to a session, starting it in mode when new
+	Append(ctx context.Context, id uuid.UUID, mode domain.ChatMode, messages ...domain.ChatMessage) error
+	// Sessions returns up to limit sessions with their messages, most recently active first
+	Sessions(ctx context.Context, limit int) ([]domain.ChatSession, error)
+	// Clear deletes a session, or every session when id is nil
+	Clear(ctx context.Context, id *uuid.UUID) error
+	// Search returns up to limit messages matching query, best ranked first
+	// and newest first among equal ranks
+	Search(ctx context.Context, query string, limit int) ([]domain.ChatSearchResult, error)
+}
+
+// MemoryHistory keeps chat sessions in memory, per tenant.
+// Used when no database is connected.
+type MemoryHistory struct {
+	mu       sync.RWMutex
+	sessions map[string]map[uuid.UUID]*domain.ChatSession
+}
+
+// NewMemoryHistory creates an in-memory chat history
+func NewMemoryHistory() *MemoryHistory {
+	return &MemoryHistory{sessions: make(map[string]map[uuid.UUID]*domain.ChatSession)}
+}
+
+// Session returns a copy of a session, or nil
+func (m *MemoryHistory) Session(ctx context.Context, id uuid.UUID) (*domain.ChatSession, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s, ok := m.sessions[tenant.ID(ctx)][id]
+	if !ok {
+		return nil, nil
+	}
+	return copySession(s), nil
+}
+
+// Append adds messages to a session
+func (m *MemoryHistory) Append(ctx context.Context, id uuid.UUID, mode domain.ChatMode, messages ...domain.ChatMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	tenantID := tenant.ID(ctx)
+	if m.sessions[tenantID] == nil {
+		m.sessions[tenantID] = make(map[uuid.UUID]*domain.ChatSession)
+	}
+	now := time.Now()
+	s, ok := m.sessions[tenantID][id]
+	if !ok {
+		s = &domain.ChatSession{ID: id, Mode: mode, CreatedAt: now}
+		m.sessions[tenantID][id] = s
+	}
+	for _, msg := range messages {
+		msg.SessionID = id
+		s.Messages = append(s.Messages, msg)
+	}
+	s.UpdatedAt = now
+	return nil
+}
+
+// Sessions returns up to limit sessions, most recently active first
+func (m *MemoryHistory) Sessions(ctx context.Context, limit int) ([]domain.ChatSession, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	sessions := make([]domain.ChatSession, 0, len(m.sessions[tenant.ID(ctx)]))
+	for _, s := range m.sessions[tenant.ID(ctx)] {
+		sessions = append(sessions, *copySession(s))
+	}
+	sort.Slice(sessions, func(i, j int) bool { return sessions[i].UpdatedAt.After(sessions[j].UpdatedAt) })
+	if limit > 0 && len(sessions) > limit {
+		sessions = sessions[:limit]
+	}
+	return sessions, nil
+}
+
+// Clear deletes one session or all of them
+func (m *MemoryHistory) Clear(ctx context.Context, id *uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if id == nil {
+		delete(m.sessions, tenant.ID(ctx))
+		return nil
+	}
+	delete(m.sessions[tenant.ID(ctx)], *id)
+	return nil
+}
+
+// Search matches query case-insensitively against message content
+func (m *MemoryHistory) Search(ctx context.Context, query string, limit int) ([]domain.ChatSearchResult, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	needle := strings.ToLower(strings.TrimSpace(query))
+	results := make([]domain.ChatSearchResult, 0)
+	for _, s := range m.sessions[tenant.ID(ctx)] {
+		for _, msg := range s.Messages {
+			at := strings.Index(strings.ToLower(msg.Content), needle)
+			if needle == "" || at < 0 {
+				continue
+			}
+			results = append(results, domain.ChatSearchResult{
+				Message:     msg,
+				SessionID:   s.ID,
+				SessionMode: s.Mode,
+				Snippet:     snippet(msg.Content, at, len(needle)),
+				Rank:        float64(strings.Count(strings.ToLower(msg.Content), needle)),
+			})
+		}
+	}
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].Rank != results[j].Rank {
+			return results[i].Rank > results[j].Rank
+		}
+		return results[i].Message.CreatedAt.After(results[j].Message.CreatedAt)
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	return results, nil
+}
+
+func copySession(s *domain.ChatSession) *domain.ChatSession {
+	c := *s
+	c.Messages = append([]domain.ChatMessage(nil), s.Messages...)
+	return &c
+}
+
+// snippet returns the text around a match, marked like Postgres ts_headline
+func snippet(content string, at, length int) string {
+	const around = 60
+	if at+length > len(content) { // lowercasing changed the byte length
+		return content[:min(2*around, len(content))]
+	}
+	start, end := max(at-around, 0), min(at+length+around, len(content))
+	s := content[start:at] + "<b>" + content[at:at+length] + "</b>" + content[at+length:end]
+	if start > 0 {
+		s = "…" + s
+	}
+	if end < len(content) {
+		s += "…"
+	}
+	return s
+}
@@ -0,0 +1,204 @@
+package rag
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresHistory persists chat sessions in chat_sessions and chat_messages,
+// searched through the messages' full-text search_vector
+type PostgresHistory struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresHistory creates a Postgres-backed chat history
+func NewPostgresHistory(db *pgxpool.Pool) *PostgresHistory {
+	return &PostgresHistory{db: db}
+}
+
+const messageColumns = `m.id, m.session_id, m.role, m.content, m.citations, m.grounding_score, m.created_at`
+
+// Session returns a session with its messages, or nil
+func (p *PostgresHistory) Session(ctx context.Context, id uuid.UUID) (*domain.ChatSession, error) {
+	sessions, err := p.load(ctx, `WHERE id = $1`, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, nil
+	}
+	return &sessions[0], nil
+}
+
+// Append adds messages to a session, starting it when new
+func (p *PostgresHistory) Append(ctx context.Context, id uuid.UUID, mode domain.ChatMode, messages ...domain.ChatMessage) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin chat history transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO chat_sessions (id, mode, message_count)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (id) DO UPDATE SET
+			message_count = chat_sessions.message_count + EXCLUDED.message_count,
+			updated_at = NOW()`, id, string(mode), len(messages)); err != nil {
+		return fmt.Errorf("failed to save chat session: %w", err)
+	}
+
+	now := time.Now()
+	for _, msg := range messages {
+		if msg.ID == uuid.Nil {
+			msg.ID = uuid.New()
+		}
+		if msg.CreatedAt.IsZero() {
+			msg.CreatedAt = now
+		}
+		if msg.Citations == nil {
+			msg.Citations = []domain.Citation{}
+		}
+		citations, err := json.Marshal(msg.Citations)
+		if err != nil {
+			return fmt.Errorf("failed to encode citations: %w", err)
+		}
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO chat_messages (id, session_id, role, content, citations, grounding_score, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+			msg.ID, id, msg.Role, msg.Content, citations, msg.GroundingScore, msg.CreatedAt); err != nil {
+			return fmt.Errorf("failed to save chat message: %w", err)
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// Sessions returns up to limit sessions with their messages, most recently
+// active first
+func (p *PostgresHistory) Sessions(ctx context.Context, limit int) ([]domain.ChatSession, error) {
+	if limit <= 0 {
+		return p.load(ctx, `ORDER BY updated_at DESC`)
+	}
+	return p.load(ctx, `ORDER BY updated_at DESC LIMIT $1`, limit)
+}
+
+// Clear deletes one session or all of them; their messages cascade
+func (p *PostgresHistory) Clear(ctx context.Context, id *uuid.UUID) error {
+	if _, err := p.db.Exec(ctx, `DELETE FROM chat_sessions WHERE $1::uuid IS NULL OR id = $1`, id); err != nil {
+		return fmt.Errorf("failed to clear chat history: %w", err)
+	}
+	return nil
+}
+
+// Search finds messages matching query with plainto_tsquery, best ranked
+// first, with the matched words highlighted in the snippet
+func (p *PostgresHistory) Search(ctx context.Context, query string, limit int) ([]domain.ChatSearchResult, error) {
+	rows, err := p.db.Query(ctx, `
+		SELECT `+messageColumns+`, s.mode,
+			ts_headline('english', m.content, q, 'StartSel=<b>, StopSel=</b>, MaxFragments=1, MaxWords=30, MinWords=10'),
+			ts_rank(m.search_vector, q)
+		FROM chat_messages m
+		JOIN chat_sessions s ON s.id = m.session_id,
+			plainto_tsquery('english', $1) q
+		WHERE m.search_vector @@ q
+		ORDER BY 10 DESC, m.created_at DESC
+		LIMIT $2`, query, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search chat history: %w", err)
+	}
+	defer rows.Close()
+
+	results := make([]domain.ChatSearchResult, 0)
+	for rows.Next() {
+		var r domain.ChatSearchResult
+		var mode string
+		var rank float32
+		if err := scanMessage(rows, &r.Message, &mode, &r.Snippet, &rank); err != nil {
+			return nil, fmt.Errorf("failed to scan chat search result: %w", err)
+		}
+		r.SessionID = r.Message.SessionID
+		r.SessionMode = domain.ChatMode(mode)
+		r.Rank = float64(rank)
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
+
+// load reads the sessions selected by clause, then their messages in order
+func (p *PostgresHistory) load(ctx context.Context, clause string, args ...interface{}) ([]domain.ChatSession, error) {
+	rows, err := p.db.Query(ctx, `
+		SELECT id, coalesce(mode, ''), created_at, updated_at
+		FROM chat_sessions `+clause, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chat sessions: %w", err)
+	}
+	defer rows.Close()
+
+	sessions := make([]domain.ChatSession, 0)
+	index := make(map[uuid.UUID]int)
+	ids := make([]uuid.UUID, 0)
+	for rows.Next() {
+		var s domain.ChatSession
+		var mode string
+		if err := rows.Scan(&s.ID, &mode, &s.CreatedAt, &s.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan chat session: %w", err)
+		}
+		s.Mode = domain.ChatMode(mode)
+		index[s.ID] = len(sessions)
+		ids = append(ids, s.ID)
+		sessions = append(sessions, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return sessions, nil
+	}
+
+	msgRows, err := p.db.Query(ctx, `
+		SELECT `+messageColumns+` FROM chat_messages m
+		WHERE m.session_id = ANY($1)
+		ORDER BY m.created_at`, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chat messages: %w", err)
+	}
+	defer msgRows.Close()
+
+	for msgRows.Next() {
+		var msg domain.ChatMessage
+		if err := scanMessage(msgRows, &msg); err != nil {
+			return nil, fmt.Errorf("failed to scan chat message: %w", err)
+		}
+		s := &sessions[index[msg.SessionID]]
+		s.Messages = append(s.Messages, msg)
+	}
+	return sessions, msgRows.Err()
+}
+
+// scanMessage reads a row starting with messageColumns into msg, and any
+// further columns into extra
+func scanMessage(row pgx.Row, msg *domain.ChatMessage, extra ...interface{}) error {
+	var citations []byte
+	var grounding *float32
+	dest := append([]interface{}{&msg.ID, &msg.SessionID, &msg.Role, &msg.Content, &citations, &grounding, &msg.CreatedAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return err
+	}
+	if len(citations) > 0 {
+		if err := json.Unmarshal(citations, &msg.Citations); err != nil {
+			return fmt.Errorf("failed to decode citations: %w", err)
+		}
+	}
+	if grounding != nil {
+		score := float64(*grounding)
+		msg.GroundingScore = &score
+	}
+	return nil
+}
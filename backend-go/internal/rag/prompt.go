@@ -0,0 +1,144 @@
+package rag
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/llm"
+)
+
+// maxJobDescription caps the job description sent with a prompt, in characters
+const maxJobDescription = 6000
+
+// groundingRules are appended to every mode's system prompt
+const groundingRules = `Use only the numbered resume excerpts for facts about the candidate, and cite them inline as [1], [2].
+If the excerpts do not cover something, say so rather than guessing.`
+
+// strictRules are added when the caller asked for verification
+const strictRules = `Every statement about the candidate must be supported by a cited excerpt; leave out anything that is not.`
+
+// rewriteFormat asks for bullet rewrites as JSON in rewrite mode
+const rewriteFormat = `Reply with JSON only, in this shape:
+{"summary": "one or two sentences", "rewrites": [{"section": "Experience", "before": "original bullet, verbatim from an excerpt", "after": "rewritten bullet", "keywords": ["job keywords used in after"], "rationale": "why"}]}`
+
+// prompt builds the completion request for a chat message: the mode's system
+// prompt, earlier turns of the session, and the message with the excerpts,
+// job description and stories it should draw on
+func prompt(req domain.ChatRequest, profile domain.ChatModeProfile, excerpts []string, sections []string, history []domain.ChatMessage) llm.Request {
+	system := profile.SystemPrompt + "\n\n" + groundingRules
+	if req.UseVerification {
+		system += "\n" + strictRules
+	}
+	if req.Mode == domain.ChatModeRewrite {
+		system += "\n\n" + rewriteFormat
+	}
+
+	messages := make([]llm.Message, 0, len(history)+1)
+	for _, msg := range history {
+		messages = append(messages, llm.Message{Role: msg.Role, Content: msg.Content})
+	}
+
+	var b strings.Builder
+	b.WriteString("Resume excerpts:\n")
+	for i, text := range excerpts {
+		fmt.Fprintf(&b, "[%d] (%s) %s\n", i+1, sections[i], text)
+	}
+	if req.JobDescription != nil && strings.TrimSpace(*req.JobDescription) != "" {
+		b.WriteString("\nJob description:\n")
+		b.WriteString(truncate(strings.TrimSpace(*req.JobDescription), maxJobDescription))
+		b.WriteString("\n")
+	}
+	if len(req.Stories) > 0 {
+		b.WriteString("\nStories from the candidate's story bank:\n")
+		for _, match := range req.Stories {
+			b.WriteString("- " + match.Story.Text() + "\n")
+		}
+	}
+	b.WriteString("\nRequest: " + req.Message)
+	messages = append(messages, llm.Message{Role: "user", Content: b.String()})
+
+	var temperature *float64
+	if req.UseVerification || req.Mode == domain.ChatModeRewrite {
+		zero := 0.0
+		temperature = &zero
+	}
+	return llm.Request{System: system, Messages: messages, Temperature: temperature}
+}
+
+// retrievalQuery is the text embedded to find excerpts: the message, plus the
+// start of the job description in modes that write for a job
+func retrievalQuery(req domain.ChatRequest) string {
+	if req.JobDescription == nil || req.Mode == domain.ChatModeChat {
+		return req.Message
+	}
+	return req.Message + "\n" + truncate(*req.JobDescription, 1000)
+}
+
+// parseRewrites reads the JSON reply of rewrite mode. A reply that is not
+// JSON is returned as the response with no rewrites.
+func parseRewrites(text string) (string, []domain.BulletRewrite) {
+	var reply struct {
+		Summary  string `json:"summary"`
+		Rewrites []struct {
+			Section   string   `json:"section"`
+			Before    string   `json:"before"`
+			After     string   `json:"after"`
+			Keywords  []string `json:"keywords"`
+			Rationale string   `json:"rationale"`
+		} `json:"rewrites"`
+	}
+	start, end := strings.Index(text, "{"), strings.LastIndex(text, "}")
+	if start < 0 || end < start || json.Unmarshal([]byte(text[start:end+1]), &reply) != nil {
+		return text, []domain.BulletRewrite{}
+	}
+
+	rewrites := make([]domain.BulletRewrite, 0, len(reply.Rewrites))
+	for i, r := range reply.Rewrites {
+		if strings.TrimSpace(r.After) == "" {
+			continue
+		}
+		rewrites = append(rewrites, domain.BulletRewrite{
+			ID:        fmt.Sprintf("rewrite-%d", i+1),
+			Section:   r.Section,
+			Before:    r.Before,
+			After:     r.After,
+			Keywords:  AnnotateKeywords(r.Before, r.After, r.Keywords),
+			Rationale: r.Rationale,
+		})
+	}
+	return reply.Summary, rewrites
+}
+
+// AnnotateKeywords locates each keyword in after, marking those the original
+// bullet lacked
+func AnnotateKeywords(before, after string, keywords []string) []domain.KeywordAnnotation {
+	lowerBefore, lowerAfter := strings.ToLower(before), strings.ToLower(after)
+	var annotations []domain.KeywordAnnotation
+	for _, keyword := range keywords {
+		k := strings.ToLower(strings.TrimSpace(keyword))
+		at := strings.Index(lowerAfter, k)
+		if k == "" || at < 0 || len(lowerAfter) != len(after) {
+			continue
+		}
+		annotations = append(annotations, domain.KeywordAnnotation{
+			Keyword: strings.TrimSpace(keyword),
+			Start:   at,
+			End:     at + len(k),
+			IsNew:   !strings.Contains(lowerBefore, k),
+		})
+	}
+	return annotations
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n] + "…"
+}
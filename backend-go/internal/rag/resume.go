@@ -0,0 +1,194 @@
+// Package rag answers questions about the candidate's resume with retrieval
+// augmented generation: the resume is split into chunks embedded in the
+// vector store, the chunks nearest a question are put into a mode-specific
+// prompt, and the answer is checked against them for grounding.
+package rag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/resume-rag/backend/internal/coverage"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/internal/vectorstore"
+)
+
+// ErrNoResume is returned when the tenant has not indexed a resume
+var ErrNoResume = errors.New("no resume has been indexed")
+
+// Embedder embeds text with the ML service
+type Embedder interface {
+	Embed(ctx context.Context, text string) ([]float32, error)
+	EmbedBatch(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// VectorStore keeps each tenant's resume chunks
+type VectorStore interface {
+	ReplaceResume(ctx context.Context, tenantID string, chunks []vectorstore.ResumeChunk, vectors [][]float32) error
+	SearchResume(ctx context.Context, tenantID string, vector []float32, limit int) ([]vectorstore.ResumeChunkMatch, error)
+	ResumeChunks(ctx context.Context, tenantID string) ([]vectorstore.ResumeChunk, error)
+}
+
+// ResumeIndex embeds the active resume of each tenant and searches it. It
+// also serves the resume text to features that read the whole resume.
+type ResumeIndex struct {
+	embedder  Embedder
+	store     VectorStore
+	chunkSize int
+
+	mu    sync.Mutex
+	texts map[string]string // tenant -> resume text, loaded from the store on first use
+}
+
+// NewResumeIndex creates a resume index; chunks hold at most chunkSize characters
+func NewResumeIndex(embedder Embedder, store VectorStore, chunkSize int) *ResumeIndex {
+	if chunkSize <= 0 {
+		chunkSize = 800
+	}
+	return &ResumeIndex{embedder: embedder, store: store, chunkSize: chunkSize, texts: make(map[string]string)}
+}
+
+// Index makes text the tenant's active resume, replacing its chunks in the
+// vector store, and returns the number of chunks
+func (x *ResumeIndex) Index(ctx context.Context, text string) (int, error) {
+	chunks := Chunk(text, x.chunkSize)
+	if len(chunks) == 0 {
+		return 0, errors.New("resume is empty")
+	}
+
+	texts := make([]string, len(chunks))
+	for i, chunk := range chunks {
+		texts[i] = chunk.Text
+	}
+	vectors, err := x.embedder.EmbedBatch(ctx, texts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to embed resume: %w", err)
+	}
+
+	tenantID := tenant.ID(ctx)
+	if err := x.store.ReplaceResume(ctx, tenantID, chunks, vectors); err != nil {
+		return 0, fmt.Errorf("failed to store resume: %w", err)
+	}
+
+	x.mu.Lock()
+	x.texts[tenantID] = text
+	x.mu.Unlock()
+	return len(chunks), nil
+}
+
+// ResumeText returns the tenant's active resume. After a restart it is
+// rebuilt from the stored chunks.
+func (x *ResumeIndex) ResumeText(ctx context.Context) (string, error) {
+	tenantID := tenant.ID(ctx)
+	x.mu.Lock()
+	text, ok := x.texts[tenantID]
+	x.mu.Unlock()
+	if ok {
+		return text, nil
+	}
+
+	chunks, err := x.store.ResumeChunks(ctx, tenantID)
+	if err != nil {
+		return "", fmt.Errorf("failed to load resume: %w", err)
+	}
+	if len(chunks) == 0 {
+		return "", ErrNoResume
+	}
+	text = joinChunks(chunks)
+
+	x.mu.Lock()
+	x.texts[tenantID] = text
+	x.mu.Unlock()
+	return text, nil
+}
+
+// ResumeEmbedding embeds the tenant's whole active resume, for scoring jobs
+// against it. It is empty when no resume has been indexed.
+func (x *ResumeIndex) ResumeEmbedding(ctx context.Context) ([]float32, error) {
+	text, err := x.ResumeText(ctx)
+	if errors.Is(err, ErrNoResume) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	vector, err := x.embedder.Embed(ctx, text)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed resume: %w", err)
+	}
+	return vector, nil
+}
+
+// Search returns up to limit resume chunks nearest to query, best first
+func (x *ResumeIndex) Search(ctx context.Context, query string, limit int) ([]vectorstore.ResumeChunkMatch, error) {
+	vector, err := x.embedder.Embed(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+	matches, err := x.store.SearchResume(ctx, tenant.ID(ctx), vector, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(matches) == 0 {
+		return nil, ErrNoResume
+	}
+	return matches, nil
+}
+
+// Chunk splits resume text into passages of at most size characters that do
+// not cross section boundaries. Lines are kept whole, so a single longer line
+// makes a longer chunk.
+func Chunk(text string, size int) []vectorstore.ResumeChunk {
+	var chunks []vectorstore.ResumeChunk
+	for _, section := range coverage.SplitSections(text) {
+		var current []string
+		length := 0
+		flush := func() {
+			if len(current) > 0 {
+				chunks = append(chunks, vectorstore.ResumeChunk{
+					Section:  section.Name,
+					Position: len(chunks),
+					Text:     strings.Join(current, "\n"),
+				})
+			}
+			current, length = nil, 0
+		}
+
+		for _, line := range strings.Split(section.Text, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			if length > 0 && length+len(line)+1 > size {
+				flush()
+			}
+			current = append(current, line)
+			length += len(line) + 1
+		}
+		flush()
+	}
+	return chunks
+}
+
+// joinChunks rebuilds resume text from its chunks in reading order, with a
+// heading before each section but the header
+func joinChunks(chunks []vectorstore.ResumeChunk) string {
+	var b strings.Builder
+	section := ""
+	for i, chunk := range chunks {
+		if chunk.Section != section {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			if chunk.Section != "Header" {
+				b.WriteString(chunk.Section + "\n")
+			}
+			section = chunk.Section
+		}
+		b.WriteString(chunk.Text + "\n")
+	}
+	return b.String()
+}
@@ -0,0 +1,117 @@
+// Package ranking holds the per-source credibility weights applied when
+// search results and recommendations are ranked by match score
+package ranking
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// MaxWeight bounds a source weight so no source can drown out the others
+const MaxWeight = 3
+
+// ErrInvalid is returned for an unknown source or an out-of-range weight
+var ErrInvalid = errors.New("invalid source weight")
+
+// SourceWeights holds each source's ranking weight. Weights come from the
+// per-source scraping config and can be overridden at runtime; sources
+// without a weight rank at 1.
+type SourceWeights struct {
+	mu        sync.RWMutex
+	defaults  map[domain.JobSource]float64
+	overrides map[domain.JobSource]float64
+}
+
+// NewSourceWeights loads the weights configured per source
+func NewSourceWeights(cfg config.ScrapingConfig) *SourceWeights {
+	w := &SourceWeights{
+		defaults:  make(map[domain.JobSource]float64),
+		overrides: make(map[domain.JobSource]float64),
+	}
+	for name, source := range cfg.Sources {
+		if source.Weight > 0 {
+			w.defaults[domain.JobSource(name)] = min(source.Weight, MaxWeight)
+		}
+	}
+	return w
+}
+
+// Weight returns a source's weight
+func (w *SourceWeights) Weight(source domain.JobSource) float64 {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.weight(source)
+}
+
+func (w *SourceWeights) weight(source domain.JobSource) float64 {
+	if v, ok := w.overrides[source]; ok {
+		return v
+	}
+	if v, ok := w.defaults[source]; ok {
+		return v
+	}
+	return 1
+}
+
+// All returns the weight of every source jobs can be stored under, by source
+func (w *SourceWeights) All() []domain.SourceWeight {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	var out []domain.SourceWeight
+	for _, source := range domain.StoredJobSources {
+		_, overridden := w.overrides[source]
+		out = append(out, domain.SourceWeight{Source: source, Weight: w.weight(source), Overridden: overridden})
+	}
+	return out
+}
+
+// Set overrides a source's weight until Reset or restart
+func (w *SourceWeights) Set(source domain.JobSource, weight float64) error {
+	if !source.Stored() {
+		return fmt.Errorf("%w: unknown source %q", ErrInvalid, source)
+	}
+	if weight <= 0 || weight > MaxWeight {
+		return fmt.Errorf("%w: weight must be above 0 and at most %d", ErrInvalid, MaxWeight)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.overrides[source] = weight
+	return nil
+}
+
+// Reset drops a runtime override, restoring the configured weight
+func (w *SourceWeights) Reset(source domain.JobSource) error {
+	if !source.Stored() {
+		return fmt.Errorf("%w: unknown source %q", ErrInvalid, source)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	delete(w.overrides, source)
+	return nil
+}
+
+// SQL returns an expression giving the weight of the source in column, for
+// scaling scores in ORDER BY clauses. Only stored sources and numbers are
+// written into it, so it is safe to inline.
+func (w *SourceWeights) SQL(column string) string {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	var b strings.Builder
+	for _, source := range domain.StoredJobSources {
+		weight := w.weight(source)
+		if weight == 1 {
+			continue
+		}
+		fmt.Fprintf(&b, " WHEN '%s' THEN %s", source, strconv.FormatFloat(weight, 'f', -1, 64))
+	}
+	if b.Len() == 0 {
+		return "1"
+	}
+	return "(CASE " + column + "::text" + b.String() + " ELSE 1 END)"
+}
@@ -0,0 +1,177 @@
+package readiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+const (
+	// DefaultTTL is how long probe results are reused
+	DefaultTTL = 10 * time.Second
+	// probeTimeout bounds a single probe
+	probeTimeout = 2 * time.Second
+)
+
+// Probe reports why a capability is unavailable, or nil when it is ready
+type Probe func(ctx context.Context) error
+
+type capability struct {
+	name  domain.Capability
+	core  bool
+	probe Probe
+}
+
+// Checker tracks which capabilities are available. Probe results are cached
+// for the TTL so gated endpoints don't probe on every request.
+type Checker struct {
+	ttl          time.Duration
+	capabilities []capability
+
+	mu        sync.Mutex
+	statuses  map[domain.Capability]domain.CapabilityStatus
+	checkedAt time.Time
+}
+
+// NewChecker creates a readiness checker; ttl <= 0 uses DefaultTTL
+func NewChecker(ttl time.Duration) *Checker {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	return &Checker{ttl: ttl, statuses: make(map[domain.Capability]domain.CapabilityStatus)}
+}
+
+// Register adds a capability. Core capabilities make the whole API not
+// ready when down; the rest only disable the features that need them.
+func (c *Checker) Register(name domain.Capability, core bool, probe Probe) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.capabilities = append(c.capabilities, capability{name: name, core: core, probe: probe})
+	c.checkedAt = time.Time{}
+}
+
+// Report returns the status of every capability
+func (c *Checker) Report(ctx context.Context) domain.ReadinessReport {
+	statuses := c.check(ctx)
+
+	report := domain.ReadinessReport{Status: domain.Ready, Capabilities: statuses}
+	for _, s := range statuses {
+		if s.Ready {
+			continue
+		}
+		report.Unavailable = append(report.Unavailable, s.Name)
+		if s.Core {
+			report.Status = domain.NotReady
+		} else if report.Status == domain.Ready {
+			report.Status = domain.Degraded
+		}
+	}
+	return report
+}
+
+// Unavailable returns the statuses of the given capabilities that are down.
+// Capabilities that were never registered are treated as available.
+func (c *Checker) Unavailable(ctx context.Context, names ...domain.Capability) []domain.CapabilityStatus {
+	c.check(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var down []domain.CapabilityStatus
+	for _, name := range names {
+		if s, ok := c.statuses[name]; ok && !s.Ready {
+			down = append(down, s)
+		}
+	}
+	return down
+}
+
+// check runs every probe when the cached results are stale
+func (c *Checker) check(ctx context.Context) []domain.CapabilityStatus {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.checkedAt) >= c.ttl {
+		results := make([]domain.CapabilityStatus, len(c.capabilities))
+		var wg sync.WaitGroup
+		for i, entry := range c.capabilities {
+			wg.Add(1)
+			go func(i int, entry capability) {
+				defer wg.Done()
+				results[i] = run(ctx, entry, now)
+			}(i, entry)
+		}
+		wg.Wait()
+
+		for _, s := range results {
+			c.statuses[s.Name] = s
+		}
+		c.checkedAt = now
+	}
+
+	statuses := make([]domain.CapabilityStatus, len(c.capabilities))
+	for i, entry := range c.capabilities {
+		statuses[i] = c.statuses[entry.name]
+	}
+	return statuses
+}
+
+// run probes one capability
+func run(ctx context.Context, entry capability, now time.Time) domain.CapabilityStatus {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	status := domain.CapabilityStatus{Name: entry.name, Ready: true, Core: entry.core, CheckedAt: now}
+	if err := entry.probe(ctx); err != nil {
+		status.Ready = false
+		status.Reason = err.Error()
+	}
+	return status
+}
+
+// Unconnected is a probe for a dependency that was not set up
+func Unconnected(reason string) Probe {
+	err := errors.New(reason)
+	return func(context.Context) error {
+		return err
+	}
+}
+
+// Configured is a probe for a dependency that only needs configuration, such
+// as an API key; it fails with reason while value is empty
+func Configured(value, reason string) Probe {
+	if value != "" {
+		return func(context.Context) error { return nil }
+	}
+	return Unconnected(reason)
+}
+
+// Dial is a probe that succeeds when a TCP connection to addr can be opened
+func Dial(addr string) Probe {
+	return func(ctx context.Context) error {
+		var d net.Dialer
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return fmt.Errorf("cannot reach %s", addr)
+		}
+		return conn.Close()
+	}
+}
+
+// Executable is a probe that succeeds when any of the named programs is on PATH
+func Executable(names ...string) Probe {
+	return func(context.Context) error {
+		for _, name := range names {
+			if _, err := exec.LookPath(name); err == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("none of %v found on PATH", names)
+	}
+}
@@ -0,0 +1,329 @@
+package redact
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/coverage"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// knownBackends are always listed in the settings, configured or not
+var knownBackends = []string{"groq", "openai", "claude"}
+
+// fieldOrder is the order fields are stripped in. References go first so
+// their contact details aren't counted twice; the name is found before any
+// other header detail is replaced.
+var fieldOrder = []domain.RedactionField{
+	domain.RedactionReferences,
+	domain.RedactionName,
+	domain.RedactionEmail,
+	domain.RedactionPhone,
+	domain.RedactionAddress,
+}
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+	phonePattern = regexp.MustCompile(`(?:\+\d{1,3}[\s.-]?)?\(?\b\d{3}\)?[\s.-]?\d{3}[\s.-]?\d{4}\b`)
+
+	// streetPattern matches a street address, e.g. "12 Oak Hill Rd, Apt 4"
+	streetPattern = regexp.MustCompile(`\b\d{1,6}\s+(?:[A-Z][A-Za-z]*\.?\s+){1,4}` +
+		`(?:Street|St|Avenue|Ave|Road|Rd|Boulevard|Blvd|Lane|Ln|Drive|Dr|Court|Ct|Way|Place|Pl|Terrace|Circle|Parkway|Pkwy)\b\.?` +
+		`(?:,?\s*(?:Apt|Suite|Unit|#)\.?\s*[\w-]+)?`)
+	// postalPattern matches a city, state and ZIP code, e.g. "Austin, TX 78701"
+	postalPattern = regexp.MustCompile(`\b[A-Z][A-Za-z .'-]*,\s*[A-Z]{2}\s+\d{5}(?:-\d{4})?\b`)
+
+	// referencesOnRequest matches the stock line standing in for references
+	referencesOnRequest = regexp.MustCompile(`(?i)^\s*references\s+(?:are\s+)?available\s+(?:up)?on\s+request\.?\s*$`)
+)
+
+// Redactor strips personal details from resume text bound for an LLM backend,
+// according to the backend's trust level
+type Redactor struct {
+	enabled        bool
+	defaultBackend string
+	defaultTrust   string
+	trust          map[string]string
+	levels         map[string][]domain.RedactionField
+}
+
+// NewRedactor creates a redactor; defaultBackend is the backend used when the
+// tenant names none
+func NewRedactor(cfg config.RedactionConfig, defaultBackend string) (*Redactor, error) {
+	r := &Redactor{
+		enabled:        cfg.Enabled,
+		defaultBackend: defaultBackend,
+		defaultTrust:   cfg.DefaultTrust,
+		trust:          make(map[string]string, len(cfg.Trust)),
+		levels:         make(map[string][]domain.RedactionField, len(cfg.Levels)),
+	}
+	for level, fields := range cfg.Levels {
+		for _, f := range fields {
+			field := domain.RedactionField(strings.ToLower(strings.TrimSpace(f)))
+			if !knownField(field) {
+				return nil, fmt.Errorf("redaction level %q: unknown field %q", level, f)
+			}
+			r.levels[level] = append(r.levels[level], field)
+		}
+		if _, ok := r.levels[level]; !ok {
+			r.levels[level] = []domain.RedactionField{}
+		}
+	}
+	if _, ok := r.levels[cfg.DefaultTrust]; !ok {
+		return nil, fmt.Errorf("redaction default trust %q is not a configured level", cfg.DefaultTrust)
+	}
+	for backend, level := range cfg.Trust {
+		if _, ok := r.levels[level]; !ok {
+			return nil, fmt.Errorf("redaction trust for %q: %q is not a configured level", backend, level)
+		}
+		r.trust[strings.ToLower(backend)] = level
+	}
+	return r, nil
+}
+
+// Backend returns the LLM backend requests in ctx are sent to
+func (r *Redactor) Backend(ctx context.Context) string {
+	if t, ok := tenant.FromContext(ctx); ok && t.LLMBackend != "" {
+		return t.LLMBackend
+	}
+	return r.defaultBackend
+}
+
+// Profile returns what is stripped for backend. Nothing is stripped while
+// redaction is disabled.
+func (r *Redactor) Profile(backend string) domain.RedactionProfile {
+	level, ok := r.trust[strings.ToLower(backend)]
+	if !ok {
+		level = r.defaultTrust
+	}
+	p := domain.RedactionProfile{Backend: backend, Trust: level, Fields: []domain.RedactionField{}}
+	if r.enabled {
+		p.Fields = append(p.Fields, r.levels[level]...)
+	}
+	return p
+}
+
+// AllowsFallback reports whether text redacted for requested may be sent to
+// fallback instead, i.e. fallback needs nothing stripped that requested keeps
+func (r *Redactor) AllowsFallback(requested, fallback string) bool {
+	stripped := make(map[domain.RedactionField]bool)
+	for _, f := range r.Profile(requested).Fields {
+		stripped[f] = true
+	}
+	for _, f := range r.Profile(fallback).Fields {
+		if !stripped[f] {
+			return false
+		}
+	}
+	return true
+}
+
+// Settings lists the profile of every known or configured backend
+func (r *Redactor) Settings() *domain.RedactionSettings {
+	seen := make(map[string]bool)
+	var backends []string
+	for _, b := range append(append([]string{r.defaultBackend}, knownBackends...), mapKeys(r.trust)...) {
+		if b != "" && !seen[b] {
+			seen[b] = true
+			backends = append(backends, b)
+		}
+	}
+
+	settings := &domain.RedactionSettings{Enabled: r.enabled, DefaultBackend: r.defaultBackend}
+	for _, b := range backends {
+		settings.Profiles = append(settings.Profiles, r.Profile(b))
+	}
+	return settings
+}
+
+// Resume returns resume text as it may be sent to the backend used for ctx
+func (r *Redactor) Resume(ctx context.Context, text string) string {
+	return r.Preview(ctx, "", text).Text
+}
+
+// Preview redacts text for backend, or for the backend used for ctx when
+// backend is empty, reporting what was stripped
+func (r *Redactor) Preview(ctx context.Context, backend, text string) *domain.RedactionPreview {
+	if backend == "" {
+		backend = r.Backend(ctx)
+	}
+	profile := r.Profile(backend)
+	text, counts := Apply(text, profile.Fields)
+	return &domain.RedactionPreview{RedactionProfile: profile, Text: text, Redacted: counts}
+}
+
+// Excerpts redacts passages of resume for the backend used for ctx. The
+// candidate's name is taken from the whole resume, since a passage rarely
+// starts with it.
+func (r *Redactor) Excerpts(ctx context.Context, resume string, excerpts []string) []string {
+	fields := r.Profile(r.Backend(ctx)).Fields
+	name := resumeName(resume)
+
+	redacted := make([]string, len(excerpts))
+	for i, text := range excerpts {
+		redacted[i], _ = apply(text, name, fields)
+	}
+	return redacted
+}
+
+// Apply strips fields from resume text, returning the redacted text and how
+// many details of each field were removed
+func Apply(text string, fields []domain.RedactionField) (string, map[domain.RedactionField]int) {
+	return apply(text, resumeName(text), fields)
+}
+
+// apply is Apply with the candidate's name given
+func apply(text, name string, fields []domain.RedactionField) (string, map[domain.RedactionField]int) {
+	counts := make(map[domain.RedactionField]int, len(fields))
+	want := make(map[domain.RedactionField]bool, len(fields))
+	for _, f := range fields {
+		want[f] = true
+	}
+
+	for _, field := range fieldOrder {
+		if !want[field] {
+			continue
+		}
+		var n int
+		switch field {
+		case domain.RedactionReferences:
+			text, n = stripReferences(text)
+		case domain.RedactionName:
+			text, n = stripName(text, name)
+		case domain.RedactionEmail:
+			text, n = replaceAll(emailPattern, text, "[EMAIL]")
+		case domain.RedactionPhone:
+			text, n = replaceAll(phonePattern, text, "[PHONE]")
+		case domain.RedactionAddress:
+			var m int
+			text, n = replaceAll(streetPattern, text, "[ADDRESS]")
+			text, m = replaceAll(postalPattern, text, "[ADDRESS]")
+			n += m
+		}
+		counts[field] = n
+	}
+	return text, counts
+}
+
+// stripReferences drops the References section and any "references available
+// on request" line
+func stripReferences(text string) (string, int) {
+	lines := strings.Split(text, "\n")
+	kept := lines[:0]
+	removed := 0
+	inReferences := false
+	for _, line := range lines {
+		if name, ok := coverage.Heading(line); ok {
+			inReferences = name == "References"
+			if inReferences {
+				removed++
+				continue
+			}
+		}
+		if inReferences {
+			continue
+		}
+		if referencesOnRequest.MatchString(line) {
+			removed++
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.Join(kept, "\n"), removed
+}
+
+// stripName replaces the candidate's name, taken from the first line of the
+// resume, wherever it appears
+func stripName(text, name string) (string, int) {
+	if name == "" {
+		return text, 0
+	}
+
+	// The full name first, then each part on its own, e.g. in a signature
+	patterns := []string{regexp.QuoteMeta(name)}
+	for _, part := range strings.Fields(name) {
+		if len(strings.Trim(part, ".")) >= 3 {
+			patterns = append(patterns, regexp.QuoteMeta(part))
+		}
+	}
+	total := 0
+	for _, p := range patterns {
+		var n int
+		text, n = replaceAll(regexp.MustCompile(`\b`+p+`\b`), text, "[NAME]")
+		total += n
+	}
+	return text, total
+}
+
+// resumeName returns the first line of text when it looks like a person's
+// name: two to four capitalised words of letters
+func resumeName(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(strings.Trim(strings.TrimSpace(line), "#*_"))
+		if line == "" {
+			continue
+		}
+		if _, ok := coverage.Heading(line); ok {
+			return ""
+		}
+		words := strings.Fields(line)
+		if len(words) < 2 || len(words) > 4 {
+			return ""
+		}
+		for _, w := range words {
+			if !nameWord(w) {
+				return ""
+			}
+		}
+		return strings.Join(words, " ")
+	}
+	return ""
+}
+
+// nameWord reports whether w is a capitalised word of letters, allowing
+// initials, hyphens and apostrophes
+func nameWord(w string) bool {
+	for i, r := range w {
+		switch {
+		case i == 0 && !unicode.IsUpper(r):
+			return false
+		case unicode.IsLetter(r), r == '-', r == '\'', r == '.':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// replaceAll replaces every match of p in text, returning the match count
+func replaceAll(p *regexp.Regexp, text, with string) (string, int) {
+	n := len(p.FindAllStringIndex(text, -1))
+	if n == 0 {
+		return text, 0
+	}
+	return p.ReplaceAllLiteralString(text, with), n
+}
+
+func knownField(f domain.RedactionField) bool {
+	for _, known := range fieldOrder {
+		if f == known {
+			return true
+		}
+	}
+	return false
+}
+
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// applicationColumns selects an application's job (jobColumns) followed by
+// the application itself (alias a), in scanApplication order
+const applicationColumns = jobColumns + `, a.id, a.status::text, a.applied_at, a.notes,
+	a.resume_version, a.resume_id, a.cover_letter, a.next_action_at, a.updated_at, a.created_at, a.deleted_at,
+	EXISTS (SELECT 1 FROM job_revisions r WHERE r.job_id = a.job_id AND r.changed_at > a.created_at)`
+
+// applicationFrom joins applications with their jobs and companies
+const applicationFrom = ` FROM applications a
+	JOIN jobs j ON j.id = a.job_id
+	LEFT JOIN companies c ON c.id = j.company_id`
+
+// resumeVersionID finds the resume version named by the resume_version
+// parameter ($4); labels that name no version leave the application unlinked
+const resumeVersionID = `SELECT id FROM resumes WHERE name = $4 AND application_id IS NULL`
+
+// statusToDB maps an API status to the application_status enum, which
+// predates the API and names the screening and interview stages differently
+func statusToDB(status domain.ApplicationStatus) string {
+	switch status {
+	case domain.ApplicationStatusScreening:
+		return "phone_screen"
+	case domain.ApplicationStatusInterview:
+		return "technical"
+	}
+	return string(status)
+}
+
+// statusFromDB maps an application_status enum value to the API status;
+// technical and onsite rounds are both interviews
+func statusFromDB(status string) domain.ApplicationStatus {
+	switch status {
+	case "phone_screen":
+		return domain.ApplicationStatusScreening
+	case "technical", "onsite":
+		return domain.ApplicationStatusInterview
+	}
+	return domain.ApplicationStatus(status)
+}
+
+// scanApplication reads a row selected with applicationColumns
+func scanApplication(row pgx.Row) (*domain.Application, error) {
+	var app domain.Application
+	var status string
+	job, err := scanJob(row, &app.ID, &status, &app.AppliedDate, &app.Notes,
+		&app.ResumeVersion, &app.ResumeID, &app.CoverLetter, &app.ReminderDate, &app.LastUpdated, &app.CreatedAt, &app.DeletedAt,
+		&app.UpdatedSinceSaved)
+	if err != nil {
+		return nil, err
+	}
+	app.Status = statusFromDB(status)
+	app.Job = toBrief(job, &app.Status)
+	app.Timeline = []domain.TimelineEntry{}
+	return &app, nil
+}
+
+// GetApplications lists applications, most recently updated first, with
+// counts per status across all of them
+func (s *JobListService) GetApplications(ctx context.Context, status *domain.ApplicationStatus, limit, offset int) (*domain.ApplicationListResponse, error) {
+	resp := &domain.ApplicationListResponse{
+		Applications: []domain.Application{},
+		ByStatus:     map[string]int{},
+	}
+
+	rows, err := s.db.Query(ctx, `SELECT status::text, count(*) FROM applications WHERE deleted_at IS NULL GROUP BY 1`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count applications: %w", err)
+	}
+	for rows.Next() {
+		var st string
+		var n int
+		if err := rows.Scan(&st, &n); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan application count: %w", err)
+		}
+		resp.ByStatus[string(statusFromDB(st))] += n
+		if status == nil || statusFromDB(st) == *status {
+			resp.Total += n
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var statuses []string
+	if status != nil {
+		statuses = []string{statusToDB(*status)}
+		if *status == domain.ApplicationStatusInterview {
+			statuses = append(statuses, "onsite")
+		}
+	}
+	rows, err = s.db.Query(ctx, `SELECT `+applicationColumns+applicationFrom+`
+		WHERE a.deleted_at IS NULL AND ($1::text[] IS NULL OR a.status::text = ANY($1))
+		ORDER BY a.updated_at DESC, a.id
+		LIMIT $2 OFFSET $3`, statuses, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list applications: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		app, err := scanApplication(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan application: %w", err)
+		}
+		resp.Applications = append(resp.Applications, *app)
+	}
+	return resp, rows.Err()
+}
+
+// GetDueReminders returns applications whose reminder date has passed
+func (s *JobListService) GetDueReminders(ctx context.Context) ([]domain.Application, error) {
+	rows, err := s.db.Query(ctx, `SELECT `+applicationColumns+applicationFrom+`
+		WHERE a.deleted_at IS NULL AND a.next_action_at <= NOW()
+		ORDER BY a.next_action_at`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list due reminders: %w", err)
+	}
+	defer rows.Close()
+
+	apps := []domain.Application{}
+	for rows.Next() {
+		app, err := scanApplication(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan application: %w", err)
+		}
+		apps = append(apps, *app)
+	}
+	return apps, rows.Err()
+}
+
+// GetApplication returns an application with its status timeline and the
+// changes to its job's posting since it was created
+func (s *JobListService) GetApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error) {
+	app, err := scanApplication(s.db.QueryRow(ctx, `SELECT `+applicationColumns+applicationFrom+`
+		WHERE a.id = $1 AND a.deleted_at IS NULL`, appID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrApplicationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application: %w", err)
+	}
+
+	rows, err := s.db.Query(ctx, `
+		SELECT id, from_status::text, to_status::text, created_at, notes
+		FROM application_timeline WHERE application_id = $1 ORDER BY created_at`, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application timeline: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		entry := domain.TimelineEntry{ApplicationID: appID}
+		var from *string
+		var to string
+		if err := rows.Scan(&entry.ID, &from, &to, &entry.ChangedAt, &entry.Notes); err != nil {
+			return nil, fmt.Errorf("failed to scan timeline entry: %w", err)
+		}
+		if from != nil {
+			old := statusFromDB(*from)
+			entry.OldStatus = &old
+		}
+		entry.NewStatus = statusFromDB(to)
+		app.Timeline = append(app.Timeline, entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if app.JobRevisions, err = s.jobRevisions(ctx, app.Job.ID, &app.CreatedAt); err != nil {
+		return nil, err
+	}
+	return app, nil
+}
+
+// CreateApplication starts tracking an application to a stored job
+func (s *JobListService) CreateApplication(ctx context.Context, req domain.ApplicationCreate) (*domain.Application, error) {
+	status := domain.ApplicationStatusSaved
+	if req.Status != nil {
+		status = *req.Status
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var appID uuid.UUID
+	err = tx.QueryRow(ctx, `
+		INSERT INTO applications (job_id, status, applied_at, notes, resume_version, resume_id, next_action_at)
+		SELECT id, $2::application_status, CASE WHEN $2 <> 'saved' THEN NOW() END, $3, $4, (`+resumeVersionID+`), $5
+		FROM jobs WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id`,
+		req.JobID, statusToDB(status), req.Notes, req.ResumeVersion, req.ReminderDate,
+	).Scan(&appID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create application: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO application_timeline (application_id, to_status) VALUES ($1, $2::application_status)`,
+		appID, statusToDB(status)); err != nil {
+		return nil, fmt.Errorf("failed to record application status: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetApplication(ctx, appID)
+}
+
+// UpdateApplication changes an application, recording status changes on its
+// timeline. Moving past "saved" for the first time sets the applied date.
+func (s *JobListService) UpdateApplication(ctx context.Context, appID uuid.UUID, req domain.ApplicationUpdate) (*domain.Application, error) {
+	var status *string
+	if req.Status != nil {
+		st := statusToDB(*req.Status)
+		status = &st
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var previous string
+	err = tx.QueryRow(ctx, `SELECT status::text FROM applications WHERE id = $1 AND deleted_at IS NULL FOR UPDATE`, appID).Scan(&previous)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrApplicationNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		UPDATE applications SET
+			status = coalesce($2::application_status, status),
+			applied_at = CASE WHEN applied_at IS NULL AND coalesce($2::application_status, status) <> 'saved' THEN NOW() ELSE applied_at END,
+			notes = coalesce($3, notes),
+			resume_version = coalesce($4, resume_version),
+			resume_id = CASE WHEN $4 IS NULL THEN resume_id ELSE (`+resumeVersionID+`) END,
+			cover_letter = coalesce($5, cover_letter),
+			next_action_at = coalesce($6, next_action_at),
+			updated_at = NOW()
+		WHERE id = $1`,
+		appID, status, req.Notes, req.ResumeVersion, req.CoverLetter, req.ReminderDate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update application: %w", err)
+	}
+	if status != nil && *status != previous {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO application_timeline (application_id, from_status, to_status)
+			VALUES ($1, $2::application_status, $3::application_status)`,
+			appID, previous, *status); err != nil {
+			return nil, fmt.Errorf("failed to record application status: %w", err)
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return s.GetApplication(ctx, appID)
+}
+
+// DeleteApplication moves an application to the trash
+func (s *JobListService) DeleteApplication(ctx context.Context, appID uuid.UUID) error {
+	tag, err := s.db.Exec(ctx, `UPDATE applications SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, appID)
+	if err != nil {
+		return fmt.Errorf("failed to delete application: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrApplicationNotFound
+	}
+	return nil
+}
+
+// RestoreApplication takes an application back out of the trash
+func (s *JobListService) RestoreApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error) {
+	tag, err := s.db.Exec(ctx, `UPDATE applications SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore application: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, ErrApplicationNotFound
+	}
+	return s.GetApplication(ctx, appID)
+}
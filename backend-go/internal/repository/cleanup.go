@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// cleanupConditions builds the WHERE clause selecting jobs for a bulk
+// cleanup. Jobs the tenant applied to or starred are never selected.
+func cleanupConditions(criteria domain.JobCleanupCriteria) (string, []interface{}) {
+	conditions := []string{
+		`j.deleted_at IS NULL`,
+		`NOT EXISTS (SELECT 1 FROM applications a WHERE a.job_id = j.id AND a.deleted_at IS NULL)`,
+		`NOT EXISTS (SELECT 1 FROM job_favorites f WHERE f.job_id = j.id)`,
+	}
+	var args []interface{}
+	arg := func(v interface{}) string {
+		args = append(args, v)
+		return fmt.Sprintf("$%d", len(args))
+	}
+
+	if criteria.InactiveDays != nil {
+		conditions = append(conditions, `coalesce(j.last_seen_at, j.created_at) < NOW() - make_interval(days => `+arg(*criteria.InactiveDays)+`)`)
+	}
+	if len(criteria.Sources) > 0 {
+		sources := make([]string, len(criteria.Sources))
+		for i, source := range criteria.Sources {
+			sources[i] = string(source)
+		}
+		conditions = append(conditions, `j.source::text = ANY(`+arg(sources)+`)`)
+	}
+	if criteria.BelowMatchScore != nil {
+		conditions = append(conditions, `j.match_score < `+arg(*criteria.BelowMatchScore))
+	}
+	return strings.Join(conditions, " AND "), args
+}
+
+// CountCleanupJobs returns how many jobs a cleanup with criteria would trash
+func (s *JobListService) CountCleanupJobs(ctx context.Context, criteria domain.JobCleanupCriteria) (int, error) {
+	where, args := cleanupConditions(criteria)
+	var count int
+	if err := s.db.QueryRow(ctx, `SELECT count(*) FROM jobs j WHERE `+where, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count jobs to clean up: %w", err)
+	}
+	return count, nil
+}
+
+// TrashCleanupBatch moves up to limit jobs matching criteria to the trash and
+// returns their IDs. Callers repeat it until no IDs are returned, so no single
+// statement holds locks on the whole selection.
+func (s *JobListService) TrashCleanupBatch(ctx context.Context, criteria domain.JobCleanupCriteria, limit int) ([]uuid.UUID, error) {
+	where, args := cleanupConditions(criteria)
+	args = append(args, limit)
+	rows, err := s.db.Query(ctx, fmt.Sprintf(`
+		UPDATE jobs SET deleted_at = NOW()
+		WHERE id IN (SELECT j.id FROM jobs j WHERE %s LIMIT $%d FOR UPDATE SKIP LOCKED)
+		RETURNING id`, where, len(args)), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to trash jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
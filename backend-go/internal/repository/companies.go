@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// saveCompany finds the company by name, creating it when it is new, and
+// returns its ID. Enrichment found for a known company (ratings, H1B filings,
+// profile details) is written over its stored values; fields the scrape has
+// no value for are kept. Jobs without a company name get no company.
+func (s *JobListService) saveCompany(ctx context.Context, company *domain.Company) (*uuid.UUID, error) {
+	if company.Name == "" {
+		return nil, nil
+	}
+
+	var size *string
+	if company.Size != nil {
+		s := string(*company.Size)
+		size = &s
+	}
+
+	var id uuid.UUID
+	err := s.db.QueryRow(ctx, `
+		UPDATE companies SET
+			domain = coalesce($2, domain), industry = coalesce($3, industry),
+			size = coalesce($4::company_size, size), logo_url = coalesce($5, logo_url),
+			glassdoor_rating = coalesce($6, glassdoor_rating),
+			rating_updated_at = CASE WHEN $6::decimal IS NULL THEN rating_updated_at ELSE NOW() END,
+			h1b_filings = coalesce($7, h1b_filings),
+			likely_sponsors_visa = CASE WHEN $7::integer IS NULL THEN likely_sponsors_visa ELSE $8 END,
+			updated_at = NOW()
+		WHERE id = (SELECT id FROM companies WHERE lower(name) = lower($1) ORDER BY created_at LIMIT 1)
+		RETURNING id`,
+		company.Name, company.Website, company.Industry, size, company.LogoURL,
+		company.Rating, company.H1BFilings, company.LikelySponsorsVisa,
+	).Scan(&id)
+	if err == nil {
+		company.ID = id
+		return &id, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to update company: %w", err)
+	}
+
+	err = s.db.QueryRow(ctx, `
+		INSERT INTO companies (name, domain, industry, size, logo_url, glassdoor_rating, h1b_filings, likely_sponsors_visa)
+		VALUES ($1, $2, $3, $4::company_size, $5, $6, $7, $8)
+		ON CONFLICT (name, domain) DO UPDATE SET updated_at = NOW()
+		RETURNING id`,
+		company.Name, company.Website, company.Industry, size, company.LogoURL,
+		company.Rating, company.H1BFilings, company.LikelySponsorsVisa,
+	).Scan(&id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save company: %w", err)
+	}
+	company.ID = id
+	return &id, nil
+}
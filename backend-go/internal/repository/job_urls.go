@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// canonicalizeBatchSize is how many stored jobs CanonicalizeURLs reads per
+// round trip
+const canonicalizeBatchSize = 500
+
+// CanonicalizeURLs rewrites the source URLs of stored jobs to their canonical
+// form, for jobs saved before URLs were canonicalized at ingest, so re-scrapes
+// find them by URL. It returns how many jobs it changed and is safe to rerun.
+func (s *JobListService) CanonicalizeURLs(ctx context.Context) (int, error) {
+	changed := 0
+	after := uuid.Nil
+	for {
+		rows, err := s.db.Query(ctx, `
+			SELECT id, coalesce(source_url, ''), sources FROM jobs
+			WHERE id > $1
+			ORDER BY id
+			LIMIT $2`, after, canonicalizeBatchSize)
+		if err != nil {
+			return changed, fmt.Errorf("failed to load job URLs: %w", err)
+		}
+
+		batch := &pgx.Batch{}
+		read := 0
+		for rows.Next() {
+			var job domain.Job
+			if err := rows.Scan(&job.ID, &job.URL, &job.Sources); err != nil {
+				rows.Close()
+				return changed, fmt.Errorf("failed to scan job URLs: %w", err)
+			}
+			read++
+			after = job.ID
+
+			url, sources := job.URL, append([]domain.JobSourceRef(nil), job.Sources...)
+			job.CanonicalizeURLs()
+			if job.URL == url && reflect.DeepEqual(job.Sources, sources) {
+				continue
+			}
+			batch.Queue(`UPDATE jobs SET source_url = nullif($2, ''), sources = $3 WHERE id = $1`,
+				job.ID, job.URL, job.Sources)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return changed, fmt.Errorf("failed to load job URLs: %w", err)
+		}
+
+		if batch.Len() > 0 {
+			if err := s.db.SendBatch(ctx, batch).Close(); err != nil {
+				return changed, fmt.Errorf("failed to save canonical job URLs: %w", err)
+			}
+			changed += batch.Len()
+		}
+		if read < canonicalizeBatchSize {
+			return changed, nil
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/geo"
+	"github.com/resume-rag/backend/internal/ranking"
+	"github.com/resume-rag/backend/internal/stats"
+	"github.com/resume-rag/backend/internal/taskevents"
+)
+
+var (
+	// ErrJobNotFound is returned for unknown or deleted jobs
+	ErrJobNotFound = errors.New("job not found")
+	// ErrApplicationNotFound is returned for unknown or deleted applications
+	ErrApplicationNotFound = errors.New("application not found")
+	// ErrSavedSearchNotFound is returned for unknown saved searches
+	ErrSavedSearchNotFound = errors.New("saved search not found")
+	// ErrTaskNotFound is returned for unknown scrape tasks
+	ErrTaskNotFound = errors.New("scrape task not found")
+	// ErrCoverLetterUnavailable is returned while no cover letter generator is configured
+	ErrCoverLetterUnavailable = errors.New("cover letter generation is not configured")
+)
+
+// recommendationMinScore is the lowest match score worth recommending
+const recommendationMinScore = 60
+
+// maxCommuteCandidates caps the jobs routed from home for one commute-filtered
+// listing; matches past it are not listed
+const maxCommuteCandidates = 500
+
+// JobListService serves job search, applications, saved searches and scrape
+// tasks from PostgreSQL. Jobs and companies are shared; applications and
+// saved searches are scoped to the tenant by row-level security.
+type JobListService struct {
+	db         *pgxpool.Pool
+	stats      *stats.PostgresStats
+	minQuality int
+	weights    *ranking.SourceWeights
+	events     *taskevents.Log
+
+	geocoder        geo.Geocoder // nil when geocoding is disabled
+	defaultRadiusKm float64
+	commute         CommuteFilter // nil when commute estimates are disabled
+}
+
+// CommuteFilter drops jobs beyond the commute limit of the filters,
+// annotating the rest with their commute (commute.Service)
+type CommuteFilter interface {
+	Filter(ctx context.Context, jobs []*domain.Job, filters *domain.JobFilters) []*domain.Job
+}
+
+// NewJobListService creates a Postgres-backed job list service. Jobs scoring
+// below minQuality are dropped by the hide_low_quality filter.
+func NewJobListService(db *pgxpool.Pool, minQuality int) *JobListService {
+	return &JobListService{
+		db:         db,
+		stats:      stats.NewPostgresStats(db),
+		minQuality: minQuality,
+	}
+}
+
+// SetSourceWeights scales match and relevance ranking by per-source weights
+func (s *JobListService) SetSourceWeights(w *ranking.SourceWeights) {
+	s.weights = w
+}
+
+// SetGeocoder places the center of radius filters; radiusKm applies when a
+// filter has no radius of its own
+func (s *JobListService) SetGeocoder(g geo.Geocoder, radiusKm float64) {
+	s.geocoder = g
+	s.defaultRadiusKm = radiusKm
+}
+
+// SetCommute applies max_commute_minutes filters to job listings
+func (s *JobListService) SetCommute(f CommuteFilter) {
+	s.commute = f
+}
+
+// geocode places a radius filter's center
+func (s *JobListService) geocode(ctx context.Context, location string) (*domain.GeoLocation, error) {
+	if s.geocoder == nil {
+		return nil, geo.ErrDisabled
+	}
+	return s.geocoder.Geocode(ctx, location)
+}
+
+// SetTaskEvents records scrape task steps on the shared task timeline
+func (s *JobListService) SetTaskEvents(events *taskevents.Log) {
+	s.events = events
+}
+
+// sourceWeight returns the SQL expression weighting a job (alias j) by its source
+func (s *JobListService) sourceWeight() string {
+	if s.weights == nil {
+		return "1"
+	}
+	return s.weights.SQL("j.source")
+}
+
+// GetRecommendations returns the best-matching active jobs not yet applied
+// to, ranked by match score scaled by the source weights
+func (s *JobListService) GetRecommendations(ctx context.Context, limit int) ([]domain.JobRecommendation, error) {
+	rows, err := s.db.Query(ctx, `SELECT `+jobColumns+`, `+applicationStatusColumn+`
+		FROM jobs j LEFT JOIN companies c ON c.id = j.company_id
+		WHERE `+activeJobs+` AND j.match_score >= $1
+		  AND NOT EXISTS (SELECT 1 FROM applications a WHERE a.job_id = j.id AND a.deleted_at IS NULL)
+		ORDER BY j.match_score * `+s.sourceWeight()+` DESC, j.posted_at DESC NULLS LAST
+		LIMIT $2`, recommendationMinScore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query recommendations: %w", err)
+	}
+	defer rows.Close()
+
+	recommendations := []domain.JobRecommendation{}
+	for rows.Next() {
+		job, status, err := scanJobWithStatus(rows)
+		if err != nil {
+			return nil, err
+		}
+		score := domain.RankingScore(*job.MatchScore, job.RepostCount)
+		if s.weights != nil {
+			score = min(score*s.weights.Weight(job.Source), 100)
+		}
+		recommendations = append(recommendations, domain.JobRecommendation{
+			Job:                  toBrief(job, status),
+			RecommendationReason: recommendationReason(job),
+			RelevanceScore:       score,
+		})
+	}
+	return recommendations, rows.Err()
+}
+
+// recommendationReason explains a recommendation from the job's match data
+func recommendationReason(job *domain.Job) string {
+	quality := domain.GetMatchQuality(*job.MatchScore)
+	if job.MatchQuality != nil {
+		quality = *job.MatchQuality
+	}
+	return fmt.Sprintf("%s match for your resume (%.0f%%)", quality, *job.MatchScore)
+}
+
+// GenerateCoverLetter is not available until an LLM-backed generator is wired in
+func (s *JobListService) GenerateCoverLetter(ctx context.Context, jobID uuid.UUID, customPrompt *string) (*domain.CoverLetterResponse, error) {
+	return nil, ErrCoverLetterUnavailable
+}
+
+// GetJobStats aggregates the job corpus
+func (s *JobListService) GetJobStats(ctx context.Context) (*domain.JobSearchStats, error) {
+	return s.stats.GetJobStats(ctx)
+}
+
+// GetApplicationStats aggregates application outcomes
+func (s *JobListService) GetApplicationStats(ctx context.Context) (*domain.ApplicationStats, error) {
+	return s.stats.GetApplicationStats(ctx)
+}
@@ -0,0 +1,663 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/geo"
+	"github.com/resume-rag/backend/internal/search"
+)
+
+// activeJobs restricts queries on jobs j to live, non-deleted listings
+const activeJobs = `j.is_active = TRUE AND j.deleted_at IS NULL`
+
+// facetLimit is how many values each facet reports
+const facetLimit = 20
+
+// jobColumns selects a job joined with its company (aliases j and c), in scanJob order
+const jobColumns = `j.id, coalesce(j.source_url, ''), j.title, j.description, j.location,
+	j.location_type::text, coalesce(j.employment_type, ''), j.city, j.state, j.country,
+	j.latitude, j.longitude, j.salary_min, j.salary_max, coalesce(j.salary_currency, 'USD'),
+	j.required_skills, j.posted_at, j.source::text, j.is_active, j.content_hash, j.sources,
+	j.first_seen_at, j.last_seen_at, j.reposted_at, coalesce(j.repost_count, 0),
+	j.quality_score, j.quality_flags, j.pay_grade, j.security_clearance, j.new_grad, j.seasons,
+	j.application_deadline, j.contacts, j.tech_stack, j.match_score::float8, j.match_quality::text,
+	j.created_at, j.updated_at, j.deleted_at, j.embedding_id,
+	c.id, coalesce(c.name, ''), c.logo_url, c.domain, c.industry, c.size::text,
+	c.glassdoor_rating::float8, c.h1b_filings, coalesce(c.likely_sponsors_visa, FALSE), c.created_at`
+
+// applicationStatusColumn selects the status of the tenant's application to job j
+const applicationStatusColumn = `(SELECT a.status::text FROM applications a
+	WHERE a.job_id = j.id AND a.deleted_at IS NULL ORDER BY a.updated_at DESC LIMIT 1)`
+
+// scanJob reads a row selected with jobColumns
+func scanJob(row pgx.Row, extra ...interface{}) (*domain.Job, error) {
+	var (
+		job                       domain.Job
+		locationType, companySize *string
+		city, state, country      *string
+		latitude, longitude       *float64
+		source                    string
+		employmentType            string
+		qualityFlags              []string
+		matchQuality              *string
+		companyID                 *uuid.UUID
+		companyCreated            *time.Time
+	)
+	dest := []interface{}{
+		&job.ID, &job.URL, &job.Title, &job.Description, &job.Location,
+		&locationType, &employmentType, &city, &state, &country,
+		&latitude, &longitude, &job.SalaryMin, &job.SalaryMax, &job.SalaryCurrency,
+		&job.RequiredSkills, &job.PostedDate, &source, &job.IsActive, &job.ContentHash, &job.Sources,
+		&job.FirstSeenAt, &job.LastSeenAt, &job.RepostedAt, &job.RepostCount,
+		&job.QualityScore, &qualityFlags, &job.PayGrade, &job.Clearance, &job.NewGrad, &job.Seasons,
+		&job.Deadline, &job.Contacts, &job.TechStack, &job.MatchScore, &matchQuality,
+		&job.CreatedAt, &job.UpdatedAt, &job.DeletedAt, &job.EmbeddingID,
+		&companyID, &job.Company.Name, &job.Company.LogoURL, &job.Company.Website, &job.Company.Industry, &companySize,
+		&job.Company.Rating, &job.Company.H1BFilings, &job.Company.LikelySponsorsVisa, &companyCreated,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	if locationType != nil {
+		t := domain.LocationType(*locationType)
+		job.LocationType = &t
+	}
+	if companyID != nil {
+		job.Company.ID = *companyID
+	}
+	if companySize != nil {
+		size := domain.CompanySize(*companySize)
+		job.Company.Size = &size
+	}
+	if companyCreated != nil {
+		job.Company.CreatedAt = *companyCreated
+	}
+	if matchQuality != nil {
+		q := domain.MatchQuality(*matchQuality)
+		job.MatchQuality = &q
+	}
+	if latitude != nil && longitude != nil {
+		job.Geo = &domain.GeoLocation{
+			City:      deref(city),
+			State:     deref(state),
+			Country:   deref(country),
+			Latitude:  *latitude,
+			Longitude: *longitude,
+		}
+	}
+	job.Source = domain.JobSource(source)
+	job.EmploymentType = domain.EmploymentType(employmentType)
+	job.ScrapedAt = job.CreatedAt
+	for _, flag := range qualityFlags {
+		job.QualityFlags = append(job.QualityFlags, domain.QualityFlag(flag))
+	}
+	return &job, nil
+}
+
+// scanJobWithStatus reads a row selected with jobColumns and applicationStatusColumn
+func scanJobWithStatus(row pgx.Row) (*domain.Job, *domain.ApplicationStatus, error) {
+	var status *string
+	job, err := scanJob(row, &status)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to scan job: %w", err)
+	}
+	if status == nil {
+		return job, nil, nil
+	}
+	s := statusFromDB(*status)
+	return job, &s, nil
+}
+
+// toBrief condenses a job for list views
+func toBrief(job *domain.Job, status *domain.ApplicationStatus) domain.JobBrief {
+	return domain.JobBrief{
+		ID:                 job.ID,
+		Title:              job.Title,
+		CompanyName:        job.Company.Name,
+		CompanyLogo:        job.Company.LogoURL,
+		CompanyRating:      job.Company.Rating,
+		Location:           job.Location,
+		LocationType:       job.LocationType,
+		EmploymentType:     job.EmploymentType,
+		LikelySponsorsVisa: job.Company.LikelySponsorsVisa,
+		SalaryText:         job.SalaryText,
+		Clearance:          job.Clearance,
+		NewGrad:            job.NewGrad,
+		Seasons:            job.Seasons,
+		Deadline:           job.Deadline,
+		TechStack:          job.TechStack,
+		PostedDate:         job.PostedDate,
+		Source:             job.Source,
+		FirstSeenAt:        job.FirstSeenAt,
+		RepostCount:        job.RepostCount,
+		QualityScore:       job.QualityScore,
+		MatchScore:         job.MatchScore,
+		MatchQuality:       job.MatchQuality,
+		ApplicationStatus:  status,
+		Commute:            job.Commute,
+	}
+}
+
+// Search runs a job search; a free-text query applies when the filters have none
+func (s *JobListService) Search(ctx context.Context, req domain.JobSearchRequest) (*domain.JobSearchResponse, error) {
+	filters := req.Filters
+	if req.Query != nil && (filters == nil || filters.Query == nil) {
+		f := domain.JobFilters{}
+		if filters != nil {
+			f = *filters
+		}
+		f.Query = req.Query
+		filters = &f
+	}
+	return s.GetJobs(ctx, req.Page, req.Limit, req.SortBy, req.SortOrder, filters)
+}
+
+// GetJobs lists active jobs matching the filters, one page at a time, with
+// facet counts over the whole result set. A radius filter keeps geocoded jobs
+// near the geocoded center; it fails with geo.ErrNotFound or
+// geo.ErrDisabled when the center can't be placed. A commute filter routes
+// the first maxCommuteCandidates matches from home and pages through those
+// within reach.
+func (s *JobListService) GetJobs(ctx context.Context, page, limit int, sortBy, sortOrder string, filters *domain.JobFilters) (*domain.JobSearchResponse, error) {
+	page = max(page, 1)
+	limit = max(limit, 1)
+
+	w := s.where(filters)
+	if filters != nil && filters.Near != nil {
+		center, err := s.geocode(ctx, *filters.Near)
+		if err != nil {
+			return nil, err
+		}
+		radius := s.defaultRadiusKm
+		if filters.RadiusKm != nil && *filters.RadiusKm > 0 {
+			radius = *filters.RadiusKm
+		}
+		w.within(*center, radius)
+	}
+	from := ` FROM jobs j LEFT JOIN companies c ON c.id = j.company_id WHERE ` + strings.Join(w.conds, " AND ")
+	query := `SELECT ` + jobColumns + `, ` + applicationStatusColumn + from + ` ORDER BY ` + w.orderBy(sortBy, sortOrder)
+
+	var jobs []domain.JobBrief
+	var total int
+	if filters != nil && filters.MaxCommute != nil && s.commute != nil {
+		matches, err := s.queryBriefs(ctx, query+fmt.Sprintf(` LIMIT %d`, maxCommuteCandidates), w.args,
+			func(found []*domain.Job) []*domain.Job { return s.commute.Filter(ctx, found, filters) })
+		if err != nil {
+			return nil, err
+		}
+		total = len(matches)
+		jobs = matches[min((page-1)*limit, total):min(page*limit, total)]
+	} else {
+		if err := s.db.QueryRow(ctx, `SELECT count(*)`+from, w.args...).Scan(&total); err != nil {
+			return nil, fmt.Errorf("failed to count jobs: %w", err)
+		}
+		args := append(w.args, limit, (page-1)*limit)
+		var err error
+		jobs, err = s.queryBriefs(ctx, query+fmt.Sprintf(` LIMIT $%d OFFSET $%d`, len(args)-1, len(args)), args, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	facets, err := s.facets(ctx, from, w.args)
+	if err != nil {
+		return nil, err
+	}
+	scrapeStatus, err := s.currentScrapeStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.JobSearchResponse{
+		Jobs:           jobs,
+		Total:          total,
+		Page:           page,
+		Pages:          (total + limit - 1) / limit,
+		Limit:          limit,
+		ScrapeStatus:   scrapeStatus,
+		FiltersApplied: filters,
+		Facets:         facets,
+	}, nil
+}
+
+// queryBriefs runs a job list query and condenses the jobs it returns,
+// passing them through filter first when one is given
+func (s *JobListService) queryBriefs(ctx context.Context, query string, args []interface{}, filter func([]*domain.Job) []*domain.Job) ([]domain.JobBrief, error) {
+	rows, err := s.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query jobs: %w", err)
+	}
+	defer rows.Close()
+
+	var found []*domain.Job
+	statuses := make(map[uuid.UUID]*domain.ApplicationStatus)
+	for rows.Next() {
+		job, status, err := scanJobWithStatus(rows)
+		if err != nil {
+			return nil, err
+		}
+		found = append(found, job)
+		statuses[job.ID] = status
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	rows.Close()
+
+	if filter != nil {
+		found = filter(found)
+	}
+	jobs := make([]domain.JobBrief, 0, len(found))
+	for _, job := range found {
+		jobs = append(jobs, toBrief(job, statuses[job.ID]))
+	}
+	return jobs, nil
+}
+
+// facets counts the matching jobs by country, state and employment type
+func (s *JobListService) facets(ctx context.Context, from string, args []interface{}) (*domain.JobFacets, error) {
+	facets := &domain.JobFacets{}
+	for _, f := range []struct {
+		column string
+		into   *[]domain.FacetCount
+	}{
+		{"j.country", &facets.Countries},
+		{"j.state", &facets.States},
+		{"j.employment_type", &facets.EmploymentTypes},
+	} {
+		rows, err := s.db.Query(ctx, fmt.Sprintf(`SELECT %s, count(*)%s AND %s IS NOT NULL
+			GROUP BY 1 ORDER BY 2 DESC, 1 LIMIT %d`, f.column, from, f.column, facetLimit), args...)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count facets: %w", err)
+		}
+		counts, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (domain.FacetCount, error) {
+			var fc domain.FacetCount
+			err := row.Scan(&fc.Value, &fc.Count)
+			return fc, err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan facets: %w", err)
+		}
+		*f.into = counts
+	}
+	return facets, nil
+}
+
+// jobQuery accumulates WHERE conditions and their positional arguments
+type jobQuery struct {
+	conds   []string
+	args    []interface{}
+	tsquery string // placeholder of the full-text query, for relevance sorting
+	weight  string // source weight expression scaling match and relevance sorting
+}
+
+// arg adds a query argument and returns its placeholder
+func (q *jobQuery) arg(v interface{}) string {
+	q.args = append(q.args, v)
+	return "$" + strconv.Itoa(len(q.args))
+}
+
+func (q *jobQuery) add(cond string) {
+	q.conds = append(q.conds, cond)
+}
+
+// within keeps jobs geocoded within radiusKm of center. The bounding box
+// narrows rows through the coordinate index before the exact distance.
+func (q *jobQuery) within(center domain.GeoLocation, radiusKm float64) {
+	minLat, maxLat, minLon, maxLon := geo.BoundingBox(center, radiusKm)
+	q.add("j.latitude BETWEEN " + q.arg(minLat) + " AND " + q.arg(maxLat))
+	q.add("j.longitude BETWEEN " + q.arg(minLon) + " AND " + q.arg(maxLon))
+
+	lat, lon := q.arg(center.Latitude), q.arg(center.Longitude)
+	q.add(fmt.Sprintf(`2 * %s::float8 * asin(least(1, sqrt(
+		power(sin(radians(j.latitude - %s) / 2), 2) +
+		cos(radians(%s)) * cos(radians(j.latitude)) * power(sin(radians(j.longitude - %s) / 2), 2)
+	))) <= %s::float8`, q.arg(geo.EarthRadiusKm), lat, lat, lon, q.arg(radiusKm)))
+}
+
+// where translates job filters into SQL conditions on jobs j and companies c
+func (s *JobListService) where(f *domain.JobFilters) *jobQuery {
+	q := &jobQuery{conds: []string{activeJobs}, weight: s.sourceWeight()}
+	if f == nil {
+		return q
+	}
+
+	if f.Query != nil {
+		if ts := search.ToTSQuery(*f.Query); ts != "" {
+			q.tsquery = q.arg(ts)
+			q.add("j.search_vector @@ to_tsquery('english', " + q.tsquery + ")")
+		}
+	}
+	for _, kw := range f.Keywords {
+		p := q.arg("%" + kw + "%")
+		q.add("(j.title ILIKE " + p + " OR j.description ILIKE " + p + ")")
+	}
+	if f.Location != nil && *f.Location != "" {
+		q.add("j.location ILIKE " + q.arg("%"+*f.Location+"%"))
+	}
+	if len(f.Countries) > 0 {
+		q.add("j.country = ANY(" + q.arg(f.Countries) + ")")
+	}
+	if len(f.States) > 0 {
+		q.add("j.state = ANY(" + q.arg(f.States) + ")")
+	}
+	if len(f.LocationTypes) > 0 {
+		q.add("j.location_type::text = ANY(" + q.arg(strs(f.LocationTypes)) + ")")
+	}
+	if len(f.EmploymentTypes) > 0 {
+		q.add("j.employment_type = ANY(" + q.arg(strs(f.EmploymentTypes)) + ")")
+	}
+	if f.SalaryMin != nil {
+		q.add("coalesce(j.salary_max, j.salary_min) >= " + q.arg(*f.SalaryMin))
+	}
+	if f.SalaryMax != nil {
+		q.add("coalesce(j.salary_min, j.salary_max) <= " + q.arg(*f.SalaryMax))
+	}
+	if len(f.CompanySizes) > 0 {
+		q.add("c.size::text = ANY(" + q.arg(strs(f.CompanySizes)) + ")")
+	}
+	if len(f.Sources) > 0 {
+		q.add("j.source::text = ANY(" + q.arg(strs(f.Sources)) + ")")
+	}
+	if f.PostedWithinDays != nil {
+		q.add("j.posted_at >= NOW() - make_interval(days => " + q.arg(*f.PostedWithinDays) + ")")
+	}
+	if f.Industry != nil && *f.Industry != "" {
+		q.add("c.industry ILIKE " + q.arg(*f.Industry))
+	}
+	if f.GenuinelyNew {
+		q.add("coalesce(j.repost_count, 0) = 0")
+	}
+	if f.SponsorsVisa {
+		q.add("c.likely_sponsors_visa")
+	}
+	if f.MinRating != nil {
+		q.add("c.glassdoor_rating >= " + q.arg(*f.MinRating))
+	}
+	if f.HideLowQuality {
+		q.add("(j.quality_score IS NULL OR j.quality_score >= " + q.arg(s.minQuality) + ")")
+	}
+	if f.NewGrad {
+		q.add("j.new_grad")
+	}
+	if len(f.Seasons) > 0 {
+		q.add("j.seasons && " + q.arg(f.Seasons))
+	}
+	if f.ClosingWithin != nil {
+		q.add("j.application_deadline BETWEEN NOW() AND NOW() + make_interval(days => " + q.arg(*f.ClosingWithin) + ")")
+	}
+	if len(f.Stack) > 0 {
+		q.add("j.tech_stack_terms @> " + q.arg(f.Stack))
+	}
+	if len(f.ExcludeStack) > 0 {
+		q.add("NOT j.tech_stack_terms && " + q.arg(f.ExcludeStack))
+	}
+	if f.SeenAfter != nil {
+		q.add("j.first_seen_at > " + q.arg(*f.SeenAfter))
+	}
+	if len(f.JobIDs) > 0 {
+		q.add("j.id = ANY(" + q.arg(f.JobIDs) + ")")
+	}
+	return q
+}
+
+// orderBy returns the ORDER BY clause for a sort key, newest postings first
+// as the fallback and the job ID as a stable tie-breaker. Match and relevance
+// sorting is scaled by the source weights.
+func (q *jobQuery) orderBy(sortBy, sortOrder string) string {
+	dir := "DESC"
+	if strings.EqualFold(sortOrder, "asc") {
+		dir = "ASC"
+	}
+
+	var key string
+	switch sortBy {
+	case "match_score":
+		key = "j.match_score * " + q.weight
+	case "salary":
+		key = "coalesce(j.salary_max, j.salary_min)"
+	case "deadline":
+		key = "j.application_deadline"
+	case "first_seen":
+		key = "j.first_seen_at"
+	case "relevance":
+		if q.tsquery != "" {
+			key = "ts_rank_cd(j.search_vector, to_tsquery('english', " + q.tsquery + "), 32) * " + q.weight
+		}
+	}
+	if key == "" {
+		return fmt.Sprintf("j.posted_at %s NULLS LAST, j.id", dir)
+	}
+	return fmt.Sprintf("%s %s NULLS LAST, j.posted_at DESC NULLS LAST, j.id", key, dir)
+}
+
+// GetJobDetails returns a job with its company
+func (s *JobListService) GetJobDetails(ctx context.Context, jobID uuid.UUID) (*domain.Job, error) {
+	job, err := scanJob(s.db.QueryRow(ctx, `SELECT `+jobColumns+`
+		FROM jobs j LEFT JOIN companies c ON c.id = j.company_id
+		WHERE j.id = $1 AND j.deleted_at IS NULL`, jobID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job: %w", err)
+	}
+
+	if job.Revisions, err = s.jobRevisions(ctx, jobID, nil); err != nil {
+		return nil, err
+	}
+	if len(job.Revisions) > 0 {
+		saved, err := s.savedAt(ctx, jobID)
+		if err != nil {
+			return nil, err
+		}
+		job.UpdatedSinceSaved = saved != nil && job.Revisions[0].ChangedAt.After(*saved)
+	}
+	return job, nil
+}
+
+// DeleteJob moves a job to the trash
+func (s *JobListService) DeleteJob(ctx context.Context, jobID uuid.UUID) error {
+	tag, err := s.db.Exec(ctx, `UPDATE jobs SET deleted_at = NOW() WHERE id = $1 AND deleted_at IS NULL`, jobID)
+	if err != nil {
+		return fmt.Errorf("failed to delete job: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrJobNotFound
+	}
+	return nil
+}
+
+// RestoreJob takes a job back out of the trash
+func (s *JobListService) RestoreJob(ctx context.Context, jobID uuid.UUID) (*domain.Job, error) {
+	tag, err := s.db.Exec(ctx, `UPDATE jobs SET deleted_at = NULL WHERE id = $1 AND deleted_at IS NOT NULL`, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore job: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return nil, ErrJobNotFound
+	}
+	return s.GetJobDetails(ctx, jobID)
+}
+
+// GetTrash lists soft-deleted jobs and applications still within retention,
+// most recently deleted first
+func (s *JobListService) GetTrash(ctx context.Context) (*domain.TrashResponse, error) {
+	rows, err := s.db.Query(ctx, `
+		SELECT j.id, 'job', j.title, coalesce(c.name, ''), j.deleted_at
+		FROM jobs j LEFT JOIN companies c ON c.id = j.company_id
+		WHERE j.deleted_at > $1
+		UNION ALL
+		SELECT a.id, 'application', coalesce(j.title, ''), coalesce(c.name, ''), a.deleted_at
+		FROM applications a
+		LEFT JOIN jobs j ON j.id = a.job_id
+		LEFT JOIN companies c ON c.id = j.company_id
+		WHERE a.deleted_at > $1
+		ORDER BY 5 DESC`, time.Now().Add(-domain.TrashRetention))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list trash: %w", err)
+	}
+	defer rows.Close()
+
+	items := []domain.TrashItem{}
+	for rows.Next() {
+		var item domain.TrashItem
+		if err := rows.Scan(&item.ID, &item.Type, &item.Title, &item.Company, &item.DeletedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan trash item: %w", err)
+		}
+		item.PurgeAt = item.DeletedAt.Add(domain.TrashRetention)
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return &domain.TrashResponse{Items: items, Total: len(items)}, nil
+}
+
+// repostedSince is true when a saved job was posted again at least
+// domain.RepostMinGap ($38, in seconds) after its stored posted or repost
+// date. Reposts spotted within one scrape batch arrive as $14 (reposted_at);
+// this catches those only visible across scrapes.
+const repostedSince = `coalesce($14, $11) >= coalesce(reposted_at, posted_at) + $38 * interval '1 second'`
+
+// mergedSources adds the boards a job was just seen on ($12) to those already
+// stored, once per board and URL, keeping the first sighting of each. Rows
+// saved before sources were tracked may hold null rather than a list.
+const mergedSources = `(
+	SELECT coalesce(jsonb_agg(ref ORDER BY pos), '[]'::jsonb) FROM (
+		SELECT DISTINCT ON (ref->>'source', ref->>'url') ref, pos
+		FROM jsonb_array_elements(
+			CASE jsonb_typeof(jobs.sources) WHEN 'array' THEN jobs.sources ELSE '[]'::jsonb END || $12::jsonb
+		) WITH ORDINALITY AS s(ref, pos)
+		ORDER BY ref->>'source', ref->>'url', pos
+	) merged)`
+
+// SaveJob stores a scraped job and its company. A job whose content hash is
+// already stored refreshes that record instead, so cross-posts and re-scrapes
+// stay one row, listing every board the job was seen on. A posting that
+// changed since it was stored is matched by its canonical URL, and the change
+// is recorded as a revision. The record keeps its earliest posted date; a
+// later one counts as a repost.
+func (s *JobListService) SaveJob(ctx context.Context, job *domain.Job) error {
+	job.CanonicalizeURLs()
+	hash := job.EnsureContentHash()
+	companyID, err := s.saveCompany(ctx, &job.Company)
+	if err != nil {
+		return err
+	}
+	revised, err := s.findRevised(ctx, job, hash)
+	if err != nil {
+		return err
+	}
+	var revisedID *uuid.UUID
+	if revised != nil {
+		revisedID = &revised.ID
+	}
+
+	var locationType *string
+	if job.LocationType != nil {
+		t := string(*job.LocationType)
+		locationType = &t
+	}
+	var employmentType *string
+	if job.EmploymentType != "" {
+		t := string(job.EmploymentType)
+		employmentType = &t
+	}
+	var city, state, country *string
+	var latitude, longitude *float64
+	if g := job.Geo; g != nil {
+		city, state, country = optional(g.City), optional(g.State), optional(g.Country)
+		latitude, longitude = &g.Latitude, &g.Longitude
+	}
+	sources := job.Sources
+	if sources == nil {
+		sources = []domain.JobSourceRef{}
+	}
+	currency := job.SalaryCurrency
+	if currency == "" {
+		currency = "USD"
+	}
+
+	err = s.db.QueryRow(ctx, `
+		WITH existing AS (
+			UPDATE jobs SET
+				title = $3, description = $4, location = $5, location_type = $6::location_type,
+				employment_type = $7, salary_min = $8, salary_max = $9, salary_currency = $10,
+				posted_at = least(posted_at, $11), is_active = TRUE, sources = `+mergedSources+`,
+				last_seen_at = coalesce($13, NOW()),
+				reposted_at = CASE WHEN `+repostedSince+` THEN coalesce($14, $11) ELSE reposted_at END,
+				repost_count = repost_count + CASE WHEN `+repostedSince+` THEN 1 ELSE 0 END,
+				quality_score = $16, quality_flags = $17, tech_stack = $18, tech_stack_terms = $19,
+				application_deadline = $20, embedding_id = coalesce($36, embedding_id), content_hash = $1,
+				updated_at = NOW()
+			WHERE (content_hash = $1 OR id = $37) AND deleted_at IS NULL
+			RETURNING id
+		), inserted AS (
+			INSERT INTO jobs (id, company_id, title, description, location, location_type,
+				employment_type, salary_min, salary_max, salary_currency, posted_at, source, source_url,
+				content_hash, sources, first_seen_at, last_seen_at, reposted_at, repost_count,
+				quality_score, quality_flags, tech_stack, tech_stack_terms, application_deadline,
+				required_skills, pay_grade, security_clearance, new_grad, seasons, contacts,
+				city, state, country, latitude, longitude, embedding_id)
+			SELECT $21, $2, $3, $4, $5, $6::location_type, $7, $8, $9, $10, $11, $22::job_source, $23,
+				$1, $12, coalesce($24, NOW()), coalesce($13, NOW()), $14, $15, $16, $17, $18, $19, $20,
+				$25, $26, $27, $28, $29, coalesce($30, '[]'::jsonb), $31, $32, $33, $34, $35, $36
+			WHERE NOT EXISTS (SELECT 1 FROM existing)
+			RETURNING id
+		)
+		SELECT id FROM existing UNION ALL SELECT id FROM inserted`,
+		hash, companyID, job.Title, job.Description, job.Location, locationType,
+		employmentType, job.SalaryMin, job.SalaryMax, currency,
+		job.PostedDate, sources,
+		job.LastSeenAt, job.RepostedAt, job.RepostCount,
+		job.QualityScore, strs(job.QualityFlags), job.TechStack, strs(job.TechStack.All()),
+		job.Deadline, uuid.New(), string(job.Source), job.URL,
+		job.FirstSeenAt, job.RequiredSkills, job.PayGrade, job.Clearance, job.NewGrad, strs(job.Seasons), job.Contacts,
+		city, state, country, latitude, longitude, job.EmbeddingID, revisedID,
+		domain.RepostMinGap.Seconds(),
+	).Scan(&job.ID)
+	if err != nil {
+		return fmt.Errorf("failed to save job: %w", err)
+	}
+
+	if revised != nil {
+		if rev := domain.NewJobRevision(revised, job); rev != nil {
+			return s.saveRevision(ctx, rev, deref(revised.ContentHash), hash)
+		}
+	}
+	return nil
+}
+
+// strs converts a slice of string-typed values for use as a text[] argument
+func strs[T ~string](values []T) []string {
+	out := make([]string, len(values))
+	for i, v := range values {
+		out[i] = string(v)
+	}
+	return out
+}
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
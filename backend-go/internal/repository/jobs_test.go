@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/migrations"
+)
+
+// testService connects to the database named by TEST_DATABASE_URL, bringing
+// its schema up to date, and skips the test when none is set
+func testService(t *testing.T) *JobListService {
+	t.Helper()
+	dsn := os.Getenv("TEST_DATABASE_URL")
+	if dsn == "" {
+		t.Skip("TEST_DATABASE_URL is not set")
+	}
+	ctx := context.Background()
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(pool.Close)
+	if _, err := migrations.Up(ctx, pool); err != nil {
+		t.Fatal(err)
+	}
+	return NewJobListService(pool, 0)
+}
+
+// testJob returns a job no other test run has stored
+func testJob(source domain.JobSource, url string, posted time.Time) *domain.Job {
+	desc := "Build job pipelines in Go. " + uuid.NewString()
+	return &domain.Job{
+		Title:       "Backend Engineer",
+		Description: desc,
+		Company:     domain.Company{Name: "Repository Test Co"},
+		Source:      source,
+		URL:         url,
+		PostedDate:  &posted,
+	}
+}
+
+func TestSaveJobCountsRepostAcrossScrapes(t *testing.T) {
+	s := testService(t)
+	ctx := context.Background()
+
+	posted := time.Now().Add(-8 * 24 * time.Hour).Truncate(time.Second)
+	first := testJob(domain.JobSourceIndeed, "https://www.indeed.com/viewjob?jk="+uuid.NewString()[:8], posted)
+	if err := s.SaveJob(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+
+	// The same posting, listed again a week later in another scrape
+	reposted := posted.Add(7 * 24 * time.Hour)
+	again := testJob(domain.JobSourceIndeed, first.URL, reposted)
+	again.Description = first.Description
+	if err := s.SaveJob(ctx, again); err != nil {
+		t.Fatal(err)
+	}
+	if again.ID != first.ID {
+		t.Fatalf("saved as %s, want the stored job %s", again.ID, first.ID)
+	}
+
+	got, err := s.GetJobDetails(ctx, first.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.RepostCount != 1 {
+		t.Errorf("repost_count = %d, want 1", got.RepostCount)
+	}
+	if got.PostedDate == nil || !got.PostedDate.Equal(posted) {
+		t.Errorf("posted_at = %v, want %v", got.PostedDate, posted)
+	}
+	if got.RepostedAt == nil || !got.RepostedAt.Equal(reposted) {
+		t.Errorf("reposted_at = %v, want %v", got.RepostedAt, reposted)
+	}
+
+	// Seeing the repost again is not another repost
+	if err := s.SaveJob(ctx, again); err != nil {
+		t.Fatal(err)
+	}
+	if got, err = s.GetJobDetails(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got.RepostCount != 1 {
+		t.Errorf("repost_count after rescrape = %d, want 1", got.RepostCount)
+	}
+}
+
+func TestSaveJobMergesSourcesAcrossScrapes(t *testing.T) {
+	s := testService(t)
+	ctx := context.Background()
+	posted := time.Now().Add(-24 * time.Hour).Truncate(time.Second)
+	id := uuid.NewString()[:8]
+
+	indeed := testJob(domain.JobSourceIndeed, "https://www.indeed.com/viewjob?jk="+id, posted)
+	indeed.Sources = []domain.JobSourceRef{{Source: domain.JobSourceIndeed, URL: indeed.URL, SeenAt: posted}}
+	linkedIn := testJob(domain.JobSourceLinkedIn, "https://www.linkedin.com/jobs/view/"+id, posted)
+	linkedIn.Description = indeed.Description
+	linkedIn.Sources = []domain.JobSourceRef{{Source: domain.JobSourceLinkedIn, URL: linkedIn.URL, SeenAt: posted}}
+
+	// Each board in its own scrape, then the first board again
+	for _, job := range []*domain.Job{indeed, linkedIn, indeed} {
+		if err := s.SaveJob(ctx, job); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := s.GetJobDetails(ctx, indeed.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Sources) != 2 || !got.HasSource(domain.JobSourceIndeed, indeed.URL) || !got.HasSource(domain.JobSourceLinkedIn, linkedIn.URL) {
+		t.Errorf("sources = %+v, want the Indeed and LinkedIn listings once each", got.Sources)
+	}
+}
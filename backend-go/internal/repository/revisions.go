@@ -0,0 +1,98 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// maxJobRevisions bounds the revisions returned with a job
+const maxJobRevisions = 10
+
+// findRevised returns the stored job at the scraped job's source URL when its
+// content has changed since, so the re-scrape updates it instead of being
+// stored as a new job. Jobs whose new content is already stored elsewhere
+// are left to the content hash match.
+func (s *JobListService) findRevised(ctx context.Context, job *domain.Job, hash string) (*domain.Job, error) {
+	if job.URL == "" {
+		return nil, nil
+	}
+	var stored domain.Job
+	err := s.db.QueryRow(ctx, `
+		SELECT id, title, description, salary_min, salary_max, content_hash FROM jobs
+		WHERE source = $1::job_source AND source_url = $2 AND deleted_at IS NULL
+			AND content_hash IS DISTINCT FROM $3
+			AND NOT EXISTS (SELECT 1 FROM jobs WHERE content_hash = $3 AND deleted_at IS NULL)
+		ORDER BY updated_at DESC
+		LIMIT 1`,
+		string(job.Source), job.URL, hash,
+	).Scan(&stored.ID, &stored.Title, &stored.Description, &stored.SalaryMin, &stored.SalaryMax, &stored.ContentHash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up job by URL: %w", err)
+	}
+	return &stored, nil
+}
+
+// saveRevision records a change between two versions of a job. A change
+// already recorded between the same two versions is not stored again.
+func (s *JobListService) saveRevision(ctx context.Context, rev *domain.JobRevision, previousHash, hash string) error {
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO job_revisions (job_id, previous_hash, content_hash, previous_title,
+			salary_changed, previous_salary_min, previous_salary_max, removed, added)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (job_id, previous_hash, content_hash) DO NOTHING`,
+		rev.JobID, previousHash, hash, rev.PreviousTitle,
+		rev.SalaryChanged, rev.PreviousSalaryMin, rev.PreviousSalaryMax, rev.Removed, rev.Added)
+	if err != nil {
+		return fmt.Errorf("failed to save job revision: %w", err)
+	}
+	return nil
+}
+
+// jobRevisions returns a job's revisions, newest first, optionally only those after since
+func (s *JobListService) jobRevisions(ctx context.Context, jobID uuid.UUID, since *time.Time) ([]domain.JobRevision, error) {
+	rows, err := s.db.Query(ctx, `
+		SELECT id, job_id, changed_at, previous_title, salary_changed,
+			previous_salary_min, previous_salary_max, removed, added
+		FROM job_revisions
+		WHERE job_id = $1 AND ($2::timestamptz IS NULL OR changed_at > $2)
+		ORDER BY changed_at DESC
+		LIMIT $3`, jobID, since, maxJobRevisions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get job revisions: %w", err)
+	}
+	defer rows.Close()
+
+	var revisions []domain.JobRevision
+	for rows.Next() {
+		var rev domain.JobRevision
+		if err := rows.Scan(&rev.ID, &rev.JobID, &rev.ChangedAt, &rev.PreviousTitle, &rev.SalaryChanged,
+			&rev.PreviousSalaryMin, &rev.PreviousSalaryMax, &rev.Removed, &rev.Added); err != nil {
+			return nil, fmt.Errorf("failed to scan job revision: %w", err)
+		}
+		revisions = append(revisions, rev)
+	}
+	return revisions, rows.Err()
+}
+
+// savedAt returns when the tenant first applied to or starred a job, or nil
+func (s *JobListService) savedAt(ctx context.Context, jobID uuid.UUID) (*time.Time, error) {
+	var saved *time.Time
+	err := s.db.QueryRow(ctx, `
+		SELECT least(
+			(SELECT min(created_at) FROM applications WHERE job_id = $1 AND deleted_at IS NULL),
+			(SELECT created_at FROM job_favorites WHERE job_id = $1))`, jobID).Scan(&saved)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read when job was saved: %w", err)
+	}
+	return saved, nil
+}
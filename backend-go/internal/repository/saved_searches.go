@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// savedSearchColumns selects a saved search in scanSavedSearch order
+const savedSearchColumns = `id, name, query, filters, coalesce(notify_new, FALSE), last_run_at, created_at`
+
+func scanSavedSearch(row pgx.Row) (*domain.SavedSearch, error) {
+	var search domain.SavedSearch
+	if err := row.Scan(&search.ID, &search.Name, &search.Query, &search.Filters,
+		&search.NotificationEnabled, &search.LastRunAt, &search.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &search, nil
+}
+
+// GetSavedSearches lists saved searches, newest first
+func (s *JobListService) GetSavedSearches(ctx context.Context) ([]domain.SavedSearch, error) {
+	rows, err := s.db.Query(ctx, `SELECT `+savedSearchColumns+` FROM saved_searches ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list saved searches: %w", err)
+	}
+	defer rows.Close()
+
+	searches := []domain.SavedSearch{}
+	for rows.Next() {
+		search, err := scanSavedSearch(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saved search: %w", err)
+		}
+		searches = append(searches, *search)
+	}
+	return searches, rows.Err()
+}
+
+// SaveSearch stores a search preset
+func (s *JobListService) SaveSearch(ctx context.Context, req domain.SavedSearchCreate) (*domain.SavedSearch, error) {
+	notify := req.NotificationEnabled != nil && *req.NotificationEnabled
+	var sources []string
+	if req.Filters != nil {
+		sources = strs(req.Filters.Sources)
+	}
+
+	search, err := scanSavedSearch(s.db.QueryRow(ctx, `
+		INSERT INTO saved_searches (name, query, filters, sources, notify_new)
+		VALUES ($1, $2, coalesce($3, '{}'::jsonb), $4::text[]::job_source[], $5)
+		RETURNING `+savedSearchColumns,
+		req.Name, req.Query, req.Filters, sources, notify))
+	if err != nil {
+		return nil, fmt.Errorf("failed to save search: %w", err)
+	}
+	return search, nil
+}
+
+// DeleteSavedSearch removes a search preset
+func (s *JobListService) DeleteSavedSearch(ctx context.Context, searchID uuid.UUID) error {
+	tag, err := s.db.Exec(ctx, `DELETE FROM saved_searches WHERE id = $1`, searchID)
+	if err != nil {
+		return fmt.Errorf("failed to delete saved search: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrSavedSearchNotFound
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// scrapeTaskColumns selects a scrape_queue row in scanScrapeTask order
+const scrapeTaskColumns = `id, keywords, location, sources::text[], max_jobs, profile_id, status::text, coalesce(jobs_found, 0),
+	error_message, error_summary, error_counts, rejected, started_at, completed_at, created_at`
+
+// scrapeStatusToDB maps a task status to the scrape_status enum, which calls queued tasks pending
+func scrapeStatusToDB(status domain.ScrapeStatus) string {
+	if status == domain.ScrapeStatusQueued {
+		return "pending"
+	}
+	return string(status)
+}
+
+func scrapeStatusFromDB(status string) domain.ScrapeStatus {
+	if status == "pending" {
+		return domain.ScrapeStatusQueued
+	}
+	return domain.ScrapeStatus(status)
+}
+
+func scanScrapeTask(row pgx.Row) (*domain.ScrapeTask, error) {
+	var task domain.ScrapeTask
+	var sources []string
+	var status string
+	if err := row.Scan(&task.ID, &task.Keywords, &task.Location, &sources, &task.MaxJobs, &task.ProfileID, &status, &task.JobsFound,
+		&task.Error, &task.Errors, &task.ErrorCounts, &task.Rejected, &task.StartedAt, &task.FinishedAt, &task.CreatedAt); err != nil {
+		return nil, err
+	}
+	task.Sources = make([]domain.JobSource, 0, len(sources))
+	for _, source := range sources {
+		task.Sources = append(task.Sources, domain.JobSource(source))
+	}
+	task.Status = scrapeStatusFromDB(status)
+	return &task, nil
+}
+
+// TriggerScrape queues a scrape of the given sources, or of every source when
+// none are named, for a scrape worker to pick up
+func (s *JobListService) TriggerScrape(ctx context.Context, keywords []string, location *string, sources []string) (*domain.ScrapeTask, error) {
+	return s.QueueScrape(ctx, domain.ScrapeRequest{Keywords: keywords, Location: location, Sources: sources})
+}
+
+// QueueScrape queues a scrape task, recording the job limit and the scrape
+// profile it was queued from
+func (s *JobListService) QueueScrape(ctx context.Context, req domain.ScrapeRequest) (*domain.ScrapeTask, error) {
+	keywords, sources := req.Keywords, req.Sources
+	if keywords == nil {
+		keywords = []string{}
+	}
+	if sources == nil {
+		sources = []string{}
+	}
+	task, err := scanScrapeTask(s.db.QueryRow(ctx, `
+		INSERT INTO scrape_queue (search_query, keywords, location, sources, max_jobs, profile_id, status)
+		VALUES ($1, $2, $3, $4::text[]::job_source[], $5, $6, 'pending')
+		RETURNING `+scrapeTaskColumns,
+		strings.Join(keywords, " "), keywords, req.Location, sources, req.MaxJobs, req.ProfileID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to queue scrape: %w", err)
+	}
+	s.events.Record(ctx, task.ID, domain.TaskKindScrape, domain.TaskEventQueued, "Scrape queued", map[string]interface{}{
+		"keywords": keywords, "sources": sources, "max_jobs": req.MaxJobs,
+	})
+	return task, nil
+}
+
+// GetScrapeStatus returns a scrape task
+func (s *JobListService) GetScrapeStatus(ctx context.Context, taskID uuid.UUID) (*domain.ScrapeTask, error) {
+	task, err := scanScrapeTask(s.db.QueryRow(ctx, `SELECT `+scrapeTaskColumns+` FROM scrape_queue WHERE id = $1`, taskID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrTaskNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scrape task: %w", err)
+	}
+	return task, nil
+}
+
+// CancelScrape cancels a queued or running scrape task. A task that already
+// finished is reported with a 409 fiber error, as the handler expects.
+func (s *JobListService) CancelScrape(ctx context.Context, taskID uuid.UUID) (*domain.ScrapeTask, error) {
+	task, err := scanScrapeTask(s.db.QueryRow(ctx, `
+		UPDATE scrape_queue SET status = 'cancelled', completed_at = NOW()
+		WHERE id = $1 AND status IN ('pending', 'in_progress')
+		RETURNING `+scrapeTaskColumns, taskID))
+	if err == nil {
+		s.events.Record(ctx, task.ID, domain.TaskKindScrape, domain.TaskEventCancelled, "Scrape cancelled", nil)
+		return task, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to cancel scrape task: %w", err)
+	}
+
+	task, err = s.GetScrapeStatus(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	return nil, fiber.NewError(fiber.StatusConflict, fmt.Sprintf("Task already %s", task.Status))
+}
+
+// ClaimScrapeTask marks the oldest queued scrape task in progress and returns
+// it, or nil when none is queued. Rows are claimed with SKIP LOCKED, so
+// workers on several replicas never take the same task.
+func (s *JobListService) ClaimScrapeTask(ctx context.Context) (*domain.ScrapeTask, error) {
+	task, err := scanScrapeTask(s.db.QueryRow(ctx, `
+		UPDATE scrape_queue SET status = 'in_progress', started_at = NOW()
+		WHERE id = (
+			SELECT id FROM scrape_queue
+			WHERE status = 'pending' AND source IS NULL
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+scrapeTaskColumns))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim scrape task: %w", err)
+	}
+	s.events.Record(ctx, task.ID, domain.TaskKindScrape, domain.TaskEventStarted, "Scrape started", nil)
+	return task, nil
+}
+
+// UpdateScrapeTask records a scrape worker's progress on a task. A task
+// cancelled in the meantime stays cancelled.
+func (s *JobListService) UpdateScrapeTask(ctx context.Context, task *domain.ScrapeTask) error {
+	errorSummary := task.Errors
+	if errorSummary == nil {
+		errorSummary = []domain.ScrapeErrorSummary{}
+	}
+	tag, err := s.db.Exec(ctx, `
+		UPDATE scrape_queue SET
+			status = $2::scrape_status, jobs_found = $3, error_message = $4, error_summary = $5,
+			error_counts = coalesce($6, '{}'::jsonb), rejected = coalesce($7, '{}'::jsonb),
+			started_at = $8, completed_at = $9
+		WHERE id = $1 AND status <> 'cancelled'`,
+		task.ID, scrapeStatusToDB(task.Status), task.JobsFound, task.Error, errorSummary,
+		task.ErrorCounts, task.Rejected, task.StartedAt, task.FinishedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update scrape task: %w", err)
+	}
+	if tag.RowsAffected() > 0 {
+		s.recordScrapeProgress(ctx, task)
+	}
+	return nil
+}
+
+// recordScrapeProgress adds a worker's update to the task's timeline
+func (s *JobListService) recordScrapeProgress(ctx context.Context, task *domain.ScrapeTask) {
+	data := map[string]interface{}{"jobs_found": task.JobsFound}
+	if len(task.ErrorCounts) > 0 {
+		data["error_counts"] = task.ErrorCounts
+	}
+	switch task.Status {
+	case domain.ScrapeStatusInProgress:
+		s.events.Record(ctx, task.ID, domain.TaskKindScrape, domain.TaskEventProgress, "Scrape in progress", data)
+	case domain.ScrapeStatusCompleted:
+		s.events.Record(ctx, task.ID, domain.TaskKindScrape, domain.TaskEventCompleted, "Scrape completed", data)
+	case domain.ScrapeStatusFailed:
+		message := "Scrape failed"
+		if task.Error != nil {
+			message += ": " + *task.Error
+		}
+		s.events.Record(ctx, task.ID, domain.TaskKindScrape, domain.TaskEventFailed, message, data)
+	}
+}
+
+// currentScrapeStatus reports whether a scrape is running, so search results
+// can say more jobs may be on the way
+func (s *JobListService) currentScrapeStatus(ctx context.Context) (domain.ScrapeStatus, error) {
+	var running bool
+	if err := s.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM scrape_queue WHERE status = 'in_progress')`).Scan(&running); err != nil {
+		return "", fmt.Errorf("failed to read scrape status: %w", err)
+	}
+	if running {
+		return domain.ScrapeStatusInProgress, nil
+	}
+	return domain.ScrapeStatusCompleted, nil
+}
@@ -0,0 +1,142 @@
+package resumes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// uniqueViolation is the Postgres error code for a duplicate key
+const uniqueViolation = "23505"
+
+// PostgresStore persists resume versions in the resumes table. Rows tailored
+// to an application (see tailor.PostgresStore) are not versions.
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed resume version store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const versionColumns = `id, name, content, is_primary, created_at, updated_at`
+
+// Save inserts or replaces a version. Applications linked to a renamed
+// version are relabelled with the new name.
+func (p *PostgresStore) Save(ctx context.Context, v *domain.ResumeVersion) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO resumes (`+versionColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name, content = EXCLUDED.content, updated_at = EXCLUDED.updated_at`,
+		v.ID, v.Name, v.Content, v.Active, v.CreatedAt, v.UpdatedAt,
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return ErrNameTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save resume version: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `
+		UPDATE applications SET resume_version = $2
+		WHERE resume_id = $1 AND resume_version IS DISTINCT FROM $2`, v.ID, v.Name); err != nil {
+		return fmt.Errorf("failed to relabel applications: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// Get returns a version by ID
+func (p *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVersion, error) {
+	v, err := scanVersion(p.db.QueryRow(ctx, `
+		SELECT `+versionColumns+` FROM resumes WHERE id = $1 AND application_id IS NULL`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resume version: %w", err)
+	}
+	return v, nil
+}
+
+// List returns every version, most recently updated first
+func (p *PostgresStore) List(ctx context.Context) ([]domain.ResumeVersion, error) {
+	rows, err := p.db.Query(ctx, `
+		SELECT `+versionColumns+` FROM resumes
+		WHERE application_id IS NULL
+		ORDER BY updated_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resume versions: %w", err)
+	}
+	defer rows.Close()
+
+	list := []domain.ResumeVersion{}
+	for rows.Next() {
+		v, err := scanVersion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan resume version: %w", err)
+		}
+		list = append(list, *v)
+	}
+	return list, rows.Err()
+}
+
+// Delete removes a version; applications sent with it keep its name as their label
+func (p *PostgresStore) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := p.db.Exec(ctx, `DELETE FROM resumes WHERE id = $1 AND application_id IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete resume version: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Activate makes a version the active one, deactivating the previous one
+func (p *PostgresStore) Activate(ctx context.Context, id uuid.UUID) error {
+	tx, err := p.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Deactivate first: at most one active version is allowed at any time
+	if _, err := tx.Exec(ctx, `
+		UPDATE resumes SET is_primary = FALSE
+		WHERE is_primary AND application_id IS NULL AND id <> $1`, id); err != nil {
+		return fmt.Errorf("failed to deactivate resume version: %w", err)
+	}
+	tag, err := tx.Exec(ctx, `
+		UPDATE resumes SET is_primary = TRUE
+		WHERE id = $1 AND application_id IS NULL`, id)
+	if err != nil {
+		return fmt.Errorf("failed to activate resume version: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return tx.Commit(ctx)
+}
+
+func scanVersion(row pgx.Row) (*domain.ResumeVersion, error) {
+	var v domain.ResumeVersion
+	if err := row.Scan(&v.ID, &v.Name, &v.Content, &v.Active, &v.CreatedAt, &v.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
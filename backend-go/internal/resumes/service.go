@@ -0,0 +1,151 @@
+// Package resumes keeps the user's named resume versions. One version is
+// active at a time; activating it indexes its text as the resume chat,
+// coverage and matching read.
+package resumes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// maxNameLength matches the resumes.name column
+const maxNameLength = 255
+
+var (
+	// ErrInvalid is returned for a malformed version
+	ErrInvalid = errors.New("invalid resume version")
+	// ErrActive is returned when deleting the active version
+	ErrActive = errors.New("the active resume version cannot be deleted; activate another version first")
+)
+
+// Indexer makes text the active resume read by chat and matching
+type Indexer interface {
+	Index(ctx context.Context, text string) (int, error)
+}
+
+// Service manages resume versions and which one is active
+type Service struct {
+	store Store
+	index Indexer
+}
+
+// NewService creates a resume version service
+func NewService(store Store, index Indexer) *Service {
+	return &Service{store: store, index: index}
+}
+
+// List returns every version, most recently updated first
+func (s *Service) List(ctx context.Context) ([]domain.ResumeVersion, error) {
+	return s.store.List(ctx)
+}
+
+// Get returns a version by ID
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVersion, error) {
+	return s.store.Get(ctx, id)
+}
+
+// Create adds a version. The first version becomes the active one.
+func (s *Service) Create(ctx context.Context, in domain.ResumeVersionInput) (*domain.ResumeVersion, error) {
+	if in.Name == nil || in.Content == nil {
+		return nil, fmt.Errorf("%w: name and content are required", ErrInvalid)
+	}
+	now := time.Now()
+	v := &domain.ResumeVersion{ID: uuid.New(), CreatedAt: now, UpdatedAt: now}
+	if err := apply(v, in); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		if _, err := s.index.Index(ctx, v.Content); err != nil {
+			return nil, err
+		}
+		v.Active = true
+	}
+	if err := s.store.Save(ctx, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Update renames a version or replaces its content. New content of the
+// active version is indexed before it is saved.
+func (s *Service) Update(ctx context.Context, id uuid.UUID, in domain.ResumeVersionInput) (*domain.ResumeVersion, error) {
+	v, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	previous := v.Content
+	if err := apply(v, in); err != nil {
+		return nil, err
+	}
+	if v.Active && v.Content != previous {
+		if _, err := s.index.Index(ctx, v.Content); err != nil {
+			return nil, err
+		}
+	}
+	v.UpdatedAt = time.Now()
+	if err := s.store.Save(ctx, v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Delete removes a version other than the active one
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	v, err := s.store.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if v.Active {
+		return ErrActive
+	}
+	return s.store.Delete(ctx, id)
+}
+
+// Activate indexes a version's content and makes it the active version
+func (s *Service) Activate(ctx context.Context, id uuid.UUID) (*domain.ResumeVersion, error) {
+	v, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.index.Index(ctx, v.Content); err != nil {
+		return nil, err
+	}
+	if err := s.store.Activate(ctx, id); err != nil {
+		return nil, err
+	}
+	v.Active = true
+	return v, nil
+}
+
+// apply copies the set fields of in onto v, validating them
+func apply(v *domain.ResumeVersion, in domain.ResumeVersionInput) error {
+	if in.Name != nil {
+		name := strings.TrimSpace(*in.Name)
+		if name == "" {
+			return fmt.Errorf("%w: name is required", ErrInvalid)
+		}
+		if len(name) > maxNameLength {
+			return fmt.Errorf("%w: name is longer than %d characters", ErrInvalid, maxNameLength)
+		}
+		v.Name = name
+	}
+	if in.Content != nil {
+		if strings.TrimSpace(*in.Content) == "" {
+			return fmt.Errorf("%w: content is required", ErrInvalid)
+		}
+		v.Content = *in.Content
+	}
+	return nil
+}
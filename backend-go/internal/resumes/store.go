@@ -0,0 +1,110 @@
+package resumes
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+var (
+	// ErrNotFound is returned when a resume version does not exist
+	ErrNotFound = errors.New("resume version not found")
+	// ErrNameTaken is returned when another version already has the name
+	ErrNameTaken = errors.New("a resume version with this name already exists")
+)
+
+// Store persists resume versions
+type Store interface {
+	// Save inserts or replaces a version, failing with ErrNameTaken when
+	// another version has its name
+	Save(ctx context.Context, v *domain.ResumeVersion) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVersion, error)
+	// List returns every version, most recently updated first
+	List(ctx context.Context) ([]domain.ResumeVersion, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Activate makes a version the active one, deactivating the previous one
+	Activate(ctx context.Context, id uuid.UUID) error
+}
+
+// MemoryStore keeps resume versions in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	versions map[uuid.UUID]domain.ResumeVersion
+}
+
+// NewMemoryStore creates an in-memory resume version store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{versions: make(map[uuid.UUID]domain.ResumeVersion)}
+}
+
+// Save inserts or replaces a version
+func (m *MemoryStore) Save(ctx context.Context, v *domain.ResumeVersion) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, other := range m.versions {
+		if id != v.ID && other.Name == v.Name {
+			return ErrNameTaken
+		}
+	}
+	m.versions[v.ID] = *v
+	return nil
+}
+
+// Get returns a version by ID
+func (m *MemoryStore) Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVersion, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	v, ok := m.versions[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &v, nil
+}
+
+// List returns every version, most recently updated first
+func (m *MemoryStore) List(ctx context.Context) ([]domain.ResumeVersion, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make([]domain.ResumeVersion, 0, len(m.versions))
+	for _, v := range m.versions {
+		out = append(out, v)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].UpdatedAt.After(out[j].UpdatedAt) })
+	return out, nil
+}
+
+// Delete removes a version
+func (m *MemoryStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.versions[id]; !ok {
+		return ErrNotFound
+	}
+	delete(m.versions, id)
+	return nil
+}
+
+// Activate makes a version the active one
+func (m *MemoryStore) Activate(ctx context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.versions[id]; !ok {
+		return ErrNotFound
+	}
+	now := time.Now()
+	for vid, v := range m.versions {
+		if active := vid == id; v.Active != active {
+			v.Active = active
+			v.UpdatedAt = now
+			m.versions[vid] = v
+		}
+	}
+	return nil
+}
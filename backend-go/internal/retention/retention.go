@@ -0,0 +1,265 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/storage"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// Target is a class of data with a retention period
+type Target interface {
+	// Name identifies the target in reports
+	Name() string
+
+	// MaxAge is how long data is kept
+	MaxAge() time.Duration
+
+	// Purge deletes (or with dryRun, counts) data older than cutoff
+	Purge(ctx context.Context, cutoff time.Time, dryRun bool) (int, error)
+}
+
+// Result is the outcome of purging one target
+type Result struct {
+	Target string    `json:"target"`
+	Cutoff time.Time `json:"cutoff"`
+	Count  int       `json:"count"`
+	Error  *string   `json:"error,omitempty"`
+}
+
+// Report summarizes a cleanup run
+type Report struct {
+	DryRun     bool      `json:"dry_run"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Results    []Result  `json:"results"`
+}
+
+// Worker runs retention policies on a schedule
+type Worker struct {
+	interval time.Duration
+	targets  []Target
+
+	mu      sync.Mutex
+	lastRun *Report
+}
+
+// NewWorker creates a retention worker for the given targets
+func NewWorker(interval time.Duration, targets ...Target) *Worker {
+	return &Worker{interval: interval, targets: targets}
+}
+
+// DefaultTargets builds targets from configuration.
+// Database targets are only included when db is connected. Tables holding
+// tenant data are purged for each of tenants.
+func DefaultTargets(cfg config.RetentionConfig, db *pgxpool.Pool, store storage.Storage, tenants []string) []Target {
+	var targets []Target
+
+	if db != nil {
+		if cfg.InactiveJobs > 0 {
+			targets = append(targets, &sqlTarget{
+				name:     "inactive_jobs",
+				maxAge:   cfg.InactiveJobs,
+				db:       db,
+				countSQL: `SELECT count(*) FROM jobs WHERE is_active = FALSE AND updated_at < $1`,
+				purgeSQL: `DELETE FROM jobs WHERE is_active = FALSE AND updated_at < $1`,
+			})
+		}
+		if cfg.ChatSessions > 0 {
+			targets = append(targets, &sqlTarget{
+				name:     "chat_sessions",
+				maxAge:   cfg.ChatSessions,
+				db:       db,
+				tenants:  tenants,
+				countSQL: `SELECT count(*) FROM chat_sessions WHERE updated_at < $1`,
+				purgeSQL: `DELETE FROM chat_sessions WHERE updated_at < $1`,
+			})
+		}
+		if cfg.Trash > 0 {
+			targets = append(targets,
+				&sqlTarget{
+					name:     "trashed_applications",
+					maxAge:   cfg.Trash,
+					db:       db,
+					tenants:  tenants,
+					countSQL: `SELECT count(*) FROM applications WHERE deleted_at < $1`,
+					purgeSQL: `DELETE FROM applications WHERE deleted_at < $1`,
+				},
+				&sqlTarget{
+					name:     "trashed_jobs",
+					maxAge:   cfg.Trash,
+					db:       db,
+					countSQL: `SELECT count(*) FROM jobs WHERE deleted_at < $1`,
+					purgeSQL: `DELETE FROM jobs WHERE deleted_at < $1`,
+				},
+			)
+		}
+	}
+
+	if store != nil && cfg.ScrapeArtifacts > 0 {
+		targets = append(targets, &storageTarget{
+			name:   "scrape_artifacts",
+			maxAge: cfg.ScrapeArtifacts,
+			store:  store,
+			prefix: storage.PrefixScreenshots,
+		})
+	}
+	if store != nil && cfg.Exports > 0 {
+		targets = append(targets, &storageTarget{
+			name:   "exports",
+			maxAge: cfg.Exports,
+			store:  store,
+			prefix: storage.PrefixExports,
+		})
+	}
+
+	return targets
+}
+
+// Start runs cleanup every interval until ctx is cancelled
+func (w *Worker) Start(ctx context.Context) {
+	if w.interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				report := w.Run(ctx, false)
+				logger.Info("Retention cleanup completed",
+					zap.Int("targets", len(report.Results)),
+					zap.Duration("duration", report.FinishedAt.Sub(report.StartedAt)),
+				)
+			}
+		}
+	}()
+}
+
+// Run applies all policies once. With dryRun nothing is deleted.
+func (w *Worker) Run(ctx context.Context, dryRun bool) *Report {
+	report := &Report{
+		DryRun:    dryRun,
+		StartedAt: time.Now(),
+		Results:   make([]Result, 0, len(w.targets)),
+	}
+
+	for _, t := range w.targets {
+		cutoff := report.StartedAt.Add(-t.MaxAge())
+		count, err := t.Purge(ctx, cutoff, dryRun)
+
+		result := Result{Target: t.Name(), Cutoff: cutoff, Count: count}
+		if err != nil {
+			msg := err.Error()
+			result.Error = &msg
+			logger.Warn("Retention target failed", zap.String("target", t.Name()), zap.Error(err))
+		}
+		report.Results = append(report.Results, result)
+	}
+
+	report.FinishedAt = time.Now()
+	if !dryRun {
+		w.mu.Lock()
+		w.lastRun = report
+		w.mu.Unlock()
+	}
+	return report
+}
+
+// LastRun returns the report of the most recent non-dry run
+func (w *Worker) LastRun() *Report {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.lastRun
+}
+
+// sqlTarget purges rows with a pair of count/delete statements taking the
+// cutoff as $1. Row-level security limits a statement to one tenant's rows,
+// so a target on a tenant table runs once per tenant.
+type sqlTarget struct {
+	name     string
+	maxAge   time.Duration
+	db       *pgxpool.Pool
+	countSQL string
+	purgeSQL string
+	tenants  []string // nil for shared tables
+}
+
+func (t *sqlTarget) Name() string          { return t.name }
+func (t *sqlTarget) MaxAge() time.Duration { return t.maxAge }
+
+func (t *sqlTarget) Purge(ctx context.Context, cutoff time.Time, dryRun bool) (int, error) {
+	if t.tenants == nil {
+		return t.purge(ctx, cutoff, dryRun)
+	}
+	total := 0
+	for _, id := range t.tenants {
+		n, err := t.purge(tenant.WithTenant(ctx, config.TenantConfig{ID: id}), cutoff, dryRun)
+		total += n
+		if err != nil {
+			return total, fmt.Errorf("tenant %s: %w", id, err)
+		}
+	}
+	return total, nil
+}
+
+func (t *sqlTarget) purge(ctx context.Context, cutoff time.Time, dryRun bool) (int, error) {
+	if dryRun {
+		var count int
+		if err := t.db.QueryRow(ctx, t.countSQL, cutoff).Scan(&count); err != nil {
+			return 0, fmt.Errorf("count %s: %w", t.name, err)
+		}
+		return count, nil
+	}
+
+	tag, err := t.db.Exec(ctx, t.purgeSQL, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("purge %s: %w", t.name, err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// storageTarget purges stored objects under a key prefix
+type storageTarget struct {
+	name   string
+	maxAge time.Duration
+	store  storage.Storage
+	prefix string
+}
+
+func (t *storageTarget) Name() string          { return t.name }
+func (t *storageTarget) MaxAge() time.Duration { return t.maxAge }
+
+func (t *storageTarget) Purge(ctx context.Context, cutoff time.Time, dryRun bool) (int, error) {
+	objects, err := t.store.List(ctx, t.prefix)
+	if err != nil {
+		return 0, fmt.Errorf("list %s: %w", t.name, err)
+	}
+
+	count := 0
+	for _, obj := range objects {
+		if !obj.LastModified.Before(cutoff) {
+			continue
+		}
+		if !dryRun {
+			if err := t.store.Delete(ctx, obj.Key); err != nil {
+				return count, fmt.Errorf("delete %s: %w", obj.Key, err)
+			}
+		}
+		count++
+	}
+	return count, nil
+}
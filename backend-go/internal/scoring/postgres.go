@@ -0,0 +1,63 @@
+package scoring
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresStore persists match scores in bulk
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a score store backed by the jobs table
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// SaveScores COPYs results into a temporary table and applies them to jobs in
+// one UPDATE, instead of one round trip per job
+func (s *PostgresStore) SaveScores(ctx context.Context, results []Result) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin score transaction: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	if _, err := tx.Exec(ctx, `
+		CREATE TEMP TABLE job_scores (
+			job_id UUID PRIMARY KEY,
+			match_score DECIMAL(5,2) NOT NULL,
+			match_quality TEXT NOT NULL
+		) ON COMMIT DROP`); err != nil {
+		return fmt.Errorf("failed to create score staging table: %w", err)
+	}
+
+	rows := make([][]interface{}, len(results))
+	for i, r := range results {
+		rows[i] = []interface{}{r.JobID, r.Score, string(r.Quality)}
+	}
+	if _, err := tx.CopyFrom(ctx, pgx.Identifier{"job_scores"},
+		[]string{"job_id", "match_score", "match_quality"}, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("failed to copy match scores: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE jobs j
+		SET match_score = s.match_score,
+			match_quality = s.match_quality::match_quality,
+			updated_at = NOW()
+		FROM job_scores s
+		WHERE j.id = s.job_id`); err != nil {
+		return fmt.Errorf("failed to apply match scores: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
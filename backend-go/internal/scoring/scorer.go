@@ -0,0 +1,258 @@
+package scoring
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/taskevents"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// ErrNoResume is returned when there is no resume vector to score against
+var ErrNoResume = errors.New("no resume embedding available")
+
+// Embedder produces embeddings for many texts in one call (the ML service EmbedBatch RPC)
+type Embedder interface {
+	EmbedBatch(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// ResumeSource provides the embedding of the active resume
+type ResumeSource interface {
+	ResumeEmbedding(ctx context.Context) ([]float32, error)
+}
+
+// Store persists computed scores (PostgresStore)
+type Store interface {
+	SaveScores(ctx context.Context, results []Result) error
+}
+
+// Result is the computed match for one job
+type Result struct {
+	JobID      uuid.UUID
+	Similarity float64
+	Score      float64
+	Quality    domain.MatchQuality
+}
+
+// Scorer embeds jobs in batches and scores them against the resume vector
+type Scorer struct {
+	embedder Embedder
+	resume   ResumeSource
+	cfg      config.ScoringConfig
+	events   *taskevents.Log
+	store    Store
+}
+
+// NewScorer creates a batch scorer
+func NewScorer(embedder Embedder, resume ResumeSource, cfg config.ScoringConfig) *Scorer {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 64
+	}
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = 4
+	}
+	if cfg.MaxSimilarity <= cfg.MinSimilarity {
+		cfg.MinSimilarity, cfg.MaxSimilarity = 0, 1
+	}
+	return &Scorer{embedder: embedder, resume: resume, cfg: cfg}
+}
+
+// SetTaskEvents records embedding and scoring on the timeline of the task
+// a context carries (see taskevents.WithTask)
+func (s *Scorer) SetTaskEvents(events *taskevents.Log) {
+	s.events = events
+}
+
+// SetStore saves the scores Enrich computes
+func (s *Scorer) SetStore(store Store) {
+	s.store = store
+}
+
+// Score embeds all jobs in batches of BatchSize, running up to Concurrency
+// batches at once, and scores each against the resume vector. Scores are
+// also written onto the jobs.
+func (s *Scorer) Score(ctx context.Context, jobs []*domain.Job) ([]Result, error) {
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	taskID, _ := taskevents.TaskID(ctx)
+	results, err := s.score(ctx, taskID, jobs)
+	if err != nil {
+		s.events.Record(ctx, taskID, domain.TaskKindMatch, domain.TaskEventFailed, err.Error(), nil)
+		return nil, err
+	}
+	s.events.Record(ctx, taskID, domain.TaskKindMatch, domain.TaskEventCompleted, "Jobs scored against the resume",
+		map[string]interface{}{"jobs": len(results)})
+	return results, nil
+}
+
+func (s *Scorer) score(ctx context.Context, taskID uuid.UUID, jobs []*domain.Job) ([]Result, error) {
+
+	resume, err := s.resume.ResumeEmbedding(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load resume embedding: %w", err)
+	}
+	if len(resume) == 0 {
+		return nil, ErrNoResume
+	}
+	resumeUnit := normalize(resume)
+
+	s.events.Record(ctx, taskID, domain.TaskKindEmbed, domain.TaskEventStarted, "Embedding jobs",
+		map[string]interface{}{"jobs": len(jobs), "batch_size": s.cfg.BatchSize})
+	vectors, err := s.embedAll(ctx, jobs)
+	if err != nil {
+		s.events.Record(ctx, taskID, domain.TaskKindEmbed, domain.TaskEventFailed, err.Error(), nil)
+		return nil, err
+	}
+	s.events.Record(ctx, taskID, domain.TaskKindEmbed, domain.TaskEventCompleted, "Jobs embedded",
+		map[string]interface{}{"jobs": len(vectors)})
+
+	results := make([]Result, len(jobs))
+	for i, job := range jobs {
+		sim := dot(resumeUnit, normalize(vectors[i]))
+		score := s.scale(sim)
+		quality := domain.GetMatchQuality(score)
+
+		job.MatchScore = &score
+		job.MatchQuality = &quality
+		results[i] = Result{JobID: job.ID, Similarity: sim, Score: score, Quality: quality}
+	}
+	return results, nil
+}
+
+// Enrich scores freshly stored jobs and saves the scores. Jobs must already
+// have their IDs assigned. Scoring is best effort: failures are logged and
+// never block ingest.
+func (s *Scorer) Enrich(ctx context.Context, jobs []*domain.Job) {
+	results, err := s.Score(ctx, jobs)
+	if errors.Is(err, ErrNoResume) {
+		logger.Debug("Skipped scoring jobs, no resume indexed", zap.Int("jobs", len(jobs)))
+		return
+	}
+	if err != nil {
+		logger.Warn("Failed to score jobs", zap.Int("jobs", len(jobs)), zap.Error(err))
+		return
+	}
+	if s.store == nil {
+		return
+	}
+	if err := s.store.SaveScores(ctx, results); err != nil {
+		logger.Warn("Failed to save match scores", zap.Int("jobs", len(results)), zap.Error(err))
+	}
+}
+
+// embedAll embeds job texts batch by batch with bounded concurrency
+func (s *Scorer) embedAll(ctx context.Context, jobs []*domain.Job) ([][]float32, error) {
+	vectors := make([][]float32, len(jobs))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+		sem      = make(chan struct{}, s.cfg.Concurrency)
+	)
+	for start := 0; start < len(jobs); start += s.cfg.BatchSize {
+		end := start + s.cfg.BatchSize
+		if end > len(jobs) {
+			end = len(jobs)
+		}
+
+		texts := make([]string, 0, end-start)
+		for _, job := range jobs[start:end] {
+			texts = append(texts, JobText(job))
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(start int, texts []string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			batch, err := s.embedder.EmbedBatch(ctx, texts)
+			if err == nil && len(batch) != len(texts) {
+				err = fmt.Errorf("embedder returned %d vectors for %d texts", len(batch), len(texts))
+			}
+			if err != nil {
+				errOnce.Do(func() {
+					firstErr = fmt.Errorf("failed to embed jobs: %w", err)
+					cancel()
+				})
+				return
+			}
+			copy(vectors[start:], batch)
+		}(start, texts)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return vectors, nil
+}
+
+// scale maps a cosine similarity onto a 0-100 score using the calibrated range
+func (s *Scorer) scale(sim float64) float64 {
+	score := (sim - s.cfg.MinSimilarity) / (s.cfg.MaxSimilarity - s.cfg.MinSimilarity) * 100
+	score = math.Max(0, math.Min(100, score))
+	return math.Round(score*100) / 100
+}
+
+// JobText is the text embedded for a job
+func JobText(job *domain.Job) string {
+	var b strings.Builder
+	b.WriteString(job.Title)
+	if len(job.RequiredSkills) > 0 {
+		b.WriteString("\nSkills: ")
+		b.WriteString(strings.Join(job.RequiredSkills, ", "))
+	}
+	if len(job.Requirements) > 0 {
+		b.WriteString("\n")
+		b.WriteString(strings.Join(job.Requirements, "\n"))
+	}
+	b.WriteString("\n")
+	b.WriteString(job.Description)
+	return b.String()
+}
+
+// normalize returns v scaled to unit length as float64
+func normalize(v []float32) []float64 {
+	out := make([]float64, len(v))
+	var sum float64
+	for i, x := range v {
+		out[i] = float64(x)
+		sum += out[i] * out[i]
+	}
+	if sum == 0 {
+		return out
+	}
+	norm := math.Sqrt(sum)
+	for i := range out {
+		out[i] /= norm
+	}
+	return out
+}
+
+// dot is the dot product over the shorter of the two vectors
+func dot(a, b []float64) float64 {
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	var sum float64
+	for i := 0; i < n; i++ {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
@@ -0,0 +1,139 @@
+package scrapeprofiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// uniqueViolation is the Postgres error code for a duplicate key
+const uniqueViolation = "23505"
+
+// PostgresStore persists scrape profiles in the scrape_profiles table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed scrape profile store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const profileColumns = `id, name, keywords, location, sources::text[], max_jobs, schedule,
+	last_run_at, last_task_id, created_at, updated_at`
+
+// Save inserts or replaces a profile
+func (s *PostgresStore) Save(ctx context.Context, p *domain.ScrapeProfile) error {
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO scrape_profiles (id, name, keywords, location, sources, max_jobs, schedule, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5::text[]::job_source[], $6, $7, $8, $9)
+		ON CONFLICT (id) DO UPDATE SET
+			name = EXCLUDED.name, keywords = EXCLUDED.keywords, location = EXCLUDED.location,
+			sources = EXCLUDED.sources, max_jobs = EXCLUDED.max_jobs, schedule = EXCLUDED.schedule,
+			updated_at = EXCLUDED.updated_at`,
+		p.ID, p.Name, p.Keywords, p.Location, sourceStrings(p.Sources), p.MaxJobs, p.Schedule, p.CreatedAt, p.UpdatedAt,
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return ErrNameTaken
+	}
+	if err != nil {
+		return fmt.Errorf("failed to save scrape profile: %w", err)
+	}
+	return nil
+}
+
+// Get returns a profile by ID
+func (s *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*domain.ScrapeProfile, error) {
+	return s.get(ctx, `id = $1`, id)
+}
+
+// GetByName returns a profile by name
+func (s *PostgresStore) GetByName(ctx context.Context, name string) (*domain.ScrapeProfile, error) {
+	return s.get(ctx, `name = $1`, name)
+}
+
+func (s *PostgresStore) get(ctx context.Context, where string, arg interface{}) (*domain.ScrapeProfile, error) {
+	p, err := scanProfile(s.db.QueryRow(ctx, `SELECT `+profileColumns+` FROM scrape_profiles WHERE `+where, arg))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scrape profile: %w", err)
+	}
+	return p, nil
+}
+
+// List returns every profile, by name
+func (s *PostgresStore) List(ctx context.Context) ([]domain.ScrapeProfile, error) {
+	rows, err := s.db.Query(ctx, `SELECT `+profileColumns+` FROM scrape_profiles ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scrape profiles: %w", err)
+	}
+	defer rows.Close()
+
+	list := []domain.ScrapeProfile{}
+	for rows.Next() {
+		p, err := scanProfile(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan scrape profile: %w", err)
+		}
+		list = append(list, *p)
+	}
+	return list, rows.Err()
+}
+
+// Delete removes a profile; tasks queued from it keep running unlinked
+func (s *PostgresStore) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := s.db.Exec(ctx, `DELETE FROM scrape_profiles WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete scrape profile: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// RecordRun notes the task last queued from a profile
+func (s *PostgresStore) RecordRun(ctx context.Context, id, taskID uuid.UUID, at time.Time) error {
+	tag, err := s.db.Exec(ctx, `
+		UPDATE scrape_profiles SET last_run_at = $2, last_task_id = $3 WHERE id = $1`, id, at, taskID)
+	if err != nil {
+		return fmt.Errorf("failed to record scrape profile run: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func scanProfile(row pgx.Row) (*domain.ScrapeProfile, error) {
+	var p domain.ScrapeProfile
+	var sources []string
+	if err := row.Scan(&p.ID, &p.Name, &p.Keywords, &p.Location, &sources, &p.MaxJobs, &p.Schedule,
+		&p.LastRunAt, &p.LastTaskID, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		return nil, err
+	}
+	p.Sources = make([]domain.JobSource, len(sources))
+	for i, source := range sources {
+		p.Sources[i] = domain.JobSource(source)
+	}
+	return &p, nil
+}
+
+func sourceStrings(sources []domain.JobSource) []string {
+	out := make([]string, len(sources))
+	for i, s := range sources {
+		out[i] = string(s)
+	}
+	return out
+}
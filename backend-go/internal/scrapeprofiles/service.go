@@ -0,0 +1,275 @@
+// Package scrapeprofiles keeps named scrape presets. A scrape can be queued
+// from a profile by name, and profiles with a schedule are queued by the
+// scheduler whenever their interval has passed since the last run.
+package scrapeprofiles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+const (
+	// maxNameLength matches the scrape_profiles.name column
+	maxNameLength = 100
+	// maxJobsLimit bounds a profile's per-source job limit
+	maxJobsLimit = 500
+	// minSchedule keeps scheduled scrapes from hammering job boards
+	minSchedule = time.Hour
+)
+
+// ErrInvalid is returned for a malformed profile
+var ErrInvalid = errors.New("invalid scrape profile")
+
+// Queue queues scrape tasks for the scrape workers
+type Queue interface {
+	QueueScrape(ctx context.Context, req domain.ScrapeRequest) (*domain.ScrapeTask, error)
+}
+
+// Service manages scrape profiles and queues scrapes from them
+type Service struct {
+	store Store
+	queue Queue
+}
+
+// NewService creates a scrape profile service
+func NewService(store Store, queue Queue) *Service {
+	return &Service{store: store, queue: queue}
+}
+
+// List returns every profile, by name
+func (s *Service) List(ctx context.Context) ([]domain.ScrapeProfile, error) {
+	return s.store.List(ctx)
+}
+
+// Get returns a profile by ID
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*domain.ScrapeProfile, error) {
+	return s.store.Get(ctx, id)
+}
+
+// Create adds a profile
+func (s *Service) Create(ctx context.Context, in domain.ScrapeProfileInput) (*domain.ScrapeProfile, error) {
+	if in.Name == nil {
+		return nil, fmt.Errorf("%w: name is required", ErrInvalid)
+	}
+	now := time.Now()
+	p := &domain.ScrapeProfile{ID: uuid.New(), Keywords: []string{}, Sources: []domain.JobSource{}, CreatedAt: now, UpdatedAt: now}
+	if err := apply(p, in); err != nil {
+		return nil, err
+	}
+	if err := s.store.Save(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Update changes the set fields of a profile
+func (s *Service) Update(ctx context.Context, id uuid.UUID, in domain.ScrapeProfileInput) (*domain.ScrapeProfile, error) {
+	p, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := apply(p, in); err != nil {
+		return nil, err
+	}
+	p.UpdatedAt = time.Now()
+	if err := s.store.Save(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Delete removes a profile
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	return s.store.Delete(ctx, id)
+}
+
+// Run queues a scrape with the parameters of the named profile
+func (s *Service) Run(ctx context.Context, name string) (*domain.ScrapeTask, error) {
+	p, err := s.store.GetByName(ctx, strings.TrimSpace(name))
+	if err != nil {
+		return nil, err
+	}
+	return s.run(ctx, p, time.Now())
+}
+
+// RunByID queues a scrape with the parameters of a profile
+func (s *Service) RunByID(ctx context.Context, id uuid.UUID) (*domain.ScrapeTask, error) {
+	p, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.run(ctx, p, time.Now())
+}
+
+func (s *Service) run(ctx context.Context, p *domain.ScrapeProfile, now time.Time) (*domain.ScrapeTask, error) {
+	sources := make([]string, len(p.Sources))
+	for i, source := range p.Sources {
+		sources[i] = string(source)
+	}
+	task, err := s.queue.QueueScrape(ctx, domain.ScrapeRequest{
+		Keywords:  p.Keywords,
+		Location:  p.Location,
+		Sources:   sources,
+		MaxJobs:   p.MaxJobs,
+		ProfileID: &p.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.RecordRun(ctx, p.ID, task.ID, now); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// RunDue queues a scrape for every scheduled profile whose interval has
+// passed since its last run, and returns how many were queued
+func (s *Service) RunDue(ctx context.Context, now time.Time) (int, error) {
+	profiles, err := s.store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+	queued := 0
+	for i := range profiles {
+		p := &profiles[i]
+		if !due(p, now) {
+			continue
+		}
+		if _, err := s.run(ctx, p, now); err != nil {
+			return queued, fmt.Errorf("profile %s: %w", p.Name, err)
+		}
+		queued++
+	}
+	return queued, nil
+}
+
+// due reports whether a scheduled profile should be queued at now
+func due(p *domain.ScrapeProfile, now time.Time) bool {
+	if p.Schedule == "" {
+		return false
+	}
+	every, err := time.ParseDuration(p.Schedule)
+	if err != nil || every <= 0 {
+		return false
+	}
+	return p.LastRunAt == nil || !now.Before(p.LastRunAt.Add(every))
+}
+
+// Scheduler queues scheduled profiles of every tenant
+type Scheduler struct {
+	service  *Service
+	interval time.Duration
+	tenants  []string
+}
+
+// NewScheduler creates a scheduler that checks profiles every interval
+func NewScheduler(service *Service, interval time.Duration, tenants []string) *Scheduler {
+	return &Scheduler{service: service, interval: interval, tenants: tenants}
+}
+
+// Start checks for due profiles on the interval until ctx is cancelled
+func (s *Scheduler) Start(ctx context.Context) {
+	if s.interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.Run(ctx, time.Now())
+			}
+		}
+	}()
+}
+
+// Run queues the due profiles of each tenant
+func (s *Scheduler) Run(ctx context.Context, now time.Time) {
+	for _, id := range s.tenants {
+		tctx := tenant.WithTenant(ctx, config.TenantConfig{ID: id})
+		n, err := s.service.RunDue(tctx, now)
+		if err != nil {
+			logger.Warn("Scheduled scrape failed", zap.String("tenant", id), zap.Error(err))
+		}
+		if n > 0 {
+			logger.Info("Queued scheduled scrapes", zap.String("tenant", id), zap.Int("count", n))
+		}
+	}
+}
+
+// apply copies the set fields of in onto p, validating them
+func apply(p *domain.ScrapeProfile, in domain.ScrapeProfileInput) error {
+	if in.Name != nil {
+		name := strings.TrimSpace(*in.Name)
+		if name == "" {
+			return fmt.Errorf("%w: name is required", ErrInvalid)
+		}
+		if len(name) > maxNameLength {
+			return fmt.Errorf("%w: name is longer than %d characters", ErrInvalid, maxNameLength)
+		}
+		p.Name = name
+	}
+	if in.Keywords != nil {
+		keywords := make([]string, 0, len(in.Keywords))
+		for _, k := range in.Keywords {
+			if k = strings.TrimSpace(k); k != "" {
+				keywords = append(keywords, k)
+			}
+		}
+		p.Keywords = keywords
+	}
+	if in.Location != nil {
+		if location := strings.TrimSpace(*in.Location); location != "" {
+			p.Location = &location
+		} else {
+			p.Location = nil
+		}
+	}
+	if in.Sources != nil {
+		for _, source := range in.Sources {
+			if !source.Stored() {
+				return fmt.Errorf("%w: unknown source %q", ErrInvalid, source)
+			}
+		}
+		p.Sources = in.Sources
+	}
+	if in.MaxJobs != nil {
+		if *in.MaxJobs < 0 || *in.MaxJobs > maxJobsLimit {
+			return fmt.Errorf("%w: max_jobs must be between 0 and %d", ErrInvalid, maxJobsLimit)
+		}
+		p.MaxJobs = *in.MaxJobs
+	}
+	if in.Schedule != nil {
+		schedule := strings.TrimSpace(*in.Schedule)
+		if schedule != "" {
+			every, err := time.ParseDuration(schedule)
+			if err != nil {
+				return fmt.Errorf("%w: schedule must be a duration such as 24h", ErrInvalid)
+			}
+			if every < minSchedule {
+				return fmt.Errorf("%w: schedule must be at least %s", ErrInvalid, minSchedule)
+			}
+		}
+		p.Schedule = schedule
+	}
+	if len(p.Keywords) == 0 {
+		return fmt.Errorf("%w: keywords are required", ErrInvalid)
+	}
+	return nil
+}
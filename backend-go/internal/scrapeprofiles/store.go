@@ -0,0 +1,118 @@
+package scrapeprofiles
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+var (
+	// ErrNotFound is returned when a scrape profile does not exist
+	ErrNotFound = errors.New("scrape profile not found")
+	// ErrNameTaken is returned when another profile already has the name
+	ErrNameTaken = errors.New("a scrape profile with this name already exists")
+)
+
+// Store persists scrape profiles
+type Store interface {
+	// Save inserts or replaces a profile, failing with ErrNameTaken when
+	// another profile has its name
+	Save(ctx context.Context, p *domain.ScrapeProfile) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.ScrapeProfile, error)
+	GetByName(ctx context.Context, name string) (*domain.ScrapeProfile, error)
+	// List returns every profile, by name
+	List(ctx context.Context) ([]domain.ScrapeProfile, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	// RecordRun notes the task last queued from a profile
+	RecordRun(ctx context.Context, id, taskID uuid.UUID, at time.Time) error
+}
+
+// MemoryStore keeps scrape profiles in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	profiles map[uuid.UUID]domain.ScrapeProfile
+}
+
+// NewMemoryStore creates an in-memory scrape profile store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{profiles: make(map[uuid.UUID]domain.ScrapeProfile)}
+}
+
+// Save inserts or replaces a profile
+func (m *MemoryStore) Save(ctx context.Context, p *domain.ScrapeProfile) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for id, other := range m.profiles {
+		if id != p.ID && other.Name == p.Name {
+			return ErrNameTaken
+		}
+	}
+	m.profiles[p.ID] = *p
+	return nil
+}
+
+// Get returns a profile by ID
+func (m *MemoryStore) Get(ctx context.Context, id uuid.UUID) (*domain.ScrapeProfile, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	p, ok := m.profiles[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &p, nil
+}
+
+// GetByName returns a profile by name
+func (m *MemoryStore) GetByName(ctx context.Context, name string) (*domain.ScrapeProfile, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, p := range m.profiles {
+		if p.Name == name {
+			return &p, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// List returns every profile, by name
+func (m *MemoryStore) List(ctx context.Context) ([]domain.ScrapeProfile, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make([]domain.ScrapeProfile, 0, len(m.profiles))
+	for _, p := range m.profiles {
+		out = append(out, p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// Delete removes a profile
+func (m *MemoryStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.profiles[id]; !ok {
+		return ErrNotFound
+	}
+	delete(m.profiles, id)
+	return nil
+}
+
+// RecordRun notes the task last queued from a profile
+func (m *MemoryStore) RecordRun(ctx context.Context, id, taskID uuid.UUID, at time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	p, ok := m.profiles[id]
+	if !ok {
+		return ErrNotFound
+	}
+	p.LastRunAt, p.LastTaskID = &at, &taskID
+	m.profiles[id] = p
+	return nil
+}
@@ -0,0 +1,128 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// AdzunaScraper searches jobs through the Adzuna API
+type AdzunaScraper struct {
+	cfg    config.AdzunaConfig
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewAdzunaScraper creates a new Adzuna API adapter
+func NewAdzunaScraper(cfg config.AdzunaConfig, client *http.Client, logger *zap.Logger) *AdzunaScraper {
+	if cfg.Country == "" {
+		cfg.Country = "us"
+	}
+	return &AdzunaScraper{cfg: cfg, client: client, logger: logger}
+}
+
+// Name returns the scraper name
+func (s *AdzunaScraper) Name() string {
+	return "Adzuna"
+}
+
+// Source returns the job source
+func (s *AdzunaScraper) Source() domain.JobSource {
+	return domain.JobSourceAdzuna
+}
+
+// APIBacked marks the scraper as using an official API
+func (s *AdzunaScraper) APIBacked() bool {
+	return true
+}
+
+type adzunaResponse struct {
+	Count   int `json:"count"`
+	Results []struct {
+		ID           string   `json:"id"`
+		Title        string   `json:"title"`
+		Description  string   `json:"description"`
+		RedirectURL  string   `json:"redirect_url"`
+		Created      string   `json:"created"`
+		SalaryMin    *float64 `json:"salary_min"`
+		SalaryMax    *float64 `json:"salary_max"`
+		ContractTime string   `json:"contract_time"`
+		Company      struct {
+			DisplayName string `json:"display_name"`
+		} `json:"company"`
+		Location struct {
+			DisplayName string `json:"display_name"`
+		} `json:"location"`
+	} `json:"results"`
+}
+
+// Scrape performs the search through the API
+func (s *AdzunaScraper) Scrape(ctx context.Context, query string, opts *ScrapeOptions) (*ScrapeResult, error) {
+	if opts == nil {
+		opts = DefaultScrapeOptions()
+	}
+	result := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		StartTime: time.Now(),
+	}
+
+	params := url.Values{}
+	params.Set("app_id", s.cfg.AppID)
+	params.Set("app_key", s.cfg.AppKey)
+	params.Set("what", query)
+	params.Set("results_per_page", strconv.Itoa(opts.MaxJobs))
+	if opts.Location != "" {
+		params.Set("where", opts.Location)
+	}
+	if opts.PostedWithin > 0 {
+		params.Set("max_days_old", strconv.Itoa(int(opts.PostedWithin.Hours()/24)+1))
+	}
+	searchURL := fmt.Sprintf("https://api.adzuna.com/v1/api/jobs/%s/search/1?%s", s.cfg.Country, params.Encode())
+
+	var resp adzunaResponse
+	if err := doJSON(ctx, s.client, http.MethodGet, searchURL, nil, nil, &resp); err != nil {
+		result.Errors = append(result.Errors, err)
+		result.EndTime = time.Now()
+		return result, err
+	}
+
+	result.Total = resp.Count
+	for _, r := range resp.Results {
+		job := newAPIJob(domain.JobSourceAdzuna, r.Title, r.Company.DisplayName, r.RedirectURL)
+		job.Description = r.Description
+		job.Location = optionalString(r.Location.DisplayName)
+		job.PostedDate = parseAPITime(r.Created)
+		job.EmploymentType = domain.NormalizeEmploymentType(r.ContractTime)
+		if r.SalaryMin != nil {
+			min := int(*r.SalaryMin)
+			job.SalaryMin = &min
+		}
+		if r.SalaryMax != nil {
+			max := int(*r.SalaryMax)
+			job.SalaryMax = &max
+		}
+		result.Jobs = append(result.Jobs, job)
+		result.Scraped++
+	}
+
+	result.EndTime = time.Now()
+	s.logger.Info("Adzuna search completed",
+		zap.Int("total", result.Total),
+		zap.Int("scraped", result.Scraped),
+		zap.Duration("duration", result.Duration()),
+	)
+	return result, nil
+}
+
+// ScrapeJob is not supported; Adzuna results already include the full listing
+func (s *AdzunaScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
+	return nil, &ScrapeError{Source: s.Source(), Category: domain.ScrapeErrorOther, URL: jobURL, Err: errJobLookupUnsupported}
+}
@@ -0,0 +1,193 @@
+package scraper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// errJobLookupUnsupported is returned by API adapters whose API has no single-job lookup
+var errJobLookupUnsupported = errors.New("job detail lookup is not supported by this API")
+
+// APIBacked is implemented by scrapers that use an official job board API.
+// The registry prefers them over HTML scrapers for the same source.
+type APIBacked interface {
+	APIBacked() bool
+}
+
+// usesAPI reports whether s is backed by an official API
+func usesAPI(s Scraper) bool {
+	a, ok := s.(APIBacked)
+	return ok && a.APIBacked()
+}
+
+// NewAPIScrapers returns an adapter for every job board API that has
+// credentials configured (Remotive and Simplify need none and only have to be
+// enabled), for configured Ashby boards and Workable accounts, and for
+// tracked career pages
+func NewAPIScrapers(cfg config.JobAPIsConfig, logger *zap.Logger) []Scraper {
+	client := &http.Client{Timeout: cfg.Timeout}
+	if cfg.Timeout <= 0 {
+		client.Timeout = 30 * time.Second
+	}
+
+	var scrapers []Scraper
+	if cfg.Adzuna.AppID != "" && cfg.Adzuna.AppKey != "" {
+		scrapers = append(scrapers, NewAdzunaScraper(cfg.Adzuna, client, logger))
+	}
+	if cfg.USAJobs.APIKey != "" && cfg.USAJobs.Email != "" {
+		scrapers = append(scrapers, NewUSAJobsScraper(cfg.USAJobs, client, logger))
+	}
+	if cfg.Jooble.APIKey != "" {
+		scrapers = append(scrapers, NewJoobleScraper(cfg.Jooble, client, logger))
+	}
+	if cfg.Remotive.Enabled {
+		scrapers = append(scrapers, NewRemotiveScraper(client, logger))
+	}
+	if cfg.Simplify.Enabled {
+		scrapers = append(scrapers, NewSimplifyScraper(cfg.Simplify, client, logger))
+	}
+	if len(cfg.Ashby.Boards) > 0 {
+		scrapers = append(scrapers, NewAshbyScraper(cfg.Ashby, client, logger))
+	}
+	if len(cfg.Workable.Accounts) > 0 {
+		scrapers = append(scrapers, NewWorkableScraper(cfg.Workable, client, logger))
+	}
+	if len(cfg.CareerPages) > 0 {
+		scrapers = append(scrapers, NewCareerPageScraper(cfg.CareerPages, client, logger))
+	}
+	return scrapers
+}
+
+// doJSON sends an API request and decodes the JSON response into out,
+// retrying timeouts, network errors and server errors
+func doJSON(ctx context.Context, client *http.Client, method, url string, headers map[string]string, body, out interface{}) error {
+	var payload []byte
+	if body != nil {
+		var err error
+		if payload, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+	return retryFetch(ctx, func() error {
+		return sendJSON(ctx, client, method, url, headers, payload, out)
+	})
+}
+
+// sendJSON makes one attempt at an API request
+func sendJSON(ctx context.Context, client *http.Client, method, url string, headers map[string]string, payload []byte, out interface{}) error {
+	var reader io.Reader
+	if payload != nil {
+		reader = bytes.NewReader(payload)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("api request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return &ScrapeError{Category: domain.ScrapeErrorBlocked, URL: url,
+			Err: fmt.Errorf("api rejected credentials: status %d", resp.StatusCode)}
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return &ScrapeError{Category: domain.ScrapeErrorBlocked, URL: url,
+			Err: fmt.Errorf("api rate limited: status %d", resp.StatusCode)}
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return &ScrapeError{Category: domain.ScrapeErrorNetwork, URL: url,
+			Err: fmt.Errorf("api request failed: status %d", resp.StatusCode)}
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("api request failed: status %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return &ScrapeError{Category: domain.ScrapeErrorParse, URL: url,
+			Err: fmt.Errorf("failed to parse api response: %w", err)}
+	}
+	return nil
+}
+
+// newAPIJob creates a job with the fields every API adapter sets
+func newAPIJob(source domain.JobSource, title, company, url string) *domain.Job {
+	now := time.Now()
+	return &domain.Job{
+		ID:        uuid.New(),
+		URL:       url,
+		Title:     strings.TrimSpace(title),
+		Company:   domain.Company{Name: strings.TrimSpace(company)},
+		Source:    source,
+		IsActive:  true,
+		ScrapedAt: now,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// parseAPITime parses the timestamp formats used by job board APIs
+func parseAPITime(v string) *time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02T15:04:05.0000000", "2006-01-02T15:04:05.0000", "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+// postedWithin reports whether a posting date passes the PostedWithin option
+func postedWithin(posted *time.Time, opts *ScrapeOptions) bool {
+	return opts.PostedWithin <= 0 || posted == nil || time.Since(*posted) <= opts.PostedWithin
+}
+
+func optionalString(v string) *string {
+	v = strings.TrimSpace(v)
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func remoteLocation() *domain.LocationType {
+	lt := domain.LocationTypeRemote
+	return &lt
+}
+
+// locationTypeOf infers the work arrangement from a scraped location,
+// onsite unless it mentions remote or hybrid work
+func locationTypeOf(location *string) *domain.LocationType {
+	lt := domain.LocationTypeOnsite
+	if location != nil {
+		lower := strings.ToLower(*location)
+		switch {
+		case strings.Contains(lower, "remote"):
+			lt = domain.LocationTypeRemote
+		case strings.Contains(lower, "hybrid"):
+			lt = domain.LocationTypeHybrid
+		}
+	}
+	return &lt
+}
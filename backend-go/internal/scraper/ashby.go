@@ -0,0 +1,159 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// AshbyScraper reads startup job boards hosted on Ashby through the public
+// posting API, with full descriptions
+type AshbyScraper struct {
+	cfg    config.AshbyConfig
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewAshbyScraper creates a new Ashby posting API adapter
+func NewAshbyScraper(cfg config.AshbyConfig, client *http.Client, logger *zap.Logger) *AshbyScraper {
+	return &AshbyScraper{cfg: cfg, client: client, logger: logger}
+}
+
+// Name returns the scraper name
+func (s *AshbyScraper) Name() string {
+	return "Ashby"
+}
+
+// Source returns the job source
+func (s *AshbyScraper) Source() domain.JobSource {
+	return domain.JobSourceAshby
+}
+
+// APIBacked marks the scraper as using an official API
+func (s *AshbyScraper) APIBacked() bool {
+	return true
+}
+
+// ashbyJob is one posting from the Ashby posting API
+type ashbyJob struct {
+	ID               string `json:"id"`
+	Title            string `json:"title"`
+	Location         string `json:"location"`
+	IsRemote         bool   `json:"isRemote"`
+	EmploymentType   string `json:"employmentType"`
+	PublishedAt      string `json:"publishedAt"`
+	JobURL           string `json:"jobUrl"`
+	DescriptionPlain string `json:"descriptionPlain"`
+	DescriptionHTML  string `json:"descriptionHtml"`
+	Compensation     *struct {
+		Summary string `json:"compensationTierSummary"`
+	} `json:"compensation"`
+}
+
+// Scrape reads every configured board and keeps the postings matching the query
+func (s *AshbyScraper) Scrape(ctx context.Context, query string, opts *ScrapeOptions) (*ScrapeResult, error) {
+	if opts == nil {
+		opts = DefaultScrapeOptions()
+	}
+	result := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		StartTime: time.Now(),
+	}
+
+	words := strings.Fields(strings.ToLower(query))
+	for _, board := range s.cfg.Boards {
+		jobs, err := fetchAshbyBoard(ctx, s.client, board, boardCompanyName(board), domain.JobSourceAshby)
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+		addBoardJobs(result, jobs, words, opts)
+	}
+
+	result.EndTime = time.Now()
+	s.logger.Info("Ashby boards completed",
+		zap.Int("boards", len(s.cfg.Boards)),
+		zap.Int("total", result.Total),
+		zap.Int("scraped", result.Scraped),
+		zap.Duration("duration", result.Duration()),
+	)
+
+	if len(result.Jobs) == 0 && len(result.Errors) > 0 {
+		return result, result.Errors[0]
+	}
+	return result, nil
+}
+
+// ScrapeJob fetches a posting from its board (jobs.ashbyhq.com/<board>/<id>)
+func (s *AshbyScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
+	board, ok := DetectCareerBoard(jobURL)
+	if !ok || board.Platform != PlatformAshby || board.JobID == "" {
+		return nil, &ScrapeError{Source: s.Source(), Category: domain.ScrapeErrorOther, URL: jobURL, Err: errJobLookupUnsupported}
+	}
+	job, err := fetchAshbyJob(ctx, s.client, board.Token, board.JobID, boardCompanyName(board.Token), domain.JobSourceAshby)
+	if err != nil {
+		return nil, withSource(s.Source(), err)
+	}
+	return job, nil
+}
+
+// fetchAshbyBoard lists every posting on an Ashby board
+func fetchAshbyBoard(ctx context.Context, client *http.Client, board, company string, source domain.JobSource) ([]*domain.Job, error) {
+	var resp struct {
+		Jobs []ashbyJob `json:"jobs"`
+	}
+	apiURL := fmt.Sprintf("https://api.ashbyhq.com/posting-api/job-board/%s?includeCompensation=true", url.PathEscape(board))
+	if err := doJSON(ctx, client, http.MethodGet, apiURL, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*domain.Job, 0, len(resp.Jobs))
+	for _, j := range resp.Jobs {
+		jobs = append(jobs, j.toJob(company, source))
+	}
+	return jobs, nil
+}
+
+// fetchAshbyJob finds one posting on its board; the API has no single-job lookup
+func fetchAshbyJob(ctx context.Context, client *http.Client, board, id, company string, source domain.JobSource) (*domain.Job, error) {
+	var resp struct {
+		Jobs []ashbyJob `json:"jobs"`
+	}
+	apiURL := fmt.Sprintf("https://api.ashbyhq.com/posting-api/job-board/%s?includeCompensation=true", url.PathEscape(board))
+	if err := doJSON(ctx, client, http.MethodGet, apiURL, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	for _, j := range resp.Jobs {
+		if j.ID == id {
+			return j.toJob(company, source), nil
+		}
+	}
+	return nil, &ScrapeError{Category: domain.ScrapeErrorSelectorMissing, URL: apiURL,
+		Err: fmt.Errorf("posting %s is not on the board", id)}
+}
+
+func (j ashbyJob) toJob(company string, source domain.JobSource) *domain.Job {
+	job := newAPIJob(source, j.Title, company, j.JobURL)
+	job.Description = strings.TrimSpace(j.DescriptionPlain)
+	if job.Description == "" {
+		job.Description = htmlText(j.DescriptionHTML)
+	}
+	job.Location = optionalString(j.Location)
+	if j.IsRemote {
+		job.LocationType = remoteLocation()
+	}
+	job.EmploymentType = domain.NormalizeEmploymentType(j.EmploymentType)
+	job.PostedDate = parseAPITime(j.PublishedAt)
+	if j.Compensation != nil {
+		job.SalaryText = optionalString(j.Compensation.Summary)
+	}
+	return job
+}
@@ -3,6 +3,7 @@ package scraper
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/chromedp/cdproto/cdp"
@@ -17,18 +18,50 @@ type BrowserPool struct {
 	cancel   context.CancelFunc
 	logger   *zap.Logger
 	opts     []chromedp.ExecAllocatorOption
+	config   *BrowserConfig
+
+	// Lifecycle tracking for leak detection (see browser_watchdog.go)
+	mu        sync.Mutex
+	contexts  map[uint64]*trackedContext
+	nextID    uint64
+	stats     BrowserStats
+	failures  int
+	stop      chan struct{}
+	closeOnce sync.Once
 }
 
 // BrowserConfig configures browser behavior
 type BrowserConfig struct {
-	Headless        bool
-	Timeout         time.Duration
-	UserAgent       string
-	ProxyURL        string
-	DisableImages   bool
-	DisableJS       bool
-	WindowWidth     int
-	WindowHeight    int
+	Headless      bool
+	Timeout       time.Duration
+	UserAgent     string
+	ProxyURL      string
+	DisableImages bool
+	DisableJS     bool
+	WindowWidth   int
+	WindowHeight  int
+
+	// MaxContextAge force-cancels contexts that outlive it (0 disables the reaper)
+	MaxContextAge time.Duration
+	// ReapInterval is how often the reaper checks for stuck contexts
+	ReapInterval time.Duration
+	// MaxFailures restarts the exec allocator after this many consecutive fetch failures (0 disables)
+	MaxFailures int
+
+	// RandomizeFingerprint gives each context a user agent, viewport and
+	// Accept-Language drawn from the pools below (defaults when empty)
+	RandomizeFingerprint bool
+	UserAgents           []UserAgentProfile
+	Viewports            []Viewport
+	AcceptLanguages      []string
+
+	// Mode records fetched pages to RecordDir or replays them from it (see
+	// browser_replay.go); empty means live
+	Mode      FetchMode
+	RecordDir string
+	// ScrubRecordings strips emails, phone numbers and credentials from
+	// recorded pages before they are saved
+	ScrubRecordings bool
 }
 
 // DefaultBrowserConfig returns sensible defaults
@@ -41,6 +74,12 @@ func DefaultBrowserConfig() *BrowserConfig {
 		DisableJS:     false,
 		WindowWidth:   1920,
 		WindowHeight:  1080,
+		MaxContextAge: 5 * time.Minute,
+		ReapInterval:  30 * time.Second,
+		MaxFailures:   5,
+
+		RandomizeFingerprint: true,
+		ScrubRecordings:      true,
 	}
 }
 
@@ -49,6 +88,9 @@ func NewBrowserPool(logger *zap.Logger, config *BrowserConfig) (*BrowserPool, er
 	if config == nil {
 		config = DefaultBrowserConfig()
 	}
+	if err := validateMode(config); err != nil {
+		return nil, err
+	}
 
 	opts := []chromedp.ExecAllocatorOption{
 		chromedp.NoFirstRun,
@@ -70,30 +112,83 @@ func NewBrowserPool(logger *zap.Logger, config *BrowserConfig) (*BrowserPool, er
 
 	allocCtx, cancel := chromedp.NewExecAllocator(context.Background(), opts...)
 
-	return &BrowserPool{
+	p := &BrowserPool{
 		allocCtx: allocCtx,
 		cancel:   cancel,
 		logger:   logger,
 		opts:     opts,
-	}, nil
+		config:   config,
+		contexts: make(map[uint64]*trackedContext),
+		stop:     make(chan struct{}),
+	}
+	if config.MaxContextAge > 0 {
+		go p.reapLoop()
+	}
+	return p, nil
 }
 
-// Close shuts down the browser pool
+// Close shuts down the browser pool, cancelling any contexts still open
 func (p *BrowserPool) Close() {
-	p.cancel()
+	p.closeOnce.Do(func() {
+		close(p.stop)
+
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.cancelAllLocked()
+		p.cancel()
+	})
 }
 
-// NewContext creates a new browser context from the pool
-func (p *BrowserPool) NewContext(timeout time.Duration) (context.Context, context.CancelFunc) {
-	ctx, cancel := chromedp.NewContext(p.allocCtx)
+// NewContext creates a new browser context from the pool. The tab is closed
+// when the returned cancel is called or when parent is cancelled, so
+// abandoned scrapes stop loading pages; cancel is tracked so stuck contexts
+// can be reaped.
+func (p *BrowserPool) NewContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	// Replayed pages need no tab, so Chrome is never started
+	if p.replaying() {
+		if timeout > 0 {
+			return context.WithTimeout(parent, timeout)
+		}
+		return context.WithCancel(parent)
+	}
+
+	p.mu.Lock()
+	ctx, tabCancel := chromedp.NewContext(p.allocCtx)
+	closeTab := tabCancel
 	if timeout > 0 {
-		ctx, cancel = context.WithTimeout(ctx, timeout)
+		var timeoutCancel context.CancelFunc
+		ctx, timeoutCancel = context.WithTimeout(ctx, timeout)
+		closeTab = func() {
+			timeoutCancel()
+			tabCancel()
+		}
 	}
+	// Tabs hang off the allocator, not the caller, so follow the caller's cancellation
+	stop := context.AfterFunc(parent, closeTab)
+	cancel := p.track(func() {
+		stop()
+		closeTab()
+	})
+	p.mu.Unlock()
+
+	if p.config.RandomizeFingerprint {
+		fp := randomFingerprint(p.config)
+		if err := applyFingerprint(ctx, fp); err != nil {
+			p.logger.Debug("Failed to apply browser fingerprint", zap.Error(err))
+		}
+	}
+
 	return ctx, cancel
 }
 
-// FetchPage fetches a page and returns its HTML content
+// FetchPage fetches a page and returns its HTML content, retrying timeouts
+// and network errors. In record mode the page is also saved; in replay mode
+// it is read from the saved copy.
 func (p *BrowserPool) FetchPage(ctx context.Context, url string, waitSelector string) (string, error) {
+	if p.replaying() {
+		p.logger.Debug("Replaying page", zap.String("url", url))
+		return p.loadPage(url)
+	}
 	p.logger.Debug("Fetching page", zap.String("url", url))
 
 	var html string
@@ -119,11 +214,25 @@ func (p *BrowserPool) FetchPage(ctx context.Context, url string, waitSelector st
 		return err
 	}))
 
-	if err := chromedp.Run(ctx, actions...); err != nil {
+	err := retryFetch(ctx, func() error {
+		if err := chromedp.Run(ctx, actions...); err != nil {
+			p.recordFailure(err)
+			p.logger.Debug("Page fetch failed", zap.String("url", url), zap.Error(err))
+			return err
+		}
+		return nil
+	})
+	if err != nil {
 		return "", fmt.Errorf("failed to fetch page: %w", err)
 	}
+	p.recordSuccess()
 
 	p.logger.Debug("Page fetched", zap.String("url", url), zap.Int("length", len(html)))
+	if p.config.Mode == FetchRecord {
+		if err := p.savePage(url, html); err != nil {
+			p.logger.Warn("Failed to record page", zap.String("url", url), zap.Error(err))
+		}
+	}
 	return html, nil
 }
 
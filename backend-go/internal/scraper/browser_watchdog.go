@@ -0,0 +1,159 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/chromedp/chromedp"
+	"go.uber.org/zap"
+)
+
+// BrowserStats reports browser context lifecycle counters
+type BrowserStats struct {
+	Created             uint64 `json:"created"`
+	Closed              uint64 `json:"closed"`
+	Reaped              uint64 `json:"reaped"`
+	Active              int    `json:"active"`
+	Restarts            uint64 `json:"restarts"`
+	ConsecutiveFailures int    `json:"consecutive_failures"`
+}
+
+// trackedContext is an open browser context and when it was created
+type trackedContext struct {
+	createdAt time.Time
+	cancel    context.CancelFunc
+}
+
+// Stats returns a snapshot of lifecycle counters. Active stays above zero
+// when callers leak contexts without calling cancel.
+func (p *BrowserPool) Stats() BrowserStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	stats := p.stats
+	stats.Active = len(p.contexts)
+	stats.ConsecutiveFailures = p.failures
+	return stats
+}
+
+// track registers a context and wraps its cancel so closing is counted once.
+// Caller holds p.mu.
+func (p *BrowserPool) track(cancel context.CancelFunc) context.CancelFunc {
+	p.nextID++
+	id := p.nextID
+	p.stats.Created++
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			cancel()
+			p.mu.Lock()
+			if _, ok := p.contexts[id]; ok {
+				delete(p.contexts, id)
+				p.stats.Closed++
+			}
+			p.mu.Unlock()
+		})
+	}
+	p.contexts[id] = &trackedContext{createdAt: time.Now(), cancel: cancel}
+	return release
+}
+
+// cancelAllLocked force-cancels every open context. Caller holds p.mu.
+func (p *BrowserPool) cancelAllLocked() {
+	for id, tc := range p.contexts {
+		tc.cancel()
+		delete(p.contexts, id)
+		p.stats.Closed++
+	}
+}
+
+// reapLoop periodically cancels contexts older than MaxContextAge
+func (p *BrowserPool) reapLoop() {
+	interval := p.config.ReapInterval
+	if interval <= 0 {
+		interval = p.config.MaxContextAge / 2
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-ticker.C:
+			if n := p.reap(time.Now()); n > 0 {
+				p.logger.Warn("Reaped stuck browser contexts", zap.Int("count", n))
+			}
+		}
+	}
+}
+
+// reap cancels contexts created before now-MaxContextAge and returns how many
+func (p *BrowserPool) reap(now time.Time) int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	reaped := 0
+	for id, tc := range p.contexts {
+		if now.Sub(tc.createdAt) < p.config.MaxContextAge {
+			continue
+		}
+		tc.cancel()
+		delete(p.contexts, id)
+		p.stats.Closed++
+		p.stats.Reaped++
+		reaped++
+	}
+	return reaped
+}
+
+// recordSuccess resets the consecutive failure count
+func (p *BrowserPool) recordSuccess() {
+	p.mu.Lock()
+	p.failures = 0
+	p.mu.Unlock()
+}
+
+// recordFailure counts a failed fetch and restarts the allocator once
+// MaxFailures consecutive fetches have failed. Cancellation by the caller
+// is not the browser's fault and is ignored.
+func (p *BrowserPool) recordFailure(err error) {
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.failures++
+	if p.config.MaxFailures <= 0 || p.failures < p.config.MaxFailures {
+		return
+	}
+
+	p.logger.Warn("Restarting browser allocator after repeated failures",
+		zap.Int("failures", p.failures),
+		zap.Int("open_contexts", len(p.contexts)),
+		zap.Error(err),
+	)
+	p.restartLocked()
+}
+
+// restartLocked kills the Chrome process and starts a fresh allocator.
+// Contexts from the old allocator are cancelled. Caller holds p.mu.
+func (p *BrowserPool) restartLocked() {
+	select {
+	case <-p.stop:
+		return // Pool is closed
+	default:
+	}
+
+	p.cancelAllLocked()
+	p.cancel()
+
+	p.allocCtx, p.cancel = chromedp.NewExecAllocator(context.Background(), p.opts...)
+	p.failures = 0
+	p.stats.Restarts++
+}
@@ -0,0 +1,142 @@
+package scraper
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+const (
+	// maxRedirects is how many hops a redirect link is followed
+	maxRedirects = 5
+	// resolveConcurrency is how many redirect links of one source resolve at once
+	resolveConcurrency = 4
+	// resolvedCacheSize caps the remembered redirect targets
+	resolvedCacheSize = 5000
+)
+
+// URLCanonicalizer rewrites scraped job URLs to their canonical form before
+// deduplication. Links through configured redirectors (URL shorteners, job
+// board click-through links) are first resolved to where they lead.
+type URLCanonicalizer struct {
+	redirectors []string // host, optionally followed by a path prefix
+	client      *http.Client
+	userAgent   string
+
+	mu       sync.Mutex
+	resolved map[string]string
+}
+
+// NewURLCanonicalizer creates a canonicalizer resolving links through the
+// configured redirectors
+func NewURLCanonicalizer(cfg config.ScrapeURLConfig) *URLCanonicalizer {
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	c := &URLCanonicalizer{
+		userAgent: DefaultBrowserConfig().UserAgent,
+		resolved:  make(map[string]string),
+	}
+	for _, r := range cfg.ResolveRedirects {
+		if r = strings.ToLower(strings.TrimSpace(r)); r != "" {
+			c.redirectors = append(c.redirectors, r)
+		}
+	}
+	c.client = &http.Client{
+		Timeout: timeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= maxRedirects {
+				return http.ErrUseLastResponse
+			}
+			return nil
+		},
+	}
+	return c
+}
+
+// Canonicalize rewrites the URLs of jobs in place
+func (c *URLCanonicalizer) Canonicalize(ctx context.Context, jobs []*domain.Job) {
+	sem := make(chan struct{}, resolveConcurrency)
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		if !c.redirects(job.URL) {
+			job.CanonicalizeURLs()
+			continue
+		}
+		wg.Add(1)
+		go func(job *domain.Job) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			job.URL = c.resolve(ctx, job.URL)
+			job.CanonicalizeURLs()
+		}(job)
+	}
+	wg.Wait()
+}
+
+// redirects reports whether raw links through a configured redirector
+func (c *URLCanonicalizer) redirects(raw string) bool {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	for _, r := range c.redirectors {
+		rhost, path, _ := strings.Cut(r, "/")
+		if host != rhost && !strings.HasSuffix(host, "."+rhost) {
+			continue
+		}
+		if path == "" || strings.HasPrefix(strings.ToLower(u.Path), "/"+path) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve returns where raw leads, or raw when it can't be followed
+func (c *URLCanonicalizer) resolve(ctx context.Context, raw string) string {
+	c.mu.Lock()
+	target, ok := c.resolved[raw]
+	c.mu.Unlock()
+	if ok {
+		return target
+	}
+
+	target = raw
+	for _, method := range []string{http.MethodHead, http.MethodGet} {
+		req, err := http.NewRequestWithContext(ctx, method, raw, nil)
+		if err != nil {
+			return raw
+		}
+		req.Header.Set("User-Agent", c.userAgent)
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return raw // not cached, so a later scrape retries
+		}
+		resp.Body.Close()
+		// Some redirectors reject HEAD; retry those with GET
+		if resp.StatusCode == http.StatusMethodNotAllowed && method == http.MethodHead {
+			continue
+		}
+		if resp.StatusCode < 400 {
+			target = resp.Request.URL.String()
+		}
+		break
+	}
+
+	c.mu.Lock()
+	if len(c.resolved) >= resolvedCacheSize {
+		c.resolved = make(map[string]string)
+	}
+	c.resolved[raw] = target
+	c.mu.Unlock()
+	return target
+}
@@ -0,0 +1,440 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// errNoCareerBoard is returned for career pages on no supported platform
+var errNoCareerBoard = errors.New("no supported job board found on career page")
+
+// CareerPlatform is the hosted job board behind a company careers page
+type CareerPlatform string
+
+const (
+	PlatformGreenhouse CareerPlatform = "greenhouse"
+	PlatformLever      CareerPlatform = "lever"
+	PlatformWorkday    CareerPlatform = "workday"
+	PlatformAshby      CareerPlatform = "ashby"
+	PlatformWorkable   CareerPlatform = "workable"
+)
+
+// CareerBoard identifies one company's board on a career platform
+type CareerBoard struct {
+	Platform CareerPlatform
+	Token    string // Greenhouse board token, Lever company, Ashby board, Workable account or Workday tenant
+	Host     string // Workday host, e.g. acme.wd5.myworkdayjobs.com
+	Site     string // Workday career site
+	JobID    string // posting in a job URL: an ID, or the Workday job path
+}
+
+// workdayLocale matches the optional locale segment of Workday URLs
+var workdayLocale = regexp.MustCompile(`^[a-z]{2}-[A-Z]{2}$`)
+
+// DetectCareerBoard recognizes a job board or posting URL on a supported platform
+func DetectCareerBoard(rawURL string) (CareerBoard, bool) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil || u.Host == "" {
+		return CareerBoard{}, false
+	}
+	host := strings.ToLower(u.Hostname())
+	segs := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+
+	switch {
+	case strings.HasSuffix(host, ".greenhouse.io"):
+		// boards.greenhouse.io/acme/jobs/123, boards.greenhouse.io/embed/job_board?for=acme
+		if len(segs) > 0 && segs[0] == "embed" {
+			if token := u.Query().Get("for"); token != "" {
+				return CareerBoard{Platform: PlatformGreenhouse, Token: token, JobID: u.Query().Get("token")}, true
+			}
+			return CareerBoard{}, false
+		}
+		if host == "boards-api.greenhouse.io" && len(segs) >= 3 && segs[1] == "boards" {
+			segs = segs[2:]
+		}
+		if len(segs) == 0 {
+			return CareerBoard{}, false
+		}
+		board := CareerBoard{Platform: PlatformGreenhouse, Token: segs[0]}
+		if len(segs) >= 3 && segs[1] == "jobs" {
+			board.JobID = segs[2]
+		}
+		return board, true
+	case host == "jobs.lever.co" || host == "jobs.eu.lever.co":
+		if len(segs) == 0 {
+			return CareerBoard{}, false
+		}
+		board := CareerBoard{Platform: PlatformLever, Token: segs[0]}
+		if len(segs) >= 2 && segs[1] != "apply" {
+			board.JobID = segs[1]
+		}
+		return board, true
+	case host == "jobs.ashbyhq.com":
+		if len(segs) == 0 {
+			return CareerBoard{}, false
+		}
+		board := CareerBoard{Platform: PlatformAshby, Token: segs[0]}
+		if len(segs) >= 2 {
+			board.JobID = segs[1]
+		}
+		return board, true
+	case host == "apply.workable.com":
+		// apply.workable.com/acme/j/3F1A2B4C5D
+		if len(segs) == 0 || segs[0] == "api" {
+			return CareerBoard{}, false
+		}
+		board := CareerBoard{Platform: PlatformWorkable, Token: segs[0]}
+		if len(segs) >= 3 && segs[1] == "j" {
+			board.JobID = segs[2]
+		}
+		return board, true
+	case strings.HasSuffix(host, ".myworkdayjobs.com"):
+		// acme.wd5.myworkdayjobs.com/en-US/External/job/Austin-TX/Engineer_R123
+		if len(segs) > 0 && workdayLocale.MatchString(segs[0]) {
+			segs = segs[1:]
+		}
+		if len(segs) == 0 {
+			return CareerBoard{}, false
+		}
+		board := CareerBoard{Platform: PlatformWorkday, Token: strings.SplitN(host, ".", 2)[0], Host: host, Site: segs[0]}
+		if len(segs) >= 2 && segs[1] == "job" {
+			board.JobID = "/" + strings.Join(segs[1:], "/")
+		}
+		return board, true
+	}
+	return CareerBoard{}, false
+}
+
+// boardLinkPattern finds links to supported job boards in a career page
+var boardLinkPattern = regexp.MustCompile(`https?://(?:[\w-]+\.)*(?:greenhouse\.io|lever\.co|ashbyhq\.com|workable\.com|myworkdayjobs\.com)/[^\s"'<>\\]*`)
+
+// detectEmbeddedBoard finds the job board a career page links to or embeds
+func detectEmbeddedBoard(page string) (CareerBoard, bool) {
+	for _, link := range boardLinkPattern.FindAllString(page, -1) {
+		if board, ok := DetectCareerBoard(html.UnescapeString(link)); ok {
+			board.JobID = ""
+			return board, true
+		}
+	}
+	return CareerBoard{}, false
+}
+
+// CareerPageScraper scrapes open roles straight from company career sites,
+// for companies that never show up on the aggregators
+type CareerPageScraper struct {
+	pages  []config.CareerPageConfig
+	client *http.Client
+	logger *zap.Logger
+
+	mu     sync.Mutex
+	boards map[string]CareerBoard // detected board per careers URL
+}
+
+// NewCareerPageScraper creates a scraper for the configured career pages
+func NewCareerPageScraper(pages []config.CareerPageConfig, client *http.Client, logger *zap.Logger) *CareerPageScraper {
+	return &CareerPageScraper{
+		pages:  pages,
+		client: client,
+		logger: logger,
+		boards: make(map[string]CareerBoard),
+	}
+}
+
+// Name returns the scraper name
+func (s *CareerPageScraper) Name() string {
+	return "Career pages"
+}
+
+// Source returns the job source
+func (s *CareerPageScraper) Source() domain.JobSource {
+	return domain.JobSourceCareerPage
+}
+
+// APIBacked marks the scraper as using the boards' public APIs
+func (s *CareerPageScraper) APIBacked() bool {
+	return true
+}
+
+// Scrape collects the open roles matching query from every tracked career page
+func (s *CareerPageScraper) Scrape(ctx context.Context, query string, opts *ScrapeOptions) (*ScrapeResult, error) {
+	if opts == nil {
+		opts = DefaultScrapeOptions()
+	}
+	result := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		StartTime: time.Now(),
+	}
+
+	for _, page := range s.pages {
+		remaining := *opts
+		remaining.MaxJobs = opts.MaxJobs - result.Scraped
+		if remaining.MaxJobs <= 0 {
+			break
+		}
+		company, err := s.ScrapeCompany(ctx, page.URL, page.Company, query, &remaining)
+		if company != nil {
+			result.Total += company.Total
+			result.Scraped += company.Scraped
+			result.Jobs = append(result.Jobs, company.Jobs...)
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+		}
+	}
+
+	result.EndTime = time.Now()
+	s.logger.Info("Career page scrape completed",
+		zap.Int("pages", len(s.pages)),
+		zap.Int("total", result.Total),
+		zap.Int("scraped", result.Scraped),
+		zap.Duration("duration", result.Duration()),
+	)
+
+	if len(result.Jobs) == 0 && len(result.Errors) > 0 {
+		return result, result.Errors[0]
+	}
+	return result, nil
+}
+
+// ScrapeCompany collects the open roles matching query from one careers URL.
+// company names the employer; when empty it is derived from the board name.
+func (s *CareerPageScraper) ScrapeCompany(ctx context.Context, careersURL, company, query string, opts *ScrapeOptions) (*ScrapeResult, error) {
+	if opts == nil {
+		opts = DefaultScrapeOptions()
+	}
+	result := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		StartTime: time.Now(),
+	}
+	fail := func(err error) (*ScrapeResult, error) {
+		err = &ScrapeError{Source: s.Source(), Category: Categorize(err), URL: careersURL, Err: err}
+		result.Errors = append(result.Errors, err)
+		result.EndTime = time.Now()
+		return result, err
+	}
+
+	board, err := s.board(ctx, careersURL)
+	if err != nil {
+		return fail(err)
+	}
+	if company == "" {
+		company = boardCompanyName(board.Token)
+	}
+
+	jobs, err := s.boardJobs(ctx, board, company, query)
+	if err != nil {
+		return fail(err)
+	}
+
+	words := strings.Fields(strings.ToLower(query))
+	for _, job := range jobs {
+		result.Total++
+		if result.Scraped >= opts.MaxJobs || !matchesCareerJob(job, words, opts) {
+			continue
+		}
+		if board.Platform == PlatformWorkday && job.Description == "" {
+			if err := s.workdayDetails(ctx, board, job); err != nil {
+				s.logger.Debug("Failed to fetch Workday job details", zap.String("url", job.URL), zap.Error(err))
+			}
+		}
+		if !postedWithin(job.PostedDate, opts) {
+			continue
+		}
+		result.Jobs = append(result.Jobs, job)
+		result.Scraped++
+	}
+
+	result.EndTime = time.Now()
+	s.logger.Debug("Career page scraped",
+		zap.String("url", careersURL),
+		zap.String("platform", string(board.Platform)),
+		zap.Int("total", result.Total),
+		zap.Int("scraped", result.Scraped),
+	)
+	return result, nil
+}
+
+// ScrapeJob fetches a single posting from its board
+func (s *CareerPageScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
+	board, ok := DetectCareerBoard(jobURL)
+	if !ok || board.JobID == "" {
+		return nil, &ScrapeError{Source: s.Source(), Category: domain.ScrapeErrorOther, URL: jobURL, Err: errNoCareerBoard}
+	}
+	job, err := s.boardJob(ctx, board, s.companyFor(board))
+	if err != nil {
+		return nil, withSource(s.Source(), err)
+	}
+	return job, nil
+}
+
+// board returns the job board behind a careers URL, detecting it from the
+// URL itself or else from the links and embeds in the page
+func (s *CareerPageScraper) board(ctx context.Context, careersURL string) (CareerBoard, error) {
+	if board, ok := DetectCareerBoard(careersURL); ok {
+		board.JobID = ""
+		return board, nil
+	}
+
+	s.mu.Lock()
+	board, ok := s.boards[careersURL]
+	s.mu.Unlock()
+	if ok {
+		return board, nil
+	}
+
+	page, err := fetchHTML(ctx, s.client, careersURL)
+	if err != nil {
+		return CareerBoard{}, err
+	}
+	board, ok = detectEmbeddedBoard(page)
+	if !ok {
+		return CareerBoard{}, errNoCareerBoard
+	}
+
+	s.mu.Lock()
+	s.boards[careersURL] = board
+	s.mu.Unlock()
+	return board, nil
+}
+
+// companyFor returns the configured company name for a board, if tracked
+func (s *CareerPageScraper) companyFor(board CareerBoard) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, page := range s.pages {
+		tracked, ok := DetectCareerBoard(page.URL)
+		if !ok {
+			tracked, ok = s.boards[page.URL]
+		}
+		if ok && page.Company != "" && tracked.Platform == board.Platform && strings.EqualFold(tracked.Token, board.Token) {
+			return page.Company
+		}
+	}
+	return boardCompanyName(board.Token)
+}
+
+// boardCompanyName turns a board token such as "acme-robotics" into "Acme Robotics"
+func boardCompanyName(token string) string {
+	words := strings.FieldsFunc(token, func(r rune) bool { return r == '-' || r == '_' || r == '.' })
+	for i, w := range words {
+		words[i] = strings.ToUpper(w[:1]) + w[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// matchesCareerJob reports whether every query word appears in the title and
+// the location option matches the job's location
+func matchesCareerJob(job *domain.Job, words []string, opts *ScrapeOptions) bool {
+	title := strings.ToLower(job.Title)
+	for _, w := range words {
+		if !strings.Contains(title, w) {
+			return false
+		}
+	}
+
+	if opts.Location == "" && !opts.Remote {
+		return true
+	}
+	if opts.Remote && job.LocationType != nil && *job.LocationType == domain.LocationTypeRemote {
+		return true
+	}
+	return opts.Location != "" && job.Location != nil &&
+		strings.Contains(strings.ToLower(*job.Location), strings.ToLower(opts.Location))
+}
+
+// addBoardJobs adds the board postings matching the query words and options
+// to result, up to MaxJobs
+func addBoardJobs(result *ScrapeResult, jobs []*domain.Job, words []string, opts *ScrapeOptions) {
+	for _, job := range jobs {
+		result.Total++
+		if result.Scraped >= opts.MaxJobs || !matchesCareerJob(job, words, opts) || !postedWithin(job.PostedDate, opts) {
+			continue
+		}
+		result.Jobs = append(result.Jobs, job)
+		result.Scraped++
+	}
+}
+
+// markRemote sets the location type when the posting describes itself as remote
+func markRemote(job *domain.Job, text string) {
+	if strings.Contains(strings.ToLower(text), "remote") {
+		job.LocationType = remoteLocation()
+	}
+}
+
+// blockBreak matches tags that end a line of text
+var blockBreak = regexp.MustCompile(`(?i)<(br\s*/?|/p|/li|/h[1-6]|/div|/tr)>`)
+
+// htmlText converts a posting's HTML description to plain text
+func htmlText(s string) string {
+	if !strings.Contains(s, "<") {
+		return strings.TrimSpace(s)
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(blockBreak.ReplaceAllString(s, "$0\n")))
+	if err != nil {
+		return strings.TrimSpace(s)
+	}
+	lines := strings.Split(doc.Text(), "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
+
+// maxCareerPageSize bounds how much of a career page is read for board detection
+const maxCareerPageSize = 2 << 20
+
+// fetchHTML fetches a web page over plain HTTP, retrying transient failures
+func fetchHTML(ctx context.Context, client *http.Client, pageURL string) (string, error) {
+	var page string
+	err := retryFetch(ctx, func() error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Accept", "text/html")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("page request failed: %w", err)
+		}
+		defer resp.Body.Close()
+
+		switch {
+		case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+			return &ScrapeError{Category: domain.ScrapeErrorBlocked, URL: pageURL,
+				Err: fmt.Errorf("page request refused: status %d", resp.StatusCode)}
+		case resp.StatusCode >= http.StatusInternalServerError:
+			return &ScrapeError{Category: domain.ScrapeErrorNetwork, URL: pageURL,
+				Err: fmt.Errorf("page request failed: status %d", resp.StatusCode)}
+		case resp.StatusCode != http.StatusOK:
+			return fmt.Errorf("page request failed: status %d", resp.StatusCode)
+		}
+
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxCareerPageSize))
+		if err != nil {
+			return fmt.Errorf("page request failed: %w", err)
+		}
+		page = string(body)
+		return nil
+	})
+	return page, err
+}
@@ -0,0 +1,284 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// boardJobs lists every open role on a board
+func (s *CareerPageScraper) boardJobs(ctx context.Context, board CareerBoard, company, query string) ([]*domain.Job, error) {
+	switch board.Platform {
+	case PlatformGreenhouse:
+		return s.greenhouseJobs(ctx, board, company)
+	case PlatformLever:
+		return s.leverJobs(ctx, board, company)
+	case PlatformAshby:
+		return fetchAshbyBoard(ctx, s.client, board.Token, company, domain.JobSourceCareerPage)
+	case PlatformWorkable:
+		return fetchWorkableAccount(ctx, s.client, board.Token, company, domain.JobSourceCareerPage)
+	case PlatformWorkday:
+		return s.workdayJobs(ctx, board, company, query)
+	}
+	return nil, errNoCareerBoard
+}
+
+// boardJob fetches the posting named by board.JobID
+func (s *CareerPageScraper) boardJob(ctx context.Context, board CareerBoard, company string) (*domain.Job, error) {
+	switch board.Platform {
+	case PlatformGreenhouse:
+		var posting greenhouseJob
+		apiURL := fmt.Sprintf("https://boards-api.greenhouse.io/v1/boards/%s/jobs/%s", url.PathEscape(board.Token), url.PathEscape(board.JobID))
+		if err := doJSON(ctx, s.client, http.MethodGet, apiURL, nil, nil, &posting); err != nil {
+			return nil, err
+		}
+		return posting.toJob(company), nil
+	case PlatformLever:
+		var posting leverPosting
+		apiURL := fmt.Sprintf("https://api.lever.co/v0/postings/%s/%s", url.PathEscape(board.Token), url.PathEscape(board.JobID))
+		if err := doJSON(ctx, s.client, http.MethodGet, apiURL, nil, nil, &posting); err != nil {
+			return nil, err
+		}
+		return posting.toJob(company), nil
+	case PlatformAshby:
+		return fetchAshbyJob(ctx, s.client, board.Token, board.JobID, company, domain.JobSourceCareerPage)
+	case PlatformWorkable:
+		return fetchWorkableJob(ctx, s.client, board.Token, board.JobID, company, domain.JobSourceCareerPage)
+	case PlatformWorkday:
+		job := newAPIJob(domain.JobSourceCareerPage, "", company, workdayJobURL(board, board.JobID))
+		if err := s.workdayDetails(ctx, board, job); err != nil {
+			return nil, err
+		}
+		return job, nil
+	}
+	return nil, errNoCareerBoard
+}
+
+// Greenhouse job board API (boards-api.greenhouse.io)
+
+type greenhouseJob struct {
+	ID             int64  `json:"id"`
+	Title          string `json:"title"`
+	AbsoluteURL    string `json:"absolute_url"`
+	UpdatedAt      string `json:"updated_at"`
+	FirstPublished string `json:"first_published"`
+	Content        string `json:"content"` // HTML, entity-escaped
+	Location       struct {
+		Name string `json:"name"`
+	} `json:"location"`
+}
+
+func (s *CareerPageScraper) greenhouseJobs(ctx context.Context, board CareerBoard, company string) ([]*domain.Job, error) {
+	var resp struct {
+		Jobs []greenhouseJob `json:"jobs"`
+	}
+	apiURL := fmt.Sprintf("https://boards-api.greenhouse.io/v1/boards/%s/jobs?content=true", url.PathEscape(board.Token))
+	if err := doJSON(ctx, s.client, http.MethodGet, apiURL, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*domain.Job, 0, len(resp.Jobs))
+	for _, j := range resp.Jobs {
+		jobs = append(jobs, j.toJob(company))
+	}
+	return jobs, nil
+}
+
+func (j greenhouseJob) toJob(company string) *domain.Job {
+	job := newAPIJob(domain.JobSourceCareerPage, j.Title, company, j.AbsoluteURL)
+	job.Description = htmlText(html.UnescapeString(j.Content))
+	job.Location = optionalString(j.Location.Name)
+	markRemote(job, j.Location.Name)
+	job.PostedDate = parseAPITime(j.FirstPublished)
+	if job.PostedDate == nil {
+		job.PostedDate = parseAPITime(j.UpdatedAt)
+	}
+	return job
+}
+
+// Lever postings API (api.lever.co)
+
+type leverPosting struct {
+	ID               string `json:"id"`
+	Text             string `json:"text"`
+	HostedURL        string `json:"hostedUrl"`
+	CreatedAt        int64  `json:"createdAt"` // Unix milliseconds
+	DescriptionPlain string `json:"descriptionPlain"`
+	AdditionalPlain  string `json:"additionalPlain"`
+	WorkplaceType    string `json:"workplaceType"`
+	Categories       struct {
+		Location   string `json:"location"`
+		Commitment string `json:"commitment"`
+		Team       string `json:"team"`
+	} `json:"categories"`
+	Lists []struct {
+		Text    string `json:"text"`
+		Content string `json:"content"` // HTML list items
+	} `json:"lists"`
+	SalaryRange *struct {
+		Min      int    `json:"min"`
+		Max      int    `json:"max"`
+		Currency string `json:"currency"`
+		Interval string `json:"interval"`
+	} `json:"salaryRange"`
+}
+
+func (s *CareerPageScraper) leverJobs(ctx context.Context, board CareerBoard, company string) ([]*domain.Job, error) {
+	var postings []leverPosting
+	apiURL := fmt.Sprintf("https://api.lever.co/v0/postings/%s?mode=json", url.PathEscape(board.Token))
+	if err := doJSON(ctx, s.client, http.MethodGet, apiURL, nil, nil, &postings); err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*domain.Job, 0, len(postings))
+	for _, p := range postings {
+		jobs = append(jobs, p.toJob(company))
+	}
+	return jobs, nil
+}
+
+func (p leverPosting) toJob(company string) *domain.Job {
+	job := newAPIJob(domain.JobSourceCareerPage, p.Text, company, p.HostedURL)
+
+	sections := []string{strings.TrimSpace(p.DescriptionPlain)}
+	for _, list := range p.Lists {
+		sections = append(sections, strings.TrimSpace(list.Text+"\n"+htmlText(list.Content)))
+	}
+	sections = append(sections, strings.TrimSpace(p.AdditionalPlain))
+	job.Description = strings.TrimSpace(strings.Join(sections, "\n\n"))
+
+	job.Location = optionalString(p.Categories.Location)
+	markRemote(job, p.WorkplaceType+" "+p.Categories.Location)
+	job.EmploymentType = domain.NormalizeEmploymentType(p.Categories.Commitment)
+	if p.CreatedAt > 0 {
+		posted := time.UnixMilli(p.CreatedAt)
+		job.PostedDate = &posted
+	}
+	if r := p.SalaryRange; r != nil && r.Interval == "per-year-salary" && r.Max > 0 {
+		job.SalaryMin, job.SalaryMax = &r.Min, &r.Max
+		job.SalaryCurrency = r.Currency
+	}
+	return job
+}
+
+// Workday career site API (/wday/cxs), as used by the sites' own front end
+
+// workdayPageSize is the largest page the Workday API serves
+const workdayPageSize = 20
+
+// workdayMaxPages bounds how far a Workday search is paged
+const workdayMaxPages = 10
+
+func (s *CareerPageScraper) workdayJobs(ctx context.Context, board CareerBoard, company, query string) ([]*domain.Job, error) {
+	apiURL := fmt.Sprintf("https://%s/wday/cxs/%s/%s/jobs", board.Host, url.PathEscape(board.Token), url.PathEscape(board.Site))
+
+	jobs := make([]*domain.Job, 0)
+	for page := 0; page < workdayMaxPages; page++ {
+		body := map[string]interface{}{
+			"appliedFacets": map[string]interface{}{},
+			"limit":         workdayPageSize,
+			"offset":        page * workdayPageSize,
+			"searchText":    query,
+		}
+		var resp struct {
+			Total       int `json:"total"`
+			JobPostings []struct {
+				Title         string `json:"title"`
+				ExternalPath  string `json:"externalPath"`
+				LocationsText string `json:"locationsText"`
+				PostedOn      string `json:"postedOn"`
+			} `json:"jobPostings"`
+		}
+		if err := doJSON(ctx, s.client, http.MethodPost, apiURL, nil, body, &resp); err != nil {
+			if len(jobs) > 0 {
+				return jobs, nil
+			}
+			return nil, err
+		}
+
+		for _, p := range resp.JobPostings {
+			job := newAPIJob(domain.JobSourceCareerPage, p.Title, company, workdayJobURL(board, p.ExternalPath))
+			job.Location = optionalString(p.LocationsText)
+			markRemote(job, p.LocationsText)
+			job.PostedDate = parseWorkdayPosted(p.PostedOn, time.Now())
+			jobs = append(jobs, job)
+		}
+		if len(resp.JobPostings) < workdayPageSize || len(jobs) >= resp.Total {
+			break
+		}
+	}
+	return jobs, nil
+}
+
+// workdayDetails fills in the description and details of a Workday posting
+func (s *CareerPageScraper) workdayDetails(ctx context.Context, board CareerBoard, job *domain.Job) error {
+	path := strings.TrimPrefix(job.URL, fmt.Sprintf("https://%s/%s", board.Host, board.Site))
+	apiURL := fmt.Sprintf("https://%s/wday/cxs/%s/%s%s", board.Host, url.PathEscape(board.Token), url.PathEscape(board.Site), path)
+
+	var resp struct {
+		JobPostingInfo struct {
+			Title          string `json:"title"`
+			JobDescription string `json:"jobDescription"`
+			Location       string `json:"location"`
+			TimeType       string `json:"timeType"`
+			StartDate      string `json:"startDate"`
+			ExternalURL    string `json:"externalUrl"`
+		} `json:"jobPostingInfo"`
+	}
+	if err := doJSON(ctx, s.client, http.MethodGet, apiURL, nil, nil, &resp); err != nil {
+		return err
+	}
+
+	info := resp.JobPostingInfo
+	if job.Title == "" {
+		job.Title = strings.TrimSpace(info.Title)
+	}
+	if info.ExternalURL != "" {
+		job.URL = info.ExternalURL
+	}
+	job.Description = htmlText(info.JobDescription)
+	if job.Location == nil {
+		job.Location = optionalString(info.Location)
+		markRemote(job, info.Location)
+	}
+	job.EmploymentType = domain.NormalizeEmploymentType(info.TimeType)
+	if posted := parseAPITime(info.StartDate); posted != nil {
+		job.PostedDate = posted
+	}
+	return nil
+}
+
+// workdayJobURL builds the public URL of a Workday posting from its path
+func workdayJobURL(board CareerBoard, path string) string {
+	return fmt.Sprintf("https://%s/%s%s", board.Host, board.Site, path)
+}
+
+// workdayPostedPattern matches "Posted 3 Days Ago" and "Posted 30+ Days Ago"
+var workdayPostedPattern = regexp.MustCompile(`(?i)(\d+)\+?\s*days?\s*ago`)
+
+// parseWorkdayPosted converts Workday's relative posting dates
+func parseWorkdayPosted(v string, now time.Time) *time.Time {
+	v = strings.ToLower(v)
+	var days int
+	switch {
+	case strings.Contains(v, "today"):
+	case strings.Contains(v, "yesterday"):
+		days = 1
+	default:
+		m := workdayPostedPattern.FindStringSubmatch(v)
+		if m == nil {
+			return nil
+		}
+		days, _ = strconv.Atoi(m[1])
+	}
+	posted := now.AddDate(0, 0, -days)
+	return &posted
+}
@@ -0,0 +1,67 @@
+package scraper
+
+import (
+	"context"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// pageLimiterKey carries a source's page semaphore in the scrape context
+type pageLimiterKey struct{}
+
+// pageLimiter bounds how many pages of one source are open at once
+type pageLimiter chan struct{}
+
+// withPageLimiter attaches a source's page limiter to ctx
+func withPageLimiter(ctx context.Context, l pageLimiter) context.Context {
+	if l == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, pageLimiterKey{}, l)
+}
+
+// acquirePage waits for a page slot for the source being scraped. Without a
+// limiter in ctx (scrapers used directly) it returns immediately.
+func acquirePage(ctx context.Context) (func(), error) {
+	l, _ := ctx.Value(pageLimiterKey{}).(pageLimiter)
+	if l == nil {
+		return func() {}, nil
+	}
+	select {
+	case l <- struct{}{}:
+		return func() { <-l }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// pageLimiterFor returns the shared page limiter for a source, creating it on first use
+func (r *ScraperRegistry) pageLimiterFor(source domain.JobSource) pageLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if l, ok := r.pages[source]; ok {
+		return l
+	}
+	limit := r.concurrency.DefaultMaxPages
+	if n, ok := r.concurrency.Sources[string(source)]; ok && n.MaxConcurrentPages > 0 {
+		limit = n.MaxConcurrentPages
+	}
+	if limit <= 0 {
+		return nil
+	}
+	l := make(pageLimiter, limit)
+	r.pages[source] = l
+	return l
+}
+
+// ScrapeJob fetches a single job through its source's scraper, respecting
+// the source's page concurrency limit and the retry policy
+func (r *ScraperRegistry) ScrapeJob(ctx context.Context, source domain.JobSource, url string) (*domain.Job, error) {
+	s, ok := r.Get(source)
+	if !ok {
+		return nil, &ScrapeError{Source: source, Category: domain.ScrapeErrorOther, URL: url, Err: errUnknownSource}
+	}
+	ctx = withRetry(withPageLimiter(ctx, r.pageLimiterFor(source)), r.retry, nil)
+	return s.ScrapeJob(ctx, url)
+}
@@ -0,0 +1,209 @@
+package scraper
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/geo"
+)
+
+// Deduplicator collapses cross-posted jobs into one canonical record per content hash
+type Deduplicator struct {
+	byHash map[string]*domain.Job
+	order  []*domain.Job
+}
+
+// NewDeduplicator creates an empty deduplicator
+func NewDeduplicator() *Deduplicator {
+	return &Deduplicator{
+		byHash: make(map[string]*domain.Job),
+	}
+}
+
+// Add registers a job and returns its canonical record. duplicate is true when
+// the job was merged into a previously seen record.
+func (d *Deduplicator) Add(job *domain.Job) (canonical *domain.Job, duplicate bool) {
+	job.CanonicalizeURLs()
+	hash := job.EnsureContentHash()
+	job.EmploymentType = domain.NormalizeEmploymentType(string(job.EmploymentType))
+
+	existing, ok := d.byHash[hash]
+	if !ok {
+		if len(job.Sources) == 0 {
+			job.Sources = []domain.JobSourceRef{sourceRef(job)}
+		}
+		job.RecordSighting(nil, seenAt(job))
+		d.byHash[hash] = job
+		d.order = append(d.order, job)
+		return job, false
+	}
+
+	mergeDuplicate(existing, job)
+	return existing, true
+}
+
+// Jobs returns the canonical jobs in first-seen order
+func (d *Deduplicator) Jobs() []*domain.Job {
+	return d.order
+}
+
+// Deduplicate collapses cross-posted jobs within a single batch
+func Deduplicate(jobs []*domain.Job) []*domain.Job {
+	d := NewDeduplicator()
+	for _, job := range jobs {
+		d.Add(job)
+	}
+	return d.Jobs()
+}
+
+// ScrapeAll runs every registered scraper and merges the results, dropping
+// jobs that fail validation and collapsing jobs that were cross-posted on
+// more than one board or linked with different tracking. Up to
+// MaxConcurrentSources scrapers run at once, each limited to its configured
+// number of concurrent pages.
+func (r *ScraperRegistry) ScrapeAll(ctx context.Context, query string, opts *ScrapeOptions) *ScrapeResult {
+	return r.ScrapeSources(ctx, nil, query, opts)
+}
+
+// ScrapeSources is ScrapeAll limited to the named sources; none names every
+// registered source. Sources without a registered scraper are reported as
+// errors of the result.
+func (r *ScraperRegistry) ScrapeSources(ctx context.Context, sources []domain.JobSource, query string, opts *ScrapeOptions) *ScrapeResult {
+	merged := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		Rejected:  make(map[domain.ScrapeRejectReason]int),
+		StartTime: time.Now(),
+	}
+
+	scrapers := r.All()
+	if len(sources) > 0 {
+		scrapers = scrapers[:0]
+		for _, source := range sources {
+			s, ok := r.Get(source)
+			if !ok {
+				merged.Errors = append(merged.Errors, &ScrapeError{Source: source, Category: domain.ScrapeErrorOther, Err: errUnknownSource})
+				continue
+			}
+			scrapers = append(scrapers, s)
+		}
+	}
+	results := make([]*ScrapeResult, len(scrapers))
+	errs := make([]error, len(scrapers))
+
+	maxSources := r.concurrency.MaxConcurrentSources
+	if maxSources <= 0 {
+		maxSources = len(scrapers)
+	}
+	sem := make(chan struct{}, maxSources)
+
+	var wg sync.WaitGroup
+	for i, s := range scrapers {
+		wg.Add(1)
+		go func(i int, s Scraper) {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			stats := &FetchStats{}
+			sourceCtx := withRetry(withPageLimiter(ctx, r.pageLimiterFor(s.Source())), r.retry, stats)
+			results[i], errs[i] = s.Scrape(sourceCtx, query, opts)
+			if results[i] != nil {
+				results[i].Attempts, results[i].Retries = stats.Attempts(), stats.Retries()
+				r.urls.Canonicalize(ctx, results[i].Jobs)
+			}
+		}(i, s)
+	}
+	wg.Wait()
+
+	// Merge sequentially; the deduplicator is not safe for concurrent use
+	d := NewDeduplicator()
+	for i, s := range scrapers {
+		result, err := results[i], errs[i]
+		if err != nil && !result.recorded(err) {
+			merged.Errors = append(merged.Errors, withSource(s.Source(), err))
+		}
+		if result == nil {
+			continue
+		}
+		merged.Total += result.Total
+		merged.Scraped += result.Scraped
+		merged.Attempts += result.Attempts
+		merged.Retries += result.Retries
+		for _, e := range result.Errors {
+			merged.Errors = append(merged.Errors, withSource(s.Source(), e))
+		}
+		for _, job := range result.Jobs {
+			if reason := r.validator.Check(job); reason != "" {
+				merged.Rejected[reason]++
+				continue
+			}
+			d.Add(job)
+		}
+	}
+
+	merged.Jobs = d.Jobs()
+	geo.GeocodeJobs(ctx, r.geocoder, merged.Jobs)
+	for _, e := range r.enrichers {
+		e.Enrich(ctx, merged.Jobs)
+	}
+	merged.EndTime = time.Now()
+	return merged
+}
+
+// mergeDuplicate folds a cross-posted copy into the canonical record
+func mergeDuplicate(canonical, dup *domain.Job) {
+	refs := dup.Sources
+	if len(refs) == 0 {
+		refs = []domain.JobSourceRef{sourceRef(dup)}
+	}
+	for _, ref := range refs {
+		if !canonical.HasSource(ref.Source, ref.URL) {
+			canonical.Sources = append(canonical.Sources, ref)
+		}
+	}
+
+	// A later posted date for the same content is a repost, not a new job
+	canonical.RecordSighting(dup.PostedDate, seenAt(dup))
+
+	// Keep the earliest posting date and fill gaps the canonical copy lacks
+	if dup.PostedDate != nil && (canonical.PostedDate == nil || dup.PostedDate.Before(*canonical.PostedDate)) {
+		canonical.PostedDate = dup.PostedDate
+	}
+	if canonical.SalaryMin == nil && dup.SalaryMin != nil {
+		canonical.SalaryMin = dup.SalaryMin
+		canonical.SalaryMax = dup.SalaryMax
+		canonical.SalaryText = dup.SalaryText
+	}
+	if canonical.Location == nil {
+		canonical.Location = dup.Location
+	}
+	if canonical.LocationType == nil {
+		canonical.LocationType = dup.LocationType
+	}
+	if canonical.EmploymentType == "" {
+		canonical.EmploymentType = domain.NormalizeEmploymentType(string(dup.EmploymentType))
+	}
+}
+
+// sourceRef builds the source reference for a freshly scraped job
+func sourceRef(job *domain.Job) domain.JobSourceRef {
+	return domain.JobSourceRef{
+		Source: job.Source,
+		URL:    job.URL,
+		SeenAt: seenAt(job),
+	}
+}
+
+// seenAt returns when the job was scraped, defaulting to now
+func seenAt(job *domain.Job) time.Time {
+	if job.ScrapedAt.IsZero() {
+		return time.Now()
+	}
+	return job.ScrapedAt
+}
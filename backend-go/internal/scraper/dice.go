@@ -15,14 +15,17 @@ import (
 	"github.com/resume-rag/backend/internal/domain"
 )
 
+// diceCardSelector matches the job cards on a search results page
+const diceCardSelector = "[data-cy='search-card'], .card-title-link"
+
 // DiceScraper scrapes Dice.com job listings (tech-focused)
 type DiceScraper struct {
-	browser *BrowserPool
+	browser PageFetcher
 	logger  *zap.Logger
 }
 
 // NewDiceScraper creates a new Dice scraper
-func NewDiceScraper(browser *BrowserPool, logger *zap.Logger) *DiceScraper {
+func NewDiceScraper(browser PageFetcher, logger *zap.Logger) *DiceScraper {
 	return &DiceScraper{
 		browser: browser,
 		logger:  logger,
@@ -57,8 +60,17 @@ func (s *DiceScraper) Scrape(ctx context.Context, query string, opts *ScrapeOpti
 		zap.Int("maxJobs", opts.MaxJobs),
 	)
 
+	// Wait for a page slot for this source
+	release, err := acquirePage(ctx)
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+		result.EndTime = time.Now()
+		return result, err
+	}
+	defer release()
+
 	// Create browser context
-	browserCtx, cancel := s.browser.NewContext(2 * time.Minute)
+	browserCtx, cancel := s.browser.NewContext(ctx, 2*time.Minute)
 	defer cancel()
 
 	// Fetch search results
@@ -78,7 +90,7 @@ func (s *DiceScraper) Scrape(ctx context.Context, query string, opts *ScrapeOpti
 	}
 
 	// Extract job cards
-	jobCards := doc.Find("[data-cy='search-card'], .card-title-link")
+	jobCards := doc.Find(diceCardSelector)
 	result.Total = jobCards.Length()
 
 	s.logger.Debug("Found job cards", zap.Int("count", result.Total))
@@ -111,7 +123,13 @@ func (s *DiceScraper) Scrape(ctx context.Context, query string, opts *ScrapeOpti
 
 // ScrapeJob fetches details for a single job
 func (s *DiceScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
-	browserCtx, cancel := s.browser.NewContext(30 * time.Second)
+	release, err := acquirePage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	browserCtx, cancel := s.browser.NewContext(ctx, 30*time.Second)
 	defer cancel()
 
 	html, err := s.browser.FetchPage(browserCtx, jobURL, "[data-cy='jobDescription']")
@@ -124,7 +142,7 @@ func (s *DiceScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job
 		return nil, fmt.Errorf("failed to parse HTML: %w", err)
 	}
 
-	return s.parseJobDetails(doc, jobURL)
+	return s.parseJobDetails(doc.Selection, jobURL)
 }
 
 func (s *DiceScraper) buildSearchURL(query string, opts *ScrapeOptions) string {
@@ -179,17 +197,9 @@ func (s *DiceScraper) parseJobCard(card *goquery.Selection) (*domain.Job, error)
 	// Extract URL
 	if href, exists := titleEl.Attr("href"); exists {
 		if strings.HasPrefix(href, "/") {
-			job.SourceURL = "https://www.dice.com" + href
+			job.URL = "https://www.dice.com" + href
 		} else {
-			job.SourceURL = href
-		}
-	}
-
-	// Extract job ID from URL
-	if job.SourceURL != "" {
-		re := regexp.MustCompile(`/job-detail/([a-f0-9-]+)`)
-		if matches := re.FindStringSubmatch(job.SourceURL); len(matches) > 1 {
-			job.ExternalID = matches[1]
+			job.URL = href
 		}
 	}
 
@@ -197,29 +207,24 @@ func (s *DiceScraper) parseJobCard(card *goquery.Selection) (*domain.Job, error)
 	companyEl := card.Find("[data-cy='search-result-company-name'], .card-company")
 	companyName := strings.TrimSpace(companyEl.Text())
 	if companyName != "" {
-		job.Company = &domain.Company{Name: companyName}
+		job.Company = domain.Company{Name: companyName}
 	}
 
 	// Extract location
 	locationEl := card.Find("[data-cy='search-result-location'], .card-location")
-	job.Location = strings.TrimSpace(locationEl.Text())
+	job.Location = optionalString(locationEl.Text())
 
 	// Determine location type
-	locationLower := strings.ToLower(job.Location)
-	if strings.Contains(locationLower, "remote") {
-		job.LocationType = domain.LocationTypeRemote
-	} else {
-		job.LocationType = domain.LocationTypeOnsite
-	}
+	job.LocationType = locationTypeOf(job.Location)
 
 	// Extract posted date
 	dateEl := card.Find("[data-cy='card-posted-date'], .posted-date")
 	dateText := strings.TrimSpace(dateEl.Text())
-	job.PostedAt = s.parseRelativeDate(dateText)
+	job.PostedDate = s.parseRelativeDate(dateText)
 
 	// Extract employment type
 	typeEl := card.Find("[data-cy='search-result-employment-type']")
-	job.EmploymentType = strings.ToLower(strings.TrimSpace(typeEl.Text()))
+	job.EmploymentType = domain.NormalizeEmploymentType(typeEl.Text())
 
 	return job, nil
 }
@@ -227,8 +232,8 @@ func (s *DiceScraper) parseJobCard(card *goquery.Selection) (*domain.Job, error)
 func (s *DiceScraper) parseJobDetails(doc *goquery.Selection, jobURL string) (*domain.Job, error) {
 	job := &domain.Job{
 		ID:        uuid.New(),
+		URL:       jobURL,
 		Source:    domain.JobSourceDice,
-		SourceURL: jobURL,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 		IsActive:  true,
@@ -240,11 +245,11 @@ func (s *DiceScraper) parseJobDetails(doc *goquery.Selection, jobURL string) (*d
 	// Company
 	companyEl := doc.Find("[data-cy='companyNameLink'], .company-name")
 	if companyName := strings.TrimSpace(companyEl.Text()); companyName != "" {
-		job.Company = &domain.Company{Name: companyName}
+		job.Company = domain.Company{Name: companyName}
 	}
 
 	// Location
-	job.Location = strings.TrimSpace(doc.Find("[data-cy='locationDetails'], .job-location").Text())
+	job.Location = optionalString(doc.Find("[data-cy='locationDetails'], .job-location").Text())
 
 	// Description
 	descEl := doc.Find("[data-cy='jobDescription'], .job-description")
@@ -260,12 +265,6 @@ func (s *DiceScraper) parseJobDetails(doc *goquery.Selection, jobURL string) (*d
 	})
 	job.RequiredSkills = skills
 
-	// Extract job ID from URL
-	re := regexp.MustCompile(`/job-detail/([a-f0-9-]+)`)
-	if matches := re.FindStringSubmatch(jobURL); len(matches) > 1 {
-		job.ExternalID = matches[1]
-	}
-
 	return job, nil
 }
 
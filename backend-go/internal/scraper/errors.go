@@ -0,0 +1,162 @@
+package scraper
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ScrapeError attributes a scrape failure to a source and category
+type ScrapeError struct {
+	Source   domain.JobSource
+	Category domain.ScrapeErrorCategory
+	URL      string
+	Err      error
+}
+
+func (e *ScrapeError) Error() string {
+	if e.URL != "" {
+		return string(e.Source) + ": " + e.URL + ": " + e.Err.Error()
+	}
+	return string(e.Source) + ": " + e.Err.Error()
+}
+
+func (e *ScrapeError) Unwrap() error {
+	return e.Err
+}
+
+// withSource tags err with the source that produced it, keeping an existing tag
+func withSource(source domain.JobSource, err error) error {
+	var se *ScrapeError
+	if errors.As(err, &se) {
+		if se.Source == "" {
+			se.Source = source
+		}
+		return err
+	}
+	return &ScrapeError{Source: source, Category: Categorize(err), Err: err}
+}
+
+// blockedMarkers appear in errors from pages that refused the scraper
+var blockedMarkers = []string{
+	"403", "429", "captcha", "access denied", "forbidden", "too many requests",
+	"unusual traffic", "are you a robot", "authwall", "blocked",
+}
+
+// selectorMarkers appear when an expected element is not on the page
+var selectorMarkers = []string{
+	"no title found", "could not find node", "waiting for selector", "no such element", "selector",
+}
+
+// Categorize classifies a scrape error
+func Categorize(err error) domain.ScrapeErrorCategory {
+	if err == nil {
+		return domain.ScrapeErrorOther
+	}
+
+	var se *ScrapeError
+	if errors.As(err, &se) && se.Category != "" {
+		return se.Category
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return domain.ScrapeErrorTimeout
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		if netErr.Timeout() {
+			return domain.ScrapeErrorTimeout
+		}
+		return domain.ScrapeErrorNetwork
+	}
+
+	msg := strings.ToLower(err.Error())
+	switch {
+	case containsAny(msg, blockedMarkers):
+		return domain.ScrapeErrorBlocked
+	case strings.Contains(msg, "timeout") || strings.Contains(msg, "timed out"):
+		return domain.ScrapeErrorTimeout
+	case containsAny(msg, selectorMarkers):
+		return domain.ScrapeErrorSelectorMissing
+	case strings.Contains(msg, "parse"):
+		return domain.ScrapeErrorParse
+	case strings.Contains(msg, "net::err_") || strings.Contains(msg, "connection"):
+		return domain.ScrapeErrorNetwork
+	default:
+		return domain.ScrapeErrorOther
+	}
+}
+
+// SummarizeErrors groups errors by source and category, keeping the first
+// message of each group as a sample
+func SummarizeErrors(errs []error) []domain.ScrapeErrorSummary {
+	type key struct {
+		source   domain.JobSource
+		category domain.ScrapeErrorCategory
+	}
+
+	index := make(map[key]int)
+	summaries := make([]domain.ScrapeErrorSummary, 0)
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		k := key{category: Categorize(err)}
+		var se *ScrapeError
+		if errors.As(err, &se) {
+			k.source = se.Source
+		}
+
+		if i, ok := index[k]; ok {
+			summaries[i].Count++
+			continue
+		}
+		index[k] = len(summaries)
+		summaries = append(summaries, domain.ScrapeErrorSummary{
+			Source:   k.source,
+			Category: k.category,
+			Count:    1,
+			Sample:   err.Error(),
+		})
+	}
+	return summaries
+}
+
+// CountErrors totals summaries per category
+func CountErrors(summaries []domain.ScrapeErrorSummary) map[domain.ScrapeErrorCategory]int {
+	counts := make(map[domain.ScrapeErrorCategory]int, len(summaries))
+	for _, s := range summaries {
+		counts[s.Category] += s.Count
+	}
+	return counts
+}
+
+// ErrorSummary groups the run's errors by source and category
+func (r *ScrapeResult) ErrorSummary() []domain.ScrapeErrorSummary {
+	return SummarizeErrors(r.Errors)
+}
+
+// recorded reports whether err wraps an error the scraper already added to
+// Errors, so a returned error is not counted twice. Safe on a nil result.
+func (r *ScrapeResult) recorded(err error) bool {
+	if r == nil {
+		return false
+	}
+	for _, e := range r.Errors {
+		if errors.Is(err, e) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(s string, markers []string) bool {
+	for _, m := range markers {
+		if strings.Contains(s, m) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package scraper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// hoursPerWorkYear is the OPM divisor between hourly and annual federal pay
+const hoursPerWorkYear = 2087
+
+// gsBaseRanges is the 2024 General Schedule base pay (step 1 to step 10) per
+// grade, used when a posting lists only its grade. Locality pay is not included.
+var gsBaseRanges = map[int][2]int{
+	1:  {22360, 27970},
+	2:  {25142, 31639},
+	3:  {27431, 35657},
+	4:  {30795, 40033},
+	5:  {34454, 44790},
+	6:  {38407, 49930},
+	7:  {42679, 55486},
+	8:  {47265, 61449},
+	9:  {52205, 67869},
+	10: {57489, 74736},
+	11: {63163, 82108},
+	12: {75706, 98422},
+	13: {90025, 117034},
+	14: {106382, 138296},
+	15: {125133, 162672},
+}
+
+// PayGrade is a federal pay plan and grade range, e.g. GS-12 to GS-13
+type PayGrade struct {
+	Plan string
+	Low  int
+	High int
+}
+
+// String renders the grade as GS-12 or GS-12/13
+func (g PayGrade) String() string {
+	if g.High > g.Low {
+		return fmt.Sprintf("%s-%d/%d", g.Plan, g.Low, g.High)
+	}
+	return fmt.Sprintf("%s-%d", g.Plan, g.Low)
+}
+
+// BaseRange returns the GS base pay range spanning the grades, or nils for
+// pay plans without a published table
+func (g PayGrade) BaseRange() (*int, *int) {
+	if g.Plan != "GS" && g.Plan != "GL" {
+		return nil, nil
+	}
+	low, okLow := gsBaseRanges[g.Low]
+	high, okHigh := gsBaseRanges[g.High]
+	if !okLow || !okHigh {
+		return nil, nil
+	}
+	return &low[0], &high[1]
+}
+
+// parsePayGrade builds a pay grade from the plan code and low/high grade
+// fields, accepting forms like "12" and "GS-12"
+func parsePayGrade(plan, low, high string) *PayGrade {
+	plan = strings.ToUpper(strings.TrimSpace(plan))
+	lowPlan, lowGrade := splitGrade(low)
+	_, highGrade := splitGrade(high)
+	if plan == "" {
+		plan = lowPlan
+	}
+	if plan == "" || lowGrade == 0 {
+		return nil
+	}
+	if highGrade < lowGrade {
+		highGrade = lowGrade
+	}
+	return &PayGrade{Plan: plan, Low: lowGrade, High: highGrade}
+}
+
+// splitGrade splits "GS-12" into ("GS", 12); a bare "12" has no plan
+func splitGrade(v string) (string, int) {
+	v = strings.ToUpper(strings.TrimSpace(v))
+	plan := ""
+	if i := strings.IndexAny(v, "-0123456789"); i > 0 {
+		plan = strings.TrimSpace(v[:i])
+		v = v[i:]
+	}
+	n, err := strconv.Atoi(strings.TrimLeft(v, "- "))
+	if err != nil {
+		return plan, 0
+	}
+	return plan, n
+}
+
+// annualSalary converts a posted pay figure to annual pay based on its rate interval
+func annualSalary(v, interval string) *int {
+	amount, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+	if err != nil || amount <= 0 {
+		return nil
+	}
+	switch strings.ToUpper(strings.TrimSpace(interval)) {
+	case "PA", "PER YEAR", "":
+		n := int(amount)
+		return &n
+	case "PH", "PER HOUR":
+		n := int(amount * hoursPerWorkYear)
+		return &n
+	default:
+		return nil // Daily, biweekly and without-compensation rates are not comparable
+	}
+}
+
+// normalizeClearance returns the required clearance, or nil when none is needed
+func normalizeClearance(v string) *string {
+	v = strings.TrimSpace(v)
+	switch strings.ToLower(v) {
+	case "", "not applicable", "not required", "none", "other":
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,127 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// maxPageSize caps how much of a page the HTTP fetcher reads
+const maxPageSize = 10 << 20
+
+// chromeExecutables are the programs chromedp can drive
+var chromeExecutables = []string{"headless-shell", "google-chrome", "google-chrome-stable", "chromium", "chromium-browser"}
+
+// PageFetcher loads the HTML of a job board page. BrowserPool renders it in
+// Chrome; HTTPFetcher downloads it as served.
+type PageFetcher interface {
+	// NewContext returns the context pages are fetched in, closed by cancel
+	NewContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc)
+
+	// FetchPage returns the page's HTML once waitSelector is visible.
+	// Fetchers that don't render the page ignore waitSelector.
+	FetchPage(ctx context.Context, url string, waitSelector string) (string, error)
+}
+
+// ChromeInstalled reports whether a Chrome or Chromium binary is on PATH
+func ChromeInstalled() bool {
+	for _, name := range chromeExecutables {
+		if _, err := exec.LookPath(name); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPFetcher fetches pages with plain HTTP requests. It is used for sources
+// whose listings are in the served HTML, and as a degraded fallback for the
+// others when Chrome isn't installed.
+type HTTPFetcher struct {
+	client    *http.Client
+	userAgent string
+	logger    *zap.Logger
+}
+
+// NewHTTPFetcher creates an HTTP fetcher, using DefaultBrowserConfig's user
+// agent when userAgent is empty
+func NewHTTPFetcher(client *http.Client, userAgent string, logger *zap.Logger) *HTTPFetcher {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	if userAgent == "" {
+		userAgent = DefaultBrowserConfig().UserAgent
+	}
+	return &HTTPFetcher{client: client, userAgent: userAgent, logger: logger}
+}
+
+// NewContext bounds the fetch by timeout; no browser state is needed
+func (f *HTTPFetcher) NewContext(parent context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout > 0 {
+		return context.WithTimeout(parent, timeout)
+	}
+	return context.WithCancel(parent)
+}
+
+// FetchPage downloads a page, retrying timeouts, network errors and server
+// errors. waitSelector is ignored: content rendered by JavaScript is missing.
+func (f *HTTPFetcher) FetchPage(ctx context.Context, url string, waitSelector string) (string, error) {
+	f.logger.Debug("Fetching page over HTTP", zap.String("url", url))
+
+	var html string
+	err := retryFetch(ctx, func() error {
+		page, err := f.get(ctx, url)
+		if err != nil {
+			f.logger.Debug("Page fetch failed", zap.String("url", url), zap.Error(err))
+			return err
+		}
+		html = page
+		return nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch page: %w", err)
+	}
+
+	f.logger.Debug("Page fetched", zap.String("url", url), zap.Int("length", len(html)))
+	return html, nil
+}
+
+// get sends one page request
+func (f *HTTPFetcher) get(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", f.userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("page request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests:
+		return "", &ScrapeError{Category: domain.ScrapeErrorBlocked, URL: url,
+			Err: fmt.Errorf("page blocked: status %d", resp.StatusCode)}
+	case resp.StatusCode >= http.StatusInternalServerError:
+		return "", &ScrapeError{Category: domain.ScrapeErrorNetwork, URL: url,
+			Err: fmt.Errorf("page request failed: status %d", resp.StatusCode)}
+	case resp.StatusCode != http.StatusOK:
+		return "", fmt.Errorf("page request failed: status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxPageSize))
+	if err != nil {
+		return "", fmt.Errorf("failed to read page: %w", err)
+	}
+	return string(body), nil
+}
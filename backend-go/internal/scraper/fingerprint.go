@@ -0,0 +1,94 @@
+package scraper
+
+import (
+	"context"
+	"math/rand"
+
+	"github.com/chromedp/cdproto/emulation"
+	"github.com/chromedp/chromedp"
+)
+
+// Fingerprint is the browser identity presented by one browser context
+type Fingerprint struct {
+	UserAgent      string
+	Platform       string
+	AcceptLanguage string
+	Width          int
+	Height         int
+}
+
+// UserAgentProfile pairs a user agent with the navigator.platform it implies
+type UserAgentProfile struct {
+	UserAgent string
+	Platform  string
+}
+
+// Viewport is a browser window size
+type Viewport struct {
+	Width  int
+	Height int
+}
+
+// DefaultUserAgents are current desktop browsers on common platforms
+var DefaultUserAgents = []UserAgentProfile{
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36", "Win32"},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/123.0.0.0 Safari/537.36", "Win32"},
+	{"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36 Edg/124.0.0.0", "Win32"},
+	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36", "MacIntel"},
+	{"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/122.0.0.0 Safari/537.36", "MacIntel"},
+	{"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0.0.0 Safari/537.36", "Linux x86_64"},
+}
+
+// DefaultViewports are common desktop resolutions
+var DefaultViewports = []Viewport{
+	{1920, 1080},
+	{1536, 864},
+	{1440, 900},
+	{1366, 768},
+	{1680, 1050},
+	{2560, 1440},
+}
+
+// DefaultAcceptLanguages are plausible Accept-Language values for US job searches
+var DefaultAcceptLanguages = []string{
+	"en-US,en;q=0.9",
+	"en-US,en;q=0.8",
+	"en-US,en;q=0.9,es;q=0.8",
+	"en-GB,en-US;q=0.9,en;q=0.8",
+}
+
+// randomFingerprint picks a user agent, viewport and language from the configured pools
+func randomFingerprint(config *BrowserConfig) Fingerprint {
+	agents := config.UserAgents
+	if len(agents) == 0 {
+		agents = DefaultUserAgents
+	}
+	viewports := config.Viewports
+	if len(viewports) == 0 {
+		viewports = DefaultViewports
+	}
+	languages := config.AcceptLanguages
+	if len(languages) == 0 {
+		languages = DefaultAcceptLanguages
+	}
+
+	agent := agents[rand.Intn(len(agents))]
+	viewport := viewports[rand.Intn(len(viewports))]
+	return Fingerprint{
+		UserAgent:      agent.UserAgent,
+		Platform:       agent.Platform,
+		AcceptLanguage: languages[rand.Intn(len(languages))],
+		Width:          viewport.Width,
+		Height:         viewport.Height,
+	}
+}
+
+// applyFingerprint overrides the user agent, language and viewport of a browser context
+func applyFingerprint(ctx context.Context, fp Fingerprint) error {
+	return chromedp.Run(ctx,
+		emulation.SetUserAgentOverride(fp.UserAgent).
+			WithAcceptLanguage(fp.AcceptLanguage).
+			WithPlatform(fp.Platform),
+		emulation.SetDeviceMetricsOverride(int64(fp.Width), int64(fp.Height), 1, false),
+	)
+}
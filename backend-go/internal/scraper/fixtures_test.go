@@ -0,0 +1,243 @@
+package scraper
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Saved pages live under testdata/<source>/ as <name>.search.html (a search
+// results page) or <name>.detail.html (a job page, with its URL in a
+// <!-- url: ... --> comment). Each has a <name>.<kind>.golden.json holding
+// the expected jobs.
+//
+//	go test ./internal/scraper -run TestFixtures          # compare with the golden output
+//	go test ./internal/scraper -run TestFixtures -update  # rewrite the golden output
+var update = flag.Bool("update", false, "rewrite fixture golden files from the current output")
+
+// Fixture kinds, from the file name
+const (
+	fixtureSearch = "search"
+	fixtureDetail = "detail"
+)
+
+// fixtureURL reads the page URL a detail fixture was saved from
+var fixtureURL = regexp.MustCompile(`<!--\s*url:\s*(\S+)\s*-->`)
+
+// volatileFields change on every parse and are left out of golden output
+var volatileFields = []string{"id", "created_at", "updated_at", "scraped_at", "first_seen_at", "last_seen_at"}
+
+// pageParser runs one source's parse functions over a saved page
+type pageParser struct {
+	cards  string
+	card   func(card *goquery.Selection) ([]*domain.Job, error)
+	detail func(doc *goquery.Selection, jobURL string) (*domain.Job, error)
+}
+
+// fixtureParsers returns the parsers for each source, keyed by the fixture
+// directory name
+func fixtureParsers() map[string]pageParser {
+	nop := zap.NewNop()
+	dice := &DiceScraper{logger: nop}
+	indeed := &IndeedScraper{logger: nop}
+	linkedIn := &LinkedInScraper{logger: nop}
+	wellfound := &WellfoundScraper{logger: nop}
+
+	return map[string]pageParser{
+		"dice":      {cards: diceCardSelector, card: single(dice.parseJobCard), detail: dice.parseJobDetails},
+		"indeed":    {cards: indeedCardSelector, card: single(indeed.parseJobCard), detail: indeed.parseJobDetails},
+		"linkedin":  {cards: linkedInCardSelector, card: single(linkedIn.parseJobCard), detail: linkedIn.parseJobDetails},
+		"wellfound": {cards: wellfoundCardSelector, card: wellfound.parseCompanyCard, detail: wellfound.parseJobDetails},
+	}
+}
+
+func single(parse func(*goquery.Selection) (*domain.Job, error)) func(*goquery.Selection) ([]*domain.Job, error) {
+	return func(card *goquery.Selection) ([]*domain.Job, error) {
+		job, err := parse(card)
+		if err != nil {
+			return nil, err
+		}
+		return []*domain.Job{job}, nil
+	}
+}
+
+// TestFixtures parses every saved page under testdata and compares the jobs
+// with its golden output, so selector changes can be checked without
+// hitting live sites
+func TestFixtures(t *testing.T) {
+	pages, err := filepath.Glob(filepath.Join("testdata", "*", "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pages) == 0 {
+		t.Fatal("no fixtures found in testdata")
+	}
+	sort.Strings(pages)
+
+	parsers := fixtureParsers()
+	for _, page := range pages {
+		source := filepath.Base(filepath.Dir(page))
+		name := strings.TrimSuffix(filepath.Base(page), ".html")
+
+		t.Run(source+"/"+name, func(t *testing.T) {
+			parser, ok := parsers[source]
+			if !ok {
+				t.Fatalf("no parser for source %q", source)
+			}
+			jobs, err := parsePage(parser, page, strings.TrimPrefix(filepath.Ext(name), "."))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(jobs) == 0 {
+				t.Fatal("no jobs parsed")
+			}
+
+			got, err := normalizeJobs(jobs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			golden := strings.TrimSuffix(page, ".html") + ".golden.json"
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("no golden output (run with -update to create it): %v", err)
+			}
+			for _, d := range diffJobs(got, want) {
+				t.Error(d)
+			}
+		})
+	}
+}
+
+// parsePage runs the parse functions for kind over a saved page
+func parsePage(parser pageParser, path, kind string) ([]*domain.Job, error) {
+	html, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(html))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse HTML: %w", err)
+	}
+
+	switch kind {
+	case fixtureSearch:
+		var jobs []*domain.Job
+		doc.Find(parser.cards).Each(func(i int, card *goquery.Selection) {
+			// Cards that fail to parse are skipped, as they are when scraping
+			if parsed, err := parser.card(card); err == nil {
+				jobs = append(jobs, parsed...)
+			}
+		})
+		return jobs, nil
+	case fixtureDetail:
+		jobURL := ""
+		if m := fixtureURL.FindSubmatch(html); m != nil {
+			jobURL = string(m[1])
+		}
+		job, err := parser.detail(doc.Selection, jobURL)
+		if err != nil {
+			return nil, err
+		}
+		return []*domain.Job{job}, nil
+	default:
+		return nil, fmt.Errorf("unknown fixture kind %q (want %s or %s)", kind, fixtureSearch, fixtureDetail)
+	}
+}
+
+// normalizeJobs encodes jobs without their volatile fields. Posted dates
+// parsed from relative text ("3 days ago") are counted back from now in
+// whole hours, so they are kept as whole days ago; absolute dates are kept
+// as they are.
+func normalizeJobs(jobs []*domain.Job) ([]byte, error) {
+	out := make([]map[string]interface{}, 0, len(jobs))
+	for _, job := range jobs {
+		data, err := json.Marshal(job)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]interface{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, err
+		}
+		for _, key := range volatileFields {
+			delete(fields, key)
+		}
+		if job.PostedDate != nil {
+			if age := time.Since(*job.PostedDate); age%time.Hour < time.Minute {
+				delete(fields, "posted_date")
+				fields["posted_days_ago"] = int(age.Hours() / 24)
+			}
+		}
+		out = append(out, fields)
+	}
+	data, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// diffJobs lists the fields that differ between normalized outputs
+func diffJobs(got, want []byte) []string {
+	var g, w []map[string]interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		return []string{"output: " + err.Error()}
+	}
+	if err := json.Unmarshal(want, &w); err != nil {
+		return []string{"golden: " + err.Error()}
+	}
+
+	var diffs []string
+	if len(g) != len(w) {
+		diffs = append(diffs, fmt.Sprintf("jobs: got %d, want %d", len(g), len(w)))
+	}
+	for i := 0; i < len(g) && i < len(w); i++ {
+		keys := make(map[string]bool)
+		for k := range g[i] {
+			keys[k] = true
+		}
+		for k := range w[i] {
+			keys[k] = true
+		}
+		names := make([]string, 0, len(keys))
+		for k := range keys {
+			names = append(names, k)
+		}
+		sort.Strings(names)
+
+		for _, k := range names {
+			if !reflect.DeepEqual(g[i][k], w[i][k]) {
+				diffs = append(diffs, fmt.Sprintf("job[%d].%s: got %s, want %s", i, k, compact(g[i][k]), compact(w[i][k])))
+			}
+		}
+	}
+	return diffs
+}
+
+func compact(v interface{}) string {
+	if v == nil {
+		return "(none)"
+	}
+	data, _ := json.Marshal(v)
+	return string(data)
+}
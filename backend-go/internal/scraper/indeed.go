@@ -15,14 +15,17 @@ import (
 	"github.com/resume-rag/backend/internal/domain"
 )
 
+// indeedCardSelector matches the job cards on a search results page
+const indeedCardSelector = ".job_seen_beacon, .jobsearch-SerpJobCard, .result"
+
 // IndeedScraper scrapes Indeed job listings
 type IndeedScraper struct {
-	browser *BrowserPool
+	browser PageFetcher
 	logger  *zap.Logger
 }
 
 // NewIndeedScraper creates a new Indeed scraper
-func NewIndeedScraper(browser *BrowserPool, logger *zap.Logger) *IndeedScraper {
+func NewIndeedScraper(browser PageFetcher, logger *zap.Logger) *IndeedScraper {
 	return &IndeedScraper{
 		browser: browser,
 		logger:  logger,
@@ -57,8 +60,17 @@ func (s *IndeedScraper) Scrape(ctx context.Context, query string, opts *ScrapeOp
 		zap.Int("maxJobs", opts.MaxJobs),
 	)
 
+	// Wait for a page slot for this source
+	release, err := acquirePage(ctx)
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+		result.EndTime = time.Now()
+		return result, err
+	}
+	defer release()
+
 	// Create browser context
-	browserCtx, cancel := s.browser.NewContext(2 * time.Minute)
+	browserCtx, cancel := s.browser.NewContext(ctx, 2*time.Minute)
 	defer cancel()
 
 	// Fetch search results
@@ -78,7 +90,7 @@ func (s *IndeedScraper) Scrape(ctx context.Context, query string, opts *ScrapeOp
 	}
 
 	// Extract job cards
-	jobCards := doc.Find(".job_seen_beacon, .jobsearch-SerpJobCard, .result")
+	jobCards := doc.Find(indeedCardSelector)
 	result.Total = jobCards.Length()
 
 	s.logger.Debug("Found job cards", zap.Int("count", result.Total))
@@ -111,7 +123,13 @@ func (s *IndeedScraper) Scrape(ctx context.Context, query string, opts *ScrapeOp
 
 // ScrapeJob fetches details for a single job
 func (s *IndeedScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
-	browserCtx, cancel := s.browser.NewContext(30 * time.Second)
+	release, err := acquirePage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	browserCtx, cancel := s.browser.NewContext(ctx, 30*time.Second)
 	defer cancel()
 
 	html, err := s.browser.FetchPage(browserCtx, jobURL, ".jobsearch-JobComponent")
@@ -124,7 +142,7 @@ func (s *IndeedScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.J
 		return nil, fmt.Errorf("failed to parse HTML: %w", err)
 	}
 
-	return s.parseJobDetails(doc, jobURL)
+	return s.parseJobDetails(doc.Selection, jobURL)
 }
 
 func (s *IndeedScraper) buildSearchURL(query string, opts *ScrapeOptions) string {
@@ -181,40 +199,24 @@ func (s *IndeedScraper) parseJobCard(card *goquery.Selection) (*domain.Job, erro
 	companyEl := card.Find(".companyName, [data-testid='company-name']")
 	companyName := strings.TrimSpace(companyEl.Text())
 	if companyName != "" {
-		job.Company = &domain.Company{Name: companyName}
+		job.Company = domain.Company{Name: companyName}
 	}
 
 	// Extract location
 	locationEl := card.Find(".companyLocation, [data-testid='text-location']")
-	job.Location = strings.TrimSpace(locationEl.Text())
+	job.Location = optionalString(locationEl.Text())
 
 	// Determine location type
-	locationLower := strings.ToLower(job.Location)
-	if strings.Contains(locationLower, "remote") {
-		job.LocationType = domain.LocationTypeRemote
-	} else if strings.Contains(locationLower, "hybrid") {
-		job.LocationType = domain.LocationTypeHybrid
-	} else {
-		job.LocationType = domain.LocationTypeOnsite
-	}
+	job.LocationType = locationTypeOf(job.Location)
 
-	// Extract job key/ID
+	// Extract the job URL, from the job key when the card has one
 	if jobKey, exists := card.Attr("data-jk"); exists {
-		job.ExternalID = jobKey
-		job.SourceURL = fmt.Sprintf("https://www.indeed.com/viewjob?jk=%s", jobKey)
-	} else {
-		// Try to find link
-		if href, exists := titleLink.Attr("href"); exists {
-			if strings.HasPrefix(href, "/") {
-				job.SourceURL = "https://www.indeed.com" + href
-			} else {
-				job.SourceURL = href
-			}
-			// Extract job key from URL
-			re := regexp.MustCompile(`jk=([a-f0-9]+)`)
-			if matches := re.FindStringSubmatch(href); len(matches) > 1 {
-				job.ExternalID = matches[1]
-			}
+		job.URL = fmt.Sprintf("https://www.indeed.com/viewjob?jk=%s", jobKey)
+	} else if href, exists := titleLink.Attr("href"); exists {
+		if strings.HasPrefix(href, "/") {
+			job.URL = "https://www.indeed.com" + href
+		} else {
+			job.URL = href
 		}
 	}
 
@@ -231,7 +233,7 @@ func (s *IndeedScraper) parseJobCard(card *goquery.Selection) (*domain.Job, erro
 	// Extract posted date
 	dateEl := card.Find(".date, [data-testid='myJobsStateDate']")
 	dateText := strings.TrimSpace(dateEl.Text())
-	job.PostedAt = s.parseRelativeDate(dateText)
+	job.PostedDate = s.parseRelativeDate(dateText)
 
 	return job, nil
 }
@@ -239,8 +241,8 @@ func (s *IndeedScraper) parseJobCard(card *goquery.Selection) (*domain.Job, erro
 func (s *IndeedScraper) parseJobDetails(doc *goquery.Selection, jobURL string) (*domain.Job, error) {
 	job := &domain.Job{
 		ID:        uuid.New(),
+		URL:       jobURL,
 		Source:    domain.JobSourceIndeed,
-		SourceURL: jobURL,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 		IsActive:  true,
@@ -252,12 +254,13 @@ func (s *IndeedScraper) parseJobDetails(doc *goquery.Selection, jobURL string) (
 	// Company
 	companyEl := doc.Find(".jobsearch-InlineCompanyRating-companyHeader, [data-testid='inlineHeader-companyName']")
 	if companyName := strings.TrimSpace(companyEl.Text()); companyName != "" {
-		job.Company = &domain.Company{Name: companyName}
+		job.Company = domain.Company{Name: companyName}
 	}
 
 	// Location
 	locationEl := doc.Find(".jobsearch-JobInfoHeader-subtitle .jobsearch-JobInfoHeader-locationWrapper")
-	job.Location = strings.TrimSpace(locationEl.Text())
+	job.Location = optionalString(locationEl.Text())
+	job.LocationType = locationTypeOf(job.Location)
 
 	// Full description
 	descEl := doc.Find("#jobDescriptionText, .jobsearch-jobDescriptionText")
@@ -269,12 +272,6 @@ func (s *IndeedScraper) parseJobDetails(doc *goquery.Selection, jobURL string) (
 		s.parseSalary(job, salaryText)
 	}
 
-	// Extract job key from URL
-	re := regexp.MustCompile(`jk=([a-f0-9]+)`)
-	if matches := re.FindStringSubmatch(jobURL); len(matches) > 1 {
-		job.ExternalID = matches[1]
-	}
-
 	return job, nil
 }
 
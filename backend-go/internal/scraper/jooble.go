@@ -0,0 +1,113 @@
+package scraper
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// JoobleScraper searches jobs through the Jooble API
+type JoobleScraper struct {
+	cfg    config.JoobleConfig
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewJoobleScraper creates a new Jooble API adapter
+func NewJoobleScraper(cfg config.JoobleConfig, client *http.Client, logger *zap.Logger) *JoobleScraper {
+	return &JoobleScraper{cfg: cfg, client: client, logger: logger}
+}
+
+// Name returns the scraper name
+func (s *JoobleScraper) Name() string {
+	return "Jooble"
+}
+
+// Source returns the job source
+func (s *JoobleScraper) Source() domain.JobSource {
+	return domain.JobSourceJooble
+}
+
+// APIBacked marks the scraper as using an official API
+func (s *JoobleScraper) APIBacked() bool {
+	return true
+}
+
+type joobleRequest struct {
+	Keywords string `json:"keywords"`
+	Location string `json:"location,omitempty"`
+	Page     int    `json:"page"`
+}
+
+type joobleResponse struct {
+	TotalCount int `json:"totalCount"`
+	Jobs       []struct {
+		Title    string `json:"title"`
+		Location string `json:"location"`
+		Snippet  string `json:"snippet"`
+		Salary   string `json:"salary"`
+		Type     string `json:"type"`
+		Link     string `json:"link"`
+		Company  string `json:"company"`
+		Updated  string `json:"updated"`
+	} `json:"jobs"`
+}
+
+// Scrape performs the search through the API
+func (s *JoobleScraper) Scrape(ctx context.Context, query string, opts *ScrapeOptions) (*ScrapeResult, error) {
+	if opts == nil {
+		opts = DefaultScrapeOptions()
+	}
+	result := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		StartTime: time.Now(),
+	}
+
+	body := joobleRequest{Keywords: query, Location: opts.Location, Page: 1}
+	if opts.Remote && body.Location == "" {
+		body.Location = "Remote"
+	}
+
+	var resp joobleResponse
+	if err := doJSON(ctx, s.client, http.MethodPost, "https://jooble.org/api/"+s.cfg.APIKey, nil, body, &resp); err != nil {
+		result.Errors = append(result.Errors, err)
+		result.EndTime = time.Now()
+		return result, err
+	}
+
+	result.Total = resp.TotalCount
+	for _, r := range resp.Jobs {
+		if result.Scraped >= opts.MaxJobs {
+			break
+		}
+		job := newAPIJob(domain.JobSourceJooble, r.Title, r.Company, r.Link)
+		job.Description = r.Snippet
+		job.Location = optionalString(r.Location)
+		job.SalaryText = optionalString(r.Salary)
+		job.PostedDate = parseAPITime(r.Updated)
+		job.EmploymentType = domain.NormalizeEmploymentType(r.Type)
+		if !postedWithin(job.PostedDate, opts) {
+			continue
+		}
+		result.Jobs = append(result.Jobs, job)
+		result.Scraped++
+	}
+
+	result.EndTime = time.Now()
+	s.logger.Info("Jooble search completed",
+		zap.Int("total", result.Total),
+		zap.Int("scraped", result.Scraped),
+		zap.Duration("duration", result.Duration()),
+	)
+	return result, nil
+}
+
+// ScrapeJob is not supported; Jooble links out to the original posting
+func (s *JoobleScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
+	return nil, &ScrapeError{Source: s.Source(), Category: domain.ScrapeErrorOther, URL: jobURL, Err: errJobLookupUnsupported}
+}
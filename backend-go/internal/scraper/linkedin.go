@@ -4,27 +4,27 @@ import (
 	"context"
 	"fmt"
 	"net/url"
-	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/PuerkitoBio/goquery"
-	"github.com/chromedp/chromedp"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"github.com/resume-rag/backend/internal/domain"
 )
 
+// linkedInCardSelector matches the job cards on a search results page
+const linkedInCardSelector = ".jobs-search__results-list li, .job-search-card"
+
 // LinkedInScraper scrapes LinkedIn job listings
 type LinkedInScraper struct {
-	browser *BrowserPool
+	browser PageFetcher
 	logger  *zap.Logger
 }
 
 // NewLinkedInScraper creates a new LinkedIn scraper
-func NewLinkedInScraper(browser *BrowserPool, logger *zap.Logger) *LinkedInScraper {
+func NewLinkedInScraper(browser PageFetcher, logger *zap.Logger) *LinkedInScraper {
 	return &LinkedInScraper{
 		browser: browser,
 		logger:  logger,
@@ -60,8 +60,17 @@ func (s *LinkedInScraper) Scrape(ctx context.Context, query string, opts *Scrape
 		zap.Int("maxJobs", opts.MaxJobs),
 	)
 
+	// Wait for a page slot for this source
+	release, err := acquirePage(ctx)
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+		result.EndTime = time.Now()
+		return result, err
+	}
+	defer release()
+
 	// Create browser context
-	browserCtx, cancel := s.browser.NewContext(2 * time.Minute)
+	browserCtx, cancel := s.browser.NewContext(ctx, 2*time.Minute)
 	defer cancel()
 
 	// Fetch search results page
@@ -86,7 +95,7 @@ func (s *LinkedInScraper) Scrape(ctx context.Context, query string, opts *Scrape
 	}
 
 	// Extract job cards
-	jobCards := doc.Find(".jobs-search__results-list li, .job-search-card")
+	jobCards := doc.Find(linkedInCardSelector)
 	result.Total = jobCards.Length()
 
 	s.logger.Debug("Found job cards", zap.Int("count", result.Total))
@@ -119,7 +128,13 @@ func (s *LinkedInScraper) Scrape(ctx context.Context, query string, opts *Scrape
 
 // ScrapeJob fetches details for a single job
 func (s *LinkedInScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
-	browserCtx, cancel := s.browser.NewContext(30 * time.Second)
+	release, err := acquirePage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	browserCtx, cancel := s.browser.NewContext(ctx, 30*time.Second)
 	defer cancel()
 
 	html, err := s.browser.FetchPage(browserCtx, jobURL, ".job-view-layout")
@@ -132,7 +147,7 @@ func (s *LinkedInScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain
 		return nil, fmt.Errorf("failed to parse HTML: %w", err)
 	}
 
-	return s.parseJobDetails(doc, jobURL)
+	return s.parseJobDetails(doc.Selection, jobURL)
 }
 
 func (s *LinkedInScraper) buildSearchURL(query string, opts *ScrapeOptions) string {
@@ -198,42 +213,27 @@ func (s *LinkedInScraper) parseJobCard(card *goquery.Selection) (*domain.Job, er
 	companyEl := card.Find(".base-search-card__subtitle, .job-search-card__company-name")
 	companyName := strings.TrimSpace(companyEl.Text())
 	if companyName != "" {
-		job.Company = &domain.Company{Name: companyName}
+		job.Company = domain.Company{Name: companyName}
 	}
 
 	// Extract location
 	locationEl := card.Find(".job-search-card__location")
-	job.Location = strings.TrimSpace(locationEl.Text())
+	job.Location = optionalString(locationEl.Text())
 
 	// Determine location type
-	locationLower := strings.ToLower(job.Location)
-	if strings.Contains(locationLower, "remote") {
-		job.LocationType = domain.LocationTypeRemote
-	} else if strings.Contains(locationLower, "hybrid") {
-		job.LocationType = domain.LocationTypeHybrid
-	} else {
-		job.LocationType = domain.LocationTypeOnsite
-	}
+	job.LocationType = locationTypeOf(job.Location)
 
 	// Extract URL
 	linkEl := card.Find("a.base-card__full-link, a.job-search-card__link")
 	if href, exists := linkEl.Attr("href"); exists {
-		job.SourceURL = strings.Split(href, "?")[0] // Remove tracking params
-	}
-
-	// Extract job ID from URL
-	if job.SourceURL != "" {
-		re := regexp.MustCompile(`/view/(\d+)`)
-		if matches := re.FindStringSubmatch(job.SourceURL); len(matches) > 1 {
-			job.ExternalID = matches[1]
-		}
+		job.URL = strings.Split(href, "?")[0] // Remove tracking params
 	}
 
 	// Extract posted date
 	dateEl := card.Find("time")
 	if datetime, exists := dateEl.Attr("datetime"); exists {
 		if t, err := time.Parse(time.RFC3339, datetime); err == nil {
-			job.PostedAt = &t
+			job.PostedDate = &t
 		}
 	}
 
@@ -243,8 +243,8 @@ func (s *LinkedInScraper) parseJobCard(card *goquery.Selection) (*domain.Job, er
 func (s *LinkedInScraper) parseJobDetails(doc *goquery.Selection, jobURL string) (*domain.Job, error) {
 	job := &domain.Job{
 		ID:        uuid.New(),
+		URL:       jobURL,
 		Source:    domain.JobSourceLinkedIn,
-		SourceURL: jobURL,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 		IsActive:  true,
@@ -256,11 +256,12 @@ func (s *LinkedInScraper) parseJobDetails(doc *goquery.Selection, jobURL string)
 	// Company
 	companyEl := doc.Find(".job-details-jobs-unified-top-card__company-name, .jobs-unified-top-card__company-name")
 	if companyName := strings.TrimSpace(companyEl.Text()); companyName != "" {
-		job.Company = &domain.Company{Name: companyName}
+		job.Company = domain.Company{Name: companyName}
 	}
 
 	// Location
-	job.Location = strings.TrimSpace(doc.Find(".job-details-jobs-unified-top-card__bullet, .jobs-unified-top-card__bullet").First().Text())
+	job.Location = optionalString(doc.Find(".job-details-jobs-unified-top-card__bullet, .jobs-unified-top-card__bullet").First().Text())
+	job.LocationType = locationTypeOf(job.Location)
 
 	// Description
 	descEl := doc.Find(".jobs-description__content, .description__text")
@@ -270,19 +271,13 @@ func (s *LinkedInScraper) parseJobDetails(doc *goquery.Selection, jobURL string)
 	doc.Find(".job-details-jobs-unified-top-card__job-insight").Each(func(i int, sel *goquery.Selection) {
 		text := strings.ToLower(sel.Text())
 		if strings.Contains(text, "full-time") {
-			job.EmploymentType = "full-time"
+			job.EmploymentType = domain.EmploymentTypeFullTime
 		} else if strings.Contains(text, "part-time") {
-			job.EmploymentType = "part-time"
+			job.EmploymentType = domain.EmploymentTypePartTime
 		} else if strings.Contains(text, "contract") {
-			job.EmploymentType = "contract"
+			job.EmploymentType = domain.EmploymentTypeContract
 		}
 	})
 
-	// Extract job ID
-	re := regexp.MustCompile(`/view/(\d+)`)
-	if matches := re.FindStringSubmatch(jobURL); len(matches) > 1 {
-		job.ExternalID = matches[1]
-	}
-
 	return job, nil
 }
@@ -0,0 +1,107 @@
+package scraper
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// RemotiveScraper searches remote jobs through the public Remotive API
+type RemotiveScraper struct {
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewRemotiveScraper creates a new Remotive API adapter
+func NewRemotiveScraper(client *http.Client, logger *zap.Logger) *RemotiveScraper {
+	return &RemotiveScraper{client: client, logger: logger}
+}
+
+// Name returns the scraper name
+func (s *RemotiveScraper) Name() string {
+	return "Remotive"
+}
+
+// Source returns the job source
+func (s *RemotiveScraper) Source() domain.JobSource {
+	return domain.JobSourceRemotive
+}
+
+// APIBacked marks the scraper as using an official API
+func (s *RemotiveScraper) APIBacked() bool {
+	return true
+}
+
+type remotiveResponse struct {
+	JobCount int `json:"job-count"`
+	Jobs     []struct {
+		URL                       string `json:"url"`
+		Title                     string `json:"title"`
+		CompanyName               string `json:"company_name"`
+		CompanyLogo               string `json:"company_logo"`
+		JobType                   string `json:"job_type"`
+		PublicationDate           string `json:"publication_date"`
+		CandidateRequiredLocation string `json:"candidate_required_location"`
+		Salary                    string `json:"salary"`
+		Description               string `json:"description"`
+	} `json:"jobs"`
+}
+
+// Scrape performs the search through the API
+func (s *RemotiveScraper) Scrape(ctx context.Context, query string, opts *ScrapeOptions) (*ScrapeResult, error) {
+	if opts == nil {
+		opts = DefaultScrapeOptions()
+	}
+	result := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		StartTime: time.Now(),
+	}
+
+	params := url.Values{}
+	params.Set("search", query)
+	params.Set("limit", strconv.Itoa(opts.MaxJobs))
+	searchURL := "https://remotive.com/api/remote-jobs?" + params.Encode()
+
+	var resp remotiveResponse
+	if err := doJSON(ctx, s.client, http.MethodGet, searchURL, nil, nil, &resp); err != nil {
+		result.Errors = append(result.Errors, err)
+		result.EndTime = time.Now()
+		return result, err
+	}
+
+	result.Total = resp.JobCount
+	for _, r := range resp.Jobs {
+		job := newAPIJob(domain.JobSourceRemotive, r.Title, r.CompanyName, r.URL)
+		job.Description = r.Description
+		job.Company.LogoURL = optionalString(r.CompanyLogo)
+		job.Location = optionalString(r.CandidateRequiredLocation)
+		job.LocationType = remoteLocation()
+		job.SalaryText = optionalString(r.Salary)
+		job.PostedDate = parseAPITime(r.PublicationDate)
+		job.EmploymentType = domain.NormalizeEmploymentType(r.JobType)
+		if !postedWithin(job.PostedDate, opts) {
+			continue
+		}
+		result.Jobs = append(result.Jobs, job)
+		result.Scraped++
+	}
+
+	result.EndTime = time.Now()
+	s.logger.Info("Remotive search completed",
+		zap.Int("total", result.Total),
+		zap.Int("scraped", result.Scraped),
+		zap.Duration("duration", result.Duration()),
+	)
+	return result, nil
+}
+
+// ScrapeJob is not supported; Remotive results already include the full listing
+func (s *RemotiveScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
+	return nil, &ScrapeError{Source: s.Source(), Category: domain.ScrapeErrorOther, URL: jobURL, Err: errJobLookupUnsupported}
+}
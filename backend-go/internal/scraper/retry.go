@@ -0,0 +1,115 @@
+package scraper
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// RetryPolicy retries fetches that failed with a transient error
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+}
+
+// DefaultRetryPolicy is used by scrapers called outside the registry
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: 3, BaseDelay: time.Second, MaxDelay: 15 * time.Second}
+}
+
+// NewRetryPolicy creates a retry policy from configuration
+func NewRetryPolicy(cfg config.ScrapeRetryConfig) RetryPolicy {
+	return RetryPolicy{MaxAttempts: cfg.MaxAttempts, BaseDelay: cfg.BaseDelay, MaxDelay: cfg.MaxDelay}
+}
+
+// backoff returns the delay before the given retry (1-based): the base delay
+// doubled per retry, capped at MaxDelay, with jitter over its upper half
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < retry && (p.MaxDelay <= 0 || d < p.MaxDelay); i++ {
+		d *= 2
+	}
+	if p.MaxDelay > 0 && d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// Transient reports whether a fetch error is worth retrying. Blocked pages,
+// missing selectors and parse failures fail the same way again.
+func Transient(err error) bool {
+	switch Categorize(err) {
+	case domain.ScrapeErrorTimeout, domain.ScrapeErrorNetwork:
+		return true
+	default:
+		return false
+	}
+}
+
+// FetchStats counts fetch attempts made for one scrape
+type FetchStats struct {
+	attempts atomic.Int64
+	retries  atomic.Int64
+}
+
+// Attempts returns the number of fetch attempts, retries included
+func (s *FetchStats) Attempts() int { return int(s.attempts.Load()) }
+
+// Retries returns the number of fetch attempts that were retries
+func (s *FetchStats) Retries() int { return int(s.retries.Load()) }
+
+// retryKey carries the retry policy and fetch counters in the scrape context
+type retryKey struct{}
+
+type retryState struct {
+	policy RetryPolicy
+	stats  *FetchStats
+}
+
+// withRetry attaches a retry policy and the counters to record attempts in
+func withRetry(ctx context.Context, policy RetryPolicy, stats *FetchStats) context.Context {
+	return context.WithValue(ctx, retryKey{}, retryState{policy: policy, stats: stats})
+}
+
+// retryFetch runs fetch until it succeeds, fails with a non-transient error,
+// runs out of attempts or ctx is done. The policy comes from ctx, falling
+// back to DefaultRetryPolicy when scrapers are used directly.
+func retryFetch(ctx context.Context, fetch func() error) error {
+	state, ok := ctx.Value(retryKey{}).(retryState)
+	if !ok {
+		state.policy = DefaultRetryPolicy()
+	}
+	attempts := max(state.policy.MaxAttempts, 1)
+
+	var err error
+	for attempt := 1; attempt <= attempts; attempt++ {
+		if attempt > 1 {
+			timer := time.NewTimer(state.policy.backoff(attempt - 1))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+			if state.stats != nil {
+				state.stats.retries.Add(1)
+			}
+		}
+		if state.stats != nil {
+			state.stats.attempts.Add(1)
+		}
+		if err = fetch(); err == nil || !Transient(err) || ctx.Err() != nil {
+			return err
+		}
+	}
+	return err
+}
@@ -2,11 +2,30 @@ package scraper
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"sync"
 	"time"
 
+	"github.com/resume-rag/backend/internal/config"
 	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/geo"
 )
 
+// errUnknownSource is returned when no scraper is registered for a source
+var errUnknownSource = errors.New("no scraper registered for source")
+
+// jsSources are the boards whose listings are rendered by JavaScript, so
+// plain HTTP fetches of them come back without jobs
+var jsSources = map[domain.JobSource]bool{
+	domain.JobSourceIndeed:      true,
+	domain.JobSourceLinkedIn:    true,
+	domain.JobSourceDice:        true,
+	domain.JobSourceWellfound:   true,
+	domain.JobSourceYCombinator: true,
+	domain.JobSourceBuiltIn:     true,
+}
+
 // Scraper interface for job board scrapers
 type Scraper interface {
 	// Name returns the scraper name
@@ -52,6 +71,9 @@ type ScrapeResult struct {
 	Total     int
 	Scraped   int
 	Errors    []error
+	Rejected  map[domain.ScrapeRejectReason]int // jobs dropped by validation, per reason
+	Attempts  int                               // page and API fetch attempts, retries included
+	Retries   int
 	StartTime time.Time
 	EndTime   time.Time
 }
@@ -61,23 +83,122 @@ func (r *ScrapeResult) Duration() time.Duration {
 	return r.EndTime.Sub(r.StartTime)
 }
 
+// Enricher adds third-party data to freshly scraped jobs before they are stored
+type Enricher interface {
+	Enrich(ctx context.Context, jobs []*domain.Job)
+}
+
 // ScraperRegistry manages multiple scrapers
 type ScraperRegistry struct {
-	scrapers map[domain.JobSource]Scraper
+	scrapers    map[domain.JobSource]Scraper
+	geocoder    geo.Geocoder
+	enrichers   []Enricher
+	concurrency config.ScrapingConfig
+	retry       RetryPolicy
+	validator   *Validator
+	urls        *URLCanonicalizer
+
+	mu       sync.Mutex
+	pages    map[domain.JobSource]pageLimiter
+	degraded map[domain.JobSource]bool
 }
 
 // NewScraperRegistry creates a new registry
 func NewScraperRegistry() *ScraperRegistry {
 	return &ScraperRegistry{
-		scrapers: make(map[domain.JobSource]Scraper),
+		scrapers:  make(map[domain.JobSource]Scraper),
+		retry:     DefaultRetryPolicy(),
+		validator: NewValidator(config.ScrapeValidationConfig{}),
+		urls:      NewURLCanonicalizer(config.ScrapeURLConfig{}),
+		pages:     make(map[domain.JobSource]pageLimiter),
+		degraded:  make(map[domain.JobSource]bool),
 	}
 }
 
-// Register adds a scraper to the registry
+// Register adds a scraper to the registry. An API-backed scraper takes
+// precedence over an HTML scraper for the same source, whichever registers first.
 func (r *ScraperRegistry) Register(s Scraper) {
+	if existing, ok := r.scrapers[s.Source()]; ok && usesAPI(existing) && !usesAPI(s) {
+		return
+	}
 	r.scrapers[s.Source()] = s
 }
 
+// SetGeocoder enables geocoding of scraped job locations
+func (r *ScraperRegistry) SetGeocoder(g geo.Geocoder) {
+	r.geocoder = g
+}
+
+// SetConcurrency configures how many sources run at once, how many pages
+// each source may have open and how fetches are retried. Call before
+// scraping starts.
+func (r *ScraperRegistry) SetConcurrency(cfg config.ScrapingConfig) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.concurrency = cfg
+	if cfg.Retry.MaxAttempts > 0 {
+		r.retry = NewRetryPolicy(cfg.Retry)
+	}
+	r.pages = make(map[domain.JobSource]pageLimiter)
+}
+
+// RequiresJS reports whether a source's pages must be rendered in a browser.
+// The built-in flag can be overridden per source with requires_js.
+func (r *ScraperRegistry) RequiresJS(source domain.JobSource) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if n, ok := r.concurrency.Sources[string(source)]; ok && n.RequiresJS != nil {
+		return *n.RequiresJS
+	}
+	return jsSources[source]
+}
+
+// Fetcher picks how a source's pages are loaded: the browser for sources
+// that require JavaScript, plain HTTP for the rest. Without a browser (nil
+// when Chrome isn't installed) JavaScript sources fall back to HTTP and are
+// reported by Degraded, since their pages may come back incomplete.
+func (r *ScraperRegistry) Fetcher(source domain.JobSource, browser *BrowserPool, plain *HTTPFetcher) PageFetcher {
+	if !r.RequiresJS(source) {
+		return plain
+	}
+	if browser != nil {
+		return browser
+	}
+	r.mu.Lock()
+	r.degraded[source] = true
+	r.mu.Unlock()
+	return plain
+}
+
+// Degraded returns the JavaScript sources fetched over plain HTTP because no
+// browser was available
+func (r *ScraperRegistry) Degraded() []domain.JobSource {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	sources := make([]domain.JobSource, 0, len(r.degraded))
+	for s := range r.degraded {
+		sources = append(sources, s)
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i] < sources[j] })
+	return sources
+}
+
+// SetValidation configures the checks scraped jobs must pass to be kept
+func (r *ScraperRegistry) SetValidation(cfg config.ScrapeValidationConfig) {
+	r.validator = NewValidator(cfg)
+}
+
+// SetURLs configures which redirect links are resolved when job URLs are
+// canonicalized
+func (r *ScraperRegistry) SetURLs(cfg config.ScrapeURLConfig) {
+	r.urls = NewURLCanonicalizer(cfg)
+}
+
+// AddEnricher registers an enricher run on every merged scrape result
+func (r *ScraperRegistry) AddEnricher(e Enricher) {
+	r.enrichers = append(r.enrichers, e)
+}
+
 // Get retrieves a scraper by source
 func (r *ScraperRegistry) Get(source domain.JobSource) (Scraper, bool) {
 	s, ok := r.scrapers[source]
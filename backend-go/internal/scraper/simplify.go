@@ -0,0 +1,188 @@
+package scraper
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// SimplifyScraper reads the internship and new-grad listings the SimplifyJobs
+// community maintains on GitHub
+type SimplifyScraper struct {
+	cfg    config.SimplifyConfig
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewSimplifyScraper creates a new Simplify listings adapter
+func NewSimplifyScraper(cfg config.SimplifyConfig, client *http.Client, logger *zap.Logger) *SimplifyScraper {
+	return &SimplifyScraper{cfg: cfg, client: client, logger: logger}
+}
+
+// Name returns the scraper name
+func (s *SimplifyScraper) Name() string {
+	return "Simplify"
+}
+
+// Source returns the job source
+func (s *SimplifyScraper) Source() domain.JobSource {
+	return domain.JobSourceSimplify
+}
+
+// APIBacked marks the scraper as reading structured data rather than HTML
+func (s *SimplifyScraper) APIBacked() bool {
+	return true
+}
+
+// simplifyListing is one entry in a listings.json file
+type simplifyListing struct {
+	ID          string   `json:"id"`
+	CompanyName string   `json:"company_name"`
+	Title       string   `json:"title"`
+	Locations   []string `json:"locations"`
+	URL         string   `json:"url"`
+	DatePosted  int64    `json:"date_posted"` // unix seconds
+	Active      bool     `json:"active"`
+	IsVisible   bool     `json:"is_visible"`
+	Terms       []string `json:"terms"`
+	Season      string   `json:"season"`
+}
+
+// Scrape matches listings against the query words, location and posting window
+func (s *SimplifyScraper) Scrape(ctx context.Context, query string, opts *ScrapeOptions) (*ScrapeResult, error) {
+	if opts == nil {
+		opts = DefaultScrapeOptions()
+	}
+	result := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		StartTime: time.Now(),
+	}
+
+	lists := []struct {
+		url     string
+		newGrad bool
+	}{
+		{s.cfg.InternshipsURL, false},
+		{s.cfg.NewGradURL, true},
+	}
+
+	words := strings.Fields(strings.ToLower(query))
+	for _, list := range lists {
+		if list.url == "" {
+			continue
+		}
+
+		var listings []simplifyListing
+		if err := doJSON(ctx, s.client, http.MethodGet, list.url, nil, nil, &listings); err != nil {
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+
+		for _, l := range listings {
+			if !l.Active || !l.IsVisible {
+				continue
+			}
+			result.Total++
+			if result.Scraped >= opts.MaxJobs || !matchesListing(l, words, opts) {
+				continue
+			}
+
+			job := s.toJob(l, list.newGrad)
+			if !postedWithin(job.PostedDate, opts) {
+				continue
+			}
+			result.Jobs = append(result.Jobs, job)
+			result.Scraped++
+		}
+	}
+
+	result.EndTime = time.Now()
+	s.logger.Info("Simplify listings completed",
+		zap.Int("total", result.Total),
+		zap.Int("scraped", result.Scraped),
+		zap.Duration("duration", result.Duration()),
+	)
+
+	if len(result.Jobs) == 0 && len(result.Errors) > 0 {
+		return result, result.Errors[0]
+	}
+	return result, nil
+}
+
+// ScrapeJob is not supported; listings link out to the employer's application page
+func (s *SimplifyScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
+	return nil, &ScrapeError{Source: s.Source(), Category: domain.ScrapeErrorOther, URL: jobURL, Err: errJobLookupUnsupported}
+}
+
+func (s *SimplifyScraper) toJob(l simplifyListing, newGrad bool) *domain.Job {
+	job := newAPIJob(domain.JobSourceSimplify, l.Title, l.CompanyName, l.URL)
+	job.NewGrad = newGrad
+	if newGrad {
+		job.EmploymentType = domain.EmploymentTypeFullTime
+	} else {
+		job.EmploymentType = domain.EmploymentTypeInternship
+	}
+
+	if len(l.Locations) > 0 {
+		job.Location = optionalString(strings.Join(l.Locations, "; "))
+		for _, loc := range l.Locations {
+			if strings.Contains(strings.ToLower(loc), "remote") {
+				job.LocationType = remoteLocation()
+				break
+			}
+		}
+	}
+	if l.DatePosted > 0 {
+		posted := time.Unix(l.DatePosted, 0)
+		job.PostedDate = &posted
+	}
+
+	terms := l.Terms
+	if l.Season != "" {
+		terms = append(terms, l.Season)
+	}
+	for _, term := range terms {
+		if season := domain.NormalizeSeason(term); season != "" && !containsString(job.Seasons, season) {
+			job.Seasons = append(job.Seasons, season)
+		}
+	}
+	return job
+}
+
+// matchesListing reports whether every query word appears in the title or
+// company and the location option matches one of the listing's locations
+func matchesListing(l simplifyListing, words []string, opts *ScrapeOptions) bool {
+	text := strings.ToLower(l.Title + " " + l.CompanyName)
+	for _, w := range words {
+		if !strings.Contains(text, w) {
+			return false
+		}
+	}
+
+	if opts.Location == "" && !opts.Remote {
+		return true
+	}
+	want := strings.ToLower(opts.Location)
+	for _, loc := range l.Locations {
+		loc = strings.ToLower(loc)
+		if (want != "" && strings.Contains(loc, want)) || (opts.Remote && strings.Contains(loc, "remote")) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, v string) bool {
+	for _, existing := range values {
+		if existing == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+package scraper
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// USAJobsScraper searches federal jobs through the USAJOBS API
+type USAJobsScraper struct {
+	cfg    config.USAJobsConfig
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewUSAJobsScraper creates a new USAJOBS API adapter
+func NewUSAJobsScraper(cfg config.USAJobsConfig, client *http.Client, logger *zap.Logger) *USAJobsScraper {
+	return &USAJobsScraper{cfg: cfg, client: client, logger: logger}
+}
+
+// Name returns the scraper name
+func (s *USAJobsScraper) Name() string {
+	return "USAJOBS"
+}
+
+// Source returns the job source
+func (s *USAJobsScraper) Source() domain.JobSource {
+	return domain.JobSourceUSAJobs
+}
+
+// APIBacked marks the scraper as using an official API
+func (s *USAJobsScraper) APIBacked() bool {
+	return true
+}
+
+type usaJobsResponse struct {
+	SearchResult struct {
+		SearchResultCountAll int `json:"SearchResultCountAll"`
+		SearchResultItems    []struct {
+			MatchedObjectDescriptor struct {
+				PositionTitle           string `json:"PositionTitle"`
+				PositionURI             string `json:"PositionURI"`
+				OrganizationName        string `json:"OrganizationName"`
+				PositionLocationDisplay string `json:"PositionLocationDisplay"`
+				PublicationStartDate    string `json:"PublicationStartDate"`
+				PositionRemuneration    []struct {
+					MinimumRange     string `json:"MinimumRange"`
+					MaximumRange     string `json:"MaximumRange"`
+					RateIntervalCode string `json:"RateIntervalCode"`
+				} `json:"PositionRemuneration"`
+				PositionSchedule []struct {
+					Name string `json:"Name"`
+				} `json:"PositionSchedule"`
+				JobGrade []struct {
+					Code string `json:"Code"`
+				} `json:"JobGrade"`
+				UserArea struct {
+					Details struct {
+						JobSummary        string `json:"JobSummary"`
+						TeleworkEligible  bool   `json:"TeleworkEligible"`
+						LowGrade          string `json:"LowGrade"`
+						HighGrade         string `json:"HighGrade"`
+						SecurityClearance string `json:"SecurityClearance"`
+					} `json:"Details"`
+				} `json:"UserArea"`
+			} `json:"MatchedObjectDescriptor"`
+		} `json:"SearchResultItems"`
+	} `json:"SearchResult"`
+}
+
+// Scrape performs the search through the API
+func (s *USAJobsScraper) Scrape(ctx context.Context, query string, opts *ScrapeOptions) (*ScrapeResult, error) {
+	if opts == nil {
+		opts = DefaultScrapeOptions()
+	}
+	result := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		StartTime: time.Now(),
+	}
+
+	params := url.Values{}
+	params.Set("Keyword", query)
+	params.Set("ResultsPerPage", strconv.Itoa(opts.MaxJobs))
+	if opts.Location != "" {
+		params.Set("LocationName", opts.Location)
+	}
+	if opts.Remote {
+		params.Set("RemoteIndicator", "True")
+	}
+	if opts.PostedWithin > 0 {
+		days := int(opts.PostedWithin.Hours()/24) + 1
+		if days > 60 {
+			days = 60 // API maximum
+		}
+		params.Set("DatePosted", strconv.Itoa(days))
+	}
+	searchURL := "https://data.usajobs.gov/api/search?" + params.Encode()
+
+	// USAJOBS identifies callers by the registered email in User-Agent
+	headers := map[string]string{
+		"Host":              "data.usajobs.gov",
+		"User-Agent":        s.cfg.Email,
+		"Authorization-Key": s.cfg.APIKey,
+	}
+
+	var resp usaJobsResponse
+	if err := doJSON(ctx, s.client, http.MethodGet, searchURL, headers, nil, &resp); err != nil {
+		result.Errors = append(result.Errors, err)
+		result.EndTime = time.Now()
+		return result, err
+	}
+
+	result.Total = resp.SearchResult.SearchResultCountAll
+	for _, item := range resp.SearchResult.SearchResultItems {
+		d := item.MatchedObjectDescriptor
+		job := newAPIJob(domain.JobSourceUSAJobs, d.PositionTitle, d.OrganizationName, d.PositionURI)
+		job.Description = d.UserArea.Details.JobSummary
+		job.Location = optionalString(d.PositionLocationDisplay)
+		job.PostedDate = parseAPITime(d.PublicationStartDate)
+		job.SalaryCurrency = "USD"
+		if d.UserArea.Details.TeleworkEligible {
+			job.LocationType = remoteLocation()
+		}
+		if len(d.PositionSchedule) > 0 {
+			job.EmploymentType = domain.NormalizeEmploymentType(d.PositionSchedule[0].Name)
+		}
+		job.Clearance = normalizeClearance(d.UserArea.Details.SecurityClearance)
+
+		var plan string
+		if len(d.JobGrade) > 0 {
+			plan = d.JobGrade[0].Code
+		}
+		grade := parsePayGrade(plan, d.UserArea.Details.LowGrade, d.UserArea.Details.HighGrade)
+		if grade != nil {
+			label := grade.String()
+			job.PayGrade = &label
+		}
+
+		if len(d.PositionRemuneration) > 0 {
+			pay := d.PositionRemuneration[0]
+			job.SalaryMin = annualSalary(pay.MinimumRange, pay.RateIntervalCode)
+			job.SalaryMax = annualSalary(pay.MaximumRange, pay.RateIntervalCode)
+		}
+		if job.SalaryMin == nil && job.SalaryMax == nil && grade != nil {
+			job.SalaryMin, job.SalaryMax = grade.BaseRange()
+		}
+		result.Jobs = append(result.Jobs, job)
+		result.Scraped++
+	}
+
+	result.EndTime = time.Now()
+	s.logger.Info("USAJOBS search completed",
+		zap.Int("total", result.Total),
+		zap.Int("scraped", result.Scraped),
+		zap.Duration("duration", result.Duration()),
+	)
+	return result, nil
+}
+
+// ScrapeJob is not supported; USAJOBS results already include the listing summary
+func (s *USAJobsScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
+	return nil, &ScrapeError{Source: s.Source(), Category: domain.ScrapeErrorOther, URL: jobURL, Err: errJobLookupUnsupported}
+}
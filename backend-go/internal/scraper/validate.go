@@ -0,0 +1,36 @@
+package scraper
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Validator rejects obviously broken parses before they are stored, so
+// selector drift does not fill search and matching with garbage rows
+type Validator struct {
+	minDescription int
+}
+
+// NewValidator creates a validator from configuration
+func NewValidator(cfg config.ScrapeValidationConfig) *Validator {
+	return &Validator{minDescription: cfg.MinDescriptionLength}
+}
+
+// Check returns why job should be rejected, or "" when it is usable
+func (v *Validator) Check(job *domain.Job) domain.ScrapeRejectReason {
+	switch {
+	case strings.TrimSpace(job.Title) == "":
+		return domain.ScrapeRejectEmptyTitle
+	case strings.TrimSpace(job.URL) == "":
+		return domain.ScrapeRejectMissingURL
+	case job.SalaryMin != nil && job.SalaryMax != nil && *job.SalaryMin > *job.SalaryMax:
+		return domain.ScrapeRejectSalaryRange
+	}
+	if desc := strings.TrimSpace(job.Description); desc != "" && utf8.RuneCountInString(desc) < v.minDescription {
+		return domain.ScrapeRejectShortDescription
+	}
+	return ""
+}
@@ -2,7 +2,6 @@ package scraper
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/url"
 	"regexp"
@@ -16,14 +15,17 @@ import (
 	"github.com/resume-rag/backend/internal/domain"
 )
 
+// wellfoundCardSelector matches the company cards on a search results page
+const wellfoundCardSelector = "[data-test='StartupResult'], .styles_component__"
+
 // WellfoundScraper scrapes Wellfound (formerly AngelList) job listings (startup-focused)
 type WellfoundScraper struct {
-	browser *BrowserPool
+	browser PageFetcher
 	logger  *zap.Logger
 }
 
 // NewWellfoundScraper creates a new Wellfound scraper
-func NewWellfoundScraper(browser *BrowserPool, logger *zap.Logger) *WellfoundScraper {
+func NewWellfoundScraper(browser PageFetcher, logger *zap.Logger) *WellfoundScraper {
 	return &WellfoundScraper{
 		browser: browser,
 		logger:  logger,
@@ -58,8 +60,17 @@ func (s *WellfoundScraper) Scrape(ctx context.Context, query string, opts *Scrap
 		zap.Int("maxJobs", opts.MaxJobs),
 	)
 
+	// Wait for a page slot for this source
+	release, err := acquirePage(ctx)
+	if err != nil {
+		result.Errors = append(result.Errors, err)
+		result.EndTime = time.Now()
+		return result, err
+	}
+	defer release()
+
 	// Create browser context
-	browserCtx, cancel := s.browser.NewContext(2 * time.Minute)
+	browserCtx, cancel := s.browser.NewContext(ctx, 2*time.Minute)
 	defer cancel()
 
 	// Fetch search results - Wellfound uses React, need to wait for content
@@ -83,7 +94,7 @@ func (s *WellfoundScraper) Scrape(ctx context.Context, query string, opts *Scrap
 	}
 
 	// Extract job cards - Wellfound lists companies with their open roles
-	companyCards := doc.Find("[data-test='StartupResult'], .styles_component__")
+	companyCards := doc.Find(wellfoundCardSelector)
 	s.logger.Debug("Found company cards", zap.Int("count", companyCards.Length()))
 
 	companyCards.Each(func(i int, card *goquery.Selection) {
@@ -121,7 +132,13 @@ func (s *WellfoundScraper) Scrape(ctx context.Context, query string, opts *Scrap
 
 // ScrapeJob fetches details for a single job
 func (s *WellfoundScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
-	browserCtx, cancel := s.browser.NewContext(30 * time.Second)
+	release, err := acquirePage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	browserCtx, cancel := s.browser.NewContext(ctx, 30*time.Second)
 	defer cancel()
 
 	html, err := s.browser.FetchPage(browserCtx, jobURL, ".styles_description__")
@@ -134,7 +151,7 @@ func (s *WellfoundScraper) ScrapeJob(ctx context.Context, jobURL string) (*domai
 		return nil, fmt.Errorf("failed to parse HTML: %w", err)
 	}
 
-	return s.parseJobDetails(doc, jobURL)
+	return s.parseJobDetails(doc.Selection, jobURL)
 }
 
 func (s *WellfoundScraper) buildSearchURL(query string, opts *ScrapeOptions) string {
@@ -164,22 +181,22 @@ func (s *WellfoundScraper) mapQueryToRole(query string) string {
 	query = strings.ToLower(query)
 
 	roleMap := map[string]string{
-		"software engineer":  "software-engineer",
-		"frontend":           "frontend-engineer",
-		"backend":            "backend-engineer",
-		"full stack":         "full-stack-engineer",
-		"fullstack":          "full-stack-engineer",
-		"devops":             "devops-engineer",
-		"data scientist":     "data-scientist",
-		"data engineer":      "data-engineer",
-		"machine learning":   "machine-learning-engineer",
-		"ml engineer":        "machine-learning-engineer",
-		"product manager":    "product-manager",
-		"designer":           "designer",
-		"ux":                 "ux-designer",
-		"mobile":             "mobile-developer",
-		"ios":                "ios-developer",
-		"android":            "android-developer",
+		"software engineer": "software-engineer",
+		"frontend":          "frontend-engineer",
+		"backend":           "backend-engineer",
+		"full stack":        "full-stack-engineer",
+		"fullstack":         "full-stack-engineer",
+		"devops":            "devops-engineer",
+		"data scientist":    "data-scientist",
+		"data engineer":     "data-engineer",
+		"machine learning":  "machine-learning-engineer",
+		"ml engineer":       "machine-learning-engineer",
+		"product manager":   "product-manager",
+		"designer":          "designer",
+		"ux":                "ux-designer",
+		"mobile":            "mobile-developer",
+		"ios":               "ios-developer",
+		"android":           "android-developer",
 	}
 
 	for key, value := range roleMap {
@@ -201,20 +218,15 @@ func (s *WellfoundScraper) parseCompanyCard(card *goquery.Selection) ([]*domain.
 		companyName = strings.TrimSpace(card.Find("h2").First().Text())
 	}
 
-	company := &domain.Company{
+	company := domain.Company{
 		Name: companyName,
 	}
 
-	// Extract company details
-	companyLink := card.Find("a[href*='/company/']")
-	if href, exists := companyLink.Attr("href"); exists {
-		company.LinkedInURL = "https://wellfound.com" + href
-	}
-
 	// Extract funding/stage info
 	stageEl := card.Find("[data-test='StartupSize'], .styles_startupSize__")
 	if size := strings.TrimSpace(stageEl.Text()); size != "" {
-		company.Size = s.parseCompanySize(size)
+		companySize := s.parseCompanySize(size)
+		company.Size = &companySize
 	}
 
 	// Extract individual job listings within the company
@@ -250,41 +262,26 @@ func (s *WellfoundScraper) parseCompanyCard(card *goquery.Selection) ([]*domain.
 		// Extract job URL
 		if href, exists := listing.Attr("href"); exists {
 			if strings.HasPrefix(href, "/") {
-				job.SourceURL = "https://wellfound.com" + href
+				job.URL = "https://wellfound.com" + href
 			} else {
-				job.SourceURL = href
+				job.URL = href
 			}
 		} else if link := listing.Find("a").First(); link.Length() > 0 {
 			if href, exists := link.Attr("href"); exists {
 				if strings.HasPrefix(href, "/") {
-					job.SourceURL = "https://wellfound.com" + href
+					job.URL = "https://wellfound.com" + href
 				} else {
-					job.SourceURL = href
+					job.URL = href
 				}
 			}
 		}
 
-		// Extract job ID from URL
-		if job.SourceURL != "" {
-			re := regexp.MustCompile(`/jobs/(\d+)`)
-			if matches := re.FindStringSubmatch(job.SourceURL); len(matches) > 1 {
-				job.ExternalID = matches[1]
-			}
-		}
-
 		// Extract location
 		locationEl := listing.Find("[data-test='JobLocation'], .styles_location__")
-		job.Location = strings.TrimSpace(locationEl.Text())
+		job.Location = optionalString(locationEl.Text())
 
 		// Determine location type
-		locationLower := strings.ToLower(job.Location)
-		if strings.Contains(locationLower, "remote") {
-			job.LocationType = domain.LocationTypeRemote
-		} else if strings.Contains(locationLower, "hybrid") {
-			job.LocationType = domain.LocationTypeHybrid
-		} else {
-			job.LocationType = domain.LocationTypeOnsite
-		}
+		job.LocationType = locationTypeOf(job.Location)
 
 		// Extract salary range
 		salaryEl := listing.Find("[data-test='JobSalary'], .styles_salary__")
@@ -292,15 +289,6 @@ func (s *WellfoundScraper) parseCompanyCard(card *goquery.Selection) ([]*domain.
 			s.parseSalary(job, salaryText)
 		}
 
-		// Extract equity if available
-		equityEl := listing.Find("[data-test='JobEquity'], .styles_equity__")
-		if equity := strings.TrimSpace(equityEl.Text()); equity != "" {
-			if job.Metadata == nil {
-				job.Metadata = make(map[string]interface{})
-			}
-			job.Metadata["equity"] = equity
-		}
-
 		jobs = append(jobs, job)
 	})
 
@@ -318,7 +306,7 @@ func (s *WellfoundScraper) parseCompanyCard(card *goquery.Selection) ([]*domain.
 
 		if href, exists := card.Find("a").First().Attr("href"); exists {
 			if strings.HasPrefix(href, "/") {
-				job.SourceURL = "https://wellfound.com" + href
+				job.URL = "https://wellfound.com" + href
 			}
 		}
 
@@ -331,8 +319,8 @@ func (s *WellfoundScraper) parseCompanyCard(card *goquery.Selection) ([]*domain.
 func (s *WellfoundScraper) parseJobDetails(doc *goquery.Selection, jobURL string) (*domain.Job, error) {
 	job := &domain.Job{
 		ID:        uuid.New(),
+		URL:       jobURL,
 		Source:    domain.JobSourceWellfound,
-		SourceURL: jobURL,
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 		IsActive:  true,
@@ -344,12 +332,13 @@ func (s *WellfoundScraper) parseJobDetails(doc *goquery.Selection, jobURL string
 	// Company
 	companyEl := doc.Find("[data-test='CompanyName'], .styles_companyName__")
 	if companyName := strings.TrimSpace(companyEl.Text()); companyName != "" {
-		job.Company = &domain.Company{Name: companyName}
+		job.Company = domain.Company{Name: companyName}
 	}
 
 	// Location
 	locationEl := doc.Find("[data-test='Location'], .styles_location__")
-	job.Location = strings.TrimSpace(locationEl.Text())
+	job.Location = optionalString(locationEl.Text())
+	job.LocationType = locationTypeOf(job.Location)
 
 	// Description
 	descEl := doc.Find("[data-test='JobDescription'], .styles_description__")
@@ -365,12 +354,6 @@ func (s *WellfoundScraper) parseJobDetails(doc *goquery.Selection, jobURL string
 	})
 	job.RequiredSkills = skills
 
-	// Extract job ID from URL
-	re := regexp.MustCompile(`/jobs/(\d+)`)
-	if matches := re.FindStringSubmatch(jobURL); len(matches) > 1 {
-		job.ExternalID = matches[1]
-	}
-
 	return job, nil
 }
 
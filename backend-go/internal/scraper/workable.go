@@ -0,0 +1,198 @@
+package scraper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// WorkableScraper reads the job boards of companies hiring through Workable,
+// using the public account widget API, with full descriptions
+type WorkableScraper struct {
+	cfg    config.WorkableConfig
+	client *http.Client
+	logger *zap.Logger
+}
+
+// NewWorkableScraper creates a new Workable API adapter
+func NewWorkableScraper(cfg config.WorkableConfig, client *http.Client, logger *zap.Logger) *WorkableScraper {
+	return &WorkableScraper{cfg: cfg, client: client, logger: logger}
+}
+
+// Name returns the scraper name
+func (s *WorkableScraper) Name() string {
+	return "Workable"
+}
+
+// Source returns the job source
+func (s *WorkableScraper) Source() domain.JobSource {
+	return domain.JobSourceWorkable
+}
+
+// APIBacked marks the scraper as using an official API
+func (s *WorkableScraper) APIBacked() bool {
+	return true
+}
+
+// workableAccount is the widget API response for one account
+type workableAccount struct {
+	Name string `json:"name"`
+	Jobs []struct {
+		Title          string `json:"title"`
+		Shortcode      string `json:"shortcode"`
+		EmploymentType string `json:"employment_type"`
+		Telecommuting  bool   `json:"telecommuting"`
+		URL            string `json:"url"`
+		PublishedOn    string `json:"published_on"`
+		City           string `json:"city"`
+		State          string `json:"state"`
+		Country        string `json:"country"`
+		Description    string `json:"description"` // HTML
+	} `json:"jobs"`
+}
+
+// workableJob is the v2 API response for one posting
+type workableJob struct {
+	Shortcode    string `json:"shortcode"`
+	Title        string `json:"title"`
+	Remote       bool   `json:"remote"`
+	Type         string `json:"type"`
+	Published    string `json:"published"`
+	Description  string `json:"description"`  // HTML
+	Requirements string `json:"requirements"` // HTML
+	Benefits     string `json:"benefits"`     // HTML
+	Location     struct {
+		City    string `json:"city"`
+		Region  string `json:"region"`
+		Country string `json:"country"`
+	} `json:"location"`
+}
+
+// Scrape reads every configured account and keeps the postings matching the query
+func (s *WorkableScraper) Scrape(ctx context.Context, query string, opts *ScrapeOptions) (*ScrapeResult, error) {
+	if opts == nil {
+		opts = DefaultScrapeOptions()
+	}
+	result := &ScrapeResult{
+		Jobs:      make([]*domain.Job, 0),
+		StartTime: time.Now(),
+	}
+
+	words := strings.Fields(strings.ToLower(query))
+	for _, account := range s.cfg.Accounts {
+		jobs, err := fetchWorkableAccount(ctx, s.client, account, "", domain.JobSourceWorkable)
+		if err != nil {
+			result.Errors = append(result.Errors, err)
+			continue
+		}
+		addBoardJobs(result, jobs, words, opts)
+	}
+
+	result.EndTime = time.Now()
+	s.logger.Info("Workable accounts completed",
+		zap.Int("accounts", len(s.cfg.Accounts)),
+		zap.Int("total", result.Total),
+		zap.Int("scraped", result.Scraped),
+		zap.Duration("duration", result.Duration()),
+	)
+
+	if len(result.Jobs) == 0 && len(result.Errors) > 0 {
+		return result, result.Errors[0]
+	}
+	return result, nil
+}
+
+// ScrapeJob fetches a posting (apply.workable.com/<account>/j/<shortcode>)
+func (s *WorkableScraper) ScrapeJob(ctx context.Context, jobURL string) (*domain.Job, error) {
+	board, ok := DetectCareerBoard(jobURL)
+	if !ok || board.Platform != PlatformWorkable || board.JobID == "" {
+		return nil, &ScrapeError{Source: s.Source(), Category: domain.ScrapeErrorOther, URL: jobURL, Err: errJobLookupUnsupported}
+	}
+	job, err := fetchWorkableJob(ctx, s.client, board.Token, board.JobID, boardCompanyName(board.Token), domain.JobSourceWorkable)
+	if err != nil {
+		return nil, withSource(s.Source(), err)
+	}
+	return job, nil
+}
+
+// fetchWorkableAccount lists every posting of a Workable account. An empty
+// company uses the account's own name.
+func fetchWorkableAccount(ctx context.Context, client *http.Client, account, company string, source domain.JobSource) ([]*domain.Job, error) {
+	var resp workableAccount
+	apiURL := fmt.Sprintf("https://apply.workable.com/api/v1/widget/accounts/%s?details=true", url.PathEscape(account))
+	if err := doJSON(ctx, client, http.MethodGet, apiURL, nil, nil, &resp); err != nil {
+		return nil, err
+	}
+	if company == "" {
+		company = resp.Name
+	}
+	if company == "" {
+		company = boardCompanyName(account)
+	}
+
+	jobs := make([]*domain.Job, 0, len(resp.Jobs))
+	for _, j := range resp.Jobs {
+		jobURL := j.URL
+		if jobURL == "" {
+			jobURL = workableJobURL(account, j.Shortcode)
+		}
+		job := newAPIJob(source, j.Title, company, jobURL)
+		job.Description = htmlText(j.Description)
+		job.Location = optionalString(joinNonEmpty(", ", j.City, j.State, j.Country))
+		if j.Telecommuting {
+			job.LocationType = remoteLocation()
+		}
+		job.EmploymentType = domain.NormalizeEmploymentType(j.EmploymentType)
+		job.PostedDate = parseAPITime(j.PublishedOn)
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// fetchWorkableJob fetches one posting with its description, requirements and benefits
+func fetchWorkableJob(ctx context.Context, client *http.Client, account, shortcode, company string, source domain.JobSource) (*domain.Job, error) {
+	var j workableJob
+	apiURL := fmt.Sprintf("https://apply.workable.com/api/v2/accounts/%s/jobs/%s", url.PathEscape(account), url.PathEscape(shortcode))
+	if err := doJSON(ctx, client, http.MethodGet, apiURL, nil, nil, &j); err != nil {
+		return nil, err
+	}
+
+	job := newAPIJob(source, j.Title, company, workableJobURL(account, shortcode))
+	sections := make([]string, 0, 3)
+	for _, part := range []string{j.Description, j.Requirements, j.Benefits} {
+		if text := htmlText(part); text != "" {
+			sections = append(sections, text)
+		}
+	}
+	job.Description = strings.Join(sections, "\n\n")
+	job.Location = optionalString(joinNonEmpty(", ", j.Location.City, j.Location.Region, j.Location.Country))
+	if j.Remote {
+		job.LocationType = remoteLocation()
+	}
+	job.EmploymentType = domain.NormalizeEmploymentType(j.Type)
+	job.PostedDate = parseAPITime(j.Published)
+	return job, nil
+}
+
+// workableJobURL returns the public URL of a Workable posting
+func workableJobURL(account, shortcode string) string {
+	return fmt.Sprintf("https://apply.workable.com/%s/j/%s/", account, shortcode)
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	kept := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			kept = append(kept, p)
+		}
+	}
+	return strings.Join(kept, sep)
+}
@@ -0,0 +1,146 @@
+package scraper
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/taskevents"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// DefaultWorkerInterval is how often the worker checks for queued scrapes
+const DefaultWorkerInterval = 15 * time.Second
+
+// TaskQueue holds queued scrape tasks and stores the jobs they find
+// (repository.JobListService)
+type TaskQueue interface {
+	// ClaimScrapeTask marks the next queued task in progress; nil when none is queued
+	ClaimScrapeTask(ctx context.Context) (*domain.ScrapeTask, error)
+	UpdateScrapeTask(ctx context.Context, task *domain.ScrapeTask) error
+	SaveJob(ctx context.Context, job *domain.Job) error
+}
+
+// EnricherFunc adapts a function to the Enricher interface
+type EnricherFunc func(ctx context.Context, jobs []*domain.Job)
+
+// Enrich calls f(ctx, jobs)
+func (f EnricherFunc) Enrich(ctx context.Context, jobs []*domain.Job) {
+	f(ctx, jobs)
+}
+
+// Worker runs queued scrape tasks through the registry and stores the jobs
+// they find. Enrichers registered on the registry run before jobs are
+// stored; those added with OnStored run afterwards, once jobs have their IDs.
+type Worker struct {
+	registry *ScraperRegistry
+	queue    TaskQueue
+	interval time.Duration
+	stored   []Enricher
+}
+
+// NewWorker creates a worker that checks the queue every interval
+func NewWorker(registry *ScraperRegistry, queue TaskQueue, interval time.Duration) *Worker {
+	if interval <= 0 {
+		interval = DefaultWorkerInterval
+	}
+	return &Worker{registry: registry, queue: queue, interval: interval}
+}
+
+// OnStored registers an enricher run on each scrape's jobs once they are stored
+func (w *Worker) OnStored(e Enricher) {
+	w.stored = append(w.stored, e)
+}
+
+// Start runs queued scrapes until ctx is cancelled
+func (w *Worker) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			w.drain(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// drain runs queued tasks one after another until the queue is empty
+func (w *Worker) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		ran, err := w.RunNext(ctx)
+		if err != nil {
+			logger.Warn("Failed to run queued scrape", zap.Error(err))
+			return
+		}
+		if !ran {
+			return
+		}
+	}
+}
+
+// RunNext claims the next queued task and runs it, reporting whether there was one
+func (w *Worker) RunNext(ctx context.Context) (bool, error) {
+	task, err := w.queue.ClaimScrapeTask(ctx)
+	if err != nil || task == nil {
+		return false, err
+	}
+	return true, w.Run(ctx, task)
+}
+
+// Run scrapes a claimed task's sources, stores the jobs found and records
+// the outcome on the task
+func (w *Worker) Run(ctx context.Context, task *domain.ScrapeTask) error {
+	ctx = taskevents.WithTask(ctx, task.ID)
+
+	opts := DefaultScrapeOptions()
+	if task.MaxJobs > 0 {
+		opts.MaxJobs = task.MaxJobs
+	}
+	if task.Location != nil {
+		opts.Location = *task.Location
+	}
+	result := w.registry.ScrapeSources(ctx, task.Sources, strings.Join(task.Keywords, " "), opts)
+
+	stored := make([]*domain.Job, 0, len(result.Jobs))
+	errs := result.Errors
+	for _, job := range result.Jobs {
+		if err := w.queue.SaveJob(ctx, job); err != nil {
+			errs = append(errs, withSource(job.Source, err))
+			continue
+		}
+		stored = append(stored, job)
+	}
+	for _, e := range w.stored {
+		e.Enrich(ctx, stored)
+	}
+
+	finished := time.Now()
+	task.JobsFound = len(stored)
+	task.Errors = SummarizeErrors(errs)
+	task.ErrorCounts = CountErrors(task.Errors)
+	task.Rejected = result.Rejected
+	task.FinishedAt = &finished
+	task.Status = domain.ScrapeStatusCompleted
+	if len(stored) == 0 && len(errs) > 0 {
+		message := errs[0].Error()
+		task.Status = domain.ScrapeStatusFailed
+		task.Error = &message
+	}
+
+	logger.Info("Scrape finished",
+		zap.String("task_id", task.ID.String()),
+		zap.String("status", string(task.Status)),
+		zap.Int("jobs", len(stored)),
+		zap.Int("errors", len(errs)),
+		zap.Duration("duration", finished.Sub(result.StartTime)),
+	)
+	return w.queue.UpdateScrapeTask(ctx, task)
+}
@@ -0,0 +1,93 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// JobRankSQL ranks jobs against a to_tsquery parameter ($1), normalized by document length
+const JobRankSQL = `ts_rank_cd(search_vector, to_tsquery('english', $1), 32)`
+
+// JobMatchSQL filters jobs matching a to_tsquery parameter ($1)
+const JobMatchSQL = `search_vector @@ to_tsquery('english', $1)`
+
+// ToTSQuery converts a user query into to_tsquery syntax. Quoted text becomes a
+// phrase (word <-> word), a trailing * on a term becomes a prefix match (term:*),
+// a leading - negates a term, and remaining terms are ANDed together.
+func ToTSQuery(q string) string {
+	var clauses []string
+
+	for _, tok := range tokenize(q) {
+		if tok.phrase {
+			words := sanitizeWords(tok.text)
+			if len(words) == 0 {
+				continue
+			}
+			clauses = append(clauses, "("+strings.Join(words, " <-> ")+")")
+			continue
+		}
+
+		text := tok.text
+		negate := strings.HasPrefix(text, "-")
+		text = strings.TrimPrefix(text, "-")
+		prefix := strings.HasSuffix(text, "*")
+
+		words := sanitizeWords(text)
+		if len(words) == 0 {
+			continue
+		}
+		if prefix {
+			words[len(words)-1] += ":*"
+		}
+
+		clause := strings.Join(words, " & ")
+		if negate {
+			clause = "!(" + clause + ")"
+		}
+		clauses = append(clauses, clause)
+	}
+
+	return strings.Join(clauses, " & ")
+}
+
+type token struct {
+	text   string
+	phrase bool
+}
+
+// tokenize splits a query on whitespace, keeping double-quoted phrases intact
+func tokenize(q string) []token {
+	var tokens []token
+	var b strings.Builder
+	inPhrase := false
+
+	flush := func(phrase bool) {
+		if b.Len() > 0 {
+			tokens = append(tokens, token{text: b.String(), phrase: phrase})
+			b.Reset()
+		}
+	}
+
+	for _, r := range q {
+		switch {
+		case r == '"':
+			flush(inPhrase)
+			inPhrase = !inPhrase
+		case unicode.IsSpace(r) && !inPhrase:
+			flush(false)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	flush(inPhrase)
+
+	return tokens
+}
+
+// sanitizeWords lowercases and strips tsquery operators, splitting on anything
+// that is not a letter, digit, or a character common in tech terms (c++, c#, .net)
+func sanitizeWords(s string) []string {
+	return strings.FieldsFunc(strings.ToLower(s), func(r rune) bool {
+		return !(unicode.IsLetter(r) || unicode.IsDigit(r) || r == '+' || r == '#' || r == '.')
+	})
+}
@@ -0,0 +1,213 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+var (
+	// ErrNotFound is returned for a credential that is not stored
+	ErrNotFound = errors.New("credential not found")
+	// ErrInvalid is returned for a malformed credential
+	ErrInvalid = errors.New("invalid credential")
+)
+
+// namePattern is the allowed form of a credential name, e.g. "openai" or "gmail-oauth"
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.-]{0,62}$`)
+
+// StoredCredential is a credential as persisted, with its value encrypted
+type StoredCredential struct {
+	Name      string
+	Kind      domain.CredentialKind
+	Value     string // ciphertext
+	Hint      string
+	UpdatedAt time.Time
+}
+
+// CredentialStore persists encrypted credentials
+type CredentialStore interface {
+	// Get returns a credential, or nil when none is stored under name
+	Get(ctx context.Context, name string) (*StoredCredential, error)
+	Save(ctx context.Context, c *StoredCredential) error
+	// Delete removes a credential, reporting whether it existed
+	Delete(ctx context.Context, name string) (bool, error)
+	// List returns every credential, by name
+	List(ctx context.Context) ([]StoredCredential, error)
+}
+
+// MemoryCredentialStore keeps credentials in memory.
+// Used when no database is connected.
+type MemoryCredentialStore struct {
+	mu          sync.RWMutex
+	credentials map[string]StoredCredential
+}
+
+// NewMemoryCredentialStore creates an in-memory credential store
+func NewMemoryCredentialStore() *MemoryCredentialStore {
+	return &MemoryCredentialStore{credentials: make(map[string]StoredCredential)}
+}
+
+// Get returns a credential, or nil
+func (m *MemoryCredentialStore) Get(ctx context.Context, name string) (*StoredCredential, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	c, ok := m.credentials[name]
+	if !ok {
+		return nil, nil
+	}
+	return &c, nil
+}
+
+// Save inserts or replaces a credential
+func (m *MemoryCredentialStore) Save(ctx context.Context, c *StoredCredential) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.credentials[c.Name] = *c
+	return nil
+}
+
+// Delete removes a credential
+func (m *MemoryCredentialStore) Delete(ctx context.Context, name string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.credentials[name]
+	delete(m.credentials, name)
+	return ok, nil
+}
+
+// List returns every credential, by name
+func (m *MemoryCredentialStore) List(ctx context.Context) ([]StoredCredential, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	out := make([]StoredCredential, 0, len(m.credentials))
+	for _, c := range m.credentials {
+		out = append(out, c)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+// Credentials stores LLM API keys, OAuth tokens and SMTP credentials
+// encrypted under the keyring
+type Credentials struct {
+	store   CredentialStore
+	keyring *Keyring
+}
+
+// NewCredentials creates a credential service
+func NewCredentials(store CredentialStore, keyring *Keyring) *Credentials {
+	return &Credentials{store: store, keyring: keyring}
+}
+
+// Set encrypts and stores a credential, replacing any under the same name
+func (s *Credentials) Set(ctx context.Context, name string, in domain.CredentialInput) (*domain.Credential, error) {
+	switch {
+	case !namePattern.MatchString(name):
+		return nil, fmt.Errorf("%w: name must be lowercase letters, digits, '.', '_' or '-'", ErrInvalid)
+	case in.Kind != domain.CredentialLLMAPIKey && in.Kind != domain.CredentialOAuthToken && in.Kind != domain.CredentialSMTP:
+		return nil, fmt.Errorf("%w: kind must be llm_api_key, oauth_token or smtp", ErrInvalid)
+	case strings.TrimSpace(in.Value) == "":
+		return nil, fmt.Errorf("%w: value is required", ErrInvalid)
+	}
+
+	sealed, err := s.keyring.Encrypt(in.Value, credentialContext(name))
+	if err != nil {
+		return nil, err
+	}
+	c := &StoredCredential{Name: name, Kind: in.Kind, Value: sealed, Hint: hint(in.Value), UpdatedAt: time.Now()}
+	if err := s.store.Save(ctx, c); err != nil {
+		return nil, err
+	}
+	return describe(*c), nil
+}
+
+// Value returns a credential's decrypted value
+func (s *Credentials) Value(ctx context.Context, name string) (string, error) {
+	c, err := s.store.Get(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	if c == nil {
+		return "", ErrNotFound
+	}
+	return s.keyring.Decrypt(c.Value, credentialContext(name))
+}
+
+// List describes every stored credential without its value
+func (s *Credentials) List(ctx context.Context) ([]domain.Credential, error) {
+	stored, err := s.store.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]domain.Credential, len(stored))
+	for i, c := range stored {
+		out[i] = *describe(c)
+	}
+	return out, nil
+}
+
+// Delete removes a credential
+func (s *Credentials) Delete(ctx context.Context, name string) error {
+	removed, err := s.store.Delete(ctx, name)
+	if err != nil {
+		return err
+	}
+	if !removed {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// Name identifies the credentials in a key rotation report
+func (s *Credentials) Name() string {
+	return "credentials"
+}
+
+// Rotate re-encrypts every credential not yet under the primary key
+func (s *Credentials) Rotate(ctx context.Context, keyring *Keyring) (scanned, rotated int, err error) {
+	stored, err := s.store.List(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, c := range stored {
+		scanned++
+		value, changed, err := keyring.Rotate(c.Value, credentialContext(c.Name))
+		if err != nil {
+			return scanned, rotated, fmt.Errorf("credential %s: %w", c.Name, err)
+		}
+		if !changed {
+			continue
+		}
+		c.Value = value
+		if err := s.store.Save(ctx, &c); err != nil {
+			return scanned, rotated, err
+		}
+		rotated++
+	}
+	return scanned, rotated, nil
+}
+
+// credentialContext binds a credential's ciphertext to its name
+func credentialContext(name string) string {
+	return "credentials:" + name
+}
+
+// hint returns the last characters of a secret long enough to keep the rest hidden
+func hint(value string) string {
+	if len(value) < 12 {
+		return ""
+	}
+	return "…" + value[len(value)-4:]
+}
+
+func describe(c StoredCredential) *domain.Credential {
+	return &domain.Credential{Name: c.Name, Kind: c.Kind, Hint: c.Hint, KeyID: KeyID(c.Value), UpdatedAt: c.UpdatedAt}
+}
@@ -0,0 +1,164 @@
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/config"
+)
+
+// prefix marks an encrypted value: enc:v1:<key id>:<base64 nonce+ciphertext>
+const prefix = "enc:v1:"
+
+var (
+	// ErrUnknownKey is returned for a value encrypted under a key that is no
+	// longer configured
+	ErrUnknownKey = errors.New("encryption key not configured")
+	// ErrCorrupt is returned for a value that cannot be decrypted
+	ErrCorrupt = errors.New("encrypted value is corrupt")
+)
+
+// Keyring encrypts values with AES-256-GCM under the primary key and
+// decrypts values written under any configured key. Each value is bound to a
+// context string, e.g. its table, column and row, so ciphertext copied to
+// another field does not decrypt.
+type Keyring struct {
+	primary string
+	keys    map[string]cipher.AEAD
+}
+
+// NewKeyring creates a keyring from configuration. Without keys it uses a
+// random per-process key, so values encrypted with it don't survive a restart.
+func NewKeyring(cfg config.EncryptionConfig) (*Keyring, error) {
+	k := &Keyring{primary: cfg.PrimaryKey, keys: make(map[string]cipher.AEAD)}
+	if len(cfg.Keys) == 0 {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		k.primary = "temporary"
+		return k, k.add(k.primary, key)
+	}
+
+	for _, kc := range cfg.Keys {
+		if kc.ID == "" || strings.Contains(kc.ID, ":") {
+			return nil, fmt.Errorf("encryption key id %q must be non-empty and contain no colon", kc.ID)
+		}
+		encoded := kc.Key
+		if kc.KeyFile != "" {
+			data, err := os.ReadFile(kc.KeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("encryption key %q: %w", kc.ID, err)
+			}
+			encoded = string(data)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil || len(key) != 32 {
+			return nil, fmt.Errorf("encryption key %q must be 32 bytes, base64-encoded", kc.ID)
+		}
+		if err := k.add(kc.ID, key); err != nil {
+			return nil, err
+		}
+	}
+	if k.primary == "" && len(cfg.Keys) == 1 {
+		k.primary = cfg.Keys[0].ID
+	}
+	if _, ok := k.keys[k.primary]; !ok {
+		return nil, fmt.Errorf("primary encryption key %q is not configured", k.primary)
+	}
+	return k, nil
+}
+
+func (k *Keyring) add(id string, key []byte) error {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return err
+	}
+	k.keys[id] = aead
+	return nil
+}
+
+// Primary returns the ID of the key new values are encrypted with
+func (k *Keyring) Primary() string {
+	return k.primary
+}
+
+// Temporary reports whether the keyring uses a random per-process key
+func (k *Keyring) Temporary() bool {
+	return k.primary == "temporary"
+}
+
+// Encrypt encrypts plaintext under the primary key, bound to context
+func (k *Keyring) Encrypt(plaintext, context string) (string, error) {
+	aead := k.keys[k.primary]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), []byte(context))
+	return prefix + k.primary + ":" + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value written by Encrypt. Values without the encrypted
+// prefix are rows written before encryption and are returned as they are.
+func (k *Keyring) Decrypt(value, context string) (string, error) {
+	id, data, ok := split(value)
+	if !ok {
+		return value, nil
+	}
+	aead, found := k.keys[id]
+	if !found {
+		return "", fmt.Errorf("%w: %s", ErrUnknownKey, id)
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(data)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrCorrupt
+	}
+	plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(context))
+	if err != nil {
+		return "", ErrCorrupt
+	}
+	return string(plain), nil
+}
+
+// Rotate re-encrypts a value under the primary key when it is plaintext or
+// encrypted under an older key, reporting whether it changed
+func (k *Keyring) Rotate(value, context string) (string, bool, error) {
+	if id, _, ok := split(value); ok && id == k.primary {
+		return value, false, nil
+	}
+	plain, err := k.Decrypt(value, context)
+	if err != nil {
+		return "", false, err
+	}
+	rotated, err := k.Encrypt(plain, context)
+	if err != nil {
+		return "", false, err
+	}
+	return rotated, true, nil
+}
+
+// KeyID returns the ID of the key a value is encrypted under, or "" for plaintext
+func KeyID(value string) string {
+	id, _, _ := split(value)
+	return id
+}
+
+// split parses an encrypted value into its key ID and payload
+func split(value string) (id, data string, ok bool) {
+	if !strings.HasPrefix(value, prefix) {
+		return "", "", false
+	}
+	id, data, ok = strings.Cut(value[len(prefix):], ":")
+	return id, data, ok
+}
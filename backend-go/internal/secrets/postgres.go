@@ -0,0 +1,155 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PostgresCredentialStore persists credentials in the credentials table
+type PostgresCredentialStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresCredentialStore creates a Postgres-backed credential store
+func NewPostgresCredentialStore(db *pgxpool.Pool) *PostgresCredentialStore {
+	return &PostgresCredentialStore{db: db}
+}
+
+// Get returns a credential, or nil
+func (p *PostgresCredentialStore) Get(ctx context.Context, name string) (*StoredCredential, error) {
+	var c StoredCredential
+	err := p.db.QueryRow(ctx, `SELECT name, kind, value, hint, updated_at FROM credentials WHERE name = $1`, name).
+		Scan(&c.Name, &c.Kind, &c.Value, &c.Hint, &c.UpdatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credential: %w", err)
+	}
+	return &c, nil
+}
+
+// Save inserts or replaces a credential
+func (p *PostgresCredentialStore) Save(ctx context.Context, c *StoredCredential) error {
+	_, err := p.db.Exec(ctx, `
+		INSERT INTO credentials (name, kind, value, hint, updated_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (tenant_id, name) DO UPDATE
+		SET kind = EXCLUDED.kind, value = EXCLUDED.value, hint = EXCLUDED.hint, updated_at = EXCLUDED.updated_at`,
+		c.Name, c.Kind, c.Value, c.Hint, c.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to save credential: %w", err)
+	}
+	return nil
+}
+
+// Delete removes a credential
+func (p *PostgresCredentialStore) Delete(ctx context.Context, name string) (bool, error) {
+	tag, err := p.db.Exec(ctx, `DELETE FROM credentials WHERE name = $1`, name)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete credential: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// List returns every credential, by name
+func (p *PostgresCredentialStore) List(ctx context.Context) ([]StoredCredential, error) {
+	rows, err := p.db.Query(ctx, `SELECT name, kind, value, hint, updated_at FROM credentials ORDER BY name`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list credentials: %w", err)
+	}
+	defer rows.Close()
+
+	credentials := []StoredCredential{}
+	for rows.Next() {
+		var c StoredCredential
+		if err := rows.Scan(&c.Name, &c.Kind, &c.Value, &c.Hint, &c.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan credential: %w", err)
+		}
+		credentials = append(credentials, c)
+	}
+	return credentials, rows.Err()
+}
+
+// ContactFields re-encrypts the contact details stored on applications.
+// Rows are read under the caller's tenant, so run it once per tenant.
+type ContactFields struct {
+	db *pgxpool.Pool
+}
+
+// NewContactFields creates the rotation target for application contact details
+func NewContactFields(db *pgxpool.Pool) *ContactFields {
+	return &ContactFields{db: db}
+}
+
+// Name identifies the contact fields in a key rotation report
+func (f *ContactFields) Name() string {
+	return "application_contacts"
+}
+
+// Rotate encrypts plaintext contact emails and phones and re-encrypts those
+// under older keys
+func (f *ContactFields) Rotate(ctx context.Context, keyring *Keyring) (scanned, rotated int, err error) {
+	rows, err := f.db.Query(ctx, `
+		SELECT id, COALESCE(contact_email, ''), COALESCE(contact_phone, '')
+		FROM applications WHERE contact_email IS NOT NULL OR contact_phone IS NOT NULL`)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to query application contacts: %w", err)
+	}
+	type contact struct {
+		id           uuid.UUID
+		email, phone string
+	}
+	var contacts []contact
+	for rows.Next() {
+		var c contact
+		if err := rows.Scan(&c.id, &c.email, &c.phone); err != nil {
+			rows.Close()
+			return 0, 0, fmt.Errorf("failed to scan application contact: %w", err)
+		}
+		contacts = append(contacts, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	for _, c := range contacts {
+		scanned++
+		email, emailChanged, err := rotateField(keyring, c.email, ContactContext("contact_email", c.id))
+		if err != nil {
+			return scanned, rotated, fmt.Errorf("application %s: %w", c.id, err)
+		}
+		phone, phoneChanged, err := rotateField(keyring, c.phone, ContactContext("contact_phone", c.id))
+		if err != nil {
+			return scanned, rotated, fmt.Errorf("application %s: %w", c.id, err)
+		}
+		if !emailChanged && !phoneChanged {
+			continue
+		}
+		if _, err := f.db.Exec(ctx, `
+			UPDATE applications SET contact_email = NULLIF($2, ''), contact_phone = NULLIF($3, '') WHERE id = $1`,
+			c.id, email, phone); err != nil {
+			return scanned, rotated, fmt.Errorf("failed to update application contact: %w", err)
+		}
+		rotated++
+	}
+	return scanned, rotated, nil
+}
+
+// ContactContext binds an application contact field's ciphertext to its row
+func ContactContext(column string, appID uuid.UUID) string {
+	return "applications." + column + ":" + appID.String()
+}
+
+// rotateField rotates a value, leaving empty values empty
+func rotateField(keyring *Keyring, value, context string) (string, bool, error) {
+	if value == "" {
+		return "", false, nil
+	}
+	return keyring.Rotate(value, context)
+}
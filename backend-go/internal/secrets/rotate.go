@@ -0,0 +1,53 @@
+package secrets
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+// RotationTarget is a set of stored fields that can be re-encrypted
+type RotationTarget interface {
+	Name() string
+	// Rotate re-encrypts values that are plaintext or under an older key
+	Rotate(ctx context.Context, keyring *Keyring) (scanned, rotated int, err error)
+}
+
+// Rotator re-encrypts every target under the keyring's primary key. Run after
+// adding a new primary key, and once after enabling encryption to encrypt
+// rows written before it.
+type Rotator struct {
+	keyring *Keyring
+	targets []RotationTarget
+}
+
+// NewRotator creates a rotator over targets
+func NewRotator(keyring *Keyring, targets ...RotationTarget) *Rotator {
+	return &Rotator{keyring: keyring, targets: targets}
+}
+
+// Rotate re-encrypts each target, carrying on past targets that fail
+func (r *Rotator) Rotate(ctx context.Context) (*domain.KeyRotationReport, error) {
+	report := &domain.KeyRotationReport{
+		PrimaryKey: r.keyring.Primary(),
+		Targets:    make([]domain.KeyRotationTarget, 0, len(r.targets)),
+		StartedAt:  time.Now(),
+	}
+	for _, t := range r.targets {
+		scanned, rotated, err := t.Rotate(ctx, r.keyring)
+		result := domain.KeyRotationTarget{Name: t.Name(), Scanned: scanned, Rotated: rotated}
+		if err != nil {
+			result.Error = err.Error()
+			logger.Warn("Key rotation target failed", zap.String("target", t.Name()), zap.Error(err))
+		}
+		report.Targets = append(report.Targets, result)
+	}
+	report.FinishedAt = time.Now()
+
+	logger.Info("Key rotation completed", zap.String("primary_key", report.PrimaryKey), zap.Duration("took", report.FinishedAt.Sub(report.StartedAt)))
+	return report, nil
+}
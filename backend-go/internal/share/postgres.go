@@ -0,0 +1,106 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists share links in the share_links table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed share link store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const linkColumns = `id, token_hash, scope, application_id, label, redact, expires_at, revoked_at, views, last_viewed_at, created_at`
+
+// Save inserts or replaces a link
+func (p *PostgresStore) Save(ctx context.Context, link *domain.ShareLink) error {
+	redact := make([]string, len(link.Redact))
+	for i, f := range link.Redact {
+		redact[i] = string(f)
+	}
+
+	_, err := p.db.Exec(ctx, `
+		INSERT INTO share_links (`+linkColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (id) DO UPDATE SET
+			label = EXCLUDED.label, redact = EXCLUDED.redact, expires_at = EXCLUDED.expires_at,
+			revoked_at = EXCLUDED.revoked_at, views = EXCLUDED.views, last_viewed_at = EXCLUDED.last_viewed_at`,
+		link.ID, link.TokenHash, string(link.Scope), link.ApplicationID, link.Label, redact,
+		link.ExpiresAt, link.RevokedAt, link.Views, link.LastViewedAt, link.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save share link: %w", err)
+	}
+	return nil
+}
+
+// Get returns a link by ID
+func (p *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*domain.ShareLink, error) {
+	return p.one(ctx, `SELECT `+linkColumns+` FROM share_links WHERE id = $1`, id)
+}
+
+// ByTokenHash returns the link whose token hashes to hash
+func (p *PostgresStore) ByTokenHash(ctx context.Context, hash string) (*domain.ShareLink, error) {
+	return p.one(ctx, `SELECT `+linkColumns+` FROM share_links WHERE token_hash = $1`, hash)
+}
+
+// List returns every link, newest first
+func (p *PostgresStore) List(ctx context.Context) ([]domain.ShareLink, error) {
+	rows, err := p.db.Query(ctx, `SELECT `+linkColumns+` FROM share_links ORDER BY created_at DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+	defer rows.Close()
+
+	links := []domain.ShareLink{}
+	for rows.Next() {
+		link, err := scanLink(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan share link: %w", err)
+		}
+		links = append(links, *link)
+	}
+	return links, rows.Err()
+}
+
+func (p *PostgresStore) one(ctx context.Context, query string, arg interface{}) (*domain.ShareLink, error) {
+	link, err := scanLink(p.db.QueryRow(ctx, query, arg))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get share link: %w", err)
+	}
+	return link, nil
+}
+
+func scanLink(row pgx.Row) (*domain.ShareLink, error) {
+	var (
+		link   domain.ShareLink
+		scope  string
+		redact []string
+	)
+	err := row.Scan(&link.ID, &link.TokenHash, &scope, &link.ApplicationID, &link.Label, &redact,
+		&link.ExpiresAt, &link.RevokedAt, &link.Views, &link.LastViewedAt, &link.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	link.Scope = domain.ShareScope(scope)
+	link.Redact = make([]domain.ShareField, len(redact))
+	for i, f := range redact {
+		link.Redact[i] = domain.ShareField(f)
+	}
+	return &link, nil
+}
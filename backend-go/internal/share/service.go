@@ -0,0 +1,251 @@
+package share
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+const (
+	// tokenBytes is the entropy of a share token
+	tokenBytes = 24
+	// boardPage is how many applications are read per page when building a board
+	boardPage = 100
+	// maxLabel caps the length of a link's label
+	maxLabel = 200
+)
+
+var (
+	// ErrApplicationNotFound is returned when a shared application does not exist
+	ErrApplicationNotFound = errors.New("application not found")
+	// ErrInvalid is returned for a malformed share link
+	ErrInvalid = errors.New("invalid share link")
+)
+
+// ApplicationService reads tracked applications
+type ApplicationService interface {
+	GetApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error)
+	GetApplications(ctx context.Context, status *domain.ApplicationStatus, limit, offset int) (*domain.ApplicationListResponse, error)
+}
+
+// Service creates share links and resolves their tokens to redacted,
+// read-only views
+type Service struct {
+	store Store
+	apps  ApplicationService
+}
+
+// NewService creates a share link service
+func NewService(store Store, apps ApplicationService) *Service {
+	return &Service{store: store, apps: apps}
+}
+
+// Create makes a new link. The returned token is not stored and cannot be
+// recovered later.
+func (s *Service) Create(ctx context.Context, in domain.ShareLinkCreate) (*domain.CreatedShareLink, error) {
+	if in.Scope == "" {
+		in.Scope = domain.ShareBoard
+	}
+	switch in.Scope {
+	case domain.ShareBoard:
+		in.ApplicationID = nil
+	case domain.ShareApplication:
+		if in.ApplicationID == nil {
+			return nil, fmt.Errorf("%w: application_id is required for an application link", ErrInvalid)
+		}
+		if _, err := s.apps.GetApplication(ctx, *in.ApplicationID); err != nil {
+			return nil, ErrApplicationNotFound
+		}
+	default:
+		return nil, fmt.Errorf("%w: unknown scope %q", ErrInvalid, in.Scope)
+	}
+	if in.ExpiresIn < 0 {
+		return nil, fmt.Errorf("%w: expires_in_hours cannot be negative", ErrInvalid)
+	}
+	label := strings.TrimSpace(in.Label)
+	if len(label) > maxLabel {
+		return nil, fmt.Errorf("%w: label is longer than %d characters", ErrInvalid, maxLabel)
+	}
+
+	redact := []domain.ShareField{}
+	for _, f := range in.Redact {
+		if !f.Valid() {
+			return nil, fmt.Errorf("%w: unknown redact field %q", ErrInvalid, f)
+		}
+		if !hasField(redact, f) {
+			redact = append(redact, f)
+		}
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	link := domain.ShareLink{
+		ID:            uuid.New(),
+		TokenHash:     hashToken(token),
+		Scope:         in.Scope,
+		ApplicationID: in.ApplicationID,
+		Label:         label,
+		Redact:        redact,
+		CreatedAt:     now,
+	}
+	if in.ExpiresIn > 0 {
+		expires := now.Add(time.Duration(in.ExpiresIn) * time.Hour)
+		link.ExpiresAt = &expires
+	}
+	if err := s.store.Save(ctx, &link); err != nil {
+		return nil, err
+	}
+
+	return &domain.CreatedShareLink{
+		ShareLink: link,
+		Token:     token,
+		Path:      "/api/shared/" + token,
+	}, nil
+}
+
+// List returns every link, newest first
+func (s *Service) List(ctx context.Context) ([]domain.ShareLink, error) {
+	return s.store.List(ctx)
+}
+
+// Revoke disables a link; revoking twice is a no-op
+func (s *Service) Revoke(ctx context.Context, id uuid.UUID) (*domain.ShareLink, error) {
+	link, err := s.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if link.RevokedAt == nil {
+		now := time.Now()
+		link.RevokedAt = &now
+		if err := s.store.Save(ctx, link); err != nil {
+			return nil, err
+		}
+	}
+	return link, nil
+}
+
+// View resolves a token to its shared view. Unknown, revoked and expired
+// tokens all return ErrNotFound so a token's history is not disclosed.
+func (s *Service) View(ctx context.Context, token string) (*domain.SharedView, error) {
+	if token == "" {
+		return nil, ErrNotFound
+	}
+	link, err := s.store.ByTokenHash(ctx, hashToken(token))
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if !link.Active(now) {
+		return nil, ErrNotFound
+	}
+
+	view := &domain.SharedView{
+		Scope:       link.Scope,
+		Label:       link.Label,
+		Redacted:    link.Redact,
+		ExpiresAt:   link.ExpiresAt,
+		GeneratedAt: now,
+	}
+	if link.Scope == domain.ShareApplication {
+		app, err := s.apps.GetApplication(ctx, *link.ApplicationID)
+		if err != nil || app.DeletedAt != nil {
+			return nil, ErrNotFound
+		}
+		redact(app, link.Redact)
+		view.Application = app
+		view.Total = 1
+	} else {
+		if view.Board, view.Total, err = s.board(ctx, link.Redact); err != nil {
+			return nil, err
+		}
+	}
+
+	link.Views++
+	link.LastViewedAt = &now
+	if err := s.store.Save(ctx, link); err != nil {
+		logger.Warn("Failed to record share link view", zap.String("link_id", link.ID.String()), zap.Error(err))
+	}
+	return view, nil
+}
+
+// board returns every application grouped by status, with fields redacted
+func (s *Service) board(ctx context.Context, fields []domain.ShareField) (map[domain.ApplicationStatus][]domain.Application, int, error) {
+	board := make(map[domain.ApplicationStatus][]domain.Application)
+	total := 0
+	for offset := 0; ; offset += boardPage {
+		page, err := s.apps.GetApplications(ctx, nil, boardPage, offset)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, app := range page.Applications {
+			redact(&app, fields)
+			board[app.Status] = append(board[app.Status], app)
+			total++
+		}
+		if len(page.Applications) < boardPage || offset+boardPage >= page.Total {
+			break
+		}
+	}
+	return board, total, nil
+}
+
+// redact clears the given fields from app
+func redact(app *domain.Application, fields []domain.ShareField) {
+	for _, f := range fields {
+		switch f {
+		case domain.ShareSalary:
+			app.Job.SalaryText = nil
+		case domain.ShareNotes:
+			app.Notes = nil
+			timeline := make([]domain.TimelineEntry, len(app.Timeline))
+			for i, entry := range app.Timeline {
+				entry.Notes = nil
+				timeline[i] = entry
+			}
+			app.Timeline = timeline
+		case domain.ShareCoverLetter:
+			app.CoverLetter = nil
+		case domain.ShareResumeVersion:
+			app.ResumeVersion = nil
+		}
+	}
+	app.DeletedAt = nil
+}
+
+// newToken returns a random URL-safe token
+func newToken() (string, error) {
+	b := make([]byte, tokenBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func hasField(fields []domain.ShareField, f domain.ShareField) bool {
+	for _, v := range fields {
+		if v == f {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ErrNotFound is returned when a share link does not exist
+var ErrNotFound = errors.New("share link not found")
+
+// Store persists share links
+type Store interface {
+	Save(ctx context.Context, link *domain.ShareLink) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.ShareLink, error)
+	// ByTokenHash returns the link whose token hashes to hash
+	ByTokenHash(ctx context.Context, hash string) (*domain.ShareLink, error)
+	// List returns every link, newest first
+	List(ctx context.Context) ([]domain.ShareLink, error)
+}
+
+// MemoryStore keeps share links in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu    sync.RWMutex
+	links map[uuid.UUID]domain.ShareLink
+}
+
+// NewMemoryStore creates an in-memory share link store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{links: make(map[uuid.UUID]domain.ShareLink)}
+}
+
+// Save inserts or replaces a link
+func (m *MemoryStore) Save(ctx context.Context, link *domain.ShareLink) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.links[link.ID] = clone(*link)
+	return nil
+}
+
+// Get returns a link by ID
+func (m *MemoryStore) Get(ctx context.Context, id uuid.UUID) (*domain.ShareLink, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	link, ok := m.links[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	link = clone(link)
+	return &link, nil
+}
+
+// ByTokenHash returns the link whose token hashes to hash
+func (m *MemoryStore) ByTokenHash(ctx context.Context, hash string) (*domain.ShareLink, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	for _, link := range m.links {
+		if link.TokenHash == hash {
+			link = clone(link)
+			return &link, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// List returns every link, newest first
+func (m *MemoryStore) List(ctx context.Context) ([]domain.ShareLink, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	links := make([]domain.ShareLink, 0, len(m.links))
+	for _, link := range m.links {
+		links = append(links, clone(link))
+	}
+	sort.Slice(links, func(i, j int) bool {
+		return links[i].CreatedAt.After(links[j].CreatedAt)
+	})
+	return links, nil
+}
+
+// clone copies link so stored fields are not shared with callers
+func clone(link domain.ShareLink) domain.ShareLink {
+	link.Redact = append([]domain.ShareField(nil), link.Redact...)
+	return link
+}
@@ -0,0 +1,307 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// salaryBucketSize is the width of each salary distribution bucket
+const salaryBucketSize = 25000
+
+// topSkillsLimit is how many matched/missing skills are reported
+const topSkillsLimit = 10
+
+// minVersionSample is how many submitted applications each resume version
+// needs before a best version is named
+const minVersionSample = 5
+
+// activeJobs restricts job queries to live, non-deleted listings
+const activeJobs = `is_active = TRUE AND deleted_at IS NULL`
+
+// PostgresStats computes job and application statistics with SQL aggregation
+type PostgresStats struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStats creates a Postgres-backed statistics provider
+func NewPostgresStats(db *pgxpool.Pool) *PostgresStats {
+	return &PostgresStats{db: db}
+}
+
+// GetJobStats aggregates the job corpus by source, location type, employment
+// type and salary, plus the time of the last completed scrape
+func (s *PostgresStats) GetJobStats(ctx context.Context) (*domain.JobSearchStats, error) {
+	stats := &domain.JobSearchStats{}
+
+	if err := s.db.QueryRow(ctx, `SELECT count(*) FROM jobs WHERE `+activeJobs).Scan(&stats.TotalJobsIndexed); err != nil {
+		return nil, fmt.Errorf("failed to count jobs: %w", err)
+	}
+
+	var err error
+	if stats.JobsBySource, err = s.countBy(ctx, "source::text"); err != nil {
+		return nil, err
+	}
+	if stats.JobsByLocationType, err = s.countBy(ctx, "location_type::text"); err != nil {
+		return nil, err
+	}
+	if stats.JobsByEmploymentType, err = s.countBy(ctx, "employment_type"); err != nil {
+		return nil, err
+	}
+
+	// Midpoint of the advertised range, or whichever bound is present
+	const salary = `COALESCE((salary_min + salary_max) / 2, salary_min, salary_max)`
+
+	var avg *float64
+	if err := s.db.QueryRow(ctx, `SELECT avg(`+salary+`) FROM jobs WHERE `+activeJobs).Scan(&avg); err != nil {
+		return nil, fmt.Errorf("failed to average salaries: %w", err)
+	}
+	if avg != nil {
+		rounded := int(*avg + 0.5)
+		stats.AverageSalary = &rounded
+	}
+
+	rows, err := s.db.Query(ctx, fmt.Sprintf(`
+		SELECT (%[1]s / %[2]d) * %[2]d AS bucket, count(*)
+		FROM jobs WHERE %[3]s AND %[1]s IS NOT NULL
+		GROUP BY bucket ORDER BY bucket`, salary, salaryBucketSize, activeJobs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute salary distribution: %w", err)
+	}
+	defer rows.Close()
+
+	stats.SalaryDistribution = []domain.SalaryBucket{}
+	for rows.Next() {
+		var b domain.SalaryBucket
+		if err := rows.Scan(&b.Min, &b.Count); err != nil {
+			return nil, err
+		}
+		b.Max = b.Min + salaryBucketSize
+		stats.SalaryDistribution = append(stats.SalaryDistribution, b)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	var lastScrape *time.Time
+	if err := s.db.QueryRow(ctx, `SELECT max(completed_at) FROM scrape_queue WHERE status = 'completed'`).Scan(&lastScrape); err != nil {
+		return nil, fmt.Errorf("failed to read last scrape: %w", err)
+	}
+	stats.LastScrapeAt = lastScrape
+
+	return stats, nil
+}
+
+// GetApplicationStats aggregates application outcomes, response timing and the
+// skills most often matched or missing on applied-to jobs
+func (s *PostgresStats) GetApplicationStats(ctx context.Context) (*domain.ApplicationStats, error) {
+	stats := &domain.ApplicationStats{
+		ByStatus: map[string]int{},
+	}
+
+	rows, err := s.db.Query(ctx, `
+		SELECT status::text, count(*) FROM applications
+		WHERE deleted_at IS NULL GROUP BY status`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count applications: %w", err)
+	}
+	for rows.Next() {
+		var status string
+		var n int
+		if err := rows.Scan(&status, &n); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		stats.ByStatus[status] = n
+		stats.TotalApplications += n
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// A response is any status change past "applied" other than withdrawing
+	var submitted, responded int
+	var avgDays *float64
+	err = s.db.QueryRow(ctx, `
+		WITH submitted AS (
+			SELECT a.id, a.applied_at,
+				(SELECT min(t.created_at) FROM application_timeline t
+				 WHERE t.application_id = a.id AND t.created_at >= a.applied_at
+				   AND t.to_status NOT IN ('saved', 'applied', 'withdrawn')) AS responded_at
+			FROM applications a
+			WHERE a.deleted_at IS NULL AND a.applied_at IS NOT NULL
+		)
+		SELECT count(*), count(responded_at),
+			avg(EXTRACT(EPOCH FROM responded_at - applied_at) / 86400)
+		FROM submitted`).Scan(&submitted, &responded, &avgDays)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute response rate: %w", err)
+	}
+	if submitted > 0 {
+		rate := float64(responded) / float64(submitted)
+		stats.ResponseRate = &rate
+	}
+	if avgDays != nil {
+		days := int(*avgDays + 0.5)
+		stats.AverageTimeToResponse = &days
+	}
+
+	if stats.TopMatchedSkills, err = s.topSkills(ctx, true); err != nil {
+		return nil, err
+	}
+	if stats.TopMissingSkills, err = s.topSkills(ctx, false); err != nil {
+		return nil, err
+	}
+
+	if stats.ByResumeVersion, err = s.byResumeVersion(ctx); err != nil {
+		return nil, err
+	}
+	stats.BestResumeVersion = bestResumeVersion(stats.ByResumeVersion)
+
+	return stats, nil
+}
+
+// byResumeVersion compares response and interview rates per resume version,
+// best response rate first. Applications without a version are left out.
+func (s *PostgresStats) byResumeVersion(ctx context.Context) ([]domain.ResumeVersionStats, error) {
+	rows, err := s.db.Query(ctx, `
+		WITH submitted AS (
+			SELECT a.resume_version, a.applied_at,
+				(SELECT min(t.created_at) FROM application_timeline t
+				 WHERE t.application_id = a.id AND t.created_at >= a.applied_at
+				   AND t.to_status NOT IN ('saved', 'applied', 'withdrawn')) AS responded_at,
+				a.status IN ('phone_screen', 'technical', 'onsite', 'offer', 'accepted') OR EXISTS (
+					SELECT 1 FROM application_timeline t
+					WHERE t.application_id = a.id
+					  AND t.to_status IN ('phone_screen', 'technical', 'onsite', 'offer', 'accepted')) AS interviewed
+			FROM applications a
+			WHERE a.deleted_at IS NULL AND a.applied_at IS NOT NULL AND a.resume_version IS NOT NULL
+		)
+		SELECT resume_version, count(*), count(responded_at), count(*) FILTER (WHERE interviewed),
+			avg(EXTRACT(EPOCH FROM responded_at - applied_at) / 86400)
+		FROM submitted GROUP BY resume_version`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare resume versions: %w", err)
+	}
+	defer rows.Close()
+
+	versions := []domain.ResumeVersionStats{}
+	for rows.Next() {
+		var v domain.ResumeVersionStats
+		var avgDays *float64
+		if err := rows.Scan(&v.Version, &v.Submitted, &v.Responses, &v.Interviews, &avgDays); err != nil {
+			return nil, err
+		}
+		if v.Submitted > 0 {
+			response := float64(v.Responses) / float64(v.Submitted)
+			interview := float64(v.Interviews) / float64(v.Submitted)
+			v.ResponseRate, v.InterviewRate = &response, &interview
+		}
+		if avgDays != nil {
+			days := int(*avgDays + 0.5)
+			v.AverageTimeToResponse = &days
+		}
+		versions = append(versions, v)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(versions, func(i, j int) bool {
+		a, b := rate(versions[i].ResponseRate), rate(versions[j].ResponseRate)
+		if a != b {
+			return a > b
+		}
+		return versions[i].Submitted > versions[j].Submitted
+	})
+	return versions, nil
+}
+
+// bestResumeVersion names the version with the highest response rate, once
+// at least two versions have minVersionSample applications each and the
+// leader is not tied
+func bestResumeVersion(versions []domain.ResumeVersionStats) *string {
+	var compared []domain.ResumeVersionStats
+	for _, v := range versions {
+		if v.Submitted >= minVersionSample {
+			compared = append(compared, v)
+		}
+	}
+	if len(compared) < 2 || rate(compared[0].ResponseRate) == rate(compared[1].ResponseRate) {
+		return nil
+	}
+	best := compared[0].Version
+	return &best
+}
+
+func rate(r *float64) float64 {
+	if r == nil {
+		return 0
+	}
+	return *r
+}
+
+// countBy counts active jobs grouped by a column expression
+func (s *PostgresStats) countBy(ctx context.Context, column string) (map[string]int, error) {
+	rows, err := s.db.Query(ctx, fmt.Sprintf(`
+		SELECT COALESCE(%[1]s, 'unknown'), count(*) FROM jobs
+		WHERE %[2]s GROUP BY 1`, column, activeJobs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to count jobs by %s: %w", column, err)
+	}
+	defer rows.Close()
+
+	counts := map[string]int{}
+	for rows.Next() {
+		var key string
+		var n int
+		if err := rows.Scan(&key, &n); err != nil {
+			return nil, err
+		}
+		counts[key] = n
+	}
+	return counts, rows.Err()
+}
+
+// topSkills returns the required skills of applied-to jobs that the primary
+// resume has (matched) or lacks (missing), most frequent first
+func (s *PostgresStats) topSkills(ctx context.Context, matched bool) ([]string, error) {
+	op := "NOT IN"
+	if matched {
+		op = "IN"
+	}
+
+	rows, err := s.db.Query(ctx, fmt.Sprintf(`
+		WITH resume_skills AS (
+			SELECT lower(skill) AS skill FROM resumes, unnest(skills) AS skill WHERE is_primary
+		)
+		SELECT skill FROM (
+			SELECT lower(skill) AS skill, count(*) AS n
+			FROM applications a
+			JOIN jobs j ON j.id = a.job_id, unnest(j.required_skills) AS skill
+			WHERE a.deleted_at IS NULL
+			GROUP BY 1
+		) counted
+		WHERE skill %s (SELECT skill FROM resume_skills)
+		ORDER BY n DESC, skill LIMIT $1`, op), topSkillsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compute top skills: %w", err)
+	}
+	defer rows.Close()
+
+	skills := []string{}
+	for rows.Next() {
+		var skill string
+		if err := rows.Scan(&skill); err != nil {
+			return nil, err
+		}
+		skills = append(skills, skill)
+	}
+	return skills, rows.Err()
+}
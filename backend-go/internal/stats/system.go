@@ -0,0 +1,143 @@
+package stats
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// largestTables is how many tables the database size breakdown lists
+const largestTables = 10
+
+// PostgresSystemStats reports database-backed figures for the admin overview
+type PostgresSystemStats struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresSystemStats creates a Postgres-backed system statistics provider
+func NewPostgresSystemStats(db *pgxpool.Pool) *PostgresSystemStats {
+	return &PostgresSystemStats{db: db}
+}
+
+// DatabaseSize returns the database size and its largest tables
+func (s *PostgresSystemStats) DatabaseSize(ctx context.Context) (*domain.DatabaseSize, error) {
+	size := &domain.DatabaseSize{Tables: []domain.TableSize{}}
+	if err := s.db.QueryRow(ctx, `SELECT pg_database_size(current_database())`).Scan(&size.TotalBytes); err != nil {
+		return nil, fmt.Errorf("failed to read database size: %w", err)
+	}
+
+	rows, err := s.db.Query(ctx, `
+		SELECT relname, GREATEST(reltuples, 0)::bigint, pg_total_relation_size(oid)
+		FROM pg_class
+		WHERE relkind = 'r' AND relnamespace = 'public'::regnamespace
+		ORDER BY pg_total_relation_size(oid) DESC
+		LIMIT $1`, largestTables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read table sizes: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var t domain.TableSize
+		if err := rows.Scan(&t.Table, &t.Rows, &t.Bytes); err != nil {
+			return nil, err
+		}
+		size.Tables = append(size.Tables, t)
+	}
+	return size, rows.Err()
+}
+
+// ScrapeQueue returns how many scrape runs are running and waiting
+func (s *PostgresSystemStats) ScrapeQueue(ctx context.Context) (domain.QueueDepth, error) {
+	var depth domain.QueueDepth
+	err := s.db.QueryRow(ctx, `
+		SELECT count(*) FILTER (WHERE status = 'in_progress'),
+		       count(*) FILTER (WHERE status = 'pending')
+		FROM scrape_queue`).Scan(&depth.Active, &depth.Queued)
+	if err != nil {
+		return depth, fmt.Errorf("failed to read scrape queue: %w", err)
+	}
+	return depth, nil
+}
+
+// ScraperErrorRates returns per-source failure and error counts for scrape
+// runs created since the given time. A run's error rate counts runs that
+// failed outright or finished with categorized errors.
+func (s *PostgresSystemStats) ScraperErrorRates(ctx context.Context, since time.Time) ([]domain.ScraperErrorRate, error) {
+	rows, err := s.db.Query(ctx, `
+		SELECT source::text,
+		       count(*),
+		       count(*) FILTER (WHERE status = 'failed'),
+		       COALESCE(sum((SELECT sum((e->>'count')::int) FROM jsonb_array_elements(error_summary) e)), 0)::int,
+		       count(*) FILTER (WHERE status = 'failed' OR jsonb_array_length(error_summary) > 0)
+		FROM scrape_queue
+		WHERE created_at >= $1 AND status IN ('completed', 'failed')
+		GROUP BY source
+		ORDER BY source`, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read scraper error rates: %w", err)
+	}
+	defer rows.Close()
+
+	rates := []domain.ScraperErrorRate{}
+	for rows.Next() {
+		var r domain.ScraperErrorRate
+		var troubled int
+		if err := rows.Scan(&r.Source, &r.Runs, &r.FailedRuns, &r.Errors, &troubled); err != nil {
+			return nil, err
+		}
+		if r.Runs > 0 {
+			r.ErrorRate = float64(troubled) / float64(r.Runs)
+		}
+		rates = append(rates, r)
+	}
+	return rates, rows.Err()
+}
+
+// RecordLLMUsage stores one completed LLM request's token usage and cost
+func (s *PostgresSystemStats) RecordLLMUsage(ctx context.Context, backend, model string, inputTokens, outputTokens int, costUSD float64) error {
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO llm_usage (backend, model, input_tokens, output_tokens, cost_usd)
+		VALUES ($1, $2, $3, $4, $5)`, backend, model, inputTokens, outputTokens, costUSD)
+	if err != nil {
+		return fmt.Errorf("failed to record llm usage: %w", err)
+	}
+	return nil
+}
+
+// LLMSpend returns LLM usage and cost for the calendar month containing now
+func (s *PostgresSystemStats) LLMSpend(ctx context.Context, now time.Time) (*domain.LLMSpend, error) {
+	start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	spend := &domain.LLMSpend{Month: start.Format("2006-01"), ByBackend: map[string]float64{}}
+
+	rows, err := s.db.Query(ctx, `
+		SELECT backend, count(*), COALESCE(sum(input_tokens), 0), COALESCE(sum(output_tokens), 0),
+		       COALESCE(sum(cost_usd), 0)::float8
+		FROM llm_usage
+		WHERE created_at >= $1
+		GROUP BY backend`, start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read llm usage: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var backend string
+		var requests int
+		var input, output int64
+		var cost float64
+		if err := rows.Scan(&backend, &requests, &input, &output, &cost); err != nil {
+			return nil, err
+		}
+		spend.Requests += requests
+		spend.InputTokens += input
+		spend.OutputTokens += output
+		spend.CostUSD += cost
+		spend.ByBackend[backend] = cost
+	}
+	return spend, rows.Err()
+}
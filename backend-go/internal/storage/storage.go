@@ -0,0 +1,60 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+)
+
+// Key prefixes for the kinds of objects the API stores
+const (
+	PrefixResumes     = "resumes/"
+	PrefixScreenshots = "screenshots/"
+	PrefixExports     = "exports/"
+	PrefixBackups     = PrefixExports + "backups/"
+)
+
+// ErrNotFound is returned when an object does not exist
+var ErrNotFound = errors.New("object not found")
+
+// ObjectInfo describes a stored object
+type ObjectInfo struct {
+	Key          string    `json:"key"`
+	Size         int64     `json:"size"`
+	ContentType  string    `json:"content_type,omitempty"`
+	LastModified time.Time `json:"last_modified"`
+}
+
+// Storage is a blob store for uploaded files and generated artifacts
+type Storage interface {
+	// Put stores an object; size may be -1 when unknown
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+
+	// Get opens an object for reading
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+
+	// Delete removes an object; deleting a missing object is not an error
+	Delete(ctx context.Context, key string) error
+
+	// List returns objects whose keys start with prefix
+	List(ctx context.Context, prefix string) ([]ObjectInfo, error)
+
+	// PresignedURL returns a time-limited download URL for an object
+	PresignedURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+}
+
+// New creates the storage backend selected by configuration
+func New(ctx context.Context, cfg config.StorageConfig) (Storage, error) {
+	switch cfg.Driver {
+	case "", "local":
+		return NewLocalStorage(cfg.Local)
+	case "s3", "minio":
+		return NewS3Storage(ctx, cfg.S3)
+	default:
+		return nil, fmt.Errorf("unknown storage driver: %s", cfg.Driver)
+	}
+}
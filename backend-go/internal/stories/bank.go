@@ -0,0 +1,111 @@
+package stories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ErrInvalid is returned for story input that is not a usable STAR story
+var ErrInvalid = errors.New("invalid story")
+
+// Bank is the user's story bank of vetted STAR stories
+type Bank struct {
+	store Store
+}
+
+// NewBank creates a story bank backed by store
+func NewBank(store Store) *Bank {
+	return &Bank{store: store}
+}
+
+// Create adds a story to the bank
+func (b *Bank) Create(ctx context.Context, in domain.StoryInput) (*domain.Story, error) {
+	if err := validate(&in); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	s := &domain.Story{ID: uuid.New(), CreatedAt: now}
+	apply(s, in, now)
+	if err := b.store.Save(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Update replaces a story's content
+func (b *Bank) Update(ctx context.Context, id uuid.UUID, in domain.StoryInput) (*domain.Story, error) {
+	if err := validate(&in); err != nil {
+		return nil, err
+	}
+	s, err := b.store.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	apply(s, in, time.Now())
+	if err := b.store.Save(ctx, s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Get returns a story by ID
+func (b *Bank) Get(ctx context.Context, id uuid.UUID) (*domain.Story, error) {
+	return b.store.Get(ctx, id)
+}
+
+// List returns the stories tagged with competency, or all when it is empty
+func (b *Bank) List(ctx context.Context, competency domain.Competency) ([]domain.Story, error) {
+	return b.store.List(ctx, competency)
+}
+
+// Delete removes a story
+func (b *Bank) Delete(ctx context.Context, id uuid.UUID) error {
+	return b.store.Delete(ctx, id)
+}
+
+// Relevant returns the stories best suited to answer a question
+func (b *Bank) Relevant(ctx context.Context, question string, limit int) ([]domain.StoryMatch, error) {
+	all, err := b.store.List(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	return Match(all, question, limit), nil
+}
+
+// validate trims a story input and checks it is a usable STAR story
+func validate(in *domain.StoryInput) error {
+	in.Title = strings.TrimSpace(in.Title)
+	if in.Title == "" {
+		return fmt.Errorf("%w: title is required", ErrInvalid)
+	}
+	if strings.TrimSpace(in.Action) == "" || strings.TrimSpace(in.Result) == "" {
+		return fmt.Errorf("%w: action and result are required", ErrInvalid)
+	}
+	if len(in.Competencies) == 0 {
+		return fmt.Errorf("%w: at least one competency is required", ErrInvalid)
+	}
+	for _, c := range in.Competencies {
+		if !c.Valid() {
+			return fmt.Errorf("%w: unknown competency %q", ErrInvalid, c)
+		}
+	}
+	return nil
+}
+
+func apply(s *domain.Story, in domain.StoryInput, now time.Time) {
+	s.Title = in.Title
+	s.Competencies = in.Competencies
+	s.Situation = strings.TrimSpace(in.Situation)
+	s.Task = strings.TrimSpace(in.Task)
+	s.Action = strings.TrimSpace(in.Action)
+	s.Result = strings.TrimSpace(in.Result)
+	s.Tags = append([]string{}, in.Tags...)
+	s.UpdatedAt = now
+}
@@ -0,0 +1,128 @@
+package stories
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Retrieval weights: a shared competency counts for more than shared words
+const (
+	competencyWeight = 0.7
+	overlapWeight    = 0.3
+	// minMatchScore drops stories that share neither a competency nor
+	// meaningful vocabulary with the question
+	minMatchScore = 0.15
+)
+
+// competencyCues recognise the competency a behavioral question probes
+var competencyCues = map[domain.Competency]*regexp.Regexp{
+	domain.CompetencyLeadership:    regexp.MustCompile(`(?i)\b(lead|led|leading|leader(ship)?|mentor(ed|ing)?|manag(ed|ing) (a|the) team|took charge)\b`),
+	domain.CompetencyConflict:      regexp.MustCompile(`(?i)\b(conflict|disagree(d|ment)?|push(ed)? back|difficult (co-?worker|colleague|person|stakeholder|manager)|tension|argument)\b`),
+	domain.CompetencyFailure:       regexp.MustCompile(`(?i)\b(fail(ed|ure)?|mistake|went wrong|setback|regret|missed (a )?deadline|learn(ed)? from)\b`),
+	domain.CompetencyTeamwork:      regexp.MustCompile(`(?i)\b(team ?work|collaborat(e|ed|ion)|cross-functional|work(ed)? with others|as part of a team)\b`),
+	domain.CompetencyOwnership:     regexp.MustCompile(`(?i)\b(ownership|took (the )?initiative|above and beyond|own(ed)? (a|the)|without being asked|responsib(le|ility))\b`),
+	domain.CompetencyInfluence:     regexp.MustCompile(`(?i)\b(persuad(e|ed)|convinc(e|ed)|influence(d)?|buy-?in|sell (an|the) idea)\b`),
+	domain.CompetencyAmbiguity:     regexp.MustCompile(`(?i)\b(ambigu(ous|ity)|uncertain(ty)?|incomplete information|unclear requirements|changing priorities)\b`),
+	domain.CompetencyCustomerFocus: regexp.MustCompile(`(?i)\b(customer|client|user feedback|end users?)\b`),
+	domain.CompetencyDelivery:      regexp.MustCompile(`(?i)\b(deadline|tight timeline|under pressure|deliver(ed)?|ship(ped)?|prioriti[sz](e|ed))\b`),
+	domain.CompetencyInnovation:    regexp.MustCompile(`(?i)\b(innovat(e|ed|ive|ion)|creative|new approach|improv(e|ed) (a|the) process|automat(e|ed))\b`),
+	domain.CompetencyGrowth:        regexp.MustCompile(`(?i)\b(learn(ed)? (a )?new|feedback|grow(th)?|weakness|outside your comfort zone)\b`),
+}
+
+var wordPattern = regexp.MustCompile(`[a-z0-9]+`)
+
+// storyStopwords are too common in questions and stories to signal relevance
+var storyStopwords = map[string]bool{
+	"a": true, "an": true, "and": true, "the": true, "of": true, "to": true, "in": true, "on": true,
+	"for": true, "with": true, "you": true, "your": true, "i": true, "me": true, "my": true, "we": true,
+	"our": true, "it": true, "was": true, "were": true, "is": true, "are": true, "be": true, "had": true,
+	"have": true, "did": true, "do": true, "how": true, "what": true, "when": true, "time": true,
+	"tell": true, "about": true, "describe": true, "give": true, "example": true, "situation": true,
+	"that": true, "this": true, "there": true, "which": true, "who": true, "as": true, "at": true,
+	"by": true, "from": true, "or": true, "so": true, "them": true, "they": true, "us": true,
+}
+
+// Classify returns the competencies a question probes
+func Classify(question string) []domain.Competency {
+	var found []domain.Competency
+	for _, c := range domain.Competencies {
+		if competencyCues[c].MatchString(question) {
+			found = append(found, c)
+		}
+	}
+	return found
+}
+
+// Match ranks stories for a question by shared competencies and vocabulary,
+// returning at most limit matches above the relevance floor
+func Match(stories []domain.Story, question string, limit int) []domain.StoryMatch {
+	wanted := Classify(question)
+	qWords := contentWords(question)
+
+	matches := []domain.StoryMatch{}
+	for _, s := range stories {
+		m := domain.StoryMatch{Story: s}
+		for _, c := range wanted {
+			if hasCompetency(s, c) {
+				m.Matched = append(m.Matched, c)
+			}
+		}
+
+		var score float64
+		if len(wanted) > 0 {
+			score += competencyWeight * float64(len(m.Matched)) / float64(len(wanted))
+		}
+		if len(qWords) > 0 {
+			sWords := contentWords(s.Text() + " " + strings.Join(s.Tags, " "))
+			shared := 0
+			for w := range qWords {
+				if sWords[w] {
+					shared++
+				}
+			}
+			score += overlapWeight * float64(shared) / float64(len(qWords))
+		}
+		if score < minMatchScore {
+			continue
+		}
+		m.Score = float64(int(score*100+0.5)) / 100
+		matches = append(matches, m)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool { return matches[i].Score > matches[j].Score })
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+func contentWords(text string) map[string]bool {
+	words := make(map[string]bool)
+	for _, w := range wordPattern.FindAllString(strings.ToLower(text), -1) {
+		if len(w) > 2 && !storyStopwords[w] {
+			words[w] = true
+		}
+	}
+	return words
+}
+
+// Prompt prefixes an answer-generation prompt with the matched stories so
+// the model builds on them rather than inventing experience
+func Prompt(prompt string, matches []domain.StoryMatch) string {
+	if len(matches) == 0 {
+		return prompt
+	}
+	var b strings.Builder
+	b.WriteString("Build the answer from one of these vetted stories from the candidate's story bank. ")
+	b.WriteString("Do not invent experience beyond them.\n\n")
+	for i, m := range matches {
+		fmt.Fprintf(&b, "Story %d: %s\n\n", i+1, m.Story.Text())
+	}
+	b.WriteString("Question: ")
+	b.WriteString(prompt)
+	return b.String()
+}
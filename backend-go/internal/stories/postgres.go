@@ -0,0 +1,110 @@
+package stories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists the story bank in the stories table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed story store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const storyColumns = `id, title, competencies, situation, task, action, result, tags, created_at, updated_at`
+
+// Save inserts or replaces a story
+func (p *PostgresStore) Save(ctx context.Context, s *domain.Story) error {
+	_, err := p.db.Exec(ctx, `
+		INSERT INTO stories (`+storyColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		ON CONFLICT (id) DO UPDATE SET
+			title = EXCLUDED.title, competencies = EXCLUDED.competencies,
+			situation = EXCLUDED.situation, task = EXCLUDED.task, action = EXCLUDED.action,
+			result = EXCLUDED.result, tags = EXCLUDED.tags, updated_at = EXCLUDED.updated_at`,
+		s.ID, s.Title, competencyStrings(s.Competencies), s.Situation, s.Task, s.Action, s.Result, s.Tags, s.CreatedAt, s.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save story: %w", err)
+	}
+	return nil
+}
+
+// Get returns a story by ID
+func (p *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*domain.Story, error) {
+	s, err := scanStory(p.db.QueryRow(ctx, `SELECT `+storyColumns+` FROM stories WHERE id = $1`, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get story: %w", err)
+	}
+	return s, nil
+}
+
+// List returns stories tagged with competency, or all when it is empty,
+// most recently updated first
+func (p *PostgresStore) List(ctx context.Context, competency domain.Competency) ([]domain.Story, error) {
+	rows, err := p.db.Query(ctx, `
+		SELECT `+storyColumns+` FROM stories
+		WHERE $1 = '' OR $1 = ANY(competencies)
+		ORDER BY updated_at DESC`, string(competency))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list stories: %w", err)
+	}
+	defer rows.Close()
+
+	list := []domain.Story{}
+	for rows.Next() {
+		s, err := scanStory(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan story: %w", err)
+		}
+		list = append(list, *s)
+	}
+	return list, rows.Err()
+}
+
+// Delete removes a story
+func (p *PostgresStore) Delete(ctx context.Context, id uuid.UUID) error {
+	tag, err := p.db.Exec(ctx, `DELETE FROM stories WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete story: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func scanStory(row pgx.Row) (*domain.Story, error) {
+	var s domain.Story
+	var competencies []string
+	if err := row.Scan(&s.ID, &s.Title, &competencies, &s.Situation, &s.Task, &s.Action, &s.Result, &s.Tags, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+	s.Competencies = make([]domain.Competency, len(competencies))
+	for i, c := range competencies {
+		s.Competencies[i] = domain.Competency(c)
+	}
+	return &s, nil
+}
+
+func competencyStrings(cs []domain.Competency) []string {
+	out := make([]string, len(cs))
+	for i, c := range cs {
+		out[i] = string(c)
+	}
+	return out
+}
@@ -0,0 +1,89 @@
+package stories
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ErrNotFound is returned when a story does not exist
+var ErrNotFound = errors.New("story not found")
+
+// Store persists the story bank
+type Store interface {
+	Save(ctx context.Context, s *domain.Story) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.Story, error)
+	List(ctx context.Context, competency domain.Competency) ([]domain.Story, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// MemoryStore keeps stories in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	stories map[uuid.UUID]domain.Story
+}
+
+// NewMemoryStore creates an in-memory story store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{stories: make(map[uuid.UUID]domain.Story)}
+}
+
+// Save inserts or replaces a story
+func (m *MemoryStore) Save(ctx context.Context, s *domain.Story) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stories[s.ID] = *s
+	return nil
+}
+
+// Get returns a story by ID
+func (m *MemoryStore) Get(ctx context.Context, id uuid.UUID) (*domain.Story, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	s, ok := m.stories[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &s, nil
+}
+
+// List returns stories tagged with competency, or all when it is empty,
+// most recently updated first
+func (m *MemoryStore) List(ctx context.Context, competency domain.Competency) ([]domain.Story, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	list := []domain.Story{}
+	for _, s := range m.stories {
+		if competency == "" || hasCompetency(s, competency) {
+			list = append(list, s)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].UpdatedAt.After(list[j].UpdatedAt) })
+	return list, nil
+}
+
+// Delete removes a story
+func (m *MemoryStore) Delete(ctx context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.stories[id]; !ok {
+		return ErrNotFound
+	}
+	delete(m.stories, id)
+	return nil
+}
+
+func hasCompetency(s domain.Story, c domain.Competency) bool {
+	for _, sc := range s.Competencies {
+		if sc == c {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package tailor
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// DOCXContentType is the MIME type of Word documents
+const DOCXContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+
+const docxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">
+<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>
+<Default Extension="xml" ContentType="application/xml"/>
+<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>
+</Types>`
+
+const docxRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>
+</Relationships>`
+
+// WriteDOCX renders resume sections as a minimal Word document. The header
+// section is written first without a heading; bullets keep a bullet glyph.
+func WriteDOCX(w io.Writer, sections []domain.ResumeSection) error {
+	var body bytes.Buffer
+	for _, section := range sections {
+		if section.Name != "Header" {
+			paragraph(&body, section.Name, true, 28)
+		}
+		for _, line := range strings.Split(section.Text, "\n") {
+			if line = strings.TrimSpace(line); line == "" {
+				continue
+			}
+			if bulletLine.MatchString(line) {
+				line = "• " + bulletLine.ReplaceAllString(line, "")
+			}
+			paragraph(&body, line, false, 22)
+		}
+	}
+
+	zw := zip.NewWriter(w)
+	files := []struct{ name, content string }{
+		{"[Content_Types].xml", docxContentTypes},
+		{"_rels/.rels", docxRels},
+		{"word/document.xml", `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` +
+			`<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>` +
+			body.String() + `</w:body></w:document>`},
+	}
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+		if _, err := io.WriteString(fw, f.content); err != nil {
+			return fmt.Errorf("failed to write %s: %w", f.name, err)
+		}
+	}
+	return zw.Close()
+}
+
+// Render returns resume sections as plain text, each under its heading
+func Render(sections []domain.ResumeSection) string {
+	var b strings.Builder
+	for i, section := range sections {
+		if i > 0 {
+			b.WriteString("\n\n")
+		}
+		if section.Name != "Header" {
+			b.WriteString(strings.ToUpper(section.Name) + "\n")
+		}
+		b.WriteString(section.Text)
+	}
+	return b.String()
+}
+
+// paragraph writes one paragraph; size is in half-points
+func paragraph(buf *bytes.Buffer, text string, bold bool, size int) {
+	buf.WriteString(`<w:p><w:r><w:rPr>`)
+	if bold {
+		buf.WriteString(`<w:b/>`)
+	}
+	fmt.Fprintf(buf, `<w:sz w:val="%d"/></w:rPr><w:t xml:space="preserve">`, size)
+	_ = xml.EscapeText(buf, []byte(text))
+	buf.WriteString(`</w:t></w:r></w:p>`)
+}
@@ -0,0 +1,105 @@
+package tailor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists resume variants as versions in the resumes table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed variant store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+const variantColumns = `id, application_id, job_id, name, base_hash, sections, changes, created_at`
+
+// Save inserts a variant as a new resume version and links it to its application
+func (s *PostgresStore) Save(ctx context.Context, v *domain.ResumeVariant) error {
+	sections, err := json.Marshal(v.Sections)
+	if err != nil {
+		return err
+	}
+	changes, err := json.Marshal(v.Changes)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO resumes (`+variantColumns+`, content)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		v.ID, v.ApplicationID, v.JobID, v.Version, v.BaseHash, sections, changes, v.CreatedAt, Render(v.Sections),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save resume variant: %w", err)
+	}
+	if _, err := tx.Exec(ctx, `UPDATE applications SET resume_id = $1, resume_version = $2 WHERE id = $3`, v.ID, v.Version, v.ApplicationID); err != nil {
+		return fmt.Errorf("failed to link resume variant: %w", err)
+	}
+	return tx.Commit(ctx)
+}
+
+// Get returns a variant by ID
+func (s *PostgresStore) Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVariant, error) {
+	row := s.db.QueryRow(ctx, `SELECT `+variantColumns+` FROM resumes WHERE id = $1 AND application_id IS NOT NULL`, id)
+	v, err := scanVariant(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return v, err
+}
+
+// ListForApplication returns an application's variants, newest first
+func (s *PostgresStore) ListForApplication(ctx context.Context, appID uuid.UUID) ([]domain.ResumeVariant, error) {
+	rows, err := s.db.Query(ctx, `
+		SELECT `+variantColumns+` FROM resumes
+		WHERE application_id = $1 ORDER BY created_at DESC`, appID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resume variants: %w", err)
+	}
+	defer rows.Close()
+
+	variants := []domain.ResumeVariant{}
+	for rows.Next() {
+		v, err := scanVariant(rows)
+		if err != nil {
+			return nil, err
+		}
+		variants = append(variants, *v)
+	}
+	return variants, rows.Err()
+}
+
+func scanVariant(row pgx.Row) (*domain.ResumeVariant, error) {
+	var (
+		v                 domain.ResumeVariant
+		sections, changes []byte
+	)
+	if err := row.Scan(&v.ID, &v.ApplicationID, &v.JobID, &v.Version, &v.BaseHash, &sections, &changes, &v.CreatedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(sections, &v.Sections); err != nil {
+		return nil, fmt.Errorf("invalid resume variant sections: %w", err)
+	}
+	if err := json.Unmarshal(changes, &v.Changes); err != nil {
+		return nil, fmt.Errorf("invalid resume variant changes: %w", err)
+	}
+	return &v, nil
+}
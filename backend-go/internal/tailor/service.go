@@ -0,0 +1,83 @@
+package tailor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ErrApplicationNotFound is returned when the application to tailor for does not exist
+var ErrApplicationNotFound = errors.New("application not found")
+
+// Applications is the subset of the job list service used to tailor resumes
+type Applications interface {
+	GetApplication(ctx context.Context, appID uuid.UUID) (*domain.Application, error)
+	GetJobDetails(ctx context.Context, jobID uuid.UUID) (*domain.Job, error)
+	UpdateApplication(ctx context.Context, appID uuid.UUID, req domain.ApplicationUpdate) (*domain.Application, error)
+}
+
+// Service creates and stores resume variants for applications
+type Service struct {
+	tailorer *Tailorer
+	store    Store
+	apps     Applications
+}
+
+// NewService creates a resume variant service
+func NewService(tailorer *Tailorer, store Store, apps Applications) *Service {
+	return &Service{tailorer: tailorer, store: store, apps: apps}
+}
+
+// Create tailors the base resume to the application's job, stores the
+// variant and records its version on the application
+func (s *Service) Create(ctx context.Context, appID uuid.UUID, base string) (*domain.ResumeVariant, error) {
+	app, err := s.apps.GetApplication(ctx, appID)
+	if err != nil {
+		return nil, ErrApplicationNotFound
+	}
+	job, err := s.apps.GetJobDetails(ctx, app.Job.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load job: %w", err)
+	}
+
+	variant := s.tailorer.Tailor(ctx, base, job)
+	variant.ApplicationID = appID
+
+	// Several variants on the same day get -v2, -v3 suffixes
+	existing, err := s.store.ListForApplication(ctx, appID)
+	if err != nil {
+		return nil, err
+	}
+	taken := 0
+	for _, v := range existing {
+		if strings.HasPrefix(v.Version, variant.Version) {
+			taken++
+		}
+	}
+	if taken > 0 {
+		variant.Version = fmt.Sprintf("%s-v%d", variant.Version, taken+1)
+	}
+
+	if err := s.store.Save(ctx, variant); err != nil {
+		return nil, err
+	}
+	if _, err := s.apps.UpdateApplication(ctx, appID, domain.ApplicationUpdate{ResumeVersion: &variant.Version}); err != nil {
+		return nil, fmt.Errorf("failed to record resume version: %w", err)
+	}
+	return variant, nil
+}
+
+// Get returns a variant by ID
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVariant, error) {
+	return s.store.Get(ctx, id)
+}
+
+// ListForApplication returns an application's variants, newest first
+func (s *Service) ListForApplication(ctx context.Context, appID uuid.UUID) ([]domain.ResumeVariant, error) {
+	return s.store.ListForApplication(ctx, appID)
+}
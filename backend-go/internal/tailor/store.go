@@ -0,0 +1,67 @@
+package tailor
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ErrNotFound is returned when a resume variant does not exist
+var ErrNotFound = errors.New("resume variant not found")
+
+// Store persists resume variants
+type Store interface {
+	Save(ctx context.Context, v *domain.ResumeVariant) error
+	Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVariant, error)
+	ListForApplication(ctx context.Context, appID uuid.UUID) ([]domain.ResumeVariant, error)
+}
+
+// MemoryStore keeps resume variants in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	variants []domain.ResumeVariant
+}
+
+// NewMemoryStore creates an in-memory variant store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// Save stores a variant
+func (s *MemoryStore) Save(ctx context.Context, v *domain.ResumeVariant) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.variants = append(s.variants, *v)
+	return nil
+}
+
+// Get returns a variant by ID
+func (s *MemoryStore) Get(ctx context.Context, id uuid.UUID) (*domain.ResumeVariant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for i := range s.variants {
+		if s.variants[i].ID == id {
+			v := s.variants[i]
+			return &v, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// ListForApplication returns an application's variants, newest first
+func (s *MemoryStore) ListForApplication(ctx context.Context, appID uuid.UUID) ([]domain.ResumeVariant, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	variants := []domain.ResumeVariant{}
+	for i := len(s.variants) - 1; i >= 0; i-- {
+		if s.variants[i].ApplicationID == appID {
+			variants = append(variants, s.variants[i])
+		}
+	}
+	return variants, nil
+}
@@ -0,0 +1,219 @@
+package tailor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/coverage"
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/llm"
+	"github.com/resume-rag/backend/internal/rag"
+)
+
+const (
+	// maxJobText caps the job description sent in the prompt
+	maxJobText = 8000
+	// suggestTokens and draftTokens cap the reply without and with a draft
+	suggestTokens = 2048
+	draftTokens   = 4096
+)
+
+var (
+	// ErrInvalid is returned for a tailoring request without a job
+	ErrInvalid = errors.New("invalid tailoring request")
+	// ErrJobNotFound is returned when the job to tailor for does not exist
+	ErrJobNotFound = errors.New("job not found")
+	// ErrBadReply is returned when the LLM's reply is not the requested JSON
+	ErrBadReply = errors.New("llm reply is not valid tailoring JSON")
+)
+
+const suggestPrompt = `You tailor a candidate's resume to a job. Compare the resume with the job and reply with JSON only, in this shape:
+{"summary": "one or two sentences on how well the resume fits and what to change",
+ "rewrites": [{"section": "Experience", "before": "the bullet exactly as written", "after": "the rewritten bullet", "keywords": ["job keywords used"], "rationale": "why"}],
+ "keywords": [{"keyword": "a job keyword the resume leaves out", "section": "where it belongs", "suggestion": "the resume experience that supports it"}]%s}
+Rewrite only bullets that gain from it, quoting "before" exactly. Only suggest keywords the candidate's experience supports. Never invent experience, employers, titles, dates or numbers.`
+
+const draftField = `,
+ "draft": "the full tailored resume in plain text, without the header, with the same section headings"`
+
+// Completer sends a prompt to an LLM backend
+type Completer interface {
+	Complete(ctx context.Context, backend string, req llm.Request) (*llm.Completion, error)
+}
+
+// Redactor strips personal details from resume text bound for an LLM backend
+type Redactor interface {
+	Backend(ctx context.Context) string
+	Excerpts(ctx context.Context, resume string, excerpts []string) []string
+}
+
+// Jobs loads the stored job a resume is tailored to
+type Jobs interface {
+	GetJobDetails(ctx context.Context, jobID uuid.UUID) (*domain.Job, error)
+}
+
+// Advisor asks an LLM how to tailor a resume to a job. Unlike the Tailorer
+// it rewrites wording, so its output is returned as suggestions for the
+// user to review rather than stored as a variant.
+type Advisor struct {
+	llm      Completer
+	redactor Redactor
+	jobs     Jobs
+}
+
+// NewAdvisor creates a tailoring advisor. redactor may be nil, in which case
+// the resume is sent as is; jobs may be nil to accept only pasted descriptions.
+func NewAdvisor(completer Completer, redactor Redactor, jobs Jobs) *Advisor {
+	return &Advisor{llm: completer, redactor: redactor, jobs: jobs}
+}
+
+// Suggest compares resume with the requested job and returns bullet
+// rewrites, keywords to add and, when asked for, a tailored draft. The
+// resume header never leaves the server; the draft is returned with it.
+func (a *Advisor) Suggest(ctx context.Context, resume string, req domain.ResumeTailorRequest) (*domain.ResumeTailoring, error) {
+	job, err := a.job(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var header string
+	var body []domain.ResumeSection
+	for _, section := range coverage.SplitSections(resume) {
+		if section.Name == "Header" {
+			header = section.Text
+			continue
+		}
+		body = append(body, section)
+	}
+	if len(body) == 0 {
+		return nil, fmt.Errorf("%w: the resume has no sections to tailor", ErrInvalid)
+	}
+	text := Render(body)
+
+	var backend string
+	if a.redactor != nil {
+		text = a.redactor.Excerpts(ctx, resume, []string{text})[0]
+		backend = a.redactor.Backend(ctx)
+	}
+
+	system, maxTokens := fmt.Sprintf(suggestPrompt, ""), suggestTokens
+	if req.IncludeDraft {
+		system, maxTokens = fmt.Sprintf(suggestPrompt, draftField), draftTokens
+	}
+	completion, err := a.llm.Complete(ctx, backend, llm.Request{
+		System:    system,
+		Messages:  []llm.Message{{Role: "user", Content: "JOB\n" + jobText(job) + "\n\nRESUME\n" + text}},
+		MaxTokens: maxTokens,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := parseSuggestions(completion.Text, text)
+	if err != nil {
+		return nil, err
+	}
+	if req.IncludeDraft && result.Draft != "" && header != "" {
+		result.Draft = header + "\n\n" + result.Draft
+	}
+	if job.ID != uuid.Nil {
+		result.JobID = &job.ID
+	}
+	result.Backend, result.Model = completion.Backend, completion.Model
+	return result, nil
+}
+
+// job returns the stored job, or a job holding the pasted description
+func (a *Advisor) job(ctx context.Context, req domain.ResumeTailorRequest) (*domain.Job, error) {
+	if req.JobID != nil {
+		if a.jobs == nil {
+			return nil, fmt.Errorf("%w: stored jobs are unavailable; send job_description", ErrInvalid)
+		}
+		job, err := a.jobs.GetJobDetails(ctx, *req.JobID)
+		if err != nil {
+			return nil, ErrJobNotFound
+		}
+		return job, nil
+	}
+	if description := strings.TrimSpace(req.JobDescription); description != "" {
+		return &domain.Job{Description: description}, nil
+	}
+	return nil, fmt.Errorf("%w: job_id or job_description is required", ErrInvalid)
+}
+
+// jobText is the job as given to the LLM
+func jobText(job *domain.Job) string {
+	var b strings.Builder
+	if job.Title != "" {
+		fmt.Fprintf(&b, "Title: %s\n", job.Title)
+	}
+	if job.Company.Name != "" {
+		fmt.Fprintf(&b, "Company: %s\n", job.Company.Name)
+	}
+	if len(job.Requirements) > 0 {
+		b.WriteString("Requirements:\n- " + strings.Join(job.Requirements, "\n- ") + "\n")
+	}
+	description := job.Description
+	if len(description) > maxJobText {
+		description = strings.ToValidUTF8(description[:maxJobText], "")
+	}
+	b.WriteString(description)
+	return b.String()
+}
+
+// parseSuggestions reads the LLM's JSON reply. Rewrites of bullets the
+// resume doesn't contain and keywords it already mentions are dropped.
+func parseSuggestions(reply, resume string) (*domain.ResumeTailoring, error) {
+	var parsed struct {
+		Summary  string `json:"summary"`
+		Rewrites []struct {
+			Section   string   `json:"section"`
+			Before    string   `json:"before"`
+			After     string   `json:"after"`
+			Keywords  []string `json:"keywords"`
+			Rationale string   `json:"rationale"`
+		} `json:"rewrites"`
+		Keywords []domain.KeywordInsertion `json:"keywords"`
+		Draft    string                    `json:"draft"`
+	}
+	start, end := strings.Index(reply, "{"), strings.LastIndex(reply, "}")
+	if start < 0 || end < start || json.Unmarshal([]byte(reply[start:end+1]), &parsed) != nil {
+		return nil, ErrBadReply
+	}
+
+	lowerResume := strings.ToLower(resume)
+	result := &domain.ResumeTailoring{
+		Summary:  strings.TrimSpace(parsed.Summary),
+		Rewrites: []domain.BulletRewrite{},
+		Keywords: []domain.KeywordInsertion{},
+		Draft:    strings.TrimSpace(parsed.Draft),
+	}
+	for _, r := range parsed.Rewrites {
+		before := strings.TrimSpace(bulletLine.ReplaceAllString(r.Before, ""))
+		after := strings.TrimSpace(bulletLine.ReplaceAllString(r.After, ""))
+		if before == "" || after == "" || after == before || !strings.Contains(resume, before) {
+			continue
+		}
+		result.Rewrites = append(result.Rewrites, domain.BulletRewrite{
+			ID:        fmt.Sprintf("rewrite-%d", len(result.Rewrites)+1),
+			Section:   r.Section,
+			Before:    before,
+			After:     after,
+			Keywords:  rag.AnnotateKeywords(before, after, r.Keywords),
+			Rationale: r.Rationale,
+		})
+	}
+	for _, k := range parsed.Keywords {
+		k.Keyword = strings.TrimSpace(k.Keyword)
+		if k.Keyword == "" || strings.Contains(lowerResume, strings.ToLower(k.Keyword)) {
+			continue
+		}
+		result.Keywords = append(result.Keywords, k)
+	}
+	return result, nil
+}
@@ -0,0 +1,254 @@
+package tailor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/analytics"
+	"github.com/resume-rag/backend/internal/coverage"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// maxSummarySkills caps how many skills are named in the adjusted summary
+const maxSummarySkills = 4
+
+// bulletLine matches a resume bullet
+var bulletLine = regexp.MustCompile(`^\s*[-*•·▪◦]\s+`)
+
+// slugPattern matches runs of characters not allowed in a version label
+var slugPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// SummaryWriter optionally rewrites the resume summary for a job, e.g. with an LLM
+type SummaryWriter interface {
+	RewriteSummary(ctx context.Context, summary string, job *domain.Job) (string, error)
+}
+
+// Tailorer produces resume variants tailored to a job. Edits only rearrange
+// and surface what the base resume already shows; it never invents experience.
+type Tailorer struct {
+	writer SummaryWriter
+}
+
+// NewTailorer creates a tailorer; writer may be nil to use the built-in
+// summary adjustment
+func NewTailorer(writer SummaryWriter) *Tailorer {
+	return &Tailorer{writer: writer}
+}
+
+// Tailor builds a variant of the base resume for job
+func (t *Tailorer) Tailor(ctx context.Context, base string, job *domain.Job) *domain.ResumeVariant {
+	sum := sha256.Sum256([]byte(base))
+	variant := &domain.ResumeVariant{
+		ID:        uuid.New(),
+		JobID:     job.ID,
+		Version:   versionLabel(job, time.Now()),
+		BaseHash:  hex.EncodeToString(sum[:]),
+		Changes:   []domain.ResumeChange{},
+		CreatedAt: time.Now(),
+	}
+
+	sections := coverage.SplitSections(base)
+	skills := jobSkills(job)
+	shown := resumeSkills(sections)
+
+	for i := range sections {
+		switch sections[i].Name {
+		case "Experience", "Projects":
+			t.reorderBullets(&sections[i], skills, variant)
+		case "Summary":
+			t.adjustSummary(ctx, &sections[i], job, skills, shown, variant)
+		}
+	}
+	addKeywords(sections, skills, variant)
+
+	variant.Sections = sections
+	return variant
+}
+
+// jobSkills returns the normalized skills a job asks for, most specific first
+func jobSkills(job *domain.Job) []string {
+	seen := make(map[string]bool)
+	var skills []string
+	for _, skill := range append(analytics.JobSkills(job), analytics.ExtractSkills(strings.Join(job.Requirements, "\n"))...) {
+		if !seen[skill] {
+			seen[skill] = true
+			skills = append(skills, skill)
+		}
+	}
+	return skills
+}
+
+// resumeSkills returns the skills mentioned anywhere in the resume
+func resumeSkills(sections []domain.ResumeSection) map[string]bool {
+	shown := make(map[string]bool)
+	for _, section := range sections {
+		for _, skill := range analytics.ExtractSkills(section.Text) {
+			shown[skill] = true
+		}
+	}
+	return shown
+}
+
+// relevance counts the job skills a line mentions
+func relevance(line string, skills []string) int {
+	mentioned := make(map[string]bool)
+	for _, skill := range analytics.ExtractSkills(line) {
+		mentioned[skill] = true
+	}
+	n := 0
+	for _, skill := range skills {
+		if mentioned[skill] {
+			n++
+		}
+	}
+	return n
+}
+
+// reorderBullets moves the most relevant bullets to the top of each run of
+// bullets, so each role leads with the work the job cares about
+func (t *Tailorer) reorderBullets(section *domain.ResumeSection, skills []string, variant *domain.ResumeVariant) {
+	lines := strings.Split(section.Text, "\n")
+	for start := 0; start < len(lines); {
+		if !bulletLine.MatchString(lines[start]) {
+			start++
+			continue
+		}
+		end := start
+		for end < len(lines) && bulletLine.MatchString(lines[end]) {
+			end++
+		}
+
+		run := append([]string(nil), lines[start:end]...)
+		sort.SliceStable(run, func(i, j int) bool {
+			return relevance(run[i], skills) > relevance(run[j], skills)
+		})
+		if run[0] != lines[start] {
+			variant.Changes = append(variant.Changes, domain.ResumeChange{
+				Kind:    domain.ResumeChangeReordered,
+				Section: section.Name,
+				Before:  strings.Join(lines[start:end], "\n"),
+				After:   strings.Join(run, "\n"),
+			})
+			copy(lines[start:end], run)
+		}
+		start = end
+	}
+	section.Text = strings.Join(lines, "\n")
+}
+
+// adjustSummary rewrites the summary through the SummaryWriter, or else adds
+// a lead sentence naming the role and the matching skills the resume shows
+func (t *Tailorer) adjustSummary(ctx context.Context, section *domain.ResumeSection, job *domain.Job, skills []string, shown map[string]bool, variant *domain.ResumeVariant) {
+	before := section.Text
+	if t.writer != nil {
+		if rewritten, err := t.writer.RewriteSummary(ctx, before, job); err == nil && strings.TrimSpace(rewritten) != "" {
+			section.Text = strings.TrimSpace(rewritten)
+		}
+	}
+
+	if section.Text == before {
+		var matching []string
+		for _, skill := range skills {
+			if shown[skill] && len(matching) < maxSummarySkills {
+				matching = append(matching, skill)
+			}
+		}
+		if len(matching) == 0 {
+			return
+		}
+		lead := fmt.Sprintf("%s with hands-on experience in %s.", roleTitle(job), joinList(matching))
+		section.Text = lead + " " + before
+	}
+
+	variant.Changes = append(variant.Changes, domain.ResumeChange{
+		Kind:    domain.ResumeChangeSummary,
+		Section: section.Name,
+		Before:  before,
+		After:   section.Text,
+	})
+}
+
+// addKeywords appends job skills that the resume demonstrates elsewhere but
+// leaves out of its skills list, so keyword screens see them
+func addKeywords(sections []domain.ResumeSection, skills []string, variant *domain.ResumeVariant) {
+	idx := -1
+	for i, section := range sections {
+		if section.Name == "Skills" {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return
+	}
+
+	listed := make(map[string]bool)
+	for _, skill := range analytics.ExtractSkills(sections[idx].Text) {
+		listed[skill] = true
+	}
+	elsewhere := make(map[string]bool)
+	for i, section := range sections {
+		if i == idx {
+			continue
+		}
+		for _, skill := range analytics.ExtractSkills(section.Text) {
+			elsewhere[skill] = true
+		}
+	}
+
+	var added []string
+	for _, skill := range skills {
+		if elsewhere[skill] && !listed[skill] {
+			added = append(added, skill)
+		}
+	}
+	if len(added) == 0 {
+		return
+	}
+
+	before := sections[idx].Text
+	sections[idx].Text = strings.TrimRight(before, " ,\n") + ", " + strings.Join(added, ", ")
+	for _, skill := range added {
+		variant.Changes = append(variant.Changes, domain.ResumeChange{
+			Kind:    domain.ResumeChangeKeyword,
+			Section: sections[idx].Name,
+			After:   skill,
+		})
+	}
+}
+
+// roleTitle is the job title used to open the summary
+func roleTitle(job *domain.Job) string {
+	title := strings.TrimSpace(job.Title)
+	if title == "" {
+		return "Engineer"
+	}
+	return title
+}
+
+func joinList(items []string) string {
+	if len(items) == 1 {
+		return items[0]
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+// versionLabel names a variant after the company, role and date
+func versionLabel(job *domain.Job, now time.Time) string {
+	label := strings.Trim(slugPattern.ReplaceAllString(strings.ToLower(job.Company.Name+" "+job.Title), "-"), "-")
+	if len(label) > 60 {
+		label = strings.TrimRight(label[:60], "-")
+	}
+	if label == "" {
+		label = "tailored"
+	}
+	return label + "-" + now.Format("20060102")
+}
@@ -0,0 +1,66 @@
+// Package taskevents keeps a shared timeline for background tasks. Scrapes,
+// matching, embedding, digests and cleanups append an event at each step,
+// so any background operation can be followed through one endpoint.
+package taskevents
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/pkg/logger"
+)
+
+type contextKey struct{}
+
+// WithTask returns a context whose work is recorded on taskID's timeline,
+// for steps such as embedding and matching that run on behalf of a task
+func WithTask(ctx context.Context, taskID uuid.UUID) context.Context {
+	return context.WithValue(ctx, contextKey{}, taskID)
+}
+
+// TaskID returns the task ctx works on behalf of, if any
+func TaskID(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(contextKey{}).(uuid.UUID)
+	return id, ok
+}
+
+// Log appends task events to a store. A nil Log records nothing, so
+// subsystems can record unconditionally.
+type Log struct {
+	store Store
+}
+
+// NewLog creates a task event log
+func NewLog(store Store) *Log {
+	return &Log{store: store}
+}
+
+// Record appends an event to a task's timeline. Events are best effort: a
+// failed append is logged and never fails the task.
+func (l *Log) Record(ctx context.Context, taskID uuid.UUID, kind domain.TaskKind, typ domain.TaskEventType, message string, data map[string]interface{}) {
+	if l == nil || taskID == uuid.Nil {
+		return
+	}
+	event := &domain.TaskEvent{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		Kind:      kind,
+		Type:      typ,
+		Message:   message,
+		Data:      data,
+		CreatedAt: time.Now(),
+	}
+	if err := l.store.Append(ctx, event); err != nil {
+		logger.Warn("Failed to record task event",
+			zap.String("task_id", taskID.String()), zap.String("kind", string(kind)), zap.Error(err))
+	}
+}
+
+// List returns a task's events, oldest first
+func (l *Log) List(ctx context.Context, taskID uuid.UUID) ([]domain.TaskEvent, error) {
+	return l.store.List(ctx, taskID)
+}
@@ -0,0 +1,63 @@
+package taskevents
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// PostgresStore persists task events in the task_events table
+type PostgresStore struct {
+	db *pgxpool.Pool
+}
+
+// NewPostgresStore creates a Postgres-backed task event store
+func NewPostgresStore(db *pgxpool.Pool) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+// Append inserts an event
+func (s *PostgresStore) Append(ctx context.Context, event *domain.TaskEvent) error {
+	data := event.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	_, err := s.db.Exec(ctx, `
+		INSERT INTO task_events (id, task_id, kind, type, message, data, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)`,
+		event.ID, event.TaskID, event.Kind, event.Type, event.Message, data, event.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to append task event: %w", err)
+	}
+	return nil
+}
+
+// List returns a task's events, oldest first
+func (s *PostgresStore) List(ctx context.Context, taskID uuid.UUID) ([]domain.TaskEvent, error) {
+	rows, err := s.db.Query(ctx, `
+		SELECT id, task_id, kind, type, message, data, created_at
+		FROM task_events WHERE task_id = $1
+		ORDER BY created_at, id`, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list task events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []domain.TaskEvent{}
+	for rows.Next() {
+		var e domain.TaskEvent
+		if err := rows.Scan(&e.ID, &e.TaskID, &e.Kind, &e.Type, &e.Message, &e.Data, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan task event: %w", err)
+		}
+		if len(e.Data) == 0 {
+			e.Data = nil
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
@@ -0,0 +1,65 @@
+package taskevents
+
+import (
+	"context"
+	"sync"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+	"github.com/resume-rag/backend/internal/tenant"
+)
+
+// memoryTasks is how many tasks' timelines the memory store keeps
+const memoryTasks = 500
+
+// Store persists task events
+type Store interface {
+	Append(ctx context.Context, event *domain.TaskEvent) error
+	// List returns a task's events, oldest first
+	List(ctx context.Context, taskID uuid.UUID) ([]domain.TaskEvent, error)
+}
+
+type memoryKey struct {
+	tenant string
+	task   uuid.UUID
+}
+
+// MemoryStore keeps the timelines of the most recent tasks in memory.
+// Used when no database is connected.
+type MemoryStore struct {
+	mu     sync.Mutex
+	events map[memoryKey][]domain.TaskEvent
+	order  []memoryKey
+}
+
+// NewMemoryStore creates an in-memory task event store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{events: make(map[memoryKey][]domain.TaskEvent)}
+}
+
+// Append adds an event to its task's timeline, dropping the oldest task's
+// timeline once memoryTasks tasks are kept
+func (m *MemoryStore) Append(ctx context.Context, event *domain.TaskEvent) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := memoryKey{tenant: tenant.ID(ctx), task: event.TaskID}
+	if _, ok := m.events[key]; !ok {
+		m.order = append(m.order, key)
+		if len(m.order) > memoryTasks {
+			delete(m.events, m.order[0])
+			m.order = m.order[1:]
+		}
+	}
+	m.events[key] = append(m.events[key], *event)
+	return nil
+}
+
+// List returns a task's events, oldest first
+func (m *MemoryStore) List(ctx context.Context, taskID uuid.UUID) ([]domain.TaskEvent, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	events := m.events[memoryKey{tenant: tenant.ID(ctx), task: taskID}]
+	return append([]domain.TaskEvent{}, events...), nil
+}
@@ -0,0 +1,21 @@
+package tenant
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ConfigurePool scopes pooled connections to the tenant of the context they
+// are acquired with. Tenant-owned tables enforce row-level security on the
+// app.tenant_id setting (migrations/028_tenants.sql), so stores need no
+// tenant filters of their own.
+func ConfigurePool(cfg *pgxpool.Config) {
+	cfg.BeforeAcquire = func(ctx context.Context, conn *pgx.Conn) bool {
+		// A connection that can't be scoped is discarded rather than
+		// handed out with the previous tenant's setting
+		_, err := conn.Exec(ctx, "SELECT set_config('app.tenant_id', $1, false)", ID(ctx))
+		return err == nil
+	}
+}
@@ -0,0 +1,152 @@
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/config"
+)
+
+// DefaultID is the tenant of data created without tenancy enabled
+const DefaultID = "default"
+
+var (
+	// ErrRequired is returned when a request names no tenant and there is no default
+	ErrRequired = errors.New("tenant required")
+	// ErrUnknown is returned for a tenant that is not configured
+	ErrUnknown = errors.New("unknown tenant")
+)
+
+// validID matches tenant IDs, which also serve as subdomains
+var validID = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+type contextKey struct{}
+
+// Key is the context key holding the request's tenant. Setting it as a
+// fiber.Ctx local makes the tenant visible through c.Context() as well.
+var Key = contextKey{}
+
+// WithTenant returns a context carrying the tenant
+func WithTenant(ctx context.Context, t config.TenantConfig) context.Context {
+	return context.WithValue(ctx, Key, t)
+}
+
+// FromContext returns the tenant carried by ctx
+func FromContext(ctx context.Context) (config.TenantConfig, bool) {
+	t, ok := ctx.Value(Key).(config.TenantConfig)
+	return t, ok
+}
+
+// ID returns the tenant ID carried by ctx, or DefaultID
+func ID(ctx context.Context) string {
+	if t, ok := FromContext(ctx); ok {
+		return t.ID
+	}
+	return DefaultID
+}
+
+// IDs returns every tenant that can own data: the default tenant, which
+// holds data from before tenancy was enabled, and each configured tenant
+func IDs(cfg config.TenancyConfig) []string {
+	ids := []string{DefaultID}
+	for _, t := range cfg.Tenants {
+		if t.ID != DefaultID {
+			ids = append(ids, t.ID)
+		}
+	}
+	return ids
+}
+
+// Registry holds the configured tenants and resolves requests to them
+type Registry struct {
+	header     string
+	baseDomain string
+	fallback   string
+	tenants    map[string]config.TenantConfig
+}
+
+// NewRegistry creates a registry from configuration
+func NewRegistry(cfg config.TenancyConfig) (*Registry, error) {
+	r := &Registry{
+		header:     cfg.Header,
+		baseDomain: strings.ToLower(strings.TrimPrefix(cfg.BaseDomain, ".")),
+		fallback:   cfg.Default,
+		tenants:    make(map[string]config.TenantConfig, len(cfg.Tenants)),
+	}
+	if r.header == "" {
+		r.header = "X-Tenant-ID"
+	}
+
+	for _, t := range cfg.Tenants {
+		if !validID.MatchString(t.ID) {
+			return nil, fmt.Errorf("invalid tenant id %q", t.ID)
+		}
+		if _, dup := r.tenants[t.ID]; dup {
+			return nil, fmt.Errorf("duplicate tenant id %q", t.ID)
+		}
+		r.tenants[t.ID] = t
+	}
+	if r.fallback != "" {
+		if _, ok := r.tenants[r.fallback]; !ok {
+			return nil, fmt.Errorf("default tenant %q is not configured", r.fallback)
+		}
+	}
+	return r, nil
+}
+
+// Header is the request header naming the tenant
+func (r *Registry) Header() string {
+	return r.header
+}
+
+// Tenants returns every configured tenant
+func (r *Registry) Tenants() []config.TenantConfig {
+	out := make([]config.TenantConfig, 0, len(r.tenants))
+	for _, t := range r.tenants {
+		out = append(out, t)
+	}
+	return out
+}
+
+// Resolve finds the tenant named by the header value, or else by the host's
+// subdomain, falling back to the default tenant
+func (r *Registry) Resolve(header, host string) (config.TenantConfig, error) {
+	id := strings.ToLower(strings.TrimSpace(header))
+	if id == "" {
+		id = r.subdomain(host)
+	}
+	if id == "" {
+		id = r.fallback
+	}
+	if id == "" {
+		return config.TenantConfig{}, ErrRequired
+	}
+
+	t, ok := r.tenants[id]
+	if !ok {
+		return config.TenantConfig{}, fmt.Errorf("%w: %s", ErrUnknown, id)
+	}
+	return t, nil
+}
+
+// subdomain returns the label in front of the base domain, e.g. acme for
+// acme.example.com; deeper subdomains are not tenants
+func (r *Registry) subdomain(host string) string {
+	if r.baseDomain == "" {
+		return ""
+	}
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	host = strings.ToLower(host)
+
+	label, ok := strings.CutSuffix(host, "."+r.baseDomain)
+	if !ok || strings.Contains(label, ".") {
+		return ""
+	}
+	return label
+}
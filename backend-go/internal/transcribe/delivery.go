@@ -0,0 +1,91 @@
+package transcribe
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// Comfortable interview speaking pace, in words per minute
+const (
+	slowPace = 110
+	fastPace = 170
+)
+
+// fillerWords are counted wherever they appear
+var fillerWords = map[string]bool{
+	"um": true, "umm": true, "uh": true, "uhh": true, "er": true, "erm": true,
+	"ah": true, "hmm": true, "basically": true, "literally": true,
+}
+
+// fillerPhrases are multi-word fillers, matched on consecutive words
+var fillerPhrases = [][]string{
+	{"you", "know"},
+	{"i", "mean"},
+}
+
+// Delivery measures filler words and, when the answer's duration is known,
+// speaking pace. "like" only counts when set off by commas, since it is
+// usually a real word.
+func Delivery(text string, durationSeconds float64) domain.DeliveryMetrics {
+	m := domain.DeliveryMetrics{Fillers: make(map[string]int)}
+
+	tokens := strings.Fields(strings.ToLower(text))
+	words := make([]string, 0, len(tokens))
+	for i, token := range tokens {
+		word := strings.TrimFunc(token, func(r rune) bool { return !unicode.IsLetter(r) && r != '\'' })
+		if word == "" {
+			continue
+		}
+		words = append(words, word)
+		switch {
+		case fillerWords[word]:
+			m.Fillers[word]++
+		case word == "like" && (strings.HasSuffix(token, ",") || i > 0 && strings.HasSuffix(tokens[i-1], ",")):
+			m.Fillers[word]++
+		}
+	}
+	for _, phrase := range fillerPhrases {
+		for i := 0; i+len(phrase) <= len(words); i++ {
+			if equalWords(words[i:i+len(phrase)], phrase) {
+				m.Fillers[strings.Join(phrase, " ")]++
+			}
+		}
+	}
+
+	m.WordCount = len(words)
+	for _, n := range m.Fillers {
+		m.FillerWords += n
+	}
+	if m.WordCount > 0 {
+		m.FillerRate = round1(float64(m.FillerWords) * 100 / float64(m.WordCount))
+	}
+
+	if durationSeconds > 0 && m.WordCount > 0 {
+		m.WordsPerMinute = round1(float64(m.WordCount) * 60 / durationSeconds)
+		switch {
+		case m.WordsPerMinute < slowPace:
+			m.Pace = "slow"
+		case m.WordsPerMinute > fastPace:
+			m.Pace = "fast"
+		default:
+			m.Pace = "good"
+		}
+	}
+	return m
+}
+
+func equalWords(a, b []string) bool {
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func round1(f float64) float64 {
+	return math.Round(f*10) / 10
+}
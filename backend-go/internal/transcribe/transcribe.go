@@ -0,0 +1,46 @@
+package transcribe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// ErrEmptyAudio is returned when the audio contains no recognisable speech
+var ErrEmptyAudio = errors.New("no speech recognised in audio")
+
+// Transcriber converts spoken audio to text
+type Transcriber interface {
+	Transcribe(ctx context.Context, audio io.Reader, filename string) (*domain.Transcript, error)
+}
+
+// New creates the transcriber selected by configuration. The API key falls
+// back to the LLM key of the same provider. Returns nil when transcription
+// is disabled.
+func New(cfg config.TranscriptionConfig, llm config.LLMConfig) (Transcriber, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	switch cfg.Provider {
+	case "", "openai":
+		if cfg.APIKey == "" {
+			cfg.APIKey = llm.OpenAI.APIKey
+		}
+	case "groq":
+		if cfg.APIKey == "" {
+			cfg.APIKey = llm.Groq.APIKey
+		}
+	default:
+		return nil, fmt.Errorf("unknown transcription provider: %s", cfg.Provider)
+	}
+	if cfg.APIKey == "" {
+		return nil, fmt.Errorf("transcription provider %s needs an API key", cfg.Provider)
+	}
+
+	return NewWhisperTranscriber(cfg), nil
+}
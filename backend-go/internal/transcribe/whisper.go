@@ -0,0 +1,101 @@
+package transcribe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/resume-rag/backend/internal/config"
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// WhisperTranscriber transcribes through an OpenAI-compatible
+// /audio/transcriptions endpoint (OpenAI Whisper, Groq)
+type WhisperTranscriber struct {
+	baseURL  string
+	apiKey   string
+	model    string
+	language string
+	client   *http.Client
+}
+
+type whisperResponse struct {
+	Text     string  `json:"text"`
+	Duration float64 `json:"duration"`
+	Language string  `json:"language"`
+}
+
+// NewWhisperTranscriber creates a Whisper transcriber
+func NewWhisperTranscriber(cfg config.TranscriptionConfig) *WhisperTranscriber {
+	return &WhisperTranscriber{
+		baseURL:  strings.TrimRight(cfg.BaseURL, "/"),
+		apiKey:   cfg.APIKey,
+		model:    cfg.Model,
+		language: cfg.Language,
+		client:   &http.Client{Timeout: cfg.Timeout},
+	}
+}
+
+// Transcribe uploads audio and returns its transcript. filename's extension
+// tells the provider the audio format.
+func (w *WhisperTranscriber) Transcribe(ctx context.Context, audio io.Reader, filename string) (*domain.Transcript, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	fields := map[string]string{"model": w.model, "response_format": "verbose_json"}
+	if w.language != "" {
+		fields["language"] = w.language
+	}
+	for name, value := range fields {
+		if err := mw.WriteField(name, value); err != nil {
+			return nil, err
+		}
+	}
+	part, err := mw.CreateFormFile("file", filepath.Base(filename))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(part, audio); err != nil {
+		return nil, fmt.Errorf("failed to read audio: %w", err)
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.baseURL+"/audio/transcriptions", &body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer "+w.apiKey)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("transcription request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("transcription request failed: status %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	var result whisperResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("failed to decode transcription response: %w", err)
+	}
+	if strings.TrimSpace(result.Text) == "" {
+		return nil, ErrEmptyAudio
+	}
+
+	return &domain.Transcript{
+		Text:            strings.TrimSpace(result.Text),
+		DurationSeconds: result.Duration,
+		Language:        result.Language,
+	}, nil
+}
@@ -0,0 +1,133 @@
+package vectorstore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"github.com/resume-rag/backend/internal/domain"
+)
+
+// JobsCollection holds one embedding per job, keyed by Job.EmbeddingID
+const JobsCollection = "jobs"
+
+// JobEmbedding is a job with its embedding vector
+type JobEmbedding struct {
+	Job    *domain.Job
+	Vector []float32
+}
+
+// JobMatch is a job near the query vector; Score is its cosine similarity
+type JobMatch struct {
+	JobID       uuid.UUID
+	EmbeddingID uuid.UUID
+	Score       float64
+}
+
+// JobSearch narrows a nearest-neighbor job search
+type JobSearch struct {
+	Limit      int
+	MinScore   *float64
+	Sources    []domain.JobSource // only jobs from these boards
+	ExcludeIDs []uuid.UUID        // jobs to leave out, e.g. already applied to
+}
+
+// EnsureJobsCollection creates the jobs collection for embeddings of the given size
+func (s *Store) EnsureJobsCollection(ctx context.Context, size int) error {
+	return s.EnsureCollection(ctx, JobsCollection, size, DistanceCosine)
+}
+
+// UpsertJobs stores job embeddings. Jobs without an EmbeddingID get a new one,
+// which the caller should persist with the job.
+func (s *Store) UpsertJobs(ctx context.Context, embeddings []JobEmbedding) error {
+	points := make([]Point, 0, len(embeddings))
+	for _, e := range embeddings {
+		if e.Job.EmbeddingID == nil {
+			id := uuid.New()
+			e.Job.EmbeddingID = &id
+		}
+		points = append(points, Point{
+			ID:      e.Job.EmbeddingID.String(),
+			Vector:  e.Vector,
+			Payload: jobPayload(e.Job),
+		})
+	}
+	return s.Upsert(ctx, JobsCollection, points)
+}
+
+// jobPayload is stored with a job's vector so searches can filter without a database round trip
+func jobPayload(job *domain.Job) map[string]interface{} {
+	payload := map[string]interface{}{
+		"job_id":  job.ID.String(),
+		"source":  string(job.Source),
+		"title":   job.Title,
+		"company": job.Company.Name,
+	}
+	if job.LocationType != nil {
+		payload["location_type"] = string(*job.LocationType)
+	}
+	if job.PostedDate != nil {
+		payload["posted_at"] = job.PostedDate.Unix()
+	}
+	return payload
+}
+
+// DeleteJobs removes the embeddings of jobs that were deleted or re-embedded
+func (s *Store) DeleteJobs(ctx context.Context, embeddingIDs []uuid.UUID) error {
+	ids := make([]string, len(embeddingIDs))
+	for i, id := range embeddingIDs {
+		ids[i] = id.String()
+	}
+	return s.Delete(ctx, JobsCollection, ids)
+}
+
+// SearchJobs returns the jobs whose embeddings are nearest to vector, best first
+func (s *Store) SearchJobs(ctx context.Context, vector []float32, opts JobSearch) ([]JobMatch, error) {
+	var must, mustNot []interface{}
+	if len(opts.Sources) > 0 {
+		sources := make([]string, len(opts.Sources))
+		for i, source := range opts.Sources {
+			sources[i] = string(source)
+		}
+		must = append(must, map[string]interface{}{"key": "source", "match": map[string]interface{}{"any": sources}})
+	}
+	if len(opts.ExcludeIDs) > 0 {
+		ids := make([]string, len(opts.ExcludeIDs))
+		for i, id := range opts.ExcludeIDs {
+			ids[i] = id.String()
+		}
+		mustNot = append(mustNot, map[string]interface{}{"key": "job_id", "match": map[string]interface{}{"any": ids}})
+	}
+
+	q := Query{Vector: vector, Limit: opts.Limit, MinScore: opts.MinScore}
+	if len(must) > 0 || len(mustNot) > 0 {
+		q.Filter = map[string]interface{}{}
+		if len(must) > 0 {
+			q.Filter["must"] = must
+		}
+		if len(mustNot) > 0 {
+			q.Filter["must_not"] = mustNot
+		}
+	}
+
+	hits, err := s.Search(ctx, JobsCollection, q)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]JobMatch, 0, len(hits))
+	for _, hit := range hits {
+		embeddingID, err := uuid.Parse(hit.ID)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected vector ID %q: %w", hit.ID, err)
+		}
+		raw, _ := hit.Payload["job_id"].(string)
+		jobID, err := uuid.Parse(raw)
+		if err != nil {
+			continue // a point stored without a job, skip it
+		}
+		matches = append(matches, JobMatch{JobID: jobID, EmbeddingID: embeddingID, Score: hit.Score})
+	}
+	return matches, nil
+}
@@ -0,0 +1,243 @@
+package vectorstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/resume-rag/backend/internal/config"
+)
+
+// requestTimeout bounds a single Qdrant request
+const requestTimeout = 30 * time.Second
+
+// errNotFound is returned for Qdrant 404 responses
+var errNotFound = errors.New("not found")
+
+// Distance is the similarity metric of a collection
+type Distance string
+
+const (
+	DistanceCosine Distance = "Cosine"
+	DistanceDot    Distance = "Dot"
+)
+
+// Store talks to Qdrant over its REST API. Collection names are namespaced
+// with the configured prefix so several deployments can share one server.
+type Store struct {
+	baseURL string
+	prefix  string
+	client  *http.Client
+}
+
+// New creates a Qdrant store from configuration
+func New(cfg config.QdrantConfig) *Store {
+	return &Store{
+		baseURL: fmt.Sprintf("http://%s:%d", cfg.Host, cfg.Port),
+		prefix:  cfg.CollectionPrefix,
+		client:  &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Collection returns the full name of a collection
+func (s *Store) Collection(name string) string {
+	if s.prefix == "" {
+		return name
+	}
+	return s.prefix + "_" + name
+}
+
+// EnsureCollection creates a collection for vectors of the given size unless
+// it already exists. An existing collection with another size is an error,
+// since its vectors come from a different embedding model.
+func (s *Store) EnsureCollection(ctx context.Context, name string, size int, distance Distance) error {
+	collection := s.Collection(name)
+
+	var info struct {
+		Config struct {
+			Params struct {
+				Vectors struct {
+					Size int `json:"size"`
+				} `json:"vectors"`
+			} `json:"params"`
+		} `json:"config"`
+	}
+	err := s.do(ctx, http.MethodGet, "/collections/"+url.PathEscape(collection), nil, &info)
+	if err == nil {
+		if existing := info.Config.Params.Vectors.Size; existing != size {
+			return fmt.Errorf("collection %s holds %d-dimensional vectors, not %d", collection, existing, size)
+		}
+		return nil
+	}
+	if !errors.Is(err, errNotFound) {
+		return err
+	}
+
+	body := map[string]interface{}{
+		"vectors": map[string]interface{}{"size": size, "distance": distance},
+	}
+	if err := s.do(ctx, http.MethodPut, "/collections/"+url.PathEscape(collection), body, nil); err != nil {
+		return fmt.Errorf("failed to create collection %s: %w", collection, err)
+	}
+	return nil
+}
+
+// Ping checks that Qdrant answers
+func (s *Store) Ping(ctx context.Context) error {
+	return s.do(ctx, http.MethodGet, "/collections", nil, nil)
+}
+
+// Point is a vector with its ID and payload
+type Point struct {
+	ID      string                 `json:"id"`
+	Vector  []float32              `json:"vector"`
+	Payload map[string]interface{} `json:"payload,omitempty"`
+}
+
+// Upsert inserts or replaces points, waiting until they are searchable
+func (s *Store) Upsert(ctx context.Context, name string, points []Point) error {
+	if len(points) == 0 {
+		return nil
+	}
+	path := fmt.Sprintf("/collections/%s/points?wait=true", url.PathEscape(s.Collection(name)))
+	if err := s.do(ctx, http.MethodPut, path, map[string]interface{}{"points": points}, nil); err != nil {
+		return fmt.Errorf("failed to upsert vectors: %w", err)
+	}
+	return nil
+}
+
+// Delete removes points by ID
+func (s *Store) Delete(ctx context.Context, name string, ids []string) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	path := fmt.Sprintf("/collections/%s/points/delete?wait=true", url.PathEscape(s.Collection(name)))
+	if err := s.do(ctx, http.MethodPost, path, map[string]interface{}{"points": ids}, nil); err != nil {
+		return fmt.Errorf("failed to delete vectors: %w", err)
+	}
+	return nil
+}
+
+// DeleteWhere removes the points matching a Qdrant payload filter
+func (s *Store) DeleteWhere(ctx context.Context, name string, filter map[string]interface{}) error {
+	path := fmt.Sprintf("/collections/%s/points/delete?wait=true", url.PathEscape(s.Collection(name)))
+	if err := s.do(ctx, http.MethodPost, path, map[string]interface{}{"filter": filter}, nil); err != nil {
+		return fmt.Errorf("failed to delete vectors: %w", err)
+	}
+	return nil
+}
+
+// Scroll returns every point matching a Qdrant payload filter, without vectors
+func (s *Store) Scroll(ctx context.Context, name string, filter map[string]interface{}) ([]Hit, error) {
+	path := fmt.Sprintf("/collections/%s/points/scroll", url.PathEscape(s.Collection(name)))
+	body := map[string]interface{}{"filter": filter, "limit": 256, "with_payload": true}
+
+	var hits []Hit
+	for {
+		var page struct {
+			Points []Hit       `json:"points"`
+			Next   interface{} `json:"next_page_offset"`
+		}
+		if err := s.do(ctx, http.MethodPost, path, body, &page); err != nil {
+			return nil, fmt.Errorf("failed to scroll vectors: %w", err)
+		}
+		hits = append(hits, page.Points...)
+		if page.Next == nil {
+			return hits, nil
+		}
+		body["offset"] = page.Next
+	}
+}
+
+// Hit is one nearest-neighbor result
+type Hit struct {
+	ID      string                 `json:"id"`
+	Score   float64                `json:"score"`
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// Query is a nearest-neighbor search
+type Query struct {
+	Vector   []float32
+	Limit    int
+	MinScore *float64               // drop hits scoring below this
+	Filter   map[string]interface{} // Qdrant filter on payload fields
+}
+
+// Search returns the points nearest to the query vector, best first
+func (s *Store) Search(ctx context.Context, name string, q Query) ([]Hit, error) {
+	body := map[string]interface{}{
+		"vector":       q.Vector,
+		"limit":        q.Limit,
+		"with_payload": true,
+	}
+	if q.MinScore != nil {
+		body["score_threshold"] = *q.MinScore
+	}
+	if q.Filter != nil {
+		body["filter"] = q.Filter
+	}
+
+	var hits []Hit
+	path := fmt.Sprintf("/collections/%s/points/search", url.PathEscape(s.Collection(name)))
+	if err := s.do(ctx, http.MethodPost, path, body, &hits); err != nil {
+		return nil, fmt.Errorf("vector search failed: %w", err)
+	}
+	return hits, nil
+}
+
+// do sends a request and decodes the "result" field of Qdrant's response
+// envelope into out, when given
+func (s *Store) do(ctx context.Context, method, path string, body, out interface{}) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, s.baseURL+path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("qdrant request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var envelope struct {
+		Result json.RawMessage `json:"result"`
+		Status interface{}     `json:"status"` // "ok", or {"error": "..."}
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, 32<<20)).Decode(&envelope); err != nil && resp.StatusCode < 300 {
+		return fmt.Errorf("failed to decode qdrant response: %w", err)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return errNotFound
+	case resp.StatusCode >= 300:
+		if status, ok := envelope.Status.(map[string]interface{}); ok {
+			return fmt.Errorf("qdrant returned status %d: %v", resp.StatusCode, status["error"])
+		}
+		return fmt.Errorf("qdrant returned status %d", resp.StatusCode)
+	}
+
+	if out == nil || len(envelope.Result) == 0 {
+		return nil
+	}
+	return json.Unmarshal(envelope.Result, out)
+}
@@ -0,0 +1,103 @@
+package vectorstore
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// ResumesCollection holds the chunks of each tenant's active resume
+const ResumesCollection = "resumes"
+
+// ResumeChunk is a passage of a resume, at Position in reading order
+type ResumeChunk struct {
+	Section  string
+	Position int
+	Text     string
+}
+
+// ResumeChunkMatch is a chunk near the query vector; Score is its cosine similarity
+type ResumeChunkMatch struct {
+	ResumeChunk
+	Score float64
+}
+
+// ReplaceResume swaps the tenant's resume chunks for new ones. vectors holds
+// the embedding of each chunk, in order.
+func (s *Store) ReplaceResume(ctx context.Context, tenantID string, chunks []ResumeChunk, vectors [][]float32) error {
+	if len(chunks) > 0 {
+		if err := s.EnsureCollection(ctx, ResumesCollection, len(vectors[0]), DistanceCosine); err != nil {
+			return err
+		}
+	}
+	if err := s.DeleteWhere(ctx, ResumesCollection, tenantFilter(tenantID)); err != nil && !errors.Is(err, errNotFound) {
+		return err
+	}
+
+	points := make([]Point, len(chunks))
+	for i, chunk := range chunks {
+		points[i] = Point{
+			ID:     uuid.NewString(),
+			Vector: vectors[i],
+			Payload: map[string]interface{}{
+				"tenant_id": tenantID,
+				"section":   chunk.Section,
+				"position":  chunk.Position,
+				"text":      chunk.Text,
+			},
+		}
+	}
+	return s.Upsert(ctx, ResumesCollection, points)
+}
+
+// SearchResume returns the tenant's resume chunks nearest to vector, best first
+func (s *Store) SearchResume(ctx context.Context, tenantID string, vector []float32, limit int) ([]ResumeChunkMatch, error) {
+	hits, err := s.Search(ctx, ResumesCollection, Query{Vector: vector, Limit: limit, Filter: tenantFilter(tenantID)})
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]ResumeChunkMatch, len(hits))
+	for i, hit := range hits {
+		matches[i] = ResumeChunkMatch{ResumeChunk: resumeChunk(hit.Payload), Score: hit.Score}
+	}
+	return matches, nil
+}
+
+// ResumeChunks returns all of the tenant's resume chunks in reading order
+func (s *Store) ResumeChunks(ctx context.Context, tenantID string) ([]ResumeChunk, error) {
+	hits, err := s.Scroll(ctx, ResumesCollection, tenantFilter(tenantID))
+	if errors.Is(err, errNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	chunks := make([]ResumeChunk, len(hits))
+	for i, hit := range hits {
+		chunks[i] = resumeChunk(hit.Payload)
+	}
+	sort.Slice(chunks, func(i, j int) bool { return chunks[i].Position < chunks[j].Position })
+	return chunks, nil
+}
+
+func tenantFilter(tenantID string) map[string]interface{} {
+	return map[string]interface{}{
+		"must": []interface{}{
+			map[string]interface{}{"key": "tenant_id", "match": map[string]interface{}{"value": tenantID}},
+		},
+	}
+}
+
+func resumeChunk(payload map[string]interface{}) ResumeChunk {
+	section, _ := payload["section"].(string)
+	text, _ := payload["text"].(string)
+	position, _ := payload["position"].(float64) // JSON numbers decode as float64
+	return ResumeChunk{Section: section, Position: int(position), Text: text}
+}
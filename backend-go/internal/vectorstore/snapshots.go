@@ -0,0 +1,103 @@
+package vectorstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// transferClient moves snapshot files, which can take longer than
+// requestTimeout; transfers are bounded by their context instead
+var transferClient = &http.Client{}
+
+// Collections returns the names of this deployment's collections, without
+// the configured prefix
+func (s *Store) Collections(ctx context.Context) ([]string, error) {
+	var result struct {
+		Collections []struct {
+			Name string `json:"name"`
+		} `json:"collections"`
+	}
+	if err := s.do(ctx, http.MethodGet, "/collections", nil, &result); err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+
+	names := make([]string, 0, len(result.Collections))
+	for _, c := range result.Collections {
+		if s.prefix == "" {
+			names = append(names, c.Name)
+		} else if name, ok := strings.CutPrefix(c.Name, s.prefix+"_"); ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// Snapshot writes a snapshot of a collection to w. The snapshot is removed
+// from the Qdrant server once it has been copied.
+func (s *Store) Snapshot(ctx context.Context, name string, w io.Writer) error {
+	collection := url.PathEscape(s.Collection(name))
+	var snapshot struct {
+		Name string `json:"name"`
+	}
+	if err := s.do(ctx, http.MethodPost, "/collections/"+collection+"/snapshots?wait=true", nil, &snapshot); err != nil {
+		return fmt.Errorf("failed to snapshot collection %s: %w", name, err)
+	}
+	path := "/collections/" + collection + "/snapshots/" + url.PathEscape(snapshot.Name)
+	defer func() { _ = s.do(context.Background(), http.MethodDelete, path, nil, nil) }()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := transferClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download snapshot of %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to download snapshot of %s: qdrant returned status %d", name, resp.StatusCode)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// RestoreSnapshot replaces a collection, creating it if needed, with the
+// snapshot read from r
+func (s *Store) RestoreSnapshot(ctx context.Context, name string, r io.Reader) error {
+	body, w := io.Pipe()
+	form := multipart.NewWriter(w)
+	go func() {
+		part, err := form.CreateFormFile("snapshot", name+".snapshot")
+		if err == nil {
+			_, err = io.Copy(part, r)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		w.CloseWithError(err)
+	}()
+
+	path := fmt.Sprintf("/collections/%s/snapshots/upload?priority=snapshot&wait=true", url.PathEscape(s.Collection(name)))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.baseURL+path, body)
+	if err != nil {
+		body.Close()
+		return err
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+
+	resp, err := transferClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to restore collection %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+		return fmt.Errorf("failed to restore collection %s: qdrant returned status %d: %s", name, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
@@ -0,0 +1,7 @@
+-- Full-text search over chat history
+
+ALTER TABLE chat_messages
+    ADD COLUMN search_vector tsvector
+    GENERATED ALWAYS AS (to_tsvector('english', coalesce(content, ''))) STORED;
+
+CREATE INDEX idx_chat_messages_search ON chat_messages USING gin(search_vector);
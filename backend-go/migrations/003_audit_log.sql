@@ -0,0 +1,20 @@
+-- Audit log for mutating operations
+
+CREATE TABLE audit_log (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    actor VARCHAR(255) NOT NULL,
+    action VARCHAR(100) NOT NULL,
+    resource VARCHAR(100) NOT NULL,
+    resource_id VARCHAR(255),
+    method VARCHAR(10) NOT NULL,
+    path VARCHAR(1024) NOT NULL,
+    status INTEGER NOT NULL,
+    request_id VARCHAR(64),
+    ip VARCHAR(64),
+    details JSONB DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_log_created ON audit_log(created_at DESC);
+CREATE INDEX idx_audit_log_resource ON audit_log(resource, resource_id);
+CREATE INDEX idx_audit_log_actor ON audit_log(actor);
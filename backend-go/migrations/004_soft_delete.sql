@@ -0,0 +1,7 @@
+-- Soft delete (trash) for jobs and applications
+
+ALTER TABLE jobs ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE applications ADD COLUMN deleted_at TIMESTAMPTZ;
+
+CREATE INDEX idx_jobs_deleted ON jobs(deleted_at) WHERE deleted_at IS NOT NULL;
+CREATE INDEX idx_applications_deleted ON applications(deleted_at) WHERE deleted_at IS NOT NULL;
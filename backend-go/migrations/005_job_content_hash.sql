@@ -0,0 +1,8 @@
+-- Cross-source job deduplication by normalized content hash
+
+ALTER TABLE jobs ADD COLUMN content_hash VARCHAR(64);
+ALTER TABLE jobs ADD COLUMN sources JSONB DEFAULT '[]';
+
+-- One canonical row per content hash; cross-posts append to sources instead
+CREATE UNIQUE INDEX idx_jobs_content_hash ON jobs(content_hash)
+    WHERE content_hash IS NOT NULL AND deleted_at IS NULL;
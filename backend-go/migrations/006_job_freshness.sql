@@ -0,0 +1,8 @@
+-- Freshness tracking and repost detection
+
+ALTER TABLE jobs ADD COLUMN first_seen_at TIMESTAMPTZ DEFAULT NOW();
+ALTER TABLE jobs ADD COLUMN last_seen_at TIMESTAMPTZ DEFAULT NOW();
+ALTER TABLE jobs ADD COLUMN reposted_at TIMESTAMPTZ;
+ALTER TABLE jobs ADD COLUMN repost_count INTEGER DEFAULT 0;
+
+CREATE INDEX idx_jobs_first_seen ON jobs(first_seen_at DESC) WHERE repost_count = 0;
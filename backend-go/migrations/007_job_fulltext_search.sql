@@ -0,0 +1,31 @@
+-- Weighted full-text search over jobs (keyword half of hybrid search)
+-- Weights: title A, company and skills B, description C
+
+ALTER TABLE jobs ADD COLUMN search_vector tsvector;
+
+CREATE OR REPLACE FUNCTION jobs_search_vector_update()
+RETURNS TRIGGER AS $$
+DECLARE
+    company_name TEXT;
+BEGIN
+    SELECT name INTO company_name FROM companies WHERE id = NEW.company_id;
+
+    NEW.search_vector :=
+        setweight(to_tsvector('english', coalesce(NEW.title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(company_name, '')), 'B') ||
+        setweight(to_tsvector('english', array_to_string(
+            coalesce(NEW.required_skills, '{}') || coalesce(NEW.preferred_skills, '{}') ||
+            coalesce(NEW.technologies, '{}'), ' ')), 'B') ||
+        setweight(to_tsvector('english', coalesce(NEW.description, '')), 'C');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_search_vector BEFORE INSERT OR UPDATE OF title, company_id, description,
+    required_skills, preferred_skills, technologies
+    ON jobs FOR EACH ROW EXECUTE FUNCTION jobs_search_vector_update();
+
+-- Backfill existing rows
+UPDATE jobs SET title = title;
+
+CREATE INDEX idx_jobs_search ON jobs USING gin(search_vector);
@@ -0,0 +1,10 @@
+-- Geocoded job locations for radius filtering and country/state facets
+
+ALTER TABLE jobs ADD COLUMN city VARCHAR(255);
+ALTER TABLE jobs ADD COLUMN state VARCHAR(255);
+ALTER TABLE jobs ADD COLUMN country CHAR(2);
+ALTER TABLE jobs ADD COLUMN latitude DOUBLE PRECISION;
+ALTER TABLE jobs ADD COLUMN longitude DOUBLE PRECISION;
+
+CREATE INDEX idx_jobs_country_state ON jobs(country, state);
+CREATE INDEX idx_jobs_lat_lon ON jobs(latitude, longitude) WHERE latitude IS NOT NULL;
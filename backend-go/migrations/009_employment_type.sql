@@ -0,0 +1,16 @@
+-- Normalize employment types to full-time, part-time, contract, internship, temp
+
+UPDATE jobs SET employment_type = CASE
+    WHEN employment_type ILIKE '%intern%' THEN 'internship'
+    WHEN employment_type ILIKE '%contract%' OR employment_type ILIKE '%freelance%' THEN 'contract'
+    WHEN employment_type ILIKE '%temp%' OR employment_type ILIKE '%seasonal%' THEN 'temp'
+    WHEN employment_type ILIKE '%part%' THEN 'part-time'
+    WHEN employment_type ILIKE '%full%' OR employment_type ILIKE '%permanent%' THEN 'full-time'
+    ELSE NULL
+END;
+
+ALTER TABLE jobs ALTER COLUMN employment_type DROP DEFAULT;
+ALTER TABLE jobs ADD CONSTRAINT jobs_employment_type_check
+    CHECK (employment_type IN ('full-time', 'part-time', 'contract', 'internship', 'temp'));
+
+CREATE INDEX idx_jobs_employment_type ON jobs(employment_type);
@@ -0,0 +1,6 @@
+-- H1B visa sponsorship enrichment for companies
+
+ALTER TABLE companies ADD COLUMN h1b_filings INTEGER;
+ALTER TABLE companies ADD COLUMN likely_sponsors_visa BOOLEAN DEFAULT FALSE;
+
+CREATE INDEX idx_companies_sponsors_visa ON companies(likely_sponsors_visa) WHERE likely_sponsors_visa = TRUE;
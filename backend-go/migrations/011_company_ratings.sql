@@ -0,0 +1,7 @@
+-- Company rating enrichment (bulk import or periodic refresh)
+
+ALTER TABLE companies ADD COLUMN rating_review_count INTEGER;
+ALTER TABLE companies ADD COLUMN rating_source VARCHAR(50);
+ALTER TABLE companies ADD COLUMN rating_updated_at TIMESTAMPTZ;
+
+CREATE INDEX idx_companies_rating ON companies(glassdoor_rating DESC) WHERE glassdoor_rating IS NOT NULL;
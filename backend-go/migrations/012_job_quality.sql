@@ -0,0 +1,6 @@
+-- Recruiter-spam, ghost job and low-quality posting detection
+
+ALTER TABLE jobs ADD COLUMN quality_score SMALLINT;
+ALTER TABLE jobs ADD COLUMN quality_flags TEXT[] DEFAULT '{}';
+
+CREATE INDEX idx_jobs_quality ON jobs(quality_score) WHERE is_active = TRUE;
@@ -0,0 +1,17 @@
+-- Timestamped skill mentions for per-skill demand trends
+
+CREATE TABLE job_skill_mentions (
+    job_id UUID REFERENCES jobs(id) ON DELETE CASCADE,
+    skill VARCHAR(100) NOT NULL,
+    mentioned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (job_id, skill)
+);
+
+CREATE INDEX idx_skill_mentions_skill_time ON job_skill_mentions(skill, mentioned_at);
+CREATE INDEX idx_skill_mentions_time ON job_skill_mentions(mentioned_at);
+
+-- Backfill from skills already extracted onto jobs
+INSERT INTO job_skill_mentions (job_id, skill, mentioned_at)
+SELECT j.id, lower(skill), COALESCE(j.posted_at, j.created_at)
+FROM jobs j, unnest(j.required_skills) AS skill
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,7 @@
+-- Categorized error summaries per scrape run (selector_missing, blocked, timeout, parse_error, ...)
+
+ALTER TABLE scrape_queue
+    ADD COLUMN error_summary JSONB NOT NULL DEFAULT '[]';
+
+-- Find runs that hit a given failure category
+CREATE INDEX idx_scrape_queue_error_summary ON scrape_queue USING gin(error_summary jsonb_path_ops);
@@ -0,0 +1,6 @@
+-- Job sources backed by official board APIs
+
+ALTER TYPE job_source ADD VALUE IF NOT EXISTS 'adzuna';
+ALTER TYPE job_source ADD VALUE IF NOT EXISTS 'usajobs';
+ALTER TYPE job_source ADD VALUE IF NOT EXISTS 'jooble';
+ALTER TYPE job_source ADD VALUE IF NOT EXISTS 'remotive';
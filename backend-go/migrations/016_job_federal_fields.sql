@@ -0,0 +1,7 @@
+-- Federal job details (USAJOBS): pay grade and required security clearance
+
+ALTER TABLE jobs
+    ADD COLUMN pay_grade VARCHAR(20),
+    ADD COLUMN security_clearance VARCHAR(100);
+
+CREATE INDEX idx_jobs_security_clearance ON jobs(security_clearance) WHERE security_clearance IS NOT NULL;
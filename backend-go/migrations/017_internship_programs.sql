@@ -0,0 +1,10 @@
+-- Internship and new-grad programs: program terms and the Simplify listings source
+
+ALTER TYPE job_source ADD VALUE IF NOT EXISTS 'simplify';
+
+ALTER TABLE jobs
+    ADD COLUMN new_grad BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN seasons TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_jobs_new_grad ON jobs(new_grad) WHERE new_grad = TRUE;
+CREATE INDEX idx_jobs_seasons ON jobs USING gin(seasons);
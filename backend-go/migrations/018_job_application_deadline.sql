@@ -0,0 +1,6 @@
+-- Application deadlines parsed from job descriptions ("apply by March 15")
+
+ALTER TABLE jobs ADD COLUMN application_deadline TIMESTAMPTZ;
+
+CREATE INDEX idx_jobs_application_deadline ON jobs(application_deadline)
+    WHERE application_deadline IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,3 @@
+-- Recruiter names and emails parsed from job descriptions
+
+ALTER TABLE jobs ADD COLUMN contacts JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,8 @@
+-- Concrete tech stack detected per job, for stack filters and per-company stats
+
+ALTER TABLE jobs ADD COLUMN tech_stack JSONB;
+
+-- Flattened canonical technologies for "must use" / "exclude" filtering
+ALTER TABLE jobs ADD COLUMN tech_stack_terms TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_jobs_tech_stack_terms ON jobs USING GIN (tech_stack_terms);
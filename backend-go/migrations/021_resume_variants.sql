@@ -0,0 +1,11 @@
+-- Resume variants tailored per application. Each variant is a new resume
+-- version linked to its application, with a change log against the base resume.
+
+ALTER TABLE resumes ADD COLUMN application_id UUID REFERENCES applications(id) ON DELETE CASCADE;
+ALTER TABLE resumes ADD COLUMN job_id UUID REFERENCES jobs(id) ON DELETE SET NULL;
+ALTER TABLE resumes ADD COLUMN base_hash CHAR(64);
+ALTER TABLE resumes ADD COLUMN sections JSONB;
+ALTER TABLE resumes ADD COLUMN changes JSONB;
+
+CREATE INDEX idx_resumes_application ON resumes(application_id, created_at DESC)
+    WHERE application_id IS NOT NULL;
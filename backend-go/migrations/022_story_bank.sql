@@ -0,0 +1,17 @@
+-- Story bank: the user's vetted STAR stories, tagged by competency and
+-- retrieved to ground generated interview answers.
+
+CREATE TABLE stories (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    title VARCHAR(255) NOT NULL,
+    competencies TEXT[] NOT NULL DEFAULT '{}',
+    situation TEXT NOT NULL DEFAULT '',
+    task TEXT NOT NULL DEFAULT '',
+    action TEXT NOT NULL,
+    result TEXT NOT NULL,
+    tags TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_stories_competencies ON stories USING GIN (competencies);
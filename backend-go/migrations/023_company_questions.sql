@@ -0,0 +1,20 @@
+-- Interview questions reported per company, from a seed dataset and user
+-- submissions. Repeat reports of the same question are merged and counted.
+
+CREATE TABLE company_questions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    company VARCHAR(255) NOT NULL,
+    company_key VARCHAR(255) NOT NULL,      -- normalized company name
+    question TEXT NOT NULL,
+    question_key TEXT NOT NULL,             -- question lowercased without punctuation
+    category VARCHAR(50) NOT NULL DEFAULT '',
+    round VARCHAR(50) NOT NULL DEFAULT '',
+    role VARCHAR(255) NOT NULL DEFAULT '',
+    source VARCHAR(10) NOT NULL DEFAULT 'user',
+    reports INTEGER NOT NULL DEFAULT 1,
+    last_reported_at TIMESTAMPTZ DEFAULT NOW(),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (company_key, question_key)
+);
+
+CREATE INDEX idx_company_questions_company ON company_questions(company_key, reports DESC);
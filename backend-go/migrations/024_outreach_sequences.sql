@@ -0,0 +1,17 @@
+-- Outreach sequences: per-application follow-up emails. Each step is drafted
+-- when due; a sequence pauses when its application changes status.
+
+CREATE TABLE outreach_sequences (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    application_id UUID NOT NULL REFERENCES applications(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    paused_reason TEXT NOT NULL DEFAULT '',
+    application_status VARCHAR(20) NOT NULL,   -- status the sequence runs under
+    steps JSONB NOT NULL DEFAULT '[]',
+    started_at TIMESTAMPTZ DEFAULT NOW(),
+    paused_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_outreach_sequences_application ON outreach_sequences(application_id, started_at DESC);
+CREATE INDEX idx_outreach_sequences_active ON outreach_sequences(status) WHERE status = 'active';
@@ -0,0 +1,11 @@
+-- Resume version label each application was sent with, so response rates can
+-- be compared per version. Tailored variants already link their resume row.
+
+ALTER TABLE applications ADD COLUMN resume_version VARCHAR(255);
+
+UPDATE applications a SET resume_version = r.name
+FROM resumes r
+WHERE a.resume_id = r.id AND a.resume_version IS NULL;
+
+CREATE INDEX idx_applications_resume_version ON applications(resume_version)
+    WHERE resume_version IS NOT NULL AND deleted_at IS NULL;
@@ -0,0 +1,9 @@
+-- Per-application checklists. Items come from per-status templates or are
+-- added by the user; dismissed lists template items the user removed.
+
+CREATE TABLE application_checklists (
+    application_id UUID PRIMARY KEY REFERENCES applications(id) ON DELETE CASCADE,
+    items JSONB NOT NULL DEFAULT '[]',
+    dismissed TEXT[] NOT NULL DEFAULT '{}',
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
@@ -0,0 +1,16 @@
+-- Read-only share links for the application board or a single application.
+-- Only the SHA-256 hash of each token is stored.
+
+CREATE TABLE share_links (
+    id UUID PRIMARY KEY,
+    token_hash TEXT NOT NULL UNIQUE,
+    scope TEXT NOT NULL CHECK (scope IN ('board', 'application')),
+    application_id UUID REFERENCES applications(id) ON DELETE CASCADE,
+    label TEXT NOT NULL DEFAULT '',
+    redact TEXT[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    views INTEGER NOT NULL DEFAULT 0,
+    last_viewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
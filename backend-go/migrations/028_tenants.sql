@@ -0,0 +1,30 @@
+-- Multi-tenant mode. Tables holding a user's own data get a tenant_id and a
+-- row-level security policy on the app.tenant_id session setting, which the
+-- API sets whenever it acquires a connection. Scraped jobs, companies and
+-- community interview questions stay shared across tenants.
+-- Existing rows belong to the 'default' tenant.
+
+CREATE OR REPLACE FUNCTION current_tenant() RETURNS TEXT AS $$
+    SELECT COALESCE(NULLIF(current_setting('app.tenant_id', true), ''), 'default')
+$$ LANGUAGE SQL STABLE;
+
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY[
+        'resumes', 'applications', 'application_timeline', 'saved_searches',
+        'chat_sessions', 'chat_messages', 'settings', 'audit_log', 'stories',
+        'outreach_sequences', 'application_checklists', 'share_links'
+    ] LOOP
+        EXECUTE format('ALTER TABLE %I ADD COLUMN tenant_id VARCHAR(63) NOT NULL DEFAULT current_tenant()', t);
+        EXECUTE format('CREATE INDEX idx_%s_tenant ON %I(tenant_id)', t, t);
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format('CREATE POLICY tenant_isolation ON %I USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant())', t);
+    END LOOP;
+END $$;
+
+-- Settings are per tenant, so the same key may appear once per tenant
+ALTER TABLE settings DROP CONSTRAINT settings_pkey;
+ALTER TABLE settings ADD PRIMARY KEY (tenant_id, key);
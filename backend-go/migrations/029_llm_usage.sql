@@ -0,0 +1,15 @@
+-- LLM usage per request, for spend reporting. Cost is computed by the caller
+-- from its backend's pricing when the request completes.
+
+CREATE TABLE llm_usage (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    backend VARCHAR(50) NOT NULL,
+    model VARCHAR(100) NOT NULL DEFAULT '',
+    feature VARCHAR(50) NOT NULL DEFAULT '',   -- chat, analyze, interview, email, ...
+    input_tokens INTEGER NOT NULL DEFAULT 0,
+    output_tokens INTEGER NOT NULL DEFAULT 0,
+    cost_usd NUMERIC(10, 6) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX idx_llm_usage_created ON llm_usage(created_at);
@@ -0,0 +1,17 @@
+-- Starred jobs, kept apart from applications. Promoting a favorite creates
+-- an application with the favorite's note and removes the star.
+
+CREATE TABLE job_favorites (
+    tenant_id VARCHAR(63) NOT NULL DEFAULT current_tenant(),
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, job_id)
+);
+
+CREATE INDEX idx_job_favorites_created ON job_favorites(tenant_id, created_at DESC);
+
+ALTER TABLE job_favorites ENABLE ROW LEVEL SECURITY;
+ALTER TABLE job_favorites FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON job_favorites
+    USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant());
@@ -0,0 +1,57 @@
+-- Incremental sync. Jobs and applications carry a version from a shared
+-- sequence, bumped on every write, so offline clients can ask for
+-- everything that changed after the last version they saw. Soft-deleted
+-- rows still carry their version; rows purged for good leave a tombstone.
+
+CREATE SEQUENCE sync_version_seq;
+
+ALTER TABLE jobs ADD COLUMN sync_version BIGINT NOT NULL DEFAULT nextval('sync_version_seq');
+ALTER TABLE applications ADD COLUMN sync_version BIGINT NOT NULL DEFAULT nextval('sync_version_seq');
+
+CREATE INDEX idx_jobs_sync_version ON jobs(sync_version);
+CREATE INDEX idx_applications_sync_version ON applications(tenant_id, sync_version);
+
+CREATE OR REPLACE FUNCTION bump_sync_version()
+RETURNS TRIGGER AS $$
+BEGIN
+    NEW.sync_version = nextval('sync_version_seq');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_sync_version BEFORE UPDATE ON jobs FOR EACH ROW EXECUTE FUNCTION bump_sync_version();
+CREATE TRIGGER applications_sync_version BEFORE UPDATE ON applications FOR EACH ROW EXECUTE FUNCTION bump_sync_version();
+
+-- Jobs are shared across tenants, so their tombstones have no tenant
+CREATE TABLE sync_tombstones (
+    sync_version BIGINT PRIMARY KEY DEFAULT nextval('sync_version_seq'),
+    tenant_id VARCHAR(63),
+    entity VARCHAR(20) NOT NULL,
+    entity_id UUID NOT NULL,
+    deleted_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+ALTER TABLE sync_tombstones ENABLE ROW LEVEL SECURITY;
+ALTER TABLE sync_tombstones FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON sync_tombstones
+    USING (tenant_id IS NULL OR tenant_id = current_tenant())
+    WITH CHECK (tenant_id IS NULL OR tenant_id = current_tenant());
+
+CREATE OR REPLACE FUNCTION record_job_tombstone()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO sync_tombstones (entity, entity_id) VALUES ('job', OLD.id);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION record_application_tombstone()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO sync_tombstones (tenant_id, entity, entity_id) VALUES (OLD.tenant_id, 'application', OLD.id);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_sync_tombstone AFTER DELETE ON jobs FOR EACH ROW EXECUTE FUNCTION record_job_tombstone();
+CREATE TRIGGER applications_sync_tombstone AFTER DELETE ON applications FOR EACH ROW EXECUTE FUNCTION record_application_tombstone();
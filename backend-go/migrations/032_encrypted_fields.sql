@@ -0,0 +1,23 @@
+-- Encryption at rest for sensitive fields. Values are encrypted by the API
+-- with AES-256-GCM and stored as text of the form enc:v1:<key id>:<payload>.
+-- Existing contact details stay readable as plaintext until
+-- POST /api/admin/encryption/rotate encrypts them under the primary key.
+
+CREATE TABLE credentials (
+    tenant_id VARCHAR(63) NOT NULL DEFAULT current_tenant(),
+    name VARCHAR(63) NOT NULL,
+    kind VARCHAR(20) NOT NULL,                 -- llm_api_key, oauth_token, smtp
+    value TEXT NOT NULL,                       -- ciphertext
+    hint VARCHAR(16) NOT NULL DEFAULT '',
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    PRIMARY KEY (tenant_id, name)
+);
+
+ALTER TABLE credentials ENABLE ROW LEVEL SECURITY;
+ALTER TABLE credentials FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON credentials
+    USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant());
+
+-- Ciphertext is longer than the plaintext columns allowed
+ALTER TABLE applications ALTER COLUMN contact_email TYPE TEXT;
+ALTER TABLE applications ALTER COLUMN contact_phone TYPE TEXT;
@@ -0,0 +1,3 @@
+-- Jobs scraped directly from company career sites (Greenhouse, Lever, Workday, Ashby boards)
+
+ALTER TYPE job_source ADD VALUE IF NOT EXISTS 'career_page';
@@ -0,0 +1,4 @@
+-- Startup job boards read through their public APIs
+
+ALTER TYPE job_source ADD VALUE IF NOT EXISTS 'ashby';
+ALTER TYPE job_source ADD VALUE IF NOT EXISTS 'workable';
@@ -0,0 +1,15 @@
+-- Scrape tasks requested through the API cover several sources at once and
+-- can be cancelled, so one queue row carries the whole request. Rows queued
+-- per source keep using the source column.
+
+ALTER TYPE scrape_status ADD VALUE IF NOT EXISTS 'cancelled';
+
+ALTER TABLE scrape_queue
+    ALTER COLUMN source DROP NOT NULL,
+    ADD COLUMN keywords TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN location VARCHAR(255),
+    ADD COLUMN sources job_source[] NOT NULL DEFAULT '{}',
+    ADD COLUMN error_counts JSONB NOT NULL DEFAULT '{}',
+    ADD COLUMN rejected JSONB NOT NULL DEFAULT '{}';
+
+CREATE INDEX idx_scrape_queue_status ON scrape_queue(status, created_at);
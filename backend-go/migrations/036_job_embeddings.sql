@@ -0,0 +1,6 @@
+-- Points in the Qdrant jobs collection are keyed by embedding ID, so a job
+-- can be re-embedded under a new ID while the old vector is cleaned up
+
+ALTER TABLE jobs ADD COLUMN embedding_id UUID;
+
+CREATE UNIQUE INDEX idx_jobs_embedding ON jobs(embedding_id) WHERE embedding_id IS NOT NULL;
@@ -0,0 +1,23 @@
+-- Changes to a posting noticed when a job is re-scraped at the same URL with
+-- a different title, description or salary. Each distinct change is stored
+-- once, so a posting that flips back and forth does not pile up revisions.
+
+CREATE TABLE job_revisions (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    job_id UUID NOT NULL REFERENCES jobs(id) ON DELETE CASCADE,
+    previous_hash VARCHAR(64) NOT NULL,
+    content_hash VARCHAR(64) NOT NULL,
+    previous_title VARCHAR(255),
+    salary_changed BOOLEAN NOT NULL DEFAULT FALSE,
+    previous_salary_min INTEGER,
+    previous_salary_max INTEGER,
+    removed TEXT[] NOT NULL DEFAULT '{}',
+    added TEXT[] NOT NULL DEFAULT '{}',
+    changed_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (job_id, previous_hash, content_hash)
+);
+
+CREATE INDEX idx_job_revisions_job ON job_revisions(job_id, changed_at DESC);
+
+-- Re-scrapes are matched to the stored job by source URL
+CREATE INDEX idx_jobs_source_url ON jobs(source, source_url) WHERE deleted_at IS NULL;
@@ -0,0 +1,40 @@
+-- Named resume versions. Resumes not tailored to an application are the
+-- user's versions, each name used once per tenant; is_primary marks the
+-- active version that chat and matching read. Applications link to the
+-- version their resume_version label names.
+
+-- Existing rows of every tenant are updated, so the owner skips row security
+ALTER TABLE resumes NO FORCE ROW LEVEL SECURITY;
+ALTER TABLE applications NO FORCE ROW LEVEL SECURITY;
+
+-- Earlier duplicate names get a suffix so names can be unique
+UPDATE resumes r SET name = left(r.name, 240) || ' (' || d.n || ')'
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY tenant_id, name ORDER BY created_at DESC) AS n
+    FROM resumes WHERE application_id IS NULL
+) d
+WHERE r.id = d.id AND d.n > 1;
+
+CREATE UNIQUE INDEX idx_resumes_version_name ON resumes(tenant_id, name)
+    WHERE application_id IS NULL;
+
+-- Keep only the most recent primary resume active
+UPDATE resumes SET is_primary = FALSE
+WHERE is_primary AND id NOT IN (
+    SELECT DISTINCT ON (tenant_id) id FROM resumes
+    WHERE is_primary AND application_id IS NULL
+    ORDER BY tenant_id, updated_at DESC
+);
+UPDATE resumes SET is_primary = FALSE WHERE is_primary IS NULL;
+ALTER TABLE resumes ALTER COLUMN is_primary SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_resumes_active ON resumes(tenant_id)
+    WHERE is_primary AND application_id IS NULL;
+
+UPDATE applications a SET resume_id = r.id
+FROM resumes r
+WHERE a.resume_id IS NULL AND a.resume_version = r.name
+  AND r.application_id IS NULL AND r.tenant_id = a.tenant_id;
+
+ALTER TABLE resumes FORCE ROW LEVEL SECURITY;
+ALTER TABLE applications FORCE ROW LEVEL SECURITY;
@@ -0,0 +1,28 @@
+-- Named scrape presets. A profile holds the parameters of a scrape request
+-- so scrapes can be queued by name; profiles with a schedule are queued by
+-- the scheduler every schedule interval. Queued tasks record their profile.
+
+CREATE TABLE scrape_profiles (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(63) NOT NULL DEFAULT current_tenant(),
+    name VARCHAR(100) NOT NULL,
+    keywords TEXT[] NOT NULL DEFAULT '{}',
+    location VARCHAR(255),
+    sources job_source[] NOT NULL DEFAULT '{}',
+    max_jobs INTEGER NOT NULL DEFAULT 0,
+    schedule VARCHAR(32) NOT NULL DEFAULT '',
+    last_run_at TIMESTAMPTZ,
+    last_task_id UUID,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (tenant_id, name)
+);
+
+ALTER TABLE scrape_profiles ENABLE ROW LEVEL SECURITY;
+ALTER TABLE scrape_profiles FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON scrape_profiles
+    USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant());
+
+ALTER TABLE scrape_queue
+    ADD COLUMN max_jobs INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN profile_id UUID REFERENCES scrape_profiles(id) ON DELETE SET NULL;
@@ -0,0 +1,21 @@
+-- Shared timeline of background tasks. Scrapes, matching, embedding, digests
+-- and cleanups append an event at each step, keyed by the task's ID, so any
+-- background operation can be followed the same way.
+
+CREATE TABLE task_events (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(63) NOT NULL DEFAULT current_tenant(),
+    task_id UUID NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    type VARCHAR(20) NOT NULL,
+    message TEXT NOT NULL DEFAULT '',
+    data JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_task_events_task ON task_events(task_id, created_at);
+
+ALTER TABLE task_events ENABLE ROW LEVEL SECURITY;
+ALTER TABLE task_events FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON task_events
+    USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant());
@@ -0,0 +1,17 @@
+-- Notification preferences of each tenant's user: the channels each event
+-- is sent on, an optional daily quiet window, and digest-only mode.
+
+CREATE TABLE notification_preferences (
+    tenant_id VARCHAR(63) PRIMARY KEY DEFAULT current_tenant(),
+    channels JSONB NOT NULL DEFAULT '{}',
+    quiet_start VARCHAR(5),
+    quiet_end VARCHAR(5),
+    quiet_timezone VARCHAR(64),
+    digest_only BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+ALTER TABLE notification_preferences ENABLE ROW LEVEL SECURITY;
+ALTER TABLE notification_preferences FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON notification_preferences
+    USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant());
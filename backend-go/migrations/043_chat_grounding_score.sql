@@ -0,0 +1,3 @@
+-- Grounding score of assistant answers, kept with the chat history
+
+ALTER TABLE chat_messages ADD COLUMN grounding_score REAL;
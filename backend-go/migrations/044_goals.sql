@@ -0,0 +1,17 @@
+-- Job search goals of each tenant's user, at most one per metric and period.
+-- Progress is measured from applications and practice activity, not stored.
+
+CREATE TABLE goals (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    tenant_id VARCHAR(63) NOT NULL DEFAULT current_tenant(),
+    metric VARCHAR(32) NOT NULL,
+    target INTEGER NOT NULL CHECK (target > 0),
+    period VARCHAR(8) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (tenant_id, metric, period)
+);
+
+ALTER TABLE goals ENABLE ROW LEVEL SECURITY;
+ALTER TABLE goals FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON goals
+    USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant());
@@ -0,0 +1,40 @@
+-- Spaced-repetition review cards for practice questions, one per question
+-- per tenant, and the practice answers each tenant recorded per day.
+
+CREATE TABLE review_cards (
+    tenant_id VARCHAR(63) NOT NULL DEFAULT current_tenant(),
+    question_id TEXT NOT NULL,
+    question TEXT NOT NULL DEFAULT '',
+    category TEXT NOT NULL DEFAULT '',
+    difficulty INTEGER NOT NULL DEFAULT 0,
+    calibrated_difficulty DOUBLE PRECISION NOT NULL DEFAULT 0,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    average_score DOUBLE PRECISION NOT NULL DEFAULT 0,
+    ease DOUBLE PRECISION NOT NULL,
+    interval_days INTEGER NOT NULL DEFAULT 0,
+    streak INTEGER NOT NULL DEFAULT 0,
+    reviewed_at TIMESTAMPTZ NOT NULL,
+    due_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (tenant_id, question_id)
+);
+
+CREATE INDEX idx_review_cards_due ON review_cards(tenant_id, due_at);
+
+CREATE TABLE practice_activity (
+    tenant_id VARCHAR(63) NOT NULL DEFAULT current_tenant(),
+    day DATE NOT NULL,
+    answers INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (tenant_id, day)
+);
+
+DO $$
+DECLARE
+    t TEXT;
+BEGIN
+    FOREACH t IN ARRAY ARRAY['review_cards', 'practice_activity'] LOOP
+        EXECUTE format('ALTER TABLE %I ENABLE ROW LEVEL SECURITY', t);
+        EXECUTE format('ALTER TABLE %I FORCE ROW LEVEL SECURITY', t);
+        EXECUTE format('CREATE POLICY tenant_isolation ON %I USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant())', t);
+    END LOOP;
+END $$;
@@ -0,0 +1,18 @@
+-- The home location each tenant's commutes are estimated from
+
+CREATE TABLE commute_homes (
+    tenant_id VARCHAR(63) PRIMARY KEY DEFAULT current_tenant(),
+    address TEXT NOT NULL DEFAULT '',
+    city TEXT NOT NULL DEFAULT '',
+    state TEXT NOT NULL DEFAULT '',
+    country VARCHAR(2) NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    mode VARCHAR(16) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+ALTER TABLE commute_homes ENABLE ROW LEVEL SECURITY;
+ALTER TABLE commute_homes FORCE ROW LEVEL SECURITY;
+CREATE POLICY tenant_isolation ON commute_homes
+    USING (tenant_id = current_tenant()) WITH CHECK (tenant_id = current_tenant());
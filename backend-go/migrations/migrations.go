@@ -0,0 +1,235 @@
+// Package migrations applies the embedded SQL schema migrations. Files are
+// named NNN_description.sql and applied in version order, each in its own
+// transaction; applied versions are recorded in schema_migrations.
+package migrations
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// lockKey is the advisory lock held while migrating, so API replicas started
+// together do not apply the same migration twice
+const lockKey = 0x7265_7375_6d65 // "resume"
+
+// Migration is one embedded SQL file
+type Migration struct {
+	Version int
+	Name    string
+	SQL     string
+}
+
+// Status is a migration and when it was applied, if it was
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// All returns the embedded migrations in version order
+func All() ([]Migration, error) {
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	migrations := make([]Migration, 0, len(names))
+	seen := make(map[int]string, len(names))
+	for _, name := range names {
+		prefix, _, ok := strings.Cut(name, "_")
+		version, err := strconv.Atoi(prefix)
+		if !ok || err != nil {
+			return nil, fmt.Errorf("migration %s is not named NNN_description.sql", name)
+		}
+		if other, dup := seen[version]; dup {
+			return nil, fmt.Errorf("migrations %s and %s share version %d", other, name, version)
+		}
+		seen[version] = name
+
+		sql, err := files.ReadFile(name)
+		if err != nil {
+			return nil, err
+		}
+		migrations = append(migrations, Migration{
+			Version: version,
+			Name:    strings.TrimSuffix(name, ".sql"),
+			SQL:     string(sql),
+		})
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+	return migrations, nil
+}
+
+// Up applies every migration that has not been applied yet and returns them.
+// A failed migration is rolled back and stops the run.
+func Up(ctx context.Context, db *pgxpool.Pool) ([]Migration, error) {
+	var applied []Migration
+	err := withLock(ctx, db, func(conn *pgx.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		if len(done) == 0 {
+			if err := checkUntracked(ctx, conn); err != nil {
+				return err
+			}
+		}
+		all, err := All()
+		if err != nil {
+			return err
+		}
+		for _, m := range all {
+			if _, ok := done[m.Version]; ok {
+				continue
+			}
+			if err := apply(ctx, conn, m); err != nil {
+				return err
+			}
+			applied = append(applied, m)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Baseline records every migration up to version as applied without running
+// it, for databases whose schema was created before migrations were tracked
+func Baseline(ctx context.Context, db *pgxpool.Pool, version int) (int, error) {
+	marked := 0
+	err := withLock(ctx, db, func(conn *pgx.Conn) error {
+		all, err := All()
+		if err != nil {
+			return err
+		}
+		for _, m := range all {
+			if m.Version > version {
+				break
+			}
+			tag, err := conn.Exec(ctx, `
+				INSERT INTO schema_migrations (version, name) VALUES ($1, $2)
+				ON CONFLICT (version) DO NOTHING`, m.Version, m.Name)
+			if err != nil {
+				return fmt.Errorf("failed to record migration %s: %w", m.Name, err)
+			}
+			marked += int(tag.RowsAffected())
+		}
+		return nil
+	})
+	return marked, err
+}
+
+// List returns every embedded migration with when it was applied
+func List(ctx context.Context, db *pgxpool.Pool) ([]Status, error) {
+	var statuses []Status
+	err := withLock(ctx, db, func(conn *pgx.Conn) error {
+		done, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+		all, err := All()
+		if err != nil {
+			return err
+		}
+		for _, m := range all {
+			status := Status{Migration: m}
+			if at, ok := done[m.Version]; ok {
+				status.AppliedAt = &at
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// withLock runs fn on one connection while holding the migration lock, after
+// making sure the schema_migrations table exists
+func withLock(ctx context.Context, db *pgxpool.Pool, fn func(conn *pgx.Conn) error) error {
+	conn, err := db.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, lockKey); err != nil {
+		return fmt.Errorf("failed to take migration lock: %w", err)
+	}
+	defer func() {
+		_, _ = conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, lockKey)
+	}()
+
+	if _, err := conn.Exec(ctx, `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name VARCHAR(255) NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+		)`); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	return fn(conn.Conn())
+}
+
+// appliedVersions returns the applied migration versions with when they were applied
+func appliedVersions(ctx context.Context, conn *pgx.Conn) (map[int]time.Time, error) {
+	rows, err := conn.Query(ctx, `SELECT version, applied_at FROM schema_migrations`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+	defer rows.Close()
+
+	done := make(map[int]time.Time)
+	for rows.Next() {
+		var version int
+		var at time.Time
+		if err := rows.Scan(&version, &at); err != nil {
+			return nil, err
+		}
+		done[version] = at
+	}
+	return done, rows.Err()
+}
+
+// checkUntracked refuses to migrate a database whose schema was created
+// without recording migrations, where the first migration would fail halfway
+func checkUntracked(ctx context.Context, conn *pgx.Conn) error {
+	var exists bool
+	if err := conn.QueryRow(ctx, `SELECT to_regclass('jobs') IS NOT NULL`).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to inspect schema: %w", err)
+	}
+	if exists {
+		return errors.New("database schema was created without tracked migrations; " +
+			"mark the migrations it already has with `migrate baseline VERSION` first")
+	}
+	return nil
+}
+
+// apply runs one migration and records it in the same transaction
+func apply(ctx context.Context, conn *pgx.Conn, m Migration) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	// Without arguments the file is sent with the simple protocol, which
+	// accepts several statements at once
+	if _, err := tx.Exec(ctx, m.SQL); err != nil {
+		return fmt.Errorf("migration %s failed: %w", m.Name, err)
+	}
+	if _, err := tx.Exec(ctx, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, m.Version, m.Name); err != nil {
+		return fmt.Errorf("failed to record migration %s: %w", m.Name, err)
+	}
+	return tx.Commit(ctx)
+}
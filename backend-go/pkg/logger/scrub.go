@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"fmt"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// SetScrubber passes every log message and string field through scrub
+// before it is written, e.g. to strip personal data
+func SetScrubber(scrub func(string) string) {
+	log = Get().WithOptions(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return &scrubCore{Core: core, scrub: scrub}
+	}))
+}
+
+// scrubCore scrubs entries before handing them to the wrapped core
+type scrubCore struct {
+	zapcore.Core
+	scrub func(string) string
+}
+
+func (c *scrubCore) With(fields []zapcore.Field) zapcore.Core {
+	return &scrubCore{Core: c.Core.With(c.fields(fields)), scrub: c.scrub}
+}
+
+func (c *scrubCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c *scrubCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = c.scrub(entry.Message)
+	return c.Core.Write(entry, c.fields(fields))
+}
+
+// fields scrubs string-like fields. Errors and stringers are rendered to
+// strings first; other types are written as they are.
+func (c *scrubCore) fields(fields []zapcore.Field) []zapcore.Field {
+	out := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		switch f.Type {
+		case zapcore.StringType:
+			f.String = c.scrub(f.String)
+		case zapcore.ByteStringType:
+			f = zap.String(f.Key, c.scrub(string(f.Interface.([]byte))))
+		case zapcore.ErrorType:
+			if err, ok := f.Interface.(error); ok && err != nil {
+				f = zap.String(f.Key, c.scrub(err.Error()))
+			}
+		case zapcore.StringerType:
+			if s, ok := f.Interface.(fmt.Stringer); ok {
+				f = zap.String(f.Key, c.scrub(s.String()))
+			}
+		}
+		out[i] = f
+	}
+	return out
+}